@@ -7,41 +7,54 @@ If a copy of the MPL was not distributed with this file,You can obtain one at ht
 package bigip
 
 import (
+	"crypto/sha256"
+	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
+	"strings"
+	"time"
 
 	bigip "github.com/f5devcentral/go-bigip"
 )
 
 func Client(config *bigip.Config) (*bigip.BigIP, error) {
+	return newClient(config, nil, nil)
+}
+
+// newClient creates the BIG-IP session. When hooks are given they are installed before the first
+// request, so token login and connection validation go through them like every later call.
+// With a client certificate the session authenticates through mutual TLS and skips the token login.
+// A server fingerprint in hooks replaces the CA validation of the BIG-IP certificate.
+func newClient(config *bigip.Config, hooks *transportHooks, clientCert *tls.Certificate) (*bigip.BigIP, error) {
 
 	log.Println("[INFO] Initializing BigIP connection")
-	var client *bigip.BigIP
 	var err error
+	client := bigip.NewSession(config)
+	// The provider will use the Token value instead of the password
+	if config.Token != "" {
+		client.Token = config.Token
+	}
+	pinned := hooks != nil && hooks.serverFingerprint != ""
+	if hooks.configured() {
+		installTransportHooks(client, hooks)
+	}
 	// If we have a token value, we do not want to authenticate using a
 	// Token Session. The user has already authenticated with the BigIP
 	// outside of the provider, so even if the BigIP is using Token Auth,
-	// we don't want to do that here. We want to use bigip.NewSession
-	if config.LoginReference != "" && config.Token == "" && config.Address != "" {
-		client, err = bigip.NewTokenSession(config)
-		// client, err = bigip.NewTokenSession(c)
-		if err != nil {
-			log.Printf("[ERROR] Error creating New Token Session %s ", err)
-			return nil, err
-		}
-
-	} else {
-		client = bigip.NewSession(config)
-		// The provider will use the Token value instead of the password
-		if config.Token != "" {
-			client.Token = config.Token
-		}
-	}
-	if config.Address != "" && config.Username != "" && config.Password != "" {
+	// we don't want to do that here.
+	certAuth := clientCert != nil && config.Address != ""
+	tokenSession := config.LoginReference != "" && config.Token == "" && config.Address != "" && !certAuth
+	hasCredentials := config.Address != "" && config.Username != "" && config.Password != ""
+	if tokenSession || hasCredentials || certAuth {
 		client.Transport.TLSClientConfig.InsecureSkipVerify = config.CertVerifyDisable
-		if !config.CertVerifyDisable {
+		if certAuth {
+			client.Transport.TLSClientConfig.Certificates = []tls.Certificate{*clientCert}
+		}
+		if !config.CertVerifyDisable && !pinned {
 			rootCAs, _ := x509.SystemCertPool()
 			if rootCAs == nil {
 				rootCAs = x509.NewCertPool()
@@ -49,22 +62,178 @@ func Client(config *bigip.Config) (*bigip.BigIP, error) {
 			certPEM, err := os.ReadFile(config.TrustedCertificate)
 			if err != nil {
 				return nil, fmt.Errorf("provide Valid Trusted certificate path :%+v", err)
-				// log.Printf("[DEBUG]read cert PEM/crt file error:%+v", err)
 			}
-			// TODO: Make sure appMgr sets certificates in bigipInfo
-			// certs := certPEM)
-
 			// Append our certs to the system pool
 			if ok := rootCAs.AppendCertsFromPEM(certPEM); !ok {
 				log.Println("[DEBUG] No certs appended, using only system certs")
 			}
 			client.Transport.TLSClientConfig.RootCAs = rootCAs
 		}
+	}
+	if pinned {
+		if err := pinServerCertificate(client.Transport.TLSClientConfig, hooks.serverFingerprint); err != nil {
+			return nil, err
+		}
+	}
+	if tokenSession {
+		if err := tokenLogin(client, config); err != nil {
+			log.Printf("[ERROR] Error creating New Token Session %s ", err)
+			return nil, classicBigipError(client, err)
+		}
+	}
+	if hasCredentials || certAuth {
 		err = client.ValidateConnection()
 		if err == nil {
 			return client, nil
 		}
+		if certAuth && strings.Contains(err.Error(), "tls:") {
+			return client, fmt.Errorf("TLS handshake with client certificate %s failed: %v", clientCertificateSubject(clientCert), err)
+		}
+		err = classicBigipError(client, err)
 	}
 	return client, err
 
 }
+
+// loadClientCertificate builds the client certificate from either the PEM files or the PEM
+// content, a nil certificate is returned when none is configured.
+func loadClientCertificate(certFile, keyFile, certPEM, keyPEM string) (*tls.Certificate, error) {
+	if certFile == "" && keyFile == "" && certPEM == "" && keyPEM == "" {
+		return nil, nil
+	}
+	var err error
+	if certPEM == "" && certFile != "" {
+		var b []byte
+		if b, err = os.ReadFile(certFile); err != nil {
+			return nil, fmt.Errorf("unable to read client certificate %s: %v", certFile, err)
+		}
+		certPEM = string(b)
+	}
+	if keyPEM == "" && keyFile != "" {
+		var b []byte
+		if b, err = os.ReadFile(keyFile); err != nil {
+			return nil, fmt.Errorf("unable to read client key %s: %v", keyFile, err)
+		}
+		keyPEM = string(b)
+	}
+	if certPEM == "" || keyPEM == "" {
+		return nil, fmt.Errorf("client certificate authentication needs both a certificate and a key")
+	}
+	cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		return nil, fmt.Errorf("invalid client certificate: %v", err)
+	}
+	return &cert, nil
+}
+
+// clientCertificateSubject returns the subject of the leaf of cert, for error messages.
+func clientCertificateSubject(cert *tls.Certificate) string {
+	if len(cert.Certificate) == 0 {
+		return "(empty)"
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return "(unparsable)"
+	}
+	return leaf.Subject.String()
+}
+
+// certificateFingerprint returns the SHA-256 fingerprint of the DER encoded certificate der, as the
+// colon separated upper case hex pairs openssl x509 -fingerprint -sha256 prints.
+func certificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return formatFingerprint(sum[:])
+}
+
+func formatFingerprint(sum []byte) string {
+	pairs := make([]string, len(sum))
+	for i, b := range sum {
+		pairs[i] = strings.ToUpper(hex.EncodeToString([]byte{b}))
+	}
+	return strings.Join(pairs, ":")
+}
+
+// normalizeCertificateFingerprint returns fingerprint in the format of certificateFingerprint. Colons,
+// spaces, the case of the hex digits and the SHA256/ prefix the BIG-IP reports are ignored, so a
+// fingerprint can be pasted from any tool.
+func normalizeCertificateFingerprint(fingerprint string) (string, error) {
+	digits := strings.NewReplacer(":", "", " ", "").Replace(strings.TrimPrefix(fingerprint, "SHA256/"))
+	sum, err := hex.DecodeString(digits)
+	if err != nil || len(sum) != sha256.Size {
+		return "", fmt.Errorf("%q is not a SHA-256 fingerprint, expected 32 hex encoded bytes", fingerprint)
+	}
+	return formatFingerprint(sum), nil
+}
+
+// pinServerCertificate makes tlsConfig accept a BIG-IP only when the SHA-256 fingerprint of the leaf
+// certificate it presents is fingerprint, whether or not a trusted CA issued it. This lets the
+// provider verify devices with self-signed certificates without disabling the verification.
+func pinServerCertificate(tlsConfig *tls.Config, fingerprint string) error {
+	pin, err := normalizeCertificateFingerprint(fingerprint)
+	if err != nil {
+		return err
+	}
+	// the CA validation is replaced by the pin, which is checked on every handshake
+	tlsConfig.InsecureSkipVerify = true
+	tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return fmt.Errorf("BIG-IP presented no TLS certificate, expected fingerprint %s", pin)
+		}
+		if actual := certificateFingerprint(rawCerts[0]); actual != pin {
+			return fmt.Errorf("BIG-IP TLS certificate fingerprint %s does not match tls_server_fingerprint %s, "+
+				"update tls_server_fingerprint if the device certificate was replaced on purpose", actual, pin)
+		}
+		return nil
+	}
+	return nil
+}
+
+// tokenLogin authenticates client against the login provider of config and applies the configured
+// token timeout, the same way bigip.NewTokenSession does, but on an already built session.
+func tokenLogin(client *bigip.BigIP, config *bigip.Config) error {
+	auth := map[string]string{
+		"username":          config.Username,
+		"password":          config.Password,
+		"loginProviderName": config.LoginReference,
+	}
+	resp, err := restCall(client, "post", "mgmt/shared/authn/login", auth)
+	if err != nil {
+		return err
+	}
+	var authResp struct {
+		Token struct {
+			Token string `json:"token"`
+		} `json:"token"`
+		Timeout struct {
+			Timeout int64 `json:"timeout"`
+		} `json:"timeout"`
+	}
+	if err := json.Unmarshal(resp, &authResp); err != nil {
+		return err
+	}
+	if authResp.Token.Token == "" {
+		return fmt.Errorf("unable to acquire authentication token")
+	}
+	client.Token = authResp.Token.Token
+
+	// Once we have obtained a token, we should actually apply the configured timeout to it
+	tokenTimeout := client.ConfigOptions.TokenTimeout
+	if time.Duration(authResp.Timeout.Timeout)*time.Second == tokenTimeout {
+		return nil
+	}
+	body := map[string]int64{"timeout": int64(tokenTimeout.Seconds())}
+	resp, err = restCall(client, "patch", "mgmt/shared/authz/tokens/"+client.Token, body)
+	if err != nil {
+		return err
+	}
+	var timeoutResp struct {
+		Timeout int64 `json:"timeout"`
+	}
+	if err := json.Unmarshal(resp, &timeoutResp); err != nil {
+		return err
+	}
+	if time.Duration(timeoutResp.Timeout)*time.Second != tokenTimeout {
+		return fmt.Errorf("failed to update token lifespan")
+	}
+	return nil
+}
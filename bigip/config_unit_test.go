@@ -0,0 +1,183 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+// testClientCertificatePEM returns a self-signed client certificate and its key for commonName.
+func testClientCertificatePEM(t *testing.T, commonName string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestLoadClientCertificate(t *testing.T) {
+	certPEM, keyPEM := testClientCertificatePEM(t, "terraform")
+
+	cert, err := loadClientCertificate("", "", "", "")
+	assert.NoError(t, err)
+	assert.Nil(t, cert)
+
+	_, err = loadClientCertificate("", "", certPEM, "")
+	assert.EqualError(t, err, "client certificate authentication needs both a certificate and a key")
+
+	cert, err = loadClientCertificate("", "", certPEM, keyPEM)
+	assert.NoError(t, err)
+	assert.Equal(t, "CN=terraform", clientCertificateSubject(cert))
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	assert.NoError(t, os.WriteFile(certFile, []byte(certPEM), 0600))
+	assert.NoError(t, os.WriteFile(keyFile, []byte(keyPEM), 0600))
+	cert, err = loadClientCertificate(certFile, keyFile, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "CN=terraform", clientCertificateSubject(cert))
+
+	_, err = loadClientCertificate(filepath.Join(dir, "missing.crt"), keyFile, "", "")
+	assert.Error(t, err)
+}
+
+func TestNewClientClientCertificate(t *testing.T) {
+	certPEM, keyPEM := testClientCertificatePEM(t, "terraform")
+	cert, err := loadClientCertificate("", "", certPEM, keyPEM)
+	assert.NoError(t, err)
+
+	var peer string
+	login := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mgmt/shared/authn/login", func(w http.ResponseWriter, r *http.Request) {
+		login = true
+	})
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) > 0 {
+			peer = r.TLS.PeerCertificates[0].Subject.CommonName
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+	server := httptest.NewUnstartedServer(mux)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	config := &bigip.Config{
+		Address:           server.URL,
+		LoginReference:    "tmos",
+		CertVerifyDisable: true,
+		ConfigOptions:     &bigip.ConfigOptions{TokenTimeout: 1200 * time.Second, APICallTimeout: 60 * time.Second, APICallRetries: 1},
+	}
+	_, err = newClient(config, nil, cert)
+	assert.NoError(t, err)
+	assert.Equal(t, "terraform", peer)
+	assert.False(t, login, "token login attempted with a client certificate")
+}
+
+func TestNewClientClientCertificateHandshakeFailure(t *testing.T) {
+	certPEM, keyPEM := testClientCertificatePEM(t, "terraform")
+	cert, err := loadClientCertificate("", "", certPEM, keyPEM)
+	assert.NoError(t, err)
+
+	// The server trusts no client CA, so the handshake fails on the client certificate.
+	server := httptest.NewUnstartedServer(http.NewServeMux())
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: x509.NewCertPool()}
+	server.StartTLS()
+	defer server.Close()
+
+	config := &bigip.Config{
+		Address:           server.URL,
+		CertVerifyDisable: true,
+		ConfigOptions:     &bigip.ConfigOptions{TokenTimeout: 1200 * time.Second, APICallTimeout: 60 * time.Second, APICallRetries: 1},
+	}
+	_, err = newClient(config, nil, cert)
+	assert.ErrorContains(t, err, "TLS handshake with client certificate CN=terraform failed")
+}
+
+func TestNormalizeCertificateFingerprint(t *testing.T) {
+	want := "AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89"
+	for _, fingerprint := range []string{
+		want,
+		"abcdef0123456789abcdef0123456789abcdef0123456789abcdef0123456789",
+		"ab cd ef 01 23 45 67 89 ab cd ef 01 23 45 67 89 ab cd ef 01 23 45 67 89 ab cd ef 01 23 45 67 89",
+		"SHA256/" + want,
+	} {
+		got, err := normalizeCertificateFingerprint(fingerprint)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	for _, fingerprint := range []string{"", "AB:CD", "zz" + want[2:], want + ":00"} {
+		_, err := normalizeCertificateFingerprint(fingerprint)
+		assert.Error(t, err, fingerprint)
+	}
+}
+
+func TestNewClientPinnedServerCertificate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+	// the test server presents a self-signed certificate, no CA is configured
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+	actual := certificateFingerprint(server.Certificate().Raw)
+
+	newConfig := func() *bigip.Config {
+		return &bigip.Config{
+			Address:       server.URL,
+			Username:      "xxxx",
+			Password:      "xxxx",
+			ConfigOptions: &bigip.ConfigOptions{TokenTimeout: 1200 * time.Second, APICallTimeout: 60 * time.Second, APICallRetries: 1},
+		}
+	}
+	client, err := newClient(newConfig(), &transportHooks{serverFingerprint: strings.ToLower(actual)}, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, client.Transport.TLSClientConfig.VerifyPeerCertificate)
+
+	pinned := "00:" + actual[3:]
+	if pinned == actual {
+		pinned = "11:" + actual[3:]
+	}
+	_, err = newClient(newConfig(), &transportHooks{serverFingerprint: pinned}, nil)
+	// the error names the fingerprint of the certificate presented, so the pin can be updated
+	assert.ErrorContains(t, err, fmt.Sprintf("BIG-IP TLS certificate fingerprint %s does not match tls_server_fingerprint %s", actual, pinned))
+
+	_, err = newClient(newConfig(), &transportHooks{serverFingerprint: "not-a-fingerprint"}, nil)
+	assert.ErrorContains(t, err, "is not a SHA-256 fingerprint")
+}
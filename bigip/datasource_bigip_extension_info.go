@@ -0,0 +1,125 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	uriAs3Info  = "mgmt/shared/appsvcs/info"
+	uriDoInfo   = "mgmt/shared/declarative-onboarding/info"
+	uriTsInfo   = "mgmt/shared/telemetry/info"
+	uriFastInfo = "mgmt/shared/fast/info"
+)
+
+// extensionInfo is the version information returned by the info endpoint of the F5 Automation
+// Toolchain extensions.
+type extensionInfo struct {
+	Version       string `json:"version"`
+	Release       string `json:"release"`
+	SchemaCurrent string `json:"schemaCurrent"`
+	SchemaMinimum string `json:"schemaMinimum"`
+}
+
+func dataSourceBigipAs3Info() *schema.Resource {
+	return dataSourceBigipExtensionInfo("AS3", uriAs3Info)
+}
+
+func dataSourceBigipDoInfo() *schema.Resource {
+	return dataSourceBigipExtensionInfo("DO", uriDoInfo)
+}
+
+func dataSourceBigipTsInfo() *schema.Resource {
+	return dataSourceBigipExtensionInfo("TS", uriTsInfo)
+}
+
+func dataSourceBigipFastInfo() *schema.Resource {
+	return dataSourceBigipExtensionInfo("FAST", uriFastInfo)
+}
+
+// dataSourceBigipExtensionInfo builds the data source exposing the installed version of the
+// extension served at uri.
+func dataSourceBigipExtensionInfo(extension, uri string) *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return dataSourceBigipExtensionInfoRead(ctx, d, meta.(*bigip.BigIP), extension, uri)
+		},
+		Schema: map[string]*schema.Schema{
+			"installed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether %s is installed on the BIG-IP", extension),
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Installed %s version, e.g. 3.50.0", extension),
+			},
+			"release": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Release number of the installed %s package", extension),
+			},
+			"schema_current": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Latest declaration schema version supported",
+			},
+			"schema_minimum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Oldest declaration schema version supported",
+			},
+		},
+	}
+}
+
+func dataSourceBigipExtensionInfoRead(ctx context.Context, d *schema.ResourceData, client *bigip.BigIP, extension, uri string) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s info", extension))
+	info, installed, err := getExtensionInfo(client, uri)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving %s info: %v", extension, err))
+	}
+	_ = d.Set("installed", installed)
+	_ = d.Set("version", info.Version)
+	_ = d.Set("release", info.Release)
+	_ = d.Set("schema_current", info.SchemaCurrent)
+	_ = d.Set("schema_minimum", info.SchemaMinimum)
+	d.SetId(uri)
+	return nil
+}
+
+// getExtensionInfo reads the info endpoint at uri. An extension which is not installed answers
+// 404, reported as installed = false. DO returns its info wrapped in a single element list.
+func getExtensionInfo(client *bigip.BigIP, uri string) (*extensionInfo, bool, error) {
+	info := &extensionInfo{}
+	resp, err := restCall(client, "get", uri, nil)
+	if isRestNotFound(resp, err) {
+		return info, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	var infoList []extensionInfo
+	if json.Unmarshal(resp, &infoList) == nil {
+		if len(infoList) > 0 {
+			info = &infoList[0]
+		}
+		return info, true, nil
+	}
+	if err := json.Unmarshal(resp, info); err != nil {
+		return nil, false, err
+	}
+	return info, true, nil
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+
+package bigip
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBigipAs3Info_basic(t *testing.T) {
+	dataSourceName := "data.bigip_as3_info.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAcctPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "bigip_as3_info" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "installed", "true"),
+					resource.TestMatchResourceAttr(dataSourceName, "version", regexp.MustCompile(`^\d+\.\d+\.\d+$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "schema_current"),
+				),
+			},
+		},
+	})
+}
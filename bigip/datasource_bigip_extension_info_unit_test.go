@@ -0,0 +1,56 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetExtensionInfo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+uriAs3Info, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"version":"3.50.0","release":"5","schemaCurrent":"3.50.0","schemaMinimum":"3.0.0"}`)
+	})
+	mux.HandleFunc("/"+uriDoInfo, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `[{"id":0,"result":{"class":"Result","code":200},"version":"1.41.0","release":"8","schemaCurrent":"1.41.0","schemaMinimum":"1.0.0"}]`)
+	})
+	mux.HandleFunc("/"+uriTsInfo, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"Public URI path not registered: /shared/telemetry/info"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	info, installed, err := getExtensionInfo(client, uriAs3Info)
+	assert.NoError(t, err)
+	assert.True(t, installed)
+	assert.Equal(t, &extensionInfo{Version: "3.50.0", Release: "5", SchemaCurrent: "3.50.0", SchemaMinimum: "3.0.0"}, info)
+
+	info, installed, err = getExtensionInfo(client, uriDoInfo)
+	assert.NoError(t, err)
+	assert.True(t, installed)
+	assert.Equal(t, "1.41.0", info.Version)
+	assert.Equal(t, "8", info.Release)
+
+	info, installed, err = getExtensionInfo(client, uriTsInfo)
+	assert.NoError(t, err)
+	assert.False(t, installed)
+	assert.Equal(t, "", info.Version)
+}
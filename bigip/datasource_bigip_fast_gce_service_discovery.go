@@ -54,8 +54,9 @@ func dataSourceBigipFastGceServiceDiscovery() *schema.Resource {
 				Description: "Specifies whether you are updating your credentials",
 			},
 			"encoded_credentials": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
 			},
 			"project_id": {
 				Type:     schema.TypeString,
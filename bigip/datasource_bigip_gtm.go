@@ -0,0 +1,313 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// gtmRecordTypes are the DNS record types of the wide IPs and GTM pools the data sources read.
+var gtmRecordTypes = []string{"a", "aaaa", "cname", "mx"}
+
+// gtmWideIP is a wide IP as read from gtm/wideip/<type>.
+type gtmWideIP struct {
+	Name           string   `json:"name"`
+	Partition      string   `json:"partition"`
+	FullPath       string   `json:"fullPath"`
+	Description    string   `json:"description,omitempty"`
+	Disabled       bool     `json:"disabled,omitempty"`
+	PoolLbMode     string   `json:"poolLbMode,omitempty"`
+	Persistence    string   `json:"persistence,omitempty"`
+	TtlPersistence int      `json:"ttlPersistence,omitempty"`
+	Aliases        []string `json:"aliases,omitempty"`
+	Pools          []struct {
+		Name      string `json:"name"`
+		Partition string `json:"partition"`
+		Order     int    `json:"order"`
+		Ratio     int    `json:"ratio"`
+	} `json:"pools,omitempty"`
+}
+
+// gtmPool is a GTM pool as read from gtm/pool/<type> with its members expanded.
+type gtmPool struct {
+	Name              string `json:"name"`
+	Partition         string `json:"partition"`
+	FullPath          string `json:"fullPath"`
+	Description       string `json:"description,omitempty"`
+	Disabled          bool   `json:"disabled,omitempty"`
+	LoadBalancingMode string `json:"loadBalancingMode,omitempty"`
+	AlternateMode     string `json:"alternateMode,omitempty"`
+	FallbackMode      string `json:"fallbackMode,omitempty"`
+	MembersReference  struct {
+		Items []struct {
+			Name        string `json:"name"`
+			Partition   string `json:"partition"`
+			Disabled    bool   `json:"disabled,omitempty"`
+			MemberOrder int    `json:"memberOrder"`
+			Ratio       int    `json:"ratio"`
+		} `json:"items"`
+	} `json:"membersReference"`
+}
+
+func dataSourceBigipGtmWideIP() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipGtmWideIPRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the wide IP, e.g. www.example.com",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(gtmRecordTypes, false),
+				Description:  "DNS record type of the wide IP: a, aaaa, cname or mx",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Common",
+				Description: "Partition of the wide IP",
+			},
+			"full_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the wide IP",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User defined description of the wide IP",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the wide IP is enabled",
+			},
+			"pool_lb_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Load balancing mode used to select a pool of the wide IP",
+			},
+			"persistence": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether persistence is enabled for the wide IP",
+			},
+			"ttl_persistence": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Persistence time in seconds",
+			},
+			"aliases": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Alternate names of the wide IP",
+			},
+			"pools": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Pools of the wide IP, in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Full path of the GTM pool",
+						},
+						"order": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Order of the pool in the wide IP",
+						},
+						"ratio": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Ratio of the pool for the ratio load balancing mode",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipGtmPool() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipGtmPoolRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the GTM pool",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(gtmRecordTypes, false),
+				Description:  "DNS record type of the GTM pool: a, aaaa, cname or mx",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Common",
+				Description: "Partition of the GTM pool",
+			},
+			"full_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the GTM pool",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User defined description of the GTM pool",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the GTM pool is enabled",
+			},
+			"load_balancing_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Preferred load balancing mode of the GTM pool",
+			},
+			"alternate_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Load balancing mode used when the preferred mode fails",
+			},
+			"fallback_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Load balancing mode used when the alternate mode fails",
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Members of the GTM pool, in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the member, <server>:<virtual server> for a and aaaa pools",
+						},
+						"partition": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Partition of the member",
+						},
+						"order": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Order of the member in the pool",
+						},
+						"ratio": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Ratio of the member for the ratio load balancing mode",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the member is enabled",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipGtmWideIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	d.SetId("")
+	recordType := d.Get("type").(string)
+	partition := d.Get("partition").(string)
+	name := fmt.Sprintf("/%s/%s", partition, d.Get("name").(string))
+
+	tflog.Info(ctx, fmt.Sprintf("Reading GTM wide IP %s of type %s", name, recordType))
+	wideIP := &gtmWideIP{}
+	found, err := getRestEntity(client, wideIP, restObjectPath("gtm/wideip/"+recordType, name))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving GTM wide IP %s of type %s: %v", name, recordType, err))
+	}
+	if !found {
+		return diag.FromErr(fmt.Errorf("GTM wide IP %s of type %s not found in partition %s", d.Get("name").(string), recordType, partition))
+	}
+
+	sort.SliceStable(wideIP.Pools, func(i, j int) bool { return wideIP.Pools[i].Order < wideIP.Pools[j].Order })
+	pools := make([]interface{}, 0, len(wideIP.Pools))
+	for _, p := range wideIP.Pools {
+		pools = append(pools, map[string]interface{}{
+			"name":  fmt.Sprintf("/%s/%s", p.Partition, p.Name),
+			"order": p.Order,
+			"ratio": p.Ratio,
+		})
+	}
+	_ = d.Set("full_path", wideIP.FullPath)
+	_ = d.Set("description", wideIP.Description)
+	_ = d.Set("enabled", !wideIP.Disabled)
+	_ = d.Set("pool_lb_mode", wideIP.PoolLbMode)
+	_ = d.Set("persistence", wideIP.Persistence == "enabled")
+	_ = d.Set("ttl_persistence", wideIP.TtlPersistence)
+	_ = d.Set("aliases", wideIP.Aliases)
+	_ = d.Set("pools", pools)
+	d.SetId(recordType + ":" + wideIP.FullPath)
+	return nil
+}
+
+func dataSourceBigipGtmPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	d.SetId("")
+	recordType := d.Get("type").(string)
+	partition := d.Get("partition").(string)
+	name := fmt.Sprintf("/%s/%s", partition, d.Get("name").(string))
+
+	tflog.Info(ctx, fmt.Sprintf("Reading GTM pool %s of type %s", name, recordType))
+	pool := &gtmPool{}
+	found, err := getRestEntity(client, pool, restObjectPath("gtm/pool/"+recordType, name)+"?expandSubcollections=true")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving GTM pool %s of type %s: %v", name, recordType, err))
+	}
+	if !found {
+		return diag.FromErr(fmt.Errorf("GTM pool %s of type %s not found in partition %s", d.Get("name").(string), recordType, partition))
+	}
+
+	items := pool.MembersReference.Items
+	sort.SliceStable(items, func(i, j int) bool { return items[i].MemberOrder < items[j].MemberOrder })
+	members := make([]interface{}, 0, len(items))
+	for _, m := range items {
+		members = append(members, map[string]interface{}{
+			"name":      m.Name,
+			"partition": m.Partition,
+			"order":     m.MemberOrder,
+			"ratio":     m.Ratio,
+			"enabled":   !m.Disabled,
+		})
+	}
+	_ = d.Set("full_path", pool.FullPath)
+	_ = d.Set("description", pool.Description)
+	_ = d.Set("enabled", !pool.Disabled)
+	_ = d.Set("load_balancing_mode", pool.LoadBalancingMode)
+	_ = d.Set("alternate_mode", pool.AlternateMode)
+	_ = d.Set("fallback_mode", pool.FallbackMode)
+	_ = d.Set("members", members)
+	d.SetId(recordType + ":" + pool.FullPath)
+	return nil
+}
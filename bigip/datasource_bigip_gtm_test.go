@@ -0,0 +1,50 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+
+package bigip
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBigipGtmWideIPDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAcctPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "bigip_gtm_wideip" "missing" {
+  name = "no-such-wideip.example.com"
+  type = "a"
+}
+`,
+				ExpectError: regexp.MustCompile("GTM wide IP no-such-wideip.example.com of type a not found in partition Common"),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmPoolDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAcctPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "bigip_gtm_pool" "missing" {
+  name = "no-such-pool"
+  type = "cname"
+}
+`,
+				ExpectError: regexp.MustCompile("GTM pool no-such-pool of type cname not found in partition Common"),
+			},
+		},
+	})
+}
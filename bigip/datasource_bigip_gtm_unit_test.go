@@ -0,0 +1,98 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceBigipGtmWideIPRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/gtm/wideip/a/~Common~www.example.com", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"name":"www.example.com","partition":"Common","fullPath":"/Common/www.example.com","enabled":true,
+			"poolLbMode":"round-robin","persistence":"enabled","ttlPersistence":3600,"aliases":["web.example.com"],
+			"pools":[{"name":"dc2","partition":"Common","order":1,"ratio":1},{"name":"dc1","partition":"Common","order":0,"ratio":2}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/gtm/wideip/aaaa/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested wide IP was not found."}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceBigipGtmWideIP().Schema, map[string]interface{}{"name": "www.example.com", "type": "a"})
+	diags := dataSourceBigipGtmWideIPRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "a:/Common/www.example.com", d.Id())
+	assert.Equal(t, true, d.Get("enabled"))
+	assert.Equal(t, true, d.Get("persistence"))
+	assert.Equal(t, 3600, d.Get("ttl_persistence"))
+	assert.Equal(t, "round-robin", d.Get("pool_lb_mode"))
+	assert.Equal(t, []interface{}{"web.example.com"}, d.Get("aliases"))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "/Common/dc1", "order": 0, "ratio": 2},
+		map[string]interface{}{"name": "/Common/dc2", "order": 1, "ratio": 1},
+	}, d.Get("pools"))
+
+	d = schema.TestResourceDataRaw(t, dataSourceBigipGtmWideIP().Schema, map[string]interface{}{"name": "www.example.com", "type": "aaaa"})
+	diags = dataSourceBigipGtmWideIPRead(context.Background(), d, client)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "GTM wide IP www.example.com of type aaaa not found in partition Common", diags[0].Summary)
+}
+
+func TestDataSourceBigipGtmPoolRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/gtm/pool/a/~Common~dc1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("expandSubcollections"))
+		_, _ = fmt.Fprintf(w, `{"name":"dc1","partition":"Common","fullPath":"/Common/dc1","enabled":true,
+			"loadBalancingMode":"global-availability","alternateMode":"round-robin","fallbackMode":"return-to-dns",
+			"membersReference":{"items":[
+				{"name":"dc1-bigip:/Common/vs-web2","partition":"Common","disabled":true,"memberOrder":1,"ratio":1},
+				{"name":"dc1-bigip:/Common/vs-web1","partition":"Common","enabled":true,"memberOrder":0,"ratio":1}
+			]}}`)
+	})
+	mux.HandleFunc("/mgmt/tm/gtm/pool/cname/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested pool was not found."}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceBigipGtmPool().Schema, map[string]interface{}{"name": "dc1", "type": "a"})
+	diags := dataSourceBigipGtmPoolRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "a:/Common/dc1", d.Id())
+	assert.Equal(t, "global-availability", d.Get("load_balancing_mode"))
+	assert.Equal(t, "return-to-dns", d.Get("fallback_mode"))
+	assert.Equal(t, 2, d.Get("members.#"))
+	assert.Equal(t, "dc1-bigip:/Common/vs-web1", d.Get("members.0.name"))
+	assert.Equal(t, true, d.Get("members.0.enabled"))
+	assert.Equal(t, false, d.Get("members.1.enabled"))
+
+	d = schema.TestResourceDataRaw(t, dataSourceBigipGtmPool().Schema, map[string]interface{}{"name": "dc1", "type": "cname", "partition": "Common"})
+	diags = dataSourceBigipGtmPoolRead(context.Background(), d, client)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "GTM pool dc1 of type cname not found in partition Common", diags[0].Summary)
+}
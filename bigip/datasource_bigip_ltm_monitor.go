@@ -138,7 +138,7 @@ func dataSourceBigipLtmMonitorRead(ctx context.Context, d *schema.ResourceData,
 	d.SetId("")
 	name := fmt.Sprintf("/%s/%s", d.Get("partition").(string), d.Get("name").(string))
 	log.Printf("[DEBUG] Retrieving Monitor: %s", name)
-	monitors, err := client.Monitors()
+	monitors, err := listLtmMonitors(client)
 	if err != nil {
 		log.Printf("[ERROR] Unable to retrieve Monitor (%s) (%v) ", name, err)
 		return diag.FromErr(err)
@@ -0,0 +1,212 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriLtmNode = "ltm/node"
+
+// bigip_ltm_nodes lists the nodes matching an address, e.g. to find the node a pool member address
+// already has whatever its name, or a name pattern. The address and partition are filtered by the
+// BIG-IP, the name pattern once the nodes are read.
+func dataSourceBigipLtmNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipLtmNodesRead,
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Address of the nodes to list, with its route domain suffix for the nodes outside the default route domain, e.g. 10.1.1.1%2",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression the names of the nodes to list match",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Partition of the nodes to list, all of them by default",
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Nodes matching the filters, ordered by full path",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the node",
+						},
+						"partition": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Partition of the node",
+						},
+						"full_path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Full path of the node",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the node, any6 for an FQDN node",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User defined description of the node",
+						},
+						"monitor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Monitor rule of the node",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the node",
+						},
+						"session": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Session state of the node",
+						},
+						"fqdn": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "FQDN settings of the node, empty for an IP address node",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Fully qualified domain name of the node",
+									},
+									"address_family": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Address family of the addresses the name resolves to",
+									},
+									"autopopulate": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Specifies whether the node scales to the addresses the name resolves to",
+									},
+									"interval": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Time between two resolutions of the name",
+									},
+									"downinterval": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Number of failed resolutions after which the node is marked down",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipLtmNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	address := d.Get("address").(string)
+	partition := d.Get("partition").(string)
+
+	var nameRegex *regexp.Regexp
+	if expr := d.Get("name_regex").(string); expr != "" {
+		var err error
+		if nameRegex, err = regexp.Compile(expr); err != nil {
+			return diag.FromErr(fmt.Errorf("error reading name_regex: %v", err))
+		}
+	}
+
+	uri := ltmNodesURI(address, partition)
+	nodes, err := getRestItems[bigip.Node](client, uri)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading nodes: %v", err))
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].FullPath < nodes[j].FullPath })
+
+	var matches []map[string]interface{}
+	for _, node := range nodes {
+		// The filters are checked again, in case the BIG-IP ignored them.
+		if address != "" && node.Address != address {
+			continue
+		}
+		if partition != "" && node.Partition != partition {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(node.Name) {
+			continue
+		}
+		matches = append(matches, flattenLtmNodesNode(node))
+	}
+	if err := d.Set("nodes", matches); err != nil {
+		return diag.FromErr(fmt.Errorf("error saving nodes to state: %v", err))
+	}
+	d.SetId(uri)
+	return nil
+}
+
+// ltmNodesURI returns the path of the nodes with address in partition, either of which may be empty.
+func ltmNodesURI(address, partition string) string {
+	var filters []string
+	if address != "" {
+		filters = append(filters, "address eq "+address)
+	}
+	if partition != "" {
+		filters = append(filters, "partition eq "+partition)
+	}
+	if len(filters) == 0 {
+		return uriLtmNode
+	}
+	return uriLtmNode + "?$filter=" + url.QueryEscape(strings.Join(filters, " and "))
+}
+
+func flattenLtmNodesNode(node bigip.Node) map[string]interface{} {
+	var fqdn []interface{}
+	if node.FQDN.Name != "" {
+		fqdn = append(fqdn, map[string]interface{}{
+			"name":           node.FQDN.Name,
+			"address_family": node.FQDN.AddressFamily,
+			"autopopulate":   node.FQDN.AutoPopulate,
+			"interval":       node.FQDN.Interval,
+			"downinterval":   node.FQDN.DownInterval,
+		})
+	}
+	return map[string]interface{}{
+		"name":        node.Name,
+		"partition":   node.Partition,
+		"full_path":   node.FullPath,
+		"address":     node.Address,
+		"description": node.Description,
+		"monitor":     node.Monitor,
+		"state":       node.State,
+		"session":     node.Session,
+		"fqdn":        fqdn,
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLtmNodesURI(t *testing.T) {
+	assert.Equal(t, "ltm/node", ltmNodesURI("", ""))
+	assert.Equal(t, "ltm/node?$filter=address+eq+10.1.1.1%252", ltmNodesURI("10.1.1.1%2", ""))
+	assert.Equal(t, "ltm/node?$filter=address+eq+10.1.1.1+and+partition+eq+Tenant1", ltmNodesURI("10.1.1.1", "Tenant1"))
+}
+
+func TestDataSourceBigipLtmNodesRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "address eq 10.1.1.1", r.URL.Query().Get("$filter"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[
+			{"name":"web1","partition":"Tenant1","fullPath":"/Tenant1/web1","address":"10.1.1.1","state":"unchecked","session":"user-enabled"},
+			{"name":"10.1.1.1","partition":"Common","fullPath":"/Common/10.1.1.1","address":"10.1.1.1","description":"legacy","monitor":"/Common/icmp "},
+			{"name":"other","partition":"Common","fullPath":"/Common/other","address":"10.1.1.2"}
+		]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceBigipLtmNodes().Schema, map[string]interface{}{"address": "10.1.1.1"})
+	diags := dataSourceBigipLtmNodesRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 2, d.Get("nodes.#"))
+	assert.Equal(t, "/Common/10.1.1.1", d.Get("nodes.0.full_path"))
+	assert.Equal(t, "legacy", d.Get("nodes.0.description"))
+	assert.Equal(t, "web1", d.Get("nodes.1.name"))
+	assert.Equal(t, "Tenant1", d.Get("nodes.1.partition"))
+	assert.Equal(t, "user-enabled", d.Get("nodes.1.session"))
+	assert.Equal(t, 0, d.Get("nodes.1.fqdn.#"))
+
+	d = schema.TestResourceDataRaw(t, dataSourceBigipLtmNodes().Schema, map[string]interface{}{
+		"address":    "10.1.1.1",
+		"name_regex": "^web",
+	})
+	diags = dataSourceBigipLtmNodesRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("nodes.#"))
+	assert.Equal(t, "/Tenant1/web1", d.Get("nodes.0.full_path"))
+}
+
+func TestFlattenLtmNodesNodeFqdn(t *testing.T) {
+	node := bigip.Node{Name: "app", Partition: "Common", FullPath: "/Common/app", Address: "any6"}
+	node.FQDN.Name = "app.example.com"
+	node.FQDN.AutoPopulate = "enabled"
+	node.FQDN.DownInterval = 5
+	flat := flattenLtmNodesNode(node)
+	fqdn := flat["fqdn"].([]interface{})
+	assert.Len(t, fqdn, 1)
+	assert.Equal(t, "app.example.com", fqdn[0].(map[string]interface{})["name"])
+	assert.Equal(t, 5, fqdn[0].(map[string]interface{})["downinterval"])
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ltmStatsEntry is one level of the nested stats returned by the /stats
+// endpoints: counters carry a value, states a description.
+type ltmStatsEntry struct {
+	Value       int64  `json:"value"`
+	Description string `json:"description"`
+	NestedStats struct {
+		Entries map[string]ltmStatsEntry `json:"entries"`
+	} `json:"nestedStats"`
+}
+
+type ltmStats map[string]ltmStatsEntry
+
+// getLtmStats returns the flattened stats of every object returned by a
+// /stats endpoint, ordered by selfLink; found is false when the object does
+// not exist.
+func getLtmStats(client *bigip.BigIP, uri string) ([]ltmStats, bool, error) {
+	var resp struct {
+		Entries map[string]ltmStatsEntry `json:"entries"`
+	}
+	found, err := getRestEntity(client, &resp, uri)
+	if err != nil || !found {
+		return nil, found, err
+	}
+	links := make([]string, 0, len(resp.Entries))
+	for link := range resp.Entries {
+		links = append(links, link)
+	}
+	sort.Strings(links)
+	stats := make([]ltmStats, 0, len(links))
+	for _, link := range links {
+		stats = append(stats, resp.Entries[link].NestedStats.Entries)
+	}
+	return stats, true, nil
+}
+
+func (s ltmStats) value(key string) int {
+	return int(s[key].Value)
+}
+
+func (s ltmStats) description(key string) string {
+	return s[key].Description
+}
+
+func ltmStatsStatusSchema(m map[string]*schema.Schema) map[string]*schema.Schema {
+	m["status_availability_state"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Availability state, e.g. available, offline or unknown",
+	}
+	m["status_enabled_state"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Enabled state, enabled or disabled",
+	}
+	m["status_reason"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Reason reported for the availability state",
+	}
+	return m
+}
+
+func ltmStatsCounterSchema(m map[string]*schema.Schema, side string) map[string]*schema.Schema {
+	for _, counter := range []struct{ name, description string }{
+		{"cur_conns", "Current connections"},
+		{"max_conns", "Maximum concurrent connections"},
+		{"tot_conns", "Total connections"},
+		{"bits_in", "Bits received"},
+		{"bits_out", "Bits sent"},
+		{"pkts_in", "Packets received"},
+		{"pkts_out", "Packets sent"},
+	} {
+		m[side+"_"+counter.name] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: counter.description + " on the " + side,
+		}
+	}
+	return m
+}
+
+func setLtmStatsCounters(m map[string]interface{}, stats ltmStats, side string) {
+	m[side+"_cur_conns"] = stats.value(side + ".curConns")
+	m[side+"_max_conns"] = stats.value(side + ".maxConns")
+	m[side+"_tot_conns"] = stats.value(side + ".totConns")
+	m[side+"_bits_in"] = stats.value(side + ".bitsIn")
+	m[side+"_bits_out"] = stats.value(side + ".bitsOut")
+	m[side+"_pkts_in"] = stats.value(side + ".pktsIn")
+	m[side+"_pkts_out"] = stats.value(side + ".pktsOut")
+}
+
+func setLtmStatsStatus(m map[string]interface{}, stats ltmStats) {
+	m["status_availability_state"] = stats.description("status.availabilityState")
+	m["status_enabled_state"] = stats.description("status.enabledState")
+	m["status_reason"] = stats.description("status.statusReason")
+}
+
+func dataSourceBigipLtmVirtualServerStats() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the virtual server",
+		},
+		"partition": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "Common",
+			Description: "Partition of the virtual server",
+		},
+		"full_path": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Full path of the virtual server",
+		},
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceBigipLtmVirtualServerStatsRead,
+		Schema:      ltmStatsStatusSchema(ltmStatsCounterSchema(s, "clientside")),
+	}
+}
+
+func dataSourceBigipLtmPoolStats() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the pool",
+		},
+		"partition": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "Common",
+			Description: "Partition of the pool",
+		},
+		"full_path": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Full path of the pool",
+		},
+		"active_member_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of members currently available",
+		},
+		"members": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Statistics of each pool member",
+			Elem: &schema.Resource{
+				Schema: ltmStatsStatusSchema(ltmStatsCounterSchema(map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the member, node:port",
+					},
+					"node_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Full path of the member's node",
+					},
+					"address": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Address of the member",
+					},
+					"port": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Port of the member",
+					},
+				}, "serverside")),
+			},
+		},
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceBigipLtmPoolStatsRead,
+		Schema:      ltmStatsStatusSchema(ltmStatsCounterSchema(s, "serverside")),
+	}
+}
+
+func dataSourceBigipLtmVirtualServerStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	d.SetId("")
+	partition := d.Get("partition").(string)
+	name := fmt.Sprintf("/%s/%s", partition, d.Get("name").(string))
+
+	tflog.Info(ctx, "Reading virtual server stats of "+name)
+	stats, found, err := getLtmStats(client, restObjectPath("ltm/virtual", name)+"/stats")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving virtual server stats %s: %v", name, err))
+	}
+	if !found || len(stats) == 0 {
+		return diag.FromErr(fmt.Errorf("virtual server %s not found in partition %s", d.Get("name").(string), partition))
+	}
+
+	attrs := map[string]interface{}{}
+	setLtmStatsCounters(attrs, stats[0], "clientside")
+	setLtmStatsStatus(attrs, stats[0])
+	for k, v := range attrs {
+		_ = d.Set(k, v)
+	}
+	_ = d.Set("full_path", name)
+	d.SetId(name)
+	return nil
+}
+
+func dataSourceBigipLtmPoolStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	d.SetId("")
+	partition := d.Get("partition").(string)
+	name := fmt.Sprintf("/%s/%s", partition, d.Get("name").(string))
+
+	tflog.Info(ctx, "Reading pool stats of "+name)
+	stats, found, err := getLtmStats(client, restObjectPath("ltm/pool", name)+"/stats")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving pool stats %s: %v", name, err))
+	}
+	if !found || len(stats) == 0 {
+		return diag.FromErr(fmt.Errorf("pool %s not found in partition %s", d.Get("name").(string), partition))
+	}
+	memberStats, _, err := getLtmStats(client, restObjectPath("ltm/pool", name)+"/members/stats")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving pool member stats %s: %v", name, err))
+	}
+
+	attrs := map[string]interface{}{}
+	setLtmStatsCounters(attrs, stats[0], "serverside")
+	setLtmStatsStatus(attrs, stats[0])
+	for k, v := range attrs {
+		_ = d.Set(k, v)
+	}
+	_ = d.Set("active_member_count", stats[0].value("activeMemberCnt"))
+
+	members := make([]interface{}, 0, len(memberStats))
+	for _, ms := range memberStats {
+		member := map[string]interface{}{
+			"name":      fmt.Sprintf("%s:%d", path.Base(ms.description("nodeName")), ms.value("port")),
+			"node_name": ms.description("nodeName"),
+			"address":   ms.description("addr"),
+			"port":      ms.value("port"),
+		}
+		setLtmStatsCounters(member, ms, "serverside")
+		setLtmStatsStatus(member, ms)
+		members = append(members, member)
+	}
+	_ = d.Set("members", members)
+	_ = d.Set("full_path", name)
+	d.SetId(name)
+	return nil
+}
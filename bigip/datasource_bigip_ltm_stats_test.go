@@ -0,0 +1,85 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+
+package bigip
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBigipLtmStatsDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAcctPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLtmStatsDataSourcesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_ltm_virtual_server_stats.test", "full_path", "/Common/test-stats-vs"),
+					resource.TestCheckResourceAttrSet("data.bigip_ltm_virtual_server_stats.test", "clientside_cur_conns"),
+					resource.TestCheckResourceAttrSet("data.bigip_ltm_virtual_server_stats.test", "status_availability_state"),
+					resource.TestCheckResourceAttr("data.bigip_ltm_pool_stats.test", "full_path", "/Common/test-stats-pool"),
+					resource.TestCheckResourceAttr("data.bigip_ltm_pool_stats.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.bigip_ltm_pool_stats.test", "members.0.name", "10.10.10.10:80"),
+					resource.TestCheckResourceAttr("data.bigip_ltm_pool_stats.test", "members.0.address", "10.10.10.10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmPoolStatsDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAcctPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "bigip_ltm_pool_stats" "missing" {
+  name = "test-stats-missing"
+}
+`,
+				ExpectError: regexp.MustCompile("pool test-stats-missing not found in partition Common"),
+			},
+		},
+	})
+}
+
+const testAccLtmStatsDataSourcesConfig = `
+resource "bigip_ltm_node" "test" {
+  name    = "/Common/10.10.10.10"
+  address = "10.10.10.10"
+}
+
+resource "bigip_ltm_pool" "test" {
+  name = "/Common/test-stats-pool"
+}
+
+resource "bigip_ltm_pool_attachment" "test" {
+  pool = bigip_ltm_pool.test.name
+  node = "${bigip_ltm_node.test.name}:80"
+}
+
+resource "bigip_ltm_virtual_server" "test" {
+  name        = "/Common/test-stats-vs"
+  destination = "10.10.20.10"
+  port        = 80
+  pool        = bigip_ltm_pool.test.name
+}
+
+data "bigip_ltm_virtual_server_stats" "test" {
+  name       = "test-stats-vs"
+  depends_on = [bigip_ltm_virtual_server.test]
+}
+
+data "bigip_ltm_pool_stats" "test" {
+  name       = "test-stats-pool"
+  depends_on = [bigip_ltm_pool_attachment.test]
+}
+`
@@ -0,0 +1,99 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceBigipLtmVirtualServerStatsRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~vs1/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"kind":"tm:ltm:virtual:virtualstats","entries":{"https://localhost/mgmt/tm/ltm/virtual/~Common~vs1/~Common~vs1/stats":{"nestedStats":{"entries":{
+			"clientside.curConns":{"value":12},"clientside.totConns":{"value":3400},"clientside.bitsIn":{"value":8589934592},
+			"status.availabilityState":{"description":"available"},"status.enabledState":{"description":"enabled"},
+			"status.statusReason":{"description":"The virtual server is available"}}}}}}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~missing/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested Virtual Server (/Common/missing) was not found."}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceBigipLtmVirtualServerStats().Schema, map[string]interface{}{"name": "vs1"})
+	diags := dataSourceBigipLtmVirtualServerStatsRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "/Common/vs1", d.Id())
+	assert.Equal(t, 12, d.Get("clientside_cur_conns"))
+	assert.Equal(t, 3400, d.Get("clientside_tot_conns"))
+	assert.Equal(t, 8589934592, d.Get("clientside_bits_in"))
+	assert.Equal(t, 0, d.Get("clientside_bits_out"))
+	assert.Equal(t, "available", d.Get("status_availability_state"))
+	assert.Equal(t, "enabled", d.Get("status_enabled_state"))
+
+	d = schema.TestResourceDataRaw(t, dataSourceBigipLtmVirtualServerStats().Schema, map[string]interface{}{"name": "missing"})
+	diags = dataSourceBigipLtmVirtualServerStatsRead(context.Background(), d, client)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "virtual server missing not found in partition Common", diags[0].Summary)
+}
+
+func TestDataSourceBigipLtmPoolStatsRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~pool1/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/ltm/pool/~Common~pool1/~Common~pool1/stats":{"nestedStats":{"entries":{
+			"activeMemberCnt":{"value":1},"serverside.curConns":{"value":5},
+			"status.availabilityState":{"description":"available"}}}}}}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~pool1/members/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"entries":{
+			"https://localhost/mgmt/tm/ltm/pool/~Common~pool1/members/~Common~10.0.0.2:80/stats":{"nestedStats":{"entries":{
+				"addr":{"description":"10.0.0.2"},"nodeName":{"description":"/Common/10.0.0.2"},"port":{"value":80},
+				"serverside.curConns":{"value":0},"status.availabilityState":{"description":"offline"}}}},
+			"https://localhost/mgmt/tm/ltm/pool/~Common~pool1/members/~Common~10.0.0.1:80/stats":{"nestedStats":{"entries":{
+				"addr":{"description":"10.0.0.1"},"nodeName":{"description":"/Common/10.0.0.1"},"port":{"value":80},
+				"serverside.curConns":{"value":5},"status.availabilityState":{"description":"available"}}}}}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceBigipLtmPoolStats().Schema, map[string]interface{}{"name": "pool1"})
+	diags := dataSourceBigipLtmPoolStatsRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "/Common/pool1", d.Id())
+	assert.Equal(t, 1, d.Get("active_member_count"))
+	assert.Equal(t, 5, d.Get("serverside_cur_conns"))
+	assert.Equal(t, 2, d.Get("members.#"))
+	assert.Equal(t, "10.0.0.1:80", d.Get("members.0.name"))
+	assert.Equal(t, "10.0.0.1", d.Get("members.0.address"))
+	assert.Equal(t, 5, d.Get("members.0.serverside_cur_conns"))
+	assert.Equal(t, "available", d.Get("members.0.status_availability_state"))
+	assert.Equal(t, "10.0.0.2:80", d.Get("members.1.name"))
+	assert.Equal(t, "offline", d.Get("members.1.status_availability_state"))
+}
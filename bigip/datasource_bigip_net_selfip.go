@@ -0,0 +1,146 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBigipNetSelfIP() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipNetSelfIPRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the self IP",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Common",
+				Description: "Partition of the self IP",
+			},
+			"full_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the self IP",
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Address and netmask of the self IP, e.g. 10.1.10.1/24",
+			},
+			"vlan": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "VLAN the self IP is on",
+			},
+			"traffic_group": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Traffic group of the self IP",
+			},
+			"floating": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the self IP is a floating address",
+			},
+			"port_lockdown": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Services the self IP accepts traffic for, all, none or a list of protocol:port",
+			},
+		},
+	}
+}
+
+func dataSourceBigipNetSelfIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipNetSelfIPsRead,
+		Schema: map[string]*schema.Schema{
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the self IPs of this partition",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Full paths of the self IPs",
+			},
+		},
+	}
+}
+
+func dataSourceBigipNetSelfIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	d.SetId("")
+	partition := d.Get("partition").(string)
+	name := fmt.Sprintf("/%s/%s", partition, d.Get("name").(string))
+
+	tflog.Info(ctx, "Reading self IP "+name)
+	selfIP := &bigip.SelfIP{}
+	found, err := getRestEntity(client, selfIP, restObjectPath("net/self", name))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving self IP %s: %v", name, err))
+	}
+	if !found {
+		return diag.FromErr(fmt.Errorf("self IP %s not found in partition %s", d.Get("name").(string), partition))
+	}
+
+	_ = d.Set("full_path", selfIP.FullPath)
+	_ = d.Set("ip", selfIP.Address)
+	_ = d.Set("vlan", selfIP.Vlan)
+	_ = d.Set("traffic_group", selfIP.TrafficGroup)
+	_ = d.Set("floating", selfIP.Floating == "enabled")
+	_ = d.Set("port_lockdown", selfIPPortLockdown(selfIP.AllowService))
+	d.SetId(selfIP.FullPath)
+	return nil
+}
+
+func dataSourceBigipNetSelfIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	partition := d.Get("partition").(string)
+
+	selfIPs := &bigip.SelfIPs{}
+	if _, err := getRestEntity(client, selfIPs, "net/self"); err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving self IPs: %v", err))
+	}
+	names := make([]string, 0, len(selfIPs.SelfIPs))
+	for _, selfIP := range selfIPs.SelfIPs {
+		if partition == "" || selfIP.Partition == partition {
+			names = append(names, selfIP.FullPath)
+		}
+	}
+	_ = d.Set("names", names)
+	d.SetId("selfips" + partition)
+	return nil
+}
+
+// selfIPPortLockdown flattens allowService, which the BIG-IP returns as "all", a list of
+// protocol:port entries, or not at all for none.
+func selfIPPortLockdown(allowService interface{}) []string {
+	switch v := allowService.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		services := make([]string, 0, len(v))
+		for _, s := range v {
+			services = append(services, fmt.Sprint(s))
+		}
+		return services
+	}
+	return []string{"none"}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+
+package bigip
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBigipNetVlanSelfIPDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAcctPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckselfipsDestroyed,
+			testCheckvlansDestroyed,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetVlanSelfIPDataSourcesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_net_vlan.test", "full_path", "/Common/test-vlan-ds"),
+					resource.TestCheckResourceAttr("data.bigip_net_vlan.test", "tag", "201"),
+					resource.TestCheckResourceAttr("data.bigip_net_vlan.test", "interfaces.#", "1"),
+					resource.TestCheckResourceAttr("data.bigip_net_vlan.test", "interfaces.0.vlanport", "1.2"),
+					resource.TestCheckResourceAttr("data.bigip_net_vlan.test", "interfaces.0.tagged", "true"),
+					resource.TestCheckResourceAttr("data.bigip_net_selfip.test", "ip", "11.2.1.1/24"),
+					resource.TestCheckResourceAttr("data.bigip_net_selfip.test", "vlan", "/Common/test-vlan-ds"),
+					resource.TestCheckResourceAttr("data.bigip_net_selfip.test", "traffic_group", "/Common/traffic-group-local-only"),
+					resource.TestCheckResourceAttr("data.bigip_net_selfip.test", "floating", "false"),
+					resource.TestCheckTypeSetElemAttr("data.bigip_net_vlans.all", "names.*", "/Common/test-vlan-ds"),
+					resource.TestCheckTypeSetElemAttr("data.bigip_net_selfips.all", "names.*", "/Common/test-selfip-ds"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetVlanDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAcctPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "bigip_net_vlan" "missing" {
+  name      = "no-such-vlan"
+  partition = "Common"
+}
+`,
+				ExpectError: regexp.MustCompile("VLAN no-such-vlan not found in partition Common"),
+			},
+		},
+	})
+}
+
+const testAccNetVlanSelfIPDataSourcesConfig = `
+resource "bigip_net_vlan" "test" {
+  name = "/Common/test-vlan-ds"
+  tag  = 201
+  interfaces {
+    vlanport = "1.2"
+    tagged   = true
+  }
+}
+
+resource "bigip_net_selfip" "test" {
+  name = "/Common/test-selfip-ds"
+  ip   = "11.2.1.1/24"
+  vlan = bigip_net_vlan.test.name
+}
+
+data "bigip_net_vlan" "test" {
+  name = split("/", bigip_net_vlan.test.name)[2]
+}
+
+data "bigip_net_selfip" "test" {
+  name = split("/", bigip_net_selfip.test.name)[2]
+}
+
+data "bigip_net_vlans" "all" {
+  partition  = "Common"
+  depends_on = [bigip_net_vlan.test]
+}
+
+data "bigip_net_selfips" "all" {
+  depends_on = [bigip_net_selfip.test]
+}
+`
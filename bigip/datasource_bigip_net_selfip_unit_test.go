@@ -0,0 +1,58 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelfIPPortLockdown(t *testing.T) {
+	assert.Equal(t, []string{"all"}, selfIPPortLockdown("all"))
+	assert.Equal(t, []string{"tcp:22", "udp:53"}, selfIPPortLockdown([]interface{}{"tcp:22", "udp:53"}))
+	assert.Equal(t, []string{"none"}, selfIPPortLockdown(nil))
+}
+
+func TestDataSourceBigipNetSelfIPRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/net/self/~Common~internal", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"internal","partition":"Common","fullPath":"/Common/internal","address":"10.1.10.1/24","floating":"disabled","trafficGroup":"/Common/traffic-group-local-only","vlan":"/Common/internal","allowService":["tcp:22"]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/self/~Tenant~internal", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested Self IP (/Tenant/internal) was not found."}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceBigipNetSelfIP().Schema, map[string]interface{}{"name": "internal"})
+	diags := dataSourceBigipNetSelfIPRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "/Common/internal", d.Id())
+	assert.Equal(t, "10.1.10.1/24", d.Get("ip"))
+	assert.Equal(t, "/Common/internal", d.Get("vlan"))
+	assert.Equal(t, false, d.Get("floating"))
+	assert.Equal(t, []interface{}{"tcp:22"}, d.Get("port_lockdown"))
+
+	d = schema.TestResourceDataRaw(t, dataSourceBigipNetSelfIP().Schema, map[string]interface{}{"name": "internal", "partition": "Tenant"})
+	diags = dataSourceBigipNetSelfIPRead(context.Background(), d, client)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "self IP internal not found in partition Tenant", diags[0].Summary)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBigipNetVlan() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipNetVlanRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the VLAN",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Common",
+				Description: "Partition of the VLAN",
+			},
+			"full_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the VLAN",
+			},
+			"tag": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "VLAN ID (tag)",
+			},
+			"mtu": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum Transmission Unit (MTU) of the VLAN",
+			},
+			"cmp_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "How the traffic on the VLAN is disaggregated",
+			},
+			"interfaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Interface(s) attached to the VLAN",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vlanport": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Interface or trunk name",
+						},
+						"tagged": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Interface tagged",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipNetVlans() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipNetVlansRead,
+		Schema: map[string]*schema.Schema{
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the VLANs of this partition",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Full paths of the VLANs",
+			},
+		},
+	}
+}
+
+func dataSourceBigipNetVlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	d.SetId("")
+	partition := d.Get("partition").(string)
+	name := fmt.Sprintf("/%s/%s", partition, d.Get("name").(string))
+
+	tflog.Info(ctx, "Reading VLAN "+name)
+	vlan := &bigip.Vlan{}
+	found, err := getRestEntity(client, vlan, restObjectPath("net/vlan", name))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving VLAN %s: %v", name, err))
+	}
+	if !found {
+		return diag.FromErr(fmt.Errorf("VLAN %s not found in partition %s", d.Get("name").(string), partition))
+	}
+	ifaces := &bigip.VlanInterfaces{}
+	if _, err := getRestEntity(client, ifaces, restObjectPath("net/vlan", name)+"/interfaces"); err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving interfaces of VLAN %s: %v", name, err))
+	}
+	interfaces := make([]interface{}, 0, len(ifaces.VlanInterfaces))
+	for _, iface := range ifaces.VlanInterfaces {
+		interfaces = append(interfaces, map[string]interface{}{
+			"vlanport": iface.Name,
+			"tagged":   iface.Tagged,
+		})
+	}
+
+	_ = d.Set("full_path", vlan.FullPath)
+	_ = d.Set("tag", vlan.Tag)
+	_ = d.Set("mtu", vlan.MTU)
+	_ = d.Set("cmp_hash", vlan.CMPHash)
+	_ = d.Set("interfaces", interfaces)
+	d.SetId(vlan.FullPath)
+	return nil
+}
+
+func dataSourceBigipNetVlansRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	partition := d.Get("partition").(string)
+
+	vlans := &bigip.Vlans{}
+	if _, err := getRestEntity(client, vlans, "net/vlan"); err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving VLANs: %v", err))
+	}
+	names := make([]string, 0, len(vlans.Vlans))
+	for _, vlan := range vlans.Vlans {
+		if partition == "" || vlan.Partition == partition {
+			names = append(names, vlan.FullPath)
+		}
+	}
+	_ = d.Set("names", names)
+	d.SetId("vlans" + partition)
+	return nil
+}
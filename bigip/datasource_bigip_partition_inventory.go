@@ -0,0 +1,177 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// partitionInventoryCollection maps a REST collection to the resource type its objects are
+// imported into.
+type partitionInventoryCollection struct {
+	resourceType string
+	collection   string
+}
+
+// partitionInventoryCollections lists the collections read by bigip_partition_inventory, in the
+// order the objects are returned.
+var partitionInventoryCollections = []partitionInventoryCollection{
+	{"bigip_ltm_node", "ltm/node"},
+	{"bigip_ltm_monitor", "ltm/monitor/http"},
+	{"bigip_ltm_monitor", "ltm/monitor/https"},
+	{"bigip_ltm_monitor", "ltm/monitor/icmp"},
+	{"bigip_ltm_monitor", "ltm/monitor/gateway-icmp"},
+	{"bigip_ltm_monitor", "ltm/monitor/tcp"},
+	{"bigip_ltm_monitor", "ltm/monitor/tcp-half-open"},
+	{"bigip_ltm_monitor", "ltm/monitor/udp"},
+	{"bigip_ltm_monitor", "ltm/monitor/ftp"},
+	{"bigip_ltm_monitor", "ltm/monitor/ldap"},
+	{"bigip_ltm_monitor", "ltm/monitor/smtp"},
+	{"bigip_ltm_monitor", "ltm/monitor/mysql"},
+	{"bigip_ltm_monitor", "ltm/monitor/mssql"},
+	{"bigip_ltm_monitor", "ltm/monitor/postgresql"},
+	{"bigip_ltm_pool", "ltm/pool"},
+	{"bigip_ltm_profile_http", "ltm/profile/http"},
+	{"bigip_ltm_profile_tcp", "ltm/profile/tcp"},
+	{"bigip_ltm_profile_client_ssl", "ltm/profile/client-ssl"},
+	{"bigip_ltm_irule", "ltm/rule"},
+	{"bigip_ltm_datagroup", "ltm/data-group/internal"},
+	{"bigip_ltm_virtual_server", "ltm/virtual"},
+}
+
+func partitionInventoryResourceTypes() []string {
+	var types []string
+	for _, c := range partitionInventoryCollections {
+		if len(types) == 0 || types[len(types)-1] != c.resourceType {
+			types = append(types, c.resourceType)
+		}
+	}
+	return types
+}
+
+func dataSourceBigipPartitionInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipPartitionInventoryRead,
+		Schema: map[string]*schema.Schema{
+			"partition": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Partition to list the objects of",
+			},
+			"resource_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(partitionInventoryResourceTypes(), false)},
+				Description: "Resource types to list, all supported types when not set",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Objects of the partition, dependencies first",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Terraform resource type managing the object",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the object",
+						},
+						"full_path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Full path of the object",
+						},
+						"import_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID to import the object with",
+						},
+					},
+				},
+			},
+			"import_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Import IDs keyed by \"<resource_type>.<name>\"",
+			},
+		},
+	}
+}
+
+// listPartitionObjects returns the full paths of the objects of collection in partition, including
+// the ones in its sub folders.
+func listPartitionObjects(client *bigip.BigIP, collection, partition string) ([]string, error) {
+	uri := fmt.Sprintf("%s?$select=fullPath,partition&$filter=partition+eq+%s", collection, partition)
+	items, err := getRestItems[struct {
+		FullPath  string `json:"fullPath"`
+		Partition string `json:"partition"`
+	}](client, uri)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, item := range items {
+		if item.Partition == partition {
+			paths = append(paths, item.FullPath)
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func dataSourceBigipPartitionInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	d.SetId("")
+	partition := d.Get("partition").(string)
+
+	wanted := make(map[string]bool)
+	if v, ok := d.GetOk("resource_types"); ok {
+		for _, t := range v.(*schema.Set).List() {
+			wanted[t.(string)] = true
+		}
+	}
+
+	tflog.Info(ctx, "Reading inventory of partition "+partition)
+	objects := make([]interface{}, 0)
+	importIDs := make(map[string]interface{})
+	for _, c := range partitionInventoryCollections {
+		if len(wanted) > 0 && !wanted[c.resourceType] {
+			continue
+		}
+		paths, err := listPartitionObjects(client, c.collection, partition)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing %s in partition %s: %v", c.collection, partition, err))
+		}
+		for _, fullPath := range paths {
+			name := strings.TrimPrefix(fullPath, "/"+partition+"/")
+			objects = append(objects, map[string]interface{}{
+				"resource_type": c.resourceType,
+				"name":          name,
+				"full_path":     fullPath,
+				"import_id":     fullPath,
+			})
+			importIDs[c.resourceType+"."+name] = fullPath
+		}
+	}
+	_ = d.Set("objects", objects)
+	_ = d.Set("import_ids", importIDs)
+	d.SetId(partition)
+	return nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+
+package bigip
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBigipPartitionInventoryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAcctPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartitionInventoryDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_partition_inventory.test", "id", "Common"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.bigip_partition_inventory.test", "objects.*", map[string]string{
+						"resource_type": "bigip_ltm_node",
+						"import_id":     "/Common/test-inventory-node",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.bigip_partition_inventory.test", "objects.*", map[string]string{
+						"resource_type": "bigip_ltm_pool",
+						"import_id":     "/Common/test-inventory-pool",
+					}),
+					resource.TestCheckResourceAttr("data.bigip_partition_inventory.pools", "import_ids.bigip_ltm_pool.test-inventory-pool", "/Common/test-inventory-pool"),
+					resource.TestCheckNoResourceAttr("data.bigip_partition_inventory.pools", "import_ids.bigip_ltm_node.test-inventory-node"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPartitionInventoryDataSourceConfig = `
+resource "bigip_ltm_node" "test" {
+  name    = "/Common/test-inventory-node"
+  address = "10.10.10.11"
+}
+resource "bigip_ltm_pool" "test" {
+  name = "/Common/test-inventory-pool"
+}
+data "bigip_partition_inventory" "test" {
+  partition  = "Common"
+  depends_on = [bigip_ltm_node.test, bigip_ltm_pool.test]
+}
+data "bigip_partition_inventory" "pools" {
+  partition      = "Common"
+  resource_types = ["bigip_ltm_pool"]
+  depends_on     = [bigip_ltm_pool.test]
+}
+`
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceBigipPartitionInventoryRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "fullPath,partition", r.URL.Query().Get("$select"))
+		assert.Equal(t, "partition eq Tenant1", r.URL.Query().Get("$filter"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Tenant1/node2","partition":"Tenant1"},{"fullPath":"/Tenant1/node1","partition":"Tenant1"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/monitor/http", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Tenant1/app.app/mon1","partition":"Tenant1"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Tenant1/vs1","partition":"Tenant1"},{"fullPath":"/Common/vs1","partition":"Common"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"kind":"tm:ltm:pool:poolcollectionstate"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceBigipPartitionInventory().Schema, map[string]interface{}{"partition": "Tenant1"})
+	diags := dataSourceBigipPartitionInventoryRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "Tenant1", d.Id())
+	assert.Equal(t, 4, d.Get("objects.#"))
+	assert.Equal(t, "bigip_ltm_node", d.Get("objects.0.resource_type"))
+	assert.Equal(t, "node1", d.Get("objects.0.name"))
+	assert.Equal(t, "/Tenant1/node1", d.Get("objects.0.import_id"))
+	assert.Equal(t, "/Tenant1/node2", d.Get("objects.1.full_path"))
+	assert.Equal(t, "bigip_ltm_monitor", d.Get("objects.2.resource_type"))
+	assert.Equal(t, "app.app/mon1", d.Get("objects.2.name"))
+	assert.Equal(t, "bigip_ltm_virtual_server", d.Get("objects.3.resource_type"))
+	assert.Equal(t, "/Tenant1/vs1", d.Get("import_ids").(map[string]interface{})["bigip_ltm_virtual_server.vs1"])
+
+	d = schema.TestResourceDataRaw(t, dataSourceBigipPartitionInventory().Schema, map[string]interface{}{
+		"partition":      "Tenant1",
+		"resource_types": []interface{}{"bigip_ltm_virtual_server"},
+	})
+	diags = dataSourceBigipPartitionInventoryRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("objects.#"))
+	assert.Equal(t, "/Tenant1/vs1", d.Get("objects.0.import_id"))
+}
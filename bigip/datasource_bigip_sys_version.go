@@ -0,0 +1,74 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBigipSysVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipSysVersionRead,
+		Schema: map[string]*schema.Schema{
+			"product": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Product running on the device, e.g. BIG-IP",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Software version, e.g. 17.1.0",
+			},
+			"build": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Build number of the software version",
+			},
+			"edition": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Edition of the software version, e.g. Final or Point Release 1",
+			},
+			"is_next": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the device is BIG-IP Next rather than classic BIG-IP",
+			},
+			"platform": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Platform of the device, classic or next",
+			},
+		},
+	}
+}
+
+func dataSourceBigipSysVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	tflog.Info(ctx, "Reading BIG-IP version")
+	platform, err := getBigipPlatformOrNext(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("product", platform.Product)
+	_ = d.Set("version", platform.Version)
+	_ = d.Set("build", platform.Build)
+	_ = d.Set("edition", platform.Edition)
+	_ = d.Set("is_next", platform.Next)
+	if platform.Next {
+		_ = d.Set("platform", "next")
+	} else {
+		_ = d.Set("platform", "classic")
+	}
+	d.SetId(uriSysVersion)
+	return nil
+}
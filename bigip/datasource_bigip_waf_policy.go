@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,9 +22,43 @@ func dataSourceBigipWafPolicy() *schema.Resource {
 		ReadContext: dataSourceBigipWafPolicyRead,
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"policy_id", "name"},
+				Description:  "ID of the policy",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the policy to look up, either a plain name or a full path e.g. /Common/my-policy",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Partition of the policy to look up by name",
+			},
+			"full_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the policy",
+			},
+			"enforcement_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Enforcement mode of the policy, blocking or transparent",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the policy is active",
+			},
+			"link": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "ID of the policy",
+				Computed:    true,
+				Description: "Self link of the policy",
 			},
 			"policy_json": {
 				Type:        schema.TypeString,
@@ -40,12 +75,23 @@ func dataSourceBigipWafPolicyRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId("")
 
 	policyID := d.Get("policy_id").(string)
+	if policyID == "" {
+		summary, err := lookupWafPolicyByName(client, d.Get("name").(string), d.Get("partition").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		policyID = summary.ID
+	}
 
 	log.Printf("[DEBUG] Reading AWAF Policy with ID: %+v", policyID)
 
-	wafpolicy, err := client.GetWafPolicy(policyID)
+	wafpolicy := &wafPolicySummary{}
+	found, err := getRestEntity(client, wafpolicy, "asm/policies/"+policyID)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error retrieving waf policy %+v: %v", wafpolicy, err))
+		return diag.FromErr(fmt.Errorf("error retrieving waf policy %+v: %v", policyID, err))
+	}
+	if !found {
+		return diag.FromErr(fmt.Errorf("waf policy with ID %s not found", policyID))
 	}
 
 	policyJson, err := client.ExportPolicy(policyID)
@@ -61,8 +107,60 @@ func dataSourceBigipWafPolicyRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	_ = d.Set("policy_id", wafpolicy.ID)
+	_ = d.Set("name", wafpolicy.Name)
+	_ = d.Set("partition", wafpolicy.Partition)
+	_ = d.Set("full_path", wafpolicy.FullPath)
+	_ = d.Set("enforcement_mode", wafpolicy.EnforcementMode)
+	_ = d.Set("active", wafpolicy.Active)
+	_ = d.Set("link", wafpolicy.SelfLink)
 	_ = d.Set("policy_json", string(plJson))
 
 	d.SetId(wafpolicy.ID)
 	return nil
 }
+
+// wafPolicySummary holds the attributes of an ASM policy needed to reference it.
+type wafPolicySummary struct {
+	Name            string `json:"name"`
+	Partition       string `json:"partition"`
+	FullPath        string `json:"fullPath"`
+	ID              string `json:"id"`
+	EnforcementMode string `json:"enforcementMode"`
+	Active          bool   `json:"active"`
+	SelfLink        string `json:"selfLink"`
+}
+
+// lookupWafPolicyByName returns the single ASM policy called name. name may be a full path, otherwise
+// partition narrows the search; a name matching policies in several partitions is an error.
+func lookupWafPolicyByName(client *bigip.BigIP, name, partition string) (*wafPolicySummary, error) {
+	if strings.HasPrefix(name, "/") {
+		idx := strings.LastIndex(name, "/")
+		partition = strings.Trim(name[:idx], "/")
+		name = name[idx+1:]
+	}
+	query := fmt.Sprintf("asm/policies?$filter=name+eq+'%s'&$select=name,partition,fullPath,id,enforcementMode,active,selfLink", name)
+	policies, err := getRestItems[wafPolicySummary](client, query)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up waf policy %s: %v", name, err)
+	}
+	var matches []wafPolicySummary
+	for _, p := range policies {
+		if p.Name == name && (partition == "" || p.Partition == partition) {
+			matches = append(matches, p)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		if partition != "" {
+			return nil, fmt.Errorf("waf policy %s not found in partition %s", name, partition)
+		}
+		return nil, fmt.Errorf("waf policy %s not found", name)
+	case 1:
+		return &matches[0], nil
+	}
+	var paths []string
+	for _, p := range matches {
+		paths = append(paths, p.FullPath)
+	}
+	return nil, fmt.Errorf("waf policy name %s matches %d policies (%s), set partition or use the full path", name, len(matches), strings.Join(paths, ", "))
+}
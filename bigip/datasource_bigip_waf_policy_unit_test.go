@@ -0,0 +1,51 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupWafPolicyByName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/asm/policies", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "name eq 'app-policy'", r.URL.Query().Get("$filter"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[
+			{"name":"app-policy","partition":"Common","fullPath":"/Common/app-policy","id":"Aa1","enforcementMode":"blocking","active":true},
+			{"name":"app-policy","partition":"Tenant","fullPath":"/Tenant/app-policy","id":"Bb2","enforcementMode":"transparent","active":false}
+		]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	_, err := lookupWafPolicyByName(client, "app-policy", "")
+	assert.ErrorContains(t, err, "matches 2 policies (/Common/app-policy, /Tenant/app-policy)")
+
+	policy, err := lookupWafPolicyByName(client, "app-policy", "Tenant")
+	assert.NoError(t, err)
+	assert.Equal(t, "Bb2", policy.ID)
+
+	policy, err = lookupWafPolicyByName(client, "/Common/app-policy", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "Aa1", policy.ID)
+	assert.Equal(t, "blocking", policy.EnforcementMode)
+
+	_, err = lookupWafPolicyByName(client, "app-policy", "Other")
+	assert.ErrorContains(t, err, "not found in partition Other")
+}
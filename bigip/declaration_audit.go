@@ -0,0 +1,62 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// The declarative resources record what was deployed and when, for audits: the checksum of the
+// declaration sent, the time of the deployment and the task that applied it. They only change when
+// a declaration is deployed, so a refresh of an unchanged declaration keeps them.
+
+func declarationSha256Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "SHA-256 checksum of the last deployed declaration, computed on its normalized JSON",
+	}
+}
+
+func lastAppliedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time of the last deployment of the declaration, in RFC 3339 format",
+	}
+}
+
+// declarationSha256 returns the checksum of the JSON declaration, which neither the formatting nor
+// the order of the keys changes.
+func declarationSha256(declaration string) string {
+	if normalized, err := structure.NormalizeJsonString(declaration); err == nil {
+		declaration = normalized
+	}
+	sum := sha256.Sum256([]byte(declaration))
+	return hex.EncodeToString(sum[:])
+}
+
+// setDeclarationAudit records the deployment of the declaration in attr by task.
+func setDeclarationAudit(d *schema.ResourceData, attr, task string) {
+	_ = d.Set("declaration_sha256", declarationSha256(d.Get(attr).(string)))
+	_ = d.Set("last_applied", time.Now().UTC().Format(time.RFC3339))
+	_ = d.Set("task_id", task)
+}
+
+// markDeclarationAuditComputed leaves the audit attributes unknown in the plan of a deployment.
+func markDeclarationAuditComputed(d *schema.ResourceDiff) error {
+	for _, attr := range []string{"declaration_sha256", "last_applied", "task_id"} {
+		if err := d.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
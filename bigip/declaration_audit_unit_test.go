@@ -0,0 +1,96 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeclarationSha256(t *testing.T) {
+	sum := declarationSha256(`{"class":"AS3","declaration":{"class":"ADC"}}`)
+	assert.Len(t, sum, 64)
+	assert.Equal(t, sum, declarationSha256("{\n  \"declaration\": {\"class\": \"ADC\"},\n  \"class\": \"AS3\"\n}"))
+	assert.NotEqual(t, sum, declarationSha256(`{"class":"AS3","declaration":{"class":"ADC","schemaVersion":"3.50.0"}}`))
+}
+
+func TestSetDeclarationAudit(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipDo().Schema, map[string]interface{}{
+		"do_json": `{"schemaVersion":"1.0.0","class":"Device"}`,
+	})
+	before := time.Now().UTC().Add(-time.Second)
+	setDeclarationAudit(d, "do_json", "task-1")
+	assert.Equal(t, declarationSha256(`{"class":"Device","schemaVersion":"1.0.0"}`), d.Get("declaration_sha256"))
+	assert.Equal(t, "task-1", d.Get("task_id"))
+	applied, err := time.Parse(time.RFC3339, d.Get("last_applied").(string))
+	if assert.NoError(t, err) {
+		assert.False(t, applied.Before(before))
+	}
+}
+
+func TestDeclarationAuditDiff(t *testing.T) {
+	r := resourceBigipAs3()
+	state := &terraform.InstanceState{
+		ID: "Tenant1",
+		Attributes: map[string]string{
+			"id":                 "Tenant1",
+			"as3_json":           testDeclarationDiffOld,
+			"tenant_list":        "Tenant1",
+			"task_id":            "task-1",
+			"declaration_sha256": declarationSha256(testDeclarationDiffOld),
+			"last_applied":       "2024-05-02T10:00:00Z",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"as3_json": testDeclarationDiffNew})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	for _, attr := range []string{"declaration_sha256", "last_applied", "task_id"} {
+		if assert.NotNil(t, diff.Attributes[attr], attr) {
+			assert.True(t, diff.Attributes[attr].NewComputed, attr)
+		}
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{"as3_json": testDeclarationDiffOld})
+	diff, err = r.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	if diff != nil {
+		assert.Nil(t, diff.Attributes["declaration_sha256"])
+		assert.Nil(t, diff.Attributes["last_applied"])
+	}
+}
+
+func TestAs3LastApplied(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/shared/appsvcs/declare/Tenant1,Tenant2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"class":"ADC","schemaVersion":"3.50.0","controls":{"archiveTimestamp":"2024-05-02T10:15:30.123Z"},"Tenant1":{"class":"Tenant"}}`)
+	})
+	mux.HandleFunc("/mgmt/shared/appsvcs/declare/Tenant3", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"class":"ADC","schemaVersion":"3.50.0","Tenant3":{"class":"Tenant"}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	lastApplied, err := as3LastApplied(client, "Tenant1,Tenant2")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-05-02T10:15:30Z", lastApplied)
+
+	lastApplied, err = as3LastApplied(client, "Tenant3")
+	assert.NoError(t, err)
+	assert.Equal(t, "", lastApplied)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// maxDeclarationDiffLines bounds diff_summary, so a rewritten declaration does not turn the plan
+// back into an unreadable wall of text.
+const maxDeclarationDiffLines = 100
+
+// maxDeclarationDiffValue bounds the length of a value shown in diff_summary.
+const maxDeclarationDiffValue = 80
+
+func diffSummarySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Summary of the changes of the last planned update of the declaration, one changed key per line",
+	}
+}
+
+// declarationDiffCustomizeDiff sets diff_summary to the semantic diff of the JSON declaration in attr
+// when an update changes it, and leaves the audit attributes of the deployment unknown. The
+// objectDepth first path segments below "declaration" (e.g. tenant, application and object for AS3)
+// are joined with "/", deeper keys with ".".
+func declarationDiffCustomizeDiff(attr string, objectDepth int) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || !d.HasChange(attr) {
+			return nil
+		}
+		if err := markDeclarationAuditComputed(d); err != nil {
+			return err
+		}
+		if !d.NewValueKnown(attr) {
+			return d.SetNewComputed("diff_summary")
+		}
+		old, new := d.GetChange(attr)
+		return d.SetNew("diff_summary", strings.Join(declarationDiff(old.(string), new.(string), objectDepth), "\n"))
+	}
+}
+
+// declarationDiff returns one line per key added, removed or changed between the old and new JSON
+// declarations, sorted by path. Lists are compared as a whole.
+func declarationDiff(old, new string, objectDepth int) []string {
+	var oldDecl, newDecl interface{}
+	if json.Unmarshal([]byte(old), &oldDecl) != nil || json.Unmarshal([]byte(new), &newDecl) != nil {
+		return []string{"declaration changed"}
+	}
+	var lines []string
+	diffDeclarationValue(unwrapDeclaration(oldDecl), unwrapDeclaration(newDecl), nil, objectDepth, &lines)
+	sort.Strings(lines)
+	if len(lines) > maxDeclarationDiffLines {
+		more := len(lines) - maxDeclarationDiffLines
+		lines = append(lines[:maxDeclarationDiffLines], fmt.Sprintf("... and %d more changes", more))
+	}
+	return lines
+}
+
+// unwrapDeclaration returns the "declaration" of an AS3 or DO request, or the document itself when it
+// is the declaration.
+func unwrapDeclaration(v interface{}) interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		if decl, ok := m["declaration"].(map[string]interface{}); ok {
+			return decl
+		}
+	}
+	return v
+}
+
+func diffDeclarationValue(old, new interface{}, path []string, objectDepth int, lines *[]string) {
+	oldMap, oldIsMap := old.(map[string]interface{})
+	newMap, newIsMap := new.(map[string]interface{})
+	if !oldIsMap || !newIsMap {
+		if !reflect.DeepEqual(old, new) {
+			*lines = append(*lines, fmt.Sprintf("%s changed from %s to %s", declarationPath(path, objectDepth), declarationValue(old), declarationValue(new)))
+		}
+		return
+	}
+	for key, oldValue := range oldMap {
+		keyPath := append(path[:len(path):len(path)], key)
+		newValue, ok := newMap[key]
+		if !ok {
+			*lines = append(*lines, fmt.Sprintf("%s removed", declarationPath(keyPath, objectDepth)))
+			continue
+		}
+		diffDeclarationValue(oldValue, newValue, keyPath, objectDepth, lines)
+	}
+	for key, newValue := range newMap {
+		if _, ok := oldMap[key]; !ok {
+			keyPath := append(path[:len(path):len(path)], key)
+			*lines = append(*lines, fmt.Sprintf("%s added with %s", declarationPath(keyPath, objectDepth), declarationValue(newValue)))
+		}
+	}
+}
+
+func declarationPath(path []string, objectDepth int) string {
+	if len(path) == 0 {
+		return "declaration"
+	}
+	if objectDepth == 0 {
+		return strings.Join(path, ".")
+	}
+	if len(path) <= objectDepth {
+		return strings.Join(path, "/")
+	}
+	return strings.Join(path[:objectDepth], "/") + "." + strings.Join(path[objectDepth:], ".")
+}
+
+func declarationValue(v interface{}) string {
+	b, _ := json.Marshal(v)
+	s := string(b)
+	if len(s) > maxDeclarationDiffValue {
+		s = s[:maxDeclarationDiffValue] + "..."
+	}
+	return s
+}
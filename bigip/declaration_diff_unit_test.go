@@ -0,0 +1,82 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDeclarationDiffOld = `{"class":"AS3","declaration":{"class":"ADC","schemaVersion":"3.50.0",
+"Tenant1":{"class":"Tenant","app1":{"class":"Application",
+"serviceMain":{"class":"Service_HTTP","virtualAddresses":["10.0.1.10"],"pool":"web_pool"},
+"web_pool":{"class":"Pool","monitors":["http"],"members":[{"servicePort":80,"serverAddresses":["192.0.1.10"]}]}}}}}`
+
+const testDeclarationDiffNew = `{"class":"AS3","declaration":{"class":"ADC","schemaVersion":"3.50.0",
+"Tenant1":{"class":"Tenant","app1":{"class":"Application",
+"serviceMain":{"class":"Service_HTTP","virtualAddresses":["10.0.1.20"],"pool":"web_pool","snat":"auto"},
+"web_pool":{"class":"Pool","members":[{"servicePort":80,"serverAddresses":["192.0.1.10"]}]}}},
+"Tenant2":{"class":"Tenant"}}}`
+
+func TestDeclarationDiff(t *testing.T) {
+	assert.Equal(t, []string{
+		`Tenant1/app1/serviceMain.snat added with "auto"`,
+		`Tenant1/app1/serviceMain.virtualAddresses changed from ["10.0.1.10"] to ["10.0.1.20"]`,
+		`Tenant1/app1/web_pool.monitors removed`,
+		`Tenant2 added with {"class":"Tenant"}`,
+	}, declarationDiff(testDeclarationDiffOld, testDeclarationDiffNew, 3))
+
+	assert.Empty(t, declarationDiff(testDeclarationDiffOld, testDeclarationDiffOld, 3))
+	assert.Equal(t, []string{"declaration changed"}, declarationDiff("{", testDeclarationDiffNew, 3))
+
+	// DO declarations are not wrapped in a request
+	assert.Equal(t, []string{`Common/internal.tag changed from 4093 to 4094`},
+		declarationDiff(`{"class":"Device","Common":{"internal":{"class":"VLAN","tag":4093}}}`,
+			`{"class":"Device","Common":{"internal":{"class":"VLAN","tag":4094}}}`, 2))
+
+	assert.Equal(t, `a.b changed from "`+strings.Repeat("x", 79)+`... to 1`,
+		declarationDiff(fmt.Sprintf(`{"a":{"b":"%s"}}`, strings.Repeat("x", 100)), `{"a":{"b":1}}`, 0)[0])
+
+	var oldKeys, newKeys []string
+	for i := 0; i < maxDeclarationDiffLines+5; i++ {
+		oldKeys = append(oldKeys, fmt.Sprintf(`"k%03d":1`, i))
+		newKeys = append(newKeys, fmt.Sprintf(`"k%03d":2`, i))
+	}
+	lines := declarationDiff("{"+strings.Join(oldKeys, ",")+"}", "{"+strings.Join(newKeys, ",")+"}", 0)
+	assert.Len(t, lines, maxDeclarationDiffLines+1)
+	assert.Equal(t, "... and 5 more changes", lines[maxDeclarationDiffLines])
+}
+
+func TestResourceBigipAs3DiffSummary(t *testing.T) {
+	r := resourceBigipAs3()
+	state := &terraform.InstanceState{
+		ID: "Tenant1",
+		Attributes: map[string]string{
+			"id":          "Tenant1",
+			"as3_json":    testDeclarationDiffOld,
+			"tenant_list": "Tenant1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"as3_json": testDeclarationDiffNew})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	summary := diff.Attributes["diff_summary"]
+	if assert.NotNil(t, summary) {
+		assert.Contains(t, summary.New, `Tenant1/app1/serviceMain.virtualAddresses changed from ["10.0.1.10"] to ["10.0.1.20"]`)
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{"as3_json": testDeclarationDiffOld})
+	diff, err = r.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	if diff != nil {
+		assert.Nil(t, diff.Attributes["diff_summary"])
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Terraform destroys the objects of a configuration after the ones referencing them, so an object
+// still referenced when its Delete runs is used by something Terraform does not manage, or does not
+// destroy in this apply. The BIG-IP would refuse the delete midway through the destroy; the Delete
+// of the pool, monitor and profile resources checks the references first and reports every referrer
+// at once. Where removing the reference leaves the referrer working, force_delete removes it instead.
+// The references the lookups below miss still make the BIG-IP refuse the delete, whose error names
+// them, see poolUncheckedReferences and monitorUncheckedReferences.
+
+// poolUncheckedReferences and monitorUncheckedReferences are the references of pools and monitors
+// which are not looked up before their delete.
+const (
+	poolUncheckedReferences    = "last hop and clone pools of virtual servers, and pools named by iRules through variables or data groups"
+	monitorUncheckedReferences = "monitors inheriting from it"
+)
+
+// ltmReference is an object holding a reference to another one, in the fields selected by the
+// lookups below.
+type ltmReference struct {
+	FullPath     string `json:"fullPath"`
+	Pool         string `json:"pool,omitempty"`
+	Monitor      string `json:"monitor,omitempty"`
+	DefaultsFrom string `json:"defaultsFrom,omitempty"`
+	Rule         string `json:"apiAnonymous,omitempty"`
+}
+
+// ltmPolicyReference is a policy with the pools its rules forward to, as read with its
+// subcollections expanded.
+type ltmPolicyReference struct {
+	FullPath       string `json:"fullPath"`
+	RulesReference struct {
+		Items []struct {
+			ActionsReference struct {
+				Items []struct {
+					Pool string `json:"pool,omitempty"`
+				} `json:"items"`
+			} `json:"actionsReference"`
+		} `json:"items"`
+	} `json:"rulesReference"`
+}
+
+// ltmReferrer is an object referencing the one being deleted, kind being its collection, and pool
+// the pool of a pool member.
+type ltmReferrer struct {
+	kind    string
+	name    string
+	pool    string
+	monitor string
+}
+
+func (r ltmReferrer) String() string {
+	if r.pool != "" {
+		return "ltm pool " + r.pool + " member " + r.name
+	}
+	return strings.ReplaceAll(r.kind, "/", " ") + " " + r.name
+}
+
+// referrersOfKind returns the referrers in one of the collections kinds.
+func referrersOfKind(referrers []ltmReferrer, kinds ...string) []ltmReferrer {
+	var matches []ltmReferrer
+	for _, r := range referrers {
+		for _, kind := range kinds {
+			if r.kind == kind {
+				matches = append(matches, r)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// forceDeleteSchema returns the force_delete attribute of the resources whose references can be
+// removed, what describes what is done to the referrers.
+func forceDeleteSchema(what string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether destroying the resource " + what + " instead of failing when objects not destroyed by Terraform still reference it",
+	}
+}
+
+// poolReferrers returns the virtual servers whose default pool is pool, the policies with a rule
+// forwarding to it and the iRules selecting it with the pool command.
+func poolReferrers(client *bigip.BigIP, pool string) ([]ltmReferrer, error) {
+	virtuals, err := getRestItems[ltmReference](client, uriLtmVirtual+"?$select=fullPath,pool")
+	if err != nil {
+		return nil, fmt.Errorf("error reading the virtual servers using pool %s: %v", pool, err)
+	}
+	var referrers []ltmReferrer
+	for _, vs := range virtuals {
+		if vs.Pool == pool {
+			referrers = append(referrers, ltmReferrer{kind: uriLtmVirtual, name: vs.FullPath})
+		}
+	}
+	policies, err := getRestItems[ltmPolicyReference](client, uriLtmPolicy+"?expandSubcollections=true")
+	if err != nil {
+		return nil, fmt.Errorf("error reading the policies using pool %s: %v", pool, err)
+	}
+	for _, policy := range policies {
+		if policyForwardsToPool(policy, pool) {
+			referrers = append(referrers, ltmReferrer{kind: uriLtmPolicy, name: policy.FullPath})
+		}
+	}
+	rules, err := getRestItems[ltmReference](client, uriLtmIRule+"?$select=fullPath,apiAnonymous")
+	if err != nil {
+		return nil, fmt.Errorf("error reading the iRules using pool %s: %v", pool, err)
+	}
+	for _, rule := range rules {
+		if iRuleSelectsPool(rule.FullPath, rule.Rule, pool) {
+			referrers = append(referrers, ltmReferrer{kind: uriLtmIRule, name: rule.FullPath})
+		}
+	}
+	return referrers, nil
+}
+
+func policyForwardsToPool(policy ltmPolicyReference, pool string) bool {
+	for _, rule := range policy.RulesReference.Items {
+		for _, action := range rule.ActionsReference.Items {
+			if action.Pool == pool {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// iRulePoolCommand matches the pool command of an iRule and captures the pool it selects.
+var iRulePoolCommand = regexp.MustCompile(`(?:^|[\s\[{;])pool\s+"?([^\s"\];}]+)`)
+
+// iRuleSelectsPool reports whether the iRule rule, of the given text, selects pool with the pool
+// command, by its full path or by its name from the partition of the pool.
+func iRuleSelectsPool(rule, text, pool string) bool {
+	for _, match := range iRulePoolCommand.FindAllStringSubmatch(text, -1) {
+		if match[1] == pool || !strings.HasPrefix(match[1], "/") && partitionOf(rule) == partitionOf(pool) && "/"+partitionOf(pool)+"/"+match[1] == pool {
+			return true
+		}
+	}
+	return false
+}
+
+// partitionOf returns the partition of the object of full path fullPath.
+func partitionOf(fullPath string) string {
+	return strings.SplitN(strings.TrimPrefix(fullPath, "/"), "/", 2)[0]
+}
+
+// monitorReferrers returns the pools, pool members and nodes whose monitor rule names monitor.
+func monitorReferrers(client *bigip.BigIP, monitor string) ([]ltmReferrer, error) {
+	var referrers []ltmReferrer
+	pools, err := getRestItems[ltmReference](client, "ltm/pool?$select=fullPath,monitor")
+	if err != nil {
+		return nil, fmt.Errorf("error reading the pools using monitor %s: %v", monitor, err)
+	}
+	for _, pool := range pools {
+		if monitorRuleNames(pool.Monitor, monitor) {
+			referrers = append(referrers, ltmReferrer{kind: "ltm/pool", name: pool.FullPath, monitor: pool.Monitor})
+		}
+		kind := restObjectPath("ltm/pool", pool.FullPath) + "/members"
+		members, err := getRestItems[ltmReference](client, kind+"?$select=fullPath,monitor")
+		if err != nil {
+			return nil, fmt.Errorf("error reading the members of pool %s using monitor %s: %v", pool.FullPath, monitor, err)
+		}
+		for _, member := range members {
+			if monitorRuleNames(member.Monitor, monitor) {
+				referrers = append(referrers, ltmReferrer{kind: kind, name: member.FullPath, pool: pool.FullPath, monitor: member.Monitor})
+			}
+		}
+	}
+	nodes, err := getRestItems[ltmReference](client, "ltm/node?$select=fullPath,monitor")
+	if err != nil {
+		return nil, fmt.Errorf("error reading the nodes using monitor %s: %v", monitor, err)
+	}
+	for _, node := range nodes {
+		if monitorRuleNames(node.Monitor, monitor) {
+			referrers = append(referrers, ltmReferrer{kind: "ltm/node", name: node.FullPath, monitor: node.Monitor})
+		}
+	}
+	return referrers, nil
+}
+
+// monitorRuleNames reports whether the monitor rule names monitor.
+func monitorRuleNames(rule, monitor string) bool {
+	for _, token := range strings.Fields(rule) {
+		if token == monitor {
+			return true
+		}
+	}
+	return false
+}
+
+// profileReferrers returns the virtual servers the profile of the collection uri is attached to,
+// and the profiles inheriting from it.
+func profileReferrers(client *bigip.BigIP, uri, profile string) ([]ltmReferrer, error) {
+	virtuals, err := virtualServersUsingProfile(client, profile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the virtual servers using profile %s: %v", profile, err)
+	}
+	var referrers []ltmReferrer
+	for _, vs := range virtuals {
+		referrers = append(referrers, ltmReferrer{kind: uriLtmVirtual, name: vs})
+	}
+	children, err := getRestItems[ltmReference](client, uri+"?$select=fullPath,defaultsFrom")
+	if err != nil {
+		return nil, fmt.Errorf("error reading the profiles inheriting from %s: %v", profile, err)
+	}
+	for _, child := range children {
+		if child.DefaultsFrom == profile {
+			referrers = append(referrers, ltmReferrer{kind: uri, name: child.FullPath})
+		}
+	}
+	return referrers, nil
+}
+
+// referencedObjectError is the error of the delete of the object name, still used by referrers.
+// forceDelete names the attribute removing the references, for the referrers it removes.
+func referencedObjectError(label, name string, referrers []ltmReferrer, forceDelete bool) diag.Diagnostics {
+	names := make([]string, 0, len(referrers))
+	for _, r := range referrers {
+		names = append(names, r.String())
+	}
+	detail := fmt.Sprintf("%s %s is referenced by objects this apply does not destroy: %s. Remove the references before destroying it",
+		label, name, strings.Join(names, ", "))
+	if forceDelete {
+		detail += ", or set force_delete to remove them on destroy"
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Cannot delete %s %s, it is still in use", label, name),
+			Detail:   detail + ".",
+		},
+	}
+}
+
+// detachPool removes pool from the virtual servers using it as default pool.
+func detachPool(ctx context.Context, client *bigip.BigIP, pool string, referrers []ltmReferrer) error {
+	for _, r := range referrers {
+		apiLog := newAPICallLogger(ctx, "bigip_ltm_pool", pool, "delete", icontrolURI(r.kind, r.name))
+		body := map[string]string{"pool": "none"}
+		apiLog.payload(body)
+		err := patchRestEntity(client, body, restObjectPath(r.kind, r.name))
+		apiLog.done(err)
+		if err != nil {
+			return fmt.Errorf("error removing pool %s from %s: %v", pool, r, err)
+		}
+	}
+	return nil
+}
+
+// detachMonitor removes monitor from the monitor rules of the pools, pool members and nodes using it.
+func detachMonitor(ctx context.Context, client *bigip.BigIP, monitor string, referrers []ltmReferrer) error {
+	for _, r := range referrers {
+		apiLog := newAPICallLogger(ctx, "bigip_ltm_monitor", monitor, "delete", icontrolURI(r.kind, r.name))
+		body := map[string]string{"monitor": monitorRuleWithout(r.monitor, monitor)}
+		apiLog.payload(body)
+		err := patchRestEntity(client, body, restObjectPath(r.kind, r.name))
+		apiLog.done(err)
+		if err != nil {
+			return fmt.Errorf("error removing monitor %s from %s: %v", monitor, r, err)
+		}
+	}
+	return nil
+}
+
+// monitorRuleWithout returns the monitor rule without monitor, either "a and b" or "min N of { a b }",
+// whose minimum is lowered to the monitors left. A rule left empty becomes none.
+func monitorRuleWithout(rule, monitor string) string {
+	fields := strings.Fields(rule)
+	if len(fields) > 4 && fields[0] == "min" && fields[2] == "of" && fields[3] == "{" && fields[len(fields)-1] == "}" {
+		var monitors []string
+		for _, m := range fields[4 : len(fields)-1] {
+			if m != monitor {
+				monitors = append(monitors, m)
+			}
+		}
+		if len(monitors) == 0 {
+			return "none"
+		}
+		minimum, err := strconv.Atoi(fields[1])
+		if err != nil || minimum > len(monitors) {
+			minimum = len(monitors)
+		}
+		return fmt.Sprintf("min %d of { %s }", minimum, strings.Join(monitors, " "))
+	}
+	var monitors []string
+	for _, m := range fields {
+		if m != monitor && m != "and" {
+			monitors = append(monitors, m)
+		}
+	}
+	if len(monitors) == 0 {
+		return "none"
+	}
+	return strings.Join(monitors, " and ")
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitorRuleWithout(t *testing.T) {
+	for _, tc := range []struct {
+		rule, expected string
+	}{
+		{"/Common/m1", "none"},
+		{"/Common/http and /Common/m1", "/Common/http"},
+		{"/Common/m1 and /Common/http and /Common/tcp", "/Common/http and /Common/tcp"},
+		{"min 1 of { /Common/m1 /Common/http }", "min 1 of { /Common/http }"},
+		{"min 2 of { /Common/m1 /Common/http }", "min 1 of { /Common/http }"},
+		{"min 2 of { /Common/m1 /Common/http /Common/tcp }", "min 2 of { /Common/http /Common/tcp }"},
+		{"min 1 of { /Common/m1 }", "none"},
+	} {
+		assert.Equal(t, tc.expected, monitorRuleWithout(tc.rule, "/Common/m1"), tc.rule)
+	}
+}
+
+func TestIRuleSelectsPool(t *testing.T) {
+	for _, tc := range []struct {
+		rule, text string
+		expected   bool
+	}{
+		{"/Common/r1", "when HTTP_REQUEST { pool /Common/web }", true},
+		{"/Common/r1", "when HTTP_REQUEST {\n  if { $x } { pool web member 10.0.0.1 80 }\n}", true},
+		{"/Common/r1", `when HTTP_REQUEST { [pool "web"] }`, true},
+		{"/Common/r1", "when HTTP_REQUEST { pool /Common/web-2 }", false},
+		{"/Common/r1", "when HTTP_REQUEST { set pool_name web }", false},
+		{"/Other/r1", "when HTTP_REQUEST { pool web }", false},
+	} {
+		assert.Equal(t, tc.expected, iRuleSelectsPool(tc.rule, tc.text, "/Common/web"), tc.text)
+	}
+}
+
+func TestResourceBigipLtmPoolDeleteReferenced(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var patched, deleted string
+	policies := `{"items":[{"fullPath":"/Common/pol1","rulesReference":{"items":[
+		{"actionsReference":{"items":[{"forward":true,"pool":"/Common/web"}]}}]}}]}`
+	rules := `{"items":[{"fullPath":"/Common/r1","apiAnonymous":"when HTTP_REQUEST { pool web }"},
+		{"fullPath":"/Common/r2","apiAnonymous":"when HTTP_REQUEST { pool /Common/other }"}]}`
+	mux.HandleFunc("/mgmt/tm/ltm/policy", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("expandSubcollections"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, policies)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/rule", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, rules)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "fullPath,pool", r.URL.Query().Get("$select"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/vs1","pool":"/Common/web"},{"fullPath":"/Common/vs2","pool":"/Common/other"},
+			{"fullPath":"/Common/vs3","pool":"/Common/web"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		patched += r.URL.Path + " " + string(body) + "\n"
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~web", func(w http.ResponseWriter, r *http.Request) {
+		deleted = r.Method
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+	r := resourceBigipLtmPool()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "/Common/web"})
+	d.SetId("/Common/web")
+
+	diags := r.DeleteContext(context.Background(), d, client)
+	if assert.True(t, diags.HasError()) {
+		assert.Equal(t, "Cannot delete pool /Common/web, it is still in use", diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "ltm virtual /Common/vs1, ltm virtual /Common/vs3, ltm policy /Common/pol1, ltm rule /Common/r1.")
+		assert.NotContains(t, diags[0].Detail, "force_delete")
+	}
+
+	// force_delete does not remove the pool from policies and iRules
+	_ = d.Set("force_delete", true)
+	diags = r.DeleteContext(context.Background(), d, client)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Detail, "referenced by objects this apply does not destroy: ltm policy /Common/pol1, ltm rule /Common/r1.")
+	}
+	assert.Equal(t, "", patched)
+	assert.Equal(t, "", deleted)
+	assert.Equal(t, "/Common/web", d.Id())
+
+	policies, rules = `{"items":[]}`, `{"items":[]}`
+	_ = d.Set("force_delete", false)
+	diags = r.DeleteContext(context.Background(), d, client)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Detail, "ltm virtual /Common/vs1, ltm virtual /Common/vs3")
+		assert.Contains(t, diags[0].Detail, "force_delete")
+	}
+	assert.Equal(t, "", deleted)
+
+	_ = d.Set("force_delete", true)
+	assert.False(t, r.DeleteContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "/mgmt/tm/ltm/virtual/~Common~vs1 {\"pool\":\"none\"}\n/mgmt/tm/ltm/virtual/~Common~vs3 {\"pool\":\"none\"}\n", patched)
+	assert.Equal(t, "DELETE", deleted)
+	assert.Equal(t, "", d.Id())
+}
+
+func TestResourceBigipLtmMonitorDeleteForce(t *testing.T) {
+	setup()
+	defer teardown()
+
+	patched := map[string]string{}
+	var deleted string
+	mux.HandleFunc("/mgmt/tm/ltm/pool", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/p1","monitor":"min 1 of { /Common/m1 /Common/http }"},
+			{"fullPath":"/Common/p2","monitor":"/Common/m10"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/n1","monitor":"/Common/m1 "}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~p1/members", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/n1:80","monitor":"default"},
+			{"fullPath":"/Common/n2:80","monitor":"/Common/m1 and /Common/tcp "}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~p2/members", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+	for _, path := range []string{"/mgmt/tm/ltm/pool/~Common~p1", "/mgmt/tm/ltm/pool/~Common~p1/members/~Common~n2:80", "/mgmt/tm/ltm/node/~Common~n1"} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "PATCH", r.Method)
+			body, _ := io.ReadAll(r.Body)
+			patched[r.URL.Path] = string(body)
+			_, _ = fmt.Fprintf(w, `{}`)
+		})
+	}
+	mux.HandleFunc("/mgmt/tm/ltm/monitor/http/~Common~m1", func(w http.ResponseWriter, r *http.Request) {
+		deleted = r.Method
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+	r := resourceBigipLtmMonitor()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":   "/Common/m1",
+		"parent": "/Common/http",
+	})
+	d.SetId("/Common/m1")
+
+	diags := r.DeleteContext(context.Background(), d, client)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Detail, "ltm pool /Common/p1, ltm pool /Common/p1 member /Common/n2:80, ltm node /Common/n1.")
+	}
+
+	_ = d.Set("force_delete", true)
+	assert.False(t, r.DeleteContext(context.Background(), d, client).HasError())
+	assert.JSONEq(t, `{"monitor":"min 1 of { /Common/http }"}`, patched["/mgmt/tm/ltm/pool/~Common~p1"])
+	assert.JSONEq(t, `{"monitor":"/Common/tcp"}`, patched["/mgmt/tm/ltm/pool/~Common~p1/members/~Common~n2:80"])
+	assert.JSONEq(t, `{"monitor":"none"}`, patched["/mgmt/tm/ltm/node/~Common~n1"])
+	assert.Equal(t, "DELETE", deleted)
+}
+
+func TestResourceBigipLtmProfileDeleteReferenced(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var deleted bool
+	mux.HandleFunc("/mgmt/tm/ltm/virtual", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/vs1","profilesReference":{"items":[{"fullPath":"/Common/web-ssl"}]}}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/profile/client-ssl", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/web-ssl","defaultsFrom":"/Common/clientssl"},
+			{"fullPath":"/Common/web-ssl-child","defaultsFrom":"/Common/web-ssl"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/profile/client-ssl/~Common~web-ssl", func(w http.ResponseWriter, r *http.Request) {
+		deleted = true
+	})
+
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+	r := resourceBigipLtmProfileClientSsl()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "/Common/web-ssl"})
+	d.SetId("/Common/web-ssl")
+
+	diags := r.DeleteContext(context.Background(), d, client)
+	if assert.True(t, diags.HasError()) {
+		assert.Equal(t, "Cannot delete client SSL profile /Common/web-ssl, it is still in use", diags[0].Summary)
+		assert.Equal(t, "client SSL profile /Common/web-ssl is referenced by objects this apply does not destroy: "+
+			"ltm virtual /Common/vs1, ltm profile client-ssl /Common/web-ssl-child. Remove the references before destroying it.", diags[0].Detail)
+	}
+	assert.False(t, deleted)
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriDo = "mgmt/shared/declarative-onboarding"
+
+// doDryRunMinMajor and doDryRunMinMinor is the first DO version the dry-run is run with.
+const doDryRunMinMajor, doDryRunMinMinor = 1, 21
+
+// doDryRunReadOnlyNote is reported in dry_run_changes instead of the changes in read-only mode.
+const doDryRunReadOnlyNote = "dry-run skipped: the provider is in read-only mode, which refuses the POST of the declaration"
+
+// doTraceDiff is one entry of the diff DO traces between the current and the desired configuration.
+type doTraceDiff struct {
+	Kind  string        `json:"kind"`
+	Path  []interface{} `json:"path"`
+	Lhs   interface{}   `json:"lhs"`
+	Rhs   interface{}   `json:"rhs"`
+	Index *int          `json:"index"`
+	Item  *doTraceDiff  `json:"item"`
+}
+
+type doTaskResponse struct {
+	ID     string `json:"id"`
+	Result struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	} `json:"result"`
+	Traces struct {
+		Diff []doTraceDiff `json:"diff"`
+	} `json:"traces"`
+}
+
+// doCustomizeDiff sets the diff_summary of do_json and, when dry_run is set, runs the changed
+// declaration through DO in dry-run mode to report the changes DO would apply in dry_run_changes.
+func doCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := declarationDiffCustomizeDiff("do_json", 2)(ctx, d, meta); err != nil {
+		return err
+	}
+	if !d.Get("dry_run").(bool) || !d.HasChange("do_json") {
+		return nil
+	}
+	if !d.NewValueKnown("do_json") {
+		return d.SetNewComputed("dry_run_changes")
+	}
+	client, ok := meta.(*bigip.BigIP)
+	if !ok || client == nil {
+		return nil
+	}
+	// The dry-run is a POST of the declaration, which read_only refuses like any other.
+	if isReadOnlyClient(client) {
+		tflog.Warn(ctx, "DO dry-run skipped as the provider is in read-only mode")
+		return d.SetNew("dry_run_changes", doDryRunReadOnlyNote)
+	}
+	if d.Get("bigip_address").(string) != "" && d.Get("bigip_user").(string) != "" && d.Get("bigip_password").(string) != "" || d.Get("bigip_port").(string) != "" {
+		var err error
+		if client, err = connectBigIP(d); err != nil {
+			return fmt.Errorf("connection to BIG-IP for the DO dry-run failed: %v", err)
+		}
+	}
+	timeout := time.Duration(d.Get("timeout").(int)) * time.Minute
+	changes, err := doDryRun(ctx, client, d.Get("do_json").(string), timeout)
+	if err != nil {
+		return fmt.Errorf("DO dry-run of do_json failed: %v", err)
+	}
+	return d.SetNew("dry_run_changes", changes)
+}
+
+// doDryRun POSTs the declaration with the dryRun and trace controls set and returns the changes DO
+// reports, one per line. DO versions without dry-run support are skipped with a note.
+func doDryRun(ctx context.Context, client *bigip.BigIP, declaration string, timeout time.Duration) (string, error) {
+	version, err := getDoVersion(client)
+	if err != nil {
+		return "", err
+	}
+	if !as3VersionAtLeast(version, doDryRunMinMajor, doDryRunMinMinor) {
+		return fmt.Sprintf("dry-run skipped: DO %s does not support it, DO %d.%d or later is required", version, doDryRunMinMajor, doDryRunMinMinor), nil
+	}
+
+	var decl interface{}
+	if err := json.Unmarshal([]byte(declaration), &decl); err != nil {
+		return "", err
+	}
+	device, ok := unwrapDeclaration(decl).(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("do_json is not a JSON object")
+	}
+	controls, _ := device["controls"].(map[string]interface{})
+	if controls == nil {
+		controls = make(map[string]interface{})
+		device["controls"] = controls
+	}
+	controls["dryRun"] = true
+	controls["trace"] = true
+	controls["traceResponse"] = true
+
+	resp, err := restCall(client, "post", uriDo+"/", decl)
+	if err != nil {
+		return "", err
+	}
+	task := &doTaskResponse{}
+	if err := json.Unmarshal(resp, task); err != nil {
+		return "", err
+	}
+	deadline := time.Now().Add(timeout)
+	for task.Result.Status == "RUNNING" {
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("timeout waiting for DO task %s", task.ID)
+		}
+		time.Sleep(1 * time.Second)
+		id := task.ID
+		task = &doTaskResponse{}
+		if _, err := getRestEntity(client, task, uriDo+"/task/"+id); err != nil {
+			return "", err
+		}
+		tflog.Debug(ctx, "DO dry-run task status", map[string]interface{}{"task": id, "status": task.Result.Status})
+	}
+	if task.Result.Status != "" && task.Result.Status != "OK" {
+		return "", fmt.Errorf("DO task %s status %s: %s", task.ID, task.Result.Status, task.Result.Message)
+	}
+	lines := doTraceDiffLines(task.Traces.Diff)
+	if len(lines) == 0 {
+		return "no changes", nil
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// getDoVersion reads the version of the DO package installed on the BIG-IP.
+func getDoVersion(client *bigip.BigIP) (string, error) {
+	resp, err := restCall(client, "get", uriDo+"/info", nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the DO version: %v", err)
+	}
+	// DO answers with a list holding the info of the device
+	var infos []struct {
+		Version string `json:"version"`
+	}
+	if json.Unmarshal(resp, &infos) == nil && len(infos) > 0 {
+		return infos[0].Version, nil
+	}
+	info := struct {
+		Version string `json:"version"`
+	}{}
+	if err := json.Unmarshal(resp, &info); err != nil {
+		return "", fmt.Errorf("unable to read the DO version: %v", err)
+	}
+	return info.Version, nil
+}
+
+// doTraceDiffLines formats the diff traced by DO, sorted by path and bounded like diff_summary.
+func doTraceDiffLines(diffs []doTraceDiff) []string {
+	var lines []string
+	for _, diff := range diffs {
+		lines = append(lines, doTraceDiffLine(diff, doTracePath(diff.Path)))
+	}
+	sort.Strings(lines)
+	if len(lines) > maxDeclarationDiffLines {
+		more := len(lines) - maxDeclarationDiffLines
+		lines = append(lines[:maxDeclarationDiffLines], fmt.Sprintf("... and %d more changes", more))
+	}
+	return lines
+}
+
+func doTraceDiffLine(diff doTraceDiff, path string) string {
+	switch diff.Kind {
+	case "N":
+		return fmt.Sprintf("%s added with %s", path, declarationValue(diff.Rhs))
+	case "D":
+		return fmt.Sprintf("%s removed", path)
+	case "A":
+		if diff.Item != nil && diff.Index != nil {
+			return doTraceDiffLine(*diff.Item, fmt.Sprintf("%s[%d]", path, *diff.Index))
+		}
+	}
+	return fmt.Sprintf("%s changed from %s to %s", path, declarationValue(diff.Lhs), declarationValue(diff.Rhs))
+}
+
+func doTracePath(path []interface{}) string {
+	if len(path) == 0 {
+		return "declaration"
+	}
+	parts := make([]string, len(path))
+	for i, p := range path {
+		parts[i] = fmt.Sprint(p)
+	}
+	return strings.Join(parts, ".")
+}
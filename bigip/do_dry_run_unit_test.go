@@ -0,0 +1,136 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoDryRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var posted map[string]interface{}
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding/info", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `[{"version":"1.36.0","release":"4","schemaCurrent":"1.36.0"}]`)
+	})
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&posted)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprintf(w, `{"id":"task-1","result":{"status":"RUNNING"}}`)
+	})
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding/task/task-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"id":"task-1","result":{"status":"OK","dryRun":true},"traces":{"diff":[
+			{"kind":"E","path":["Common","hostname"],"lhs":"old.example.com","rhs":"bigip1.example.com"},
+			{"kind":"N","path":["Common","myDns"],"rhs":{"class":"DNS"}},
+			{"kind":"D","path":["Common","oldVlan"],"lhs":{"class":"VLAN"}},
+			{"kind":"A","path":["Common","myNtp","servers"],"index":1,"item":{"kind":"N","rhs":"1.pool.ntp.org"}}
+		]}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	changes, err := doDryRun(context.Background(), client, `{"schemaVersion":"1.36.0","class":"Device","Common":{"class":"Tenant","hostname":"bigip1.example.com"}}`, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, `Common.hostname changed from "old.example.com" to "bigip1.example.com"
+Common.myDns added with {"class":"DNS"}
+Common.myNtp.servers[1] added with "1.pool.ntp.org"
+Common.oldVlan removed`, changes)
+	assert.Equal(t, map[string]interface{}{"dryRun": true, "trace": true, "traceResponse": true}, posted["controls"])
+}
+
+func TestDoDryRunDeclarationWrapper(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var posted map[string]interface{}
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding/info", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"version":"1.21.0"}`)
+	})
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding/", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&posted)
+		_, _ = fmt.Fprintf(w, `{"id":"task-2","result":{"status":"OK"},"traces":{"diff":[]}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	changes, err := doDryRun(context.Background(), client, `{"class":"DO","declaration":{"class":"Device","controls":{"trace":false,"userAgent":"tf"}}}`, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "no changes", changes)
+	assert.Nil(t, posted["controls"])
+	assert.Equal(t, map[string]interface{}{"dryRun": true, "trace": true, "traceResponse": true, "userAgent": "tf"}, posted["declaration"].(map[string]interface{})["controls"])
+}
+
+func TestDoDryRunOldVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding/info", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `[{"version":"1.15.0"}]`)
+	})
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s of the declaration to DO 1.15", r.Method)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	changes, err := doDryRun(context.Background(), client, `{"class":"Device"}`, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "dry-run skipped: DO 1.15.0 does not support it, DO 1.21 or later is required", changes)
+}
+
+func TestDoDryRunReadOnly(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s of the declaration to DO in read-only mode", r.Method)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	installTransportHooks(client, &transportHooks{readOnly: true})
+	r := resourceBigipDo()
+	state := &terraform.InstanceState{
+		ID: "do",
+		Attributes: map[string]string{
+			"id":      "do",
+			"do_json": `{"class":"Device","Common":{"hostname":"old.example.com"}}`,
+			"dry_run": "true",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"do_json": `{"class":"Device","Common":{"hostname":"bigip1.example.com"}}`,
+		"dry_run": true,
+	})
+	diff, err := r.Diff(context.Background(), state, config, client)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff.Attributes["dry_run_changes"]) {
+		assert.Equal(t, doDryRunReadOnlyNote, diff.Attributes["dry_run_changes"].New)
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// enabledDisabledSynonyms maps the short forms the BIG-IP accepts for enabled and disabled.
+var enabledDisabledSynonyms = map[string]string{
+	"enable":  "enabled",
+	"disable": "disabled",
+}
+
+// yesNoSynonyms maps the enabled and disabled spellings of the settings the BIG-IP reports as yes
+// or no, e.g. the allowNat of a pool.
+var yesNoSynonyms = map[string]string{
+	"enabled":  "yes",
+	"enable":   "yes",
+	"disabled": "no",
+	"disable":  "no",
+}
+
+// httpChunkingSynonyms maps the request and response chunking values replaced by sustain in
+// BIG-IP 15.0, which reports sustain for a profile configured with either of them.
+var httpChunkingSynonyms = map[string]string{
+	"preserve":  "sustain",
+	"selective": "sustain",
+}
+
+// normalizeEnum returns the canonical form of a keyword value: lower case, with a synonym replaced
+// by the value it stands for.
+func normalizeEnum(value string, synonyms map[string]string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if canonical, ok := synonyms[value]; ok {
+		return canonical
+	}
+	return value
+}
+
+// suppressEnumDiff returns a DiffSuppressFunc suppressing the diff between two spellings of the same
+// keyword value, e.g. the Preserve the BIG-IP reports for a configured preserve.
+func suppressEnumDiff(synonyms map[string]string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		return normalizeEnum(old, synonyms) == normalizeEnum(new, synonyms)
+	}
+}
+
+// suppressCaseDiff suppresses the diff of keyword values the BIG-IP accepts in any case but
+// reports in its own, e.g. Preserve for preserve.
+var suppressCaseDiff = suppressEnumDiff(nil)
+
+// suppressEnabledDisabledDiff suppresses the diff of enabled / disabled settings spelled in another
+// case or in their short form.
+var suppressEnabledDisabledDiff = suppressEnumDiff(enabledDisabledSynonyms)
+
+// suppressYesNoDiff suppresses the diff of yes / no settings configured as enabled / disabled.
+var suppressYesNoDiff = suppressEnumDiff(yesNoSynonyms)
+
+// suppressHttpChunkingDiff suppresses the diff of the HTTP profile chunking settings across BIG-IP
+// versions.
+var suppressHttpChunkingDiff = suppressEnumDiff(httpChunkingSynonyms)
+
+// validateEnum returns a ValidateFunc accepting the keyword values, in any case or spelled as one of
+// synonyms, so that a typo is reported at plan time rather than by the BIG-IP at apply time. The
+// values are the ones of all the supported BIG-IP versions, a device rejects those it does not know.
+func validateEnum(values []string, synonyms map[string]string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+		normalized := normalizeEnum(v, synonyms)
+		for _, value := range values {
+			if normalized == normalizeEnum(value, synonyms) {
+				return nil, nil
+			}
+		}
+		return nil, []error{fmt.Errorf("expected %s to be one of %s, got %q", k, strings.Join(values, ", "), v)}
+	}
+}
+
+// validateEnabledDisabledEnum validates the enabled / disabled settings suppressEnabledDisabledDiff
+// applies to, which unlike validateEnabledDisabled accepts the other spellings of the values.
+var validateEnabledDisabledEnum = validateEnum([]string{"enabled", "disabled"}, enabledDisabledSynonyms)
+
+// validateHttpChunking validates the HTTP profile chunking settings, sustain being the value of
+// BIG-IP 15.0 and later for preserve and selective.
+var validateHttpChunking = validateEnum([]string{"preserve", "selective", "rechunk", "unchunk", "sustain"}, nil)
+
+// validateHttpVia validates the HTTP profile settings of the Via header.
+var validateHttpVia = validateEnum([]string{"preserve", "append", "remove"}, nil)
@@ -0,0 +1,132 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeEnum(t *testing.T) {
+	for _, tc := range []struct {
+		value    string
+		synonyms map[string]string
+		expected string
+	}{
+		{"Preserve", nil, "preserve"},
+		{" Enabled ", nil, "enabled"},
+		{"", nil, ""},
+		{"enable", enabledDisabledSynonyms, "enabled"},
+		{"Disable", enabledDisabledSynonyms, "disabled"},
+		{"enabled", enabledDisabledSynonyms, "enabled"},
+		{"auto", enabledDisabledSynonyms, "auto"},
+		{"Enabled", yesNoSynonyms, "yes"},
+		{"disable", yesNoSynonyms, "no"},
+		{"no", yesNoSynonyms, "no"},
+		{"Selective", httpChunkingSynonyms, "sustain"},
+		{"rechunk", httpChunkingSynonyms, "rechunk"},
+	} {
+		assert.Equal(t, tc.expected, normalizeEnum(tc.value, tc.synonyms), tc.value)
+	}
+}
+
+func TestSuppressEnumDiff(t *testing.T) {
+	for _, tc := range []struct {
+		old, new string
+		suppress schema.SchemaDiffSuppressFunc
+		expected bool
+	}{
+		{"Enabled", "enabled", suppressEnabledDisabledDiff, true},
+		{"enable", "enabled", suppressEnabledDisabledDiff, true},
+		{"disabled", "enabled", suppressEnabledDisabledDiff, false},
+		{"", "enabled", suppressEnabledDisabledDiff, false},
+		{"yes", "enabled", suppressYesNoDiff, true},
+		{"no", "enabled", suppressYesNoDiff, false},
+		{"sustain", "preserve", suppressHttpChunkingDiff, true},
+		{"Rechunk", "rechunk", suppressHttpChunkingDiff, true},
+		{"rechunk", "preserve", suppressHttpChunkingDiff, false},
+		{"enable", "enabled", suppressCaseDiff, false},
+		{"Carp", "carp", suppressCaseDiff, true},
+	} {
+		assert.Equal(t, tc.expected, tc.suppress("attr", tc.old, tc.new, nil), "%q %q", tc.old, tc.new)
+	}
+}
+
+// TestProfileEnumDiffSuppress covers attributes of each profile family that showed a perpetual diff
+// with the value the BIG-IP reports.
+func TestProfileEnumDiffSuppress(t *testing.T) {
+	for _, tc := range []struct {
+		resource *schema.Resource
+		attr     string
+		old, new string
+	}{
+		{resourceBigipLtmProfileHttp(), "request_chunking", "sustain", "preserve"},
+		{resourceBigipLtmProfileHttp(), "insert_xforwarded_for", "Enabled", "enabled"},
+		{resourceBigipLtmProfileTcp(), "nagle", "Disabled", "disabled"},
+		{resourceBigipLtmProfileClientSsl(), "renegotiation", "enable", "enabled"},
+		{resourceBigipLtmProfileServerSsl(), "secure_renegotiation", "Require-Strict", "require-strict"},
+		{resourceBigipLtmPool(), "allow_nat", "yes", "enabled"},
+		{resourceBigipLtmPool(), "ignore_persisted_weight", "Disabled", "disabled"},
+		{resourceBigipLtmPersistenceProfileCookie(), "cookie_encryption", "Disabled", "disabled"},
+		{resourceBigipLtmPersistenceProfileSrcAddr(), "match_across_virtuals", "Enabled", "enabled"},
+	} {
+		suppress := tc.resource.Schema[tc.attr].DiffSuppressFunc
+		if assert.NotNil(t, suppress, tc.attr) {
+			assert.True(t, suppress(tc.attr, tc.old, tc.new, nil), "%s: %q %q", tc.attr, tc.old, tc.new)
+		}
+	}
+}
+
+func TestValidateEnum(t *testing.T) {
+	for _, tc := range []struct {
+		value    interface{}
+		validate schema.SchemaValidateFunc
+		valid    bool
+	}{
+		{"reverse", validateEnum([]string{"reverse", "explicit"}, nil), true},
+		{"Explicit", validateEnum([]string{"reverse", "explicit"}, nil), true},
+		{"revrese", validateEnum([]string{"reverse", "explicit"}, nil), false},
+		{"", validateEnum([]string{"reverse", "explicit"}, nil), false},
+		{1, validateEnum([]string{"reverse", "explicit"}, nil), false},
+		{"enable", validateEnabledDisabledEnum, true},
+		{"Disabled", validateEnabledDisabledEnum, true},
+		{"yes", validateEnabledDisabledEnum, false},
+		{"sustain", validateHttpChunking, true},
+		{"Selective", validateHttpChunking, true},
+		{"selektive", validateHttpChunking, false},
+		{"Append", validateHttpVia, true},
+		{"add", validateHttpVia, false},
+	} {
+		_, errs := tc.validate(tc.value, "attr")
+		assert.Equal(t, tc.valid, len(errs) == 0, "%v: %v", tc.value, errs)
+	}
+}
+
+// TestHttpProfileEnumValidation checks that typos in the keyword settings of the HTTP profile fail
+// at plan time.
+func TestHttpProfileEnumValidation(t *testing.T) {
+	r := resourceBigipLtmProfileHttp()
+	for _, tc := range []struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		{map[string]interface{}{"name": "/Common/http", "proxy_type": "transparent", "request_chunking": "rechunk"}, true},
+		{map[string]interface{}{"name": "/Common/http", "proxy_type": "revrese"}, false},
+		{map[string]interface{}{"name": "/Common/http", "request_chunking": "selektive"}, false},
+		{map[string]interface{}{"name": "/Common/http", "redirect_rewrite": "matching", "via_request": "Append"}, true},
+		{map[string]interface{}{"name": "/Common/http", "via_response": "rewrite"}, false},
+		{map[string]interface{}{"name": "/Common/http", "insert_xforwarded_for": "enable", "accept_xff": "disabled"}, true},
+		{map[string]interface{}{"name": "/Common/http", "oneconnect_transformations": "on"}, false},
+		{map[string]interface{}{"name": "/Common/http", "http_strict_transport_security": []interface{}{map[string]interface{}{"mode": "enabeld"}}}, false},
+		{map[string]interface{}{"name": "/Common/http", "enforcement": []interface{}{map[string]interface{}{"unknown_method": "drop"}}}, false},
+	} {
+		diags := r.Validate(terraform.NewResourceConfigRaw(tc.config))
+		assert.Equal(t, tc.valid, !diags.HasError(), "%v: %v", tc.config, diags)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redactedValue = "***REDACTED***"
+
+// sensitiveLogKeys lists the (lower-cased) key fragments whose values are never written to the logs.
+var sensitiveLogKeys = []string{"authorization", "password", "passphrase", "secret", "token"}
+
+// apiCallLogger logs a single iControl REST call made on behalf of a resource, tagging every
+// line with the resource type, name, operation and target URI, and recording the elapsed time.
+type apiCallLogger struct {
+	ctx   context.Context
+	start time.Time
+}
+
+// newAPICallLogger starts timing an API call and logs that the operation began.
+func newAPICallLogger(ctx context.Context, resourceType, name, operation, uri string) *apiCallLogger {
+	ctx = tflog.SetField(ctx, "tf_resource_type", resourceType)
+	ctx = tflog.SetField(ctx, "bigip_object", name)
+	ctx = tflog.SetField(ctx, "bigip_operation", operation)
+	ctx = tflog.SetField(ctx, "bigip_uri", uri)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, sensitiveLogKeys...)
+	tflog.Info(ctx, fmt.Sprintf("%s %s %s", strings.ToUpper(operation[:1])+operation[1:], resourceType, name))
+	return &apiCallLogger{
+		ctx:   ctx,
+		start: time.Now(),
+	}
+}
+
+// payload logs the request body at TRACE level with secrets redacted.
+func (l *apiCallLogger) payload(body interface{}) {
+	tflog.Trace(l.ctx, "iControl REST request body", map[string]interface{}{
+		"body": redactLogPayload(body),
+	})
+}
+
+// done logs the outcome of the call along with the time it took.
+func (l *apiCallLogger) done(err error) {
+	fields := map[string]interface{}{
+		"elapsed_ms": time.Since(l.start).Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Error(l.ctx, "iControl REST call failed", fields)
+		return
+	}
+	tflog.Debug(l.ctx, "iControl REST call completed", fields)
+}
+
+// logResponseBody logs the body of resp, the answer to req, at TRACE level with secrets redacted and
+// restores it for the caller. Reading the body is only worth it when the provider logs at TRACE
+// level, and only JSON bodies are logged. The transport has no context to log with tflog, whose
+// fields would not name the resource anyway: go-bigip does not pass the context of the call.
+func logResponseBody(req *http.Request, resp *http.Response) {
+	if resp == nil || resp.Body == nil || !traceLogEnabled() || !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	log.Printf("[TRACE] iControl REST response body of %s %s (status %d): %s", req.Method, req.URL.Path, resp.StatusCode, redactLogBody(body))
+}
+
+// traceLogEnabled reports whether Terraform logs the provider at TRACE level.
+func traceLogEnabled() bool {
+	for _, env := range []string{"TF_LOG_PROVIDER_BIGIP", "TF_LOG_PROVIDER", "TF_LOG"} {
+		if level := os.Getenv(env); level != "" {
+			return strings.EqualFold(level, "TRACE") || strings.EqualFold(level, "JSON")
+		}
+	}
+	return false
+}
+
+// redactLogBody renders the JSON body with the values of any sensitive keys replaced.
+func redactLogBody(body []byte) string {
+	var generic interface{}
+	if err := json.Unmarshal(body, &generic); err != nil {
+		return fmt.Sprintf("<unable to render body of %d bytes: %v>", len(body), err)
+	}
+	redacted, _ := json.Marshal(redactLogValue(generic))
+	return string(redacted)
+}
+
+// redactLogPayload renders body as JSON, replacing the values of any sensitive keys.
+func redactLogPayload(body interface{}) string {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Sprintf("<unable to render payload: %v>", err)
+	}
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return string(data)
+	}
+	redacted, _ := json.Marshal(redactLogValue(generic))
+	return string(redacted)
+}
+
+func redactLogValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if isSensitiveLogKey(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactLogValue(val)
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactLogValue(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveLogKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveLogKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// icontrolURI builds the REST URI used in log lines for an object, e.g. /mgmt/tm/ltm/profile/http/~Common~test-http.
+func icontrolURI(collection, name string) string {
+	return fmt.Sprintf("/mgmt/tm/%s/%s", collection, strings.ReplaceAll(name, "/", "~"))
+}
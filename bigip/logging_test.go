@@ -0,0 +1,103 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSensitiveLogKey(t *testing.T) {
+	data := map[string]bool{
+		"password":              true,
+		"monitorPassphrase":     true,
+		"encryptCookieSecret":   true,
+		"Authorization":         true,
+		"X-F5-Auth-Token":       true,
+		"token":                 true,
+		"proxyCaPassphrase":     true,
+		"name":                  false,
+		"defaultsFrom":          false,
+		"cookieEncryptionLabel": false,
+	}
+	for key, sensitive := range data {
+		assert.Equal(t, sensitive, isSensitiveLogKey(key), "unexpected result for %s", key)
+	}
+}
+
+func TestRedactLogPayload(t *testing.T) {
+	body := struct {
+		Name       string                   `json:"name"`
+		Passphrase string                   `json:"passphrase"`
+		Monitors   []map[string]interface{} `json:"monitors"`
+		Nested     map[string]interface{}   `json:"nested"`
+	}{
+		Name:       "/Common/test",
+		Passphrase: "s3cr3t",
+		Monitors: []map[string]interface{}{
+			{"name": "http", "password": "hunter2"},
+		},
+		Nested: map[string]interface{}{
+			"encryptCookieSecret": "cookie-secret",
+			"timeout":             5,
+		},
+	}
+
+	out := redactLogPayload(body)
+	assert.NotContains(t, out, "s3cr3t")
+	assert.NotContains(t, out, "hunter2")
+	assert.NotContains(t, out, "cookie-secret")
+
+	var redacted map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(out), &redacted))
+	assert.Equal(t, "/Common/test", redacted["name"])
+	assert.Equal(t, redactedValue, redacted["passphrase"])
+	assert.Equal(t, redactedValue, redacted["monitors"].([]interface{})[0].(map[string]interface{})["password"])
+	assert.Equal(t, "http", redacted["monitors"].([]interface{})[0].(map[string]interface{})["name"])
+	assert.Equal(t, redactedValue, redacted["nested"].(map[string]interface{})["encryptCookieSecret"])
+	assert.Equal(t, float64(5), redacted["nested"].(map[string]interface{})["timeout"])
+}
+
+func TestRedactLogPayloadUnmarshalable(t *testing.T) {
+	out := redactLogPayload(map[string]interface{}{"bad": make(chan int)})
+	assert.Contains(t, out, "unable to render payload")
+}
+
+func TestLogResponseBody(t *testing.T) {
+	var out bytes.Buffer
+	defer log.SetOutput(log.Writer())
+	log.SetOutput(&out)
+
+	req := httptest.NewRequest(http.MethodPost, "https://bigip/mgmt/shared/authn/login", nil)
+	newResp := func() *http.Response {
+		resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+		resp.Header.Set("Content-Type", "application/json; charset=UTF-8")
+		resp.Body = io.NopCloser(bytes.NewBufferString(`{"username":"admin","token":{"token":"ABCDEF","timeout":1200}}`))
+		return resp
+	}
+
+	t.Setenv("TF_LOG", "DEBUG")
+	resp := newResp()
+	logResponseBody(req, resp)
+	assert.Empty(t, out.String())
+
+	t.Setenv("TF_LOG", "TRACE")
+	resp = newResp()
+	logResponseBody(req, resp)
+	assert.Contains(t, out.String(), "[TRACE] iControl REST response body of POST /mgmt/shared/authn/login (status 200)")
+	assert.Contains(t, out.String(), `"username":"admin"`)
+	assert.NotContains(t, out.String(), "ABCDEF")
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "ABCDEF", "the body is restored for the caller")
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ltmProfile holds what differs between the LTM profile resources; the CRUD functions built by
+// resource are shared. The profile is created with a POST of the payload built by expand, modified
+// with a PATCH of the same payload, read back into a T and deleted, all on the collection uri.
+//
+// Read writes every value returned by flatten, so an import fills the whole state in. The optional
+// attributes are therefore computed, so leaving them out of the configuration does not diff against
+// the value the profile inherits, except those listed in tracked, whose removal is sent to the
+// BIG-IP on update.
+type ltmProfile[T any] struct {
+	resourceType string
+	label        string
+	uri          string
+	tracked      []string
+	// expand returns the payload of the create and update calls.
+	expand func(d *schema.ResourceData, name string) (interface{}, error)
+	// flatten returns the attribute values of the profile read from the BIG-IP.
+	flatten func(d *schema.ResourceData, obj *T) map[string]interface{}
+	// beforeUpdate, when set, runs ahead of the update call.
+	beforeUpdate func(d *schema.ResourceData, client *bigip.BigIP, name string) error
+	// afterWrite, when set, runs after a successful create or update call, e.g. for the values the
+	// payload cannot carry.
+	afterWrite func(ctx context.Context, d *schema.ResourceData, client *bigip.BigIP, name string, create bool) error
+	// additionalAttributes adds the additional_attributes, additional_json_attributes and
+	// raw_attributes attributes, passing through the API fields the resource does not model yet.
+	additionalAttributes bool
+	// checkReferences refuses the delete of a profile still attached to virtual servers or inherited
+	// from, see profileReferrers.
+	checkReferences bool
+}
+
+// resource returns the resource with the shared CRUD functions, identified by the full path of the
+// profile.
+func (p *ltmProfile[T]) resource(s map[string]*schema.Schema) *schema.Resource {
+	if p.additionalAttributes {
+		s["additional_attributes"] = &schema.Schema{
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "API fields of the profile not modeled by the resource, by their camelCase name, merged into the payload of create and update as strings",
+		}
+		s["additional_json_attributes"] = &schema.Schema{
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "API fields of the profile not modeled by the resource, by their camelCase name, whose JSON encoded values are merged into the payload of create and update",
+		}
+		s["raw_attributes"] = &schema.Schema{
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Computed:    true,
+			Description: "Values of the additional_attributes and additional_json_attributes fields read back from the BIG-IP",
+		}
+	}
+	return &schema.Resource{
+		CreateContext: p.create,
+		ReadContext:   p.read,
+		UpdateContext: p.update,
+		DeleteContext: p.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importProfileFullPath,
+		},
+		SchemaVersion:  1,
+		StateUpgraders: profileFullPathStateUpgraders(s),
+		Schema:         s,
+	}
+}
+
+func (p *ltmProfile[T]) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, p.resourceType, name, "create", icontrolURI(p.uri, name))
+
+	config, err := p.payload(d, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	apiLog.payload(config)
+	err = postRestEntity(client, config, p.uri)
+	if err == nil && p.afterWrite != nil {
+		err = p.afterWrite(apiLog.ctx, d, client, name, true)
+	}
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating %s (%s): %s", p.label, name, err))
+	}
+	d.SetId(name)
+	return p.read(ctx, d, meta)
+}
+
+func (p *ltmProfile[T]) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, p.resourceType, name, "read", icontrolURI(p.uri, name))
+
+	var raw json.RawMessage
+	found, err := getRestEntity(client, &raw, restObjectPath(p.uri, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, p.label+" not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	obj := new(T)
+	if err := json.Unmarshal(raw, obj); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading %s (%s): %s", p.label, name, err))
+	}
+	_ = d.Set("name", name)
+	for attr, value := range p.flatten(d, obj) {
+		_ = d.Set(attr, value)
+	}
+	if p.additionalAttributes {
+		if err := setRawAttributes(d, raw); err != nil {
+			return diag.FromErr(fmt.Errorf("error reading %s (%s): %s", p.label, name, err))
+		}
+	}
+	return nil
+}
+
+// payload returns the payload built by expand with the additional_attributes and
+// additional_json_attributes merged in. They take precedence over the modeled attributes, an
+// unknown field is left for the BIG-IP to reject.
+func (p *ltmProfile[T]) payload(d *schema.ResourceData, name string) (interface{}, error) {
+	config, err := p.expand(d, name)
+	if err != nil || !p.additionalAttributes {
+		return config, err
+	}
+	additional := d.Get("additional_attributes").(map[string]interface{})
+	additionalJSON := d.Get("additional_json_attributes").(map[string]interface{})
+	if len(additional) == 0 && len(additionalJSON) == 0 {
+		return config, nil
+	}
+	data, err := restMarshal(config)
+	if err != nil {
+		return nil, err
+	}
+	merged := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(data), &merged); err != nil {
+		return nil, err
+	}
+	for key, value := range additional {
+		merged[key] = value
+	}
+	for key, value := range additionalJSON {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value.(string)), &decoded); err != nil {
+			return nil, fmt.Errorf("additional_json_attributes %s is not valid JSON: %v", key, err)
+		}
+		merged[key] = decoded
+	}
+	return merged, nil
+}
+
+// setRawAttributes reads the fields of additional_attributes and additional_json_attributes back
+// from the profile raw returned by the BIG-IP into raw_attributes. The configured value of a field
+// is kept while the BIG-IP returns an equal one, whatever its formatting; otherwise the value of the
+// BIG-IP replaces it so the drift shows in the plan, as does a field the BIG-IP no longer returns.
+func setRawAttributes(d *schema.ResourceData, raw json.RawMessage) error {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return err
+	}
+	rawValues := make(map[string]interface{})
+	for _, attr := range []string{"additional_attributes", "additional_json_attributes"} {
+		values := make(map[string]interface{})
+		for key, configured := range d.Get(attr).(map[string]interface{}) {
+			value, ok := fields[key]
+			if !ok {
+				continue
+			}
+			rawValues[key] = rawAttributeString(value)
+			if additionalAttributeEqual(configured.(string), value, attr == "additional_json_attributes") {
+				values[key] = configured
+			} else if attr == "additional_json_attributes" {
+				values[key] = string(value)
+			} else {
+				values[key] = rawValues[key]
+			}
+		}
+		_ = d.Set(attr, values)
+	}
+	_ = d.Set("raw_attributes", rawValues)
+	return nil
+}
+
+// rawAttributeString returns a string field as is and any other field as its JSON text.
+func rawAttributeString(value json.RawMessage) string {
+	var s string
+	if json.Unmarshal(value, &s) == nil {
+		return s
+	}
+	return string(value)
+}
+
+// additionalAttributeEqual reports whether the field value returned by the BIG-IP equals the
+// configured one, JSON encoded when isJSON is set. A string sent for a number or boolean field is
+// converted by the BIG-IP, so it equals the JSON value it holds.
+func additionalAttributeEqual(configured string, value json.RawMessage, isJSON bool) bool {
+	var s string
+	if !isJSON && json.Unmarshal(value, &s) == nil {
+		return s == configured
+	}
+	var want, got interface{}
+	if json.Unmarshal([]byte(configured), &want) != nil || json.Unmarshal(value, &got) != nil {
+		return false
+	}
+	return reflect.DeepEqual(want, got)
+}
+
+func (p *ltmProfile[T]) update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, p.resourceType, name, "update", icontrolURI(p.uri, name))
+
+	config, err := p.payload(d, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	apiLog.payload(config)
+	if p.beforeUpdate != nil {
+		if err := p.beforeUpdate(d, client, name); err != nil {
+			apiLog.done(err)
+			return diag.FromErr(err)
+		}
+	}
+	err = patchRestEntity(client, config, restObjectPath(p.uri, name))
+	if err == nil && p.afterWrite != nil {
+		err = p.afterWrite(apiLog.ctx, d, client, name, false)
+	}
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying %s (%s): %s", p.label, name, err))
+	}
+	return p.read(ctx, d, meta)
+}
+
+func (p *ltmProfile[T]) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	if p.checkReferences {
+		referrers, err := profileReferrers(client, p.uri, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(referrers) > 0 {
+			return referencedObjectError(p.label, name, referrers, false)
+		}
+	}
+	apiLog := newAPICallLogger(ctx, p.resourceType, name, "delete", icontrolURI(p.uri, name))
+
+	err := deleteRestEntity(client, restObjectPath(p.uri, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting %s (%s): %s", p.label, name, err))
+	}
+	d.SetId("")
+	return nil
+}
+
+// profileFullPath returns the full path of the profile name, which is a bare name when it was
+// imported or stored without its partition: the BIG-IP looks a bare name up in /Common, so a
+// profile of another partition with the same name would be read instead.
+func profileFullPath(name, partition string) string {
+	if strings.HasPrefix(name, "/") {
+		return name
+	}
+	if partition == "" {
+		partition = "Common"
+	}
+	return "/" + partition + "/" + name
+}
+
+// importProfileFullPath imports a profile by its full path, e.g. /Uat/web-ssl. A bare name is taken
+// as a /Common profile, the one the BIG-IP returns for it.
+func importProfileFullPath(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(profileFullPath(d.Id(), ""))
+	return []*schema.ResourceData{d}, nil
+}
+
+// profileFullPathStateUpgraders upgrades the states of version 0 of a profile resource with schema
+// s, whose ID could be a bare name, to IDs holding the full path.
+func profileFullPathStateUpgraders(s map[string]*schema.Schema) []schema.StateUpgrader {
+	return []schema.StateUpgrader{stateUpgrader(0, s, upgradeProfileFullPathV0)}
+}
+
+// upgradeProfileFullPathV0 rewrites a bare name in the ID and name of a state to the full path,
+// in the partition of the state when the resource has one.
+func upgradeProfileFullPathV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+	partition, _ := rawState["partition"].(string)
+	for _, attr := range []string{"id", "name"} {
+		if v, ok := rawState[attr].(string); ok && v != "" {
+			rawState[attr] = profileFullPath(v, partition)
+		}
+	}
+	return rawState, nil
+}
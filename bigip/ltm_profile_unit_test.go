@@ -0,0 +1,289 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// ltmProfileResources are the profile resources built on ltmProfile, each with an optional
+// attribute that an import fills in from the profile read.
+var ltmProfileResources = []struct {
+	resourceType string
+	resource     func() *schema.Resource
+	uri          string
+	optional     string
+	value        interface{}
+}{
+	{"bigip_ltm_profile_http", resourceBigipLtmProfileHttp, uriProfileHttp, "fallback_host", "example.com"},
+	{"bigip_ltm_profile_tcp", resourceBigipLtmProfileTcp, uriProfileTcp, "congestion_control", "bbr"},
+	{"bigip_ltm_profile_fasthttp", resourceBigipLtmProfileFasthttp, uriProfileFasthttp, "", nil},
+	{"bigip_ltm_profile_oneconnect", resourceBigipLtmProfileOneconnect, uriProfileOneconnect, "", nil},
+}
+
+func TestLtmProfileImport(t *testing.T) {
+	for _, tc := range ltmProfileResources {
+		t.Run(tc.resourceType, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/mgmt/tm/"+tc.uri+"/~Common~test-profile", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"name":"test-profile","fullPath":"/Common/test-profile","defaultsFrom":"/Common/parent",
+					"fallbackHost":"example.com","congestionControl":"bbr"}`)
+			})
+
+			client := bigip.NewSession(&bigip.Config{
+				Address:  server.URL,
+				Username: "xxxx",
+				Password: "xxxx",
+			})
+			r := tc.resource()
+			d := r.TestResourceData()
+			d.SetId("/Common/test-profile")
+			states, err := r.Importer.StateContext(context.Background(), d, client)
+			assert.NoError(t, err)
+			if assert.Len(t, states, 1) {
+				d = states[0]
+			}
+			assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+			assert.Equal(t, "/Common/test-profile", d.Id())
+			assert.Equal(t, "/Common/test-profile", d.Get("name"))
+			assert.Equal(t, "/Common/parent", d.Get("defaults_from"))
+			if tc.optional != "" {
+				assert.Equal(t, tc.value, d.Get(tc.optional))
+			}
+		})
+	}
+}
+
+func TestLtmProfileReadNotFound(t *testing.T) {
+	for _, tc := range ltmProfileResources {
+		t.Run(tc.resourceType, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/mgmt/tm/"+tc.uri+"/~Common~test-profile", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested profile (/Common/test-profile) was not found."}`)
+			})
+
+			client := bigip.NewSession(&bigip.Config{
+				Address:  server.URL,
+				Username: "xxxx",
+				Password: "xxxx",
+			})
+			r := tc.resource()
+			d := r.TestResourceData()
+			d.SetId("/Common/test-profile")
+			assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+			assert.Equal(t, "", d.Id())
+		})
+	}
+}
+
+func TestLtmProfileCreateDelete(t *testing.T) {
+	for _, tc := range ltmProfileResources {
+		t.Run(tc.resourceType, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			var methods []string
+			mux.HandleFunc("/mgmt/tm/"+tc.uri, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == "GET" {
+					// the profiles inheriting from the one deleted, when its references are checked
+					_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/test-profile","defaultsFrom":"/Common/parent"}]}`)
+					return
+				}
+				methods = append(methods, r.Method)
+				_, _ = fmt.Fprintf(w, `{"name":"test-profile"}`)
+			})
+			mux.HandleFunc("/mgmt/tm/ltm/virtual", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/vs1","profilesReference":{"items":[{"fullPath":"/Common/tcp"}]}}]}`)
+			})
+			mux.HandleFunc("/mgmt/tm/"+tc.uri+"/~Common~test-profile", func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"name":"test-profile","fullPath":"/Common/test-profile","defaultsFrom":"/Common/parent"}`)
+			})
+
+			client := bigip.NewSession(&bigip.Config{
+				Address:  server.URL,
+				Username: "xxxx",
+				Password: "xxxx",
+			})
+			client.Teem = true
+			r := tc.resource()
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"name":          "/Common/test-profile",
+				"defaults_from": "/Common/parent",
+			})
+			assert.False(t, r.CreateContext(context.Background(), d, client).HasError())
+			assert.Equal(t, "/Common/test-profile", d.Id())
+			assert.Equal(t, "/Common/parent", d.Get("defaults_from"))
+
+			assert.False(t, r.DeleteContext(context.Background(), d, client).HasError())
+			assert.Equal(t, "", d.Id())
+			assert.Equal(t, []string{"POST", "GET", "DELETE"}, methods)
+		})
+	}
+}
+
+func TestLtmProfileImportBareName(t *testing.T) {
+	for _, tc := range ltmProfileResources {
+		t.Run(tc.resourceType, func(t *testing.T) {
+			r := tc.resource()
+			d := r.TestResourceData()
+			d.SetId("test-profile")
+			states, err := r.Importer.StateContext(context.Background(), d, nil)
+			assert.NoError(t, err)
+			if assert.Len(t, states, 1) {
+				assert.Equal(t, "/Common/test-profile", states[0].Id())
+			}
+		})
+	}
+}
+
+func TestUpgradeProfileFullPathV0(t *testing.T) {
+	for _, tc := range []struct {
+		state    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			map[string]interface{}{"id": "web-ssl", "name": "web-ssl", "partition": "Uat"},
+			map[string]interface{}{"id": "/Uat/web-ssl", "name": "/Uat/web-ssl", "partition": "Uat"},
+		},
+		{
+			map[string]interface{}{"id": "web-http", "name": "web-http"},
+			map[string]interface{}{"id": "/Common/web-http", "name": "/Common/web-http"},
+		},
+		{
+			map[string]interface{}{"id": "/Uat/web-ssl", "name": "/Uat/web-ssl", "partition": "Uat"},
+			map[string]interface{}{"id": "/Uat/web-ssl", "name": "/Uat/web-ssl", "partition": "Uat"},
+		},
+	} {
+		state, err := upgradeProfileFullPathV0(context.Background(), tc.state, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, state)
+	}
+}
+
+// TestProfileFullPathStateUpgraders checks the upgrader of each profile resource accepts its own
+// states of version 0.
+func TestProfileFullPathStateUpgraders(t *testing.T) {
+	for name, r := range map[string]*schema.Resource{
+		"bigip_ltm_profile_client_ssl": resourceBigipLtmProfileClientSsl(),
+		"bigip_ltm_profile_server_ssl": resourceBigipLtmProfileServerSsl(),
+		"bigip_ltm_profile_http":       resourceBigipLtmProfileHttp(),
+		"bigip_ltm_profile_tcp":        resourceBigipLtmProfileTcp(),
+	} {
+		assert.Equal(t, 1, r.SchemaVersion, name)
+		if assert.Len(t, r.StateUpgraders, 1, name) {
+			assert.Equal(t, 0, r.StateUpgraders[0].Version, name)
+			assert.True(t, r.StateUpgraders[0].Type.Equals(r.CoreConfigSchema().ImpliedType()), name)
+		}
+		assert.NoError(t, r.InternalValidate(nil, true), name)
+	}
+}
+
+func TestAdditionalAttributeEqual(t *testing.T) {
+	for _, tc := range []struct {
+		configured, value string
+		isJSON, expected  bool
+	}{
+		{"enabled", `"enabled"`, false, true},
+		{"enabled", `"disabled"`, false, false},
+		{"300", `300`, false, true},
+		{"true", `"true"`, false, true},
+		{"5", `6`, false, false},
+		{`{"a":1,"b":[1,2]}`, `{ "b": [1, 2], "a": 1 }`, true, true},
+		{`["a","b"]`, `["b","a"]`, true, false},
+		{`"enabled"`, `"enabled"`, true, true},
+		{`300`, `"300"`, true, false},
+	} {
+		assert.Equal(t, tc.expected, additionalAttributeEqual(tc.configured, json.RawMessage(tc.value), tc.isJSON), tc.configured+" "+tc.value)
+	}
+}
+
+func TestLtmProfileAdditionalAttributes(t *testing.T) {
+	for _, tc := range ltmProfileResources[:2] {
+		t.Run(tc.resourceType, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			var bodies []map[string]interface{}
+			mux.HandleFunc("/mgmt/tm/"+tc.uri, func(w http.ResponseWriter, r *http.Request) {
+				body := map[string]interface{}{}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				bodies = append(bodies, body)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{}`)
+			})
+			mux.HandleFunc("/mgmt/tm/"+tc.uri+"/~Common~test-profile", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == "PATCH" {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = fmt.Fprintf(w, `{"code":400,"message":"one or more properties must be specified: unknown property \"noSuchField\""}`)
+					return
+				}
+				_, _ = fmt.Fprintf(w, `{"name":"test-profile","fullPath":"/Common/test-profile","defaultsFrom":"/Common/parent",
+					"newFeature":"enabled","newTimeout":600,"newLabel":"5","newList":[ "b", "a" ],"newObject":{"y":2,"x":1},"other":"x"}`)
+			})
+
+			client := bigip.NewSession(&bigip.Config{
+				Address:  server.URL,
+				Username: "xxxx",
+				Password: "xxxx",
+			})
+			client.Teem = true
+			r := tc.resource()
+			d := r.TestResourceData()
+			_ = d.Set("name", "/Common/test-profile")
+			_ = d.Set("additional_attributes", map[string]interface{}{"newFeature": "enabled", "newTimeout": "300", "newLabel": "5"})
+			_ = d.Set("additional_json_attributes", map[string]interface{}{"newList": `["a","b"]`, "newObject": `{"x":1,"y":2}`})
+			assert.False(t, r.CreateContext(context.Background(), d, client).HasError())
+			if assert.Len(t, bodies, 1) {
+				assert.Equal(t, "enabled", bodies[0]["newFeature"])
+				assert.Equal(t, "300", bodies[0]["newTimeout"])
+				assert.Equal(t, "5", bodies[0]["newLabel"])
+				assert.Equal(t, []interface{}{"a", "b"}, bodies[0]["newList"])
+				assert.Equal(t, map[string]interface{}{"x": float64(1), "y": float64(2)}, bodies[0]["newObject"])
+				assert.Equal(t, "/Common/test-profile", bodies[0]["name"])
+			}
+			assert.Equal(t, map[string]interface{}{"newFeature": "enabled", "newTimeout": "600", "newLabel": "5",
+				"newList": `[ "b", "a" ]`, "newObject": `{"y":2,"x":1}`}, d.Get("raw_attributes"))
+			// newTimeout and newList were changed on the BIG-IP: the drift shows, the object reordered
+			// by the BIG-IP keeps its configured value
+			assert.Equal(t, map[string]interface{}{"newFeature": "enabled", "newTimeout": "600", "newLabel": "5"}, d.Get("additional_attributes"))
+			assert.Equal(t, map[string]interface{}{"newList": `[ "b", "a" ]`, "newObject": `{"x":1,"y":2}`}, d.Get("additional_json_attributes"))
+
+			_ = d.Set("additional_json_attributes", map[string]interface{}{"newList": `[a]`})
+			diags := r.UpdateContext(context.Background(), d, client)
+			if assert.True(t, diags.HasError()) {
+				assert.Contains(t, diags[0].Summary, "additional_json_attributes newList is not valid JSON")
+			}
+			_ = d.Set("additional_json_attributes", map[string]interface{}{})
+
+			_ = d.Set("additional_attributes", map[string]interface{}{"noSuchField": "1"})
+			diags = r.UpdateContext(context.Background(), d, client)
+			if assert.True(t, diags.HasError()) {
+				assert.Contains(t, diags[0].Summary, `: one or more properties must be specified: unknown property "noSuchField"`)
+			}
+		})
+	}
+}
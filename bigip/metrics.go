@@ -0,0 +1,229 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// apiMetrics counts the requests sent to a BIG-IP by method, URI pattern and status, and writes
+// their summary to path once, when the provider stops.
+type apiMetrics struct {
+	path  string
+	start time.Time
+	once  sync.Once
+	mu    sync.Mutex
+	calls map[apiCallKey][]time.Duration
+}
+
+type apiCallKey struct {
+	method string
+	uri    string
+	status int
+}
+
+// apiMetricsSummary is the JSON document written to metrics_output_path.
+type apiMetricsSummary struct {
+	Start           string           `json:"start"`
+	End             string           `json:"end"`
+	TotalCalls      int              `json:"total_calls"`
+	TotalDurationMs int64            `json:"total_duration_ms"`
+	Calls           []apiCallMetrics `json:"calls"`
+}
+
+// apiCallMetrics is the latency distribution of the calls with the same method, URI pattern and
+// status, in milliseconds.
+type apiCallMetrics struct {
+	Method  string `json:"method"`
+	URI     string `json:"uri"`
+	Status  int    `json:"status"`
+	Count   int    `json:"count"`
+	TotalMs int64  `json:"total_ms"`
+	MinMs   int64  `json:"min_ms"`
+	MaxMs   int64  `json:"max_ms"`
+	P50Ms   int64  `json:"p50_ms"`
+	P90Ms   int64  `json:"p90_ms"`
+	P99Ms   int64  `json:"p99_ms"`
+}
+
+// runMetrics holds the apiMetrics of the provider instances of this process, flushed by
+// FlushMetrics when the plugin exits.
+var runMetrics sync.Map
+
+func newAPIMetrics(path string) *apiMetrics {
+	m := &apiMetrics{
+		path:  path,
+		start: time.Now(),
+		calls: make(map[apiCallKey][]time.Duration),
+	}
+	runMetrics.Store(m, struct{}{})
+	return m
+}
+
+// record counts a request, a failed request without response has status 0.
+func (m *apiMetrics) record(req *http.Request, resp *http.Response, elapsed time.Duration) {
+	key := apiCallKey{method: req.Method, uri: apiURIPattern(req.URL.Path)}
+	if resp != nil {
+		key.status = resp.StatusCode
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls[key] = append(m.calls[key], elapsed)
+}
+
+// summary returns the metrics of the calls recorded so far, sorted by URI pattern, method and status.
+func (m *apiMetrics) summary() *apiMetricsSummary {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := &apiMetricsSummary{
+		Start: m.start.UTC().Format(time.RFC3339Nano),
+		End:   time.Now().UTC().Format(time.RFC3339Nano),
+		Calls: make([]apiCallMetrics, 0, len(m.calls)),
+	}
+	for key, durations := range m.calls {
+		sorted := append([]time.Duration(nil), durations...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		c := apiCallMetrics{
+			Method: key.method,
+			URI:    key.uri,
+			Status: key.status,
+			Count:  len(sorted),
+			MinMs:  sorted[0].Milliseconds(),
+			MaxMs:  sorted[len(sorted)-1].Milliseconds(),
+			P50Ms:  durationPercentile(sorted, 50).Milliseconds(),
+			P90Ms:  durationPercentile(sorted, 90).Milliseconds(),
+			P99Ms:  durationPercentile(sorted, 99).Milliseconds(),
+		}
+		for _, d := range sorted {
+			c.TotalMs += d.Milliseconds()
+		}
+		s.TotalCalls += c.Count
+		s.TotalDurationMs += c.TotalMs
+		s.Calls = append(s.Calls, c)
+	}
+	sort.Slice(s.Calls, func(i, j int) bool {
+		a, b := s.Calls[i], s.Calls[j]
+		if a.URI != b.URI {
+			return a.URI < b.URI
+		}
+		if a.Method != b.Method {
+			return a.Method < b.Method
+		}
+		return a.Status < b.Status
+	})
+	return s
+}
+
+// flush writes the summary to the metrics file, only the first call writes it. The file is
+// replaced atomically, so a reader never sees a partial summary.
+func (m *apiMetrics) flush() error {
+	var err error
+	m.once.Do(func() {
+		runMetrics.Delete(m)
+		var b []byte
+		if b, err = json.MarshalIndent(m.summary(), "", "  "); err != nil {
+			return
+		}
+		tmp := m.path + ".tmp"
+		if err = os.WriteFile(tmp, append(b, '\n'), 0600); err != nil {
+			return
+		}
+		err = os.Rename(tmp, m.path)
+	})
+	return err
+}
+
+// FlushMetrics writes the API call summary of every provider instance configured with
+// metrics_output_path that was not stopped yet. It is called once the plugin stops serving.
+func FlushMetrics() {
+	runMetrics.Range(func(k, _ interface{}) bool {
+		m := k.(*apiMetrics)
+		if err := m.flush(); err != nil {
+			log.Printf("[ERROR] Unable to write API metrics to %s: %v", m.path, err)
+		}
+		return true
+	})
+}
+
+var (
+	// uuidSegment matches the IDs of tasks, e.g. the AS3, DO and FAST ones.
+	uuidSegment = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	// asmIDSegment matches the IDs of ASM objects, e.g. asm/policies/<id>.
+	asmIDSegment = regexp.MustCompile(`^[A-Za-z0-9_-]{22}$`)
+)
+
+// typedCollections lists the iControl REST paths under /mgmt/tm whose collections are one segment
+// deeper, by type, e.g. ltm/profile/http; the longest match applies. The other collections are the
+// module and the segment after it, e.g. ltm/pool.
+var typedCollections = []string{
+	"apm/policy", "apm/profile", "asm/tasks",
+	"gtm/global-settings", "gtm/monitor", "gtm/pool", "gtm/wideip",
+	"ltm/data-group", "ltm/message-routing/generic", "ltm/monitor", "ltm/persistence", "ltm/profile",
+	"net/bwc", "net/tunnels",
+	"security/bot-defense", "security/dos", "security/firewall", "security/log", "security/nat", "security/shared-objects",
+	"sys/application", "sys/crypto", "sys/file", "sys/icall", "sys/icall/handler", "sys/ntp", "sys/software",
+}
+
+// apiURIPattern returns path with its variable segments replaced, so the calls on different objects
+// of a collection are counted together and no object name or token is written to the metrics. Every
+// segment naming an object, the first after its collection and each after a subcollection, becomes
+// {name}, or {id} for a task or ASM ID, and an auth token {token}. Under /mgmt/shared the
+// collections are the service and the segment after it, e.g. appsvcs/task.
+func apiURIPattern(path string) string {
+	segments := strings.Split(path, "/")
+	// segments[0] is empty, the path being absolute
+	if len(segments) < 4 || segments[1] != "mgmt" {
+		return path
+	}
+	first := 0
+	switch segments[2] {
+	case "tm":
+		first = 5
+		rest := strings.Join(segments[3:], "/") + "/"
+		longest := ""
+		for _, typed := range typedCollections {
+			if strings.HasPrefix(rest, typed+"/") && len(typed) > len(longest) {
+				longest = typed
+			}
+		}
+		if longest != "" {
+			first = 4 + strings.Count(longest, "/") + 1
+		}
+	case "shared":
+		first = 5
+	default:
+		return path
+	}
+	for i := first; i < len(segments); i += 2 {
+		switch s := segments[i]; {
+		case s == "":
+		case segments[i-1] == "tokens":
+			segments[i] = "{token}"
+		case uuidSegment.MatchString(s) || (segments[i-1] == "policies" && asmIDSegment.MatchString(s)):
+			segments[i] = "{id}"
+		default:
+			segments[i] = "{name}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// durationPercentile returns the nearest-rank percentile p of the sorted durations.
+func durationPercentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
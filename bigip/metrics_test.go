@@ -0,0 +1,142 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransportHooksMetrics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/pool/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/mgmt/tm/ltm/pool/~Common~missing" {
+			http.Error(w, `{"code":404,"message":"not found"}`, http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"pool"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	metrics := newAPIMetrics(path)
+	installTransportHooks(client, &transportHooks{metrics: metrics})
+
+	for _, uri := range []string{"ltm/pool/~Common~pool1", "ltm/pool/~Common~pool2", "ltm/pool/~Common~missing", "ltm/pool?expandSubcollections=true"} {
+		_, _ = client.APICall(&bigip.APIRequest{Method: "get", URL: uri})
+	}
+	assert.NoError(t, metrics.flush())
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	var summary apiMetricsSummary
+	assert.NoError(t, json.Unmarshal(b, &summary))
+	assert.Equal(t, 4, summary.TotalCalls)
+	assert.Len(t, summary.Calls, 3)
+	for i, want := range []struct {
+		uri    string
+		status int
+		count  int
+	}{
+		{"/mgmt/tm/ltm/pool", http.StatusOK, 1},
+		{"/mgmt/tm/ltm/pool/{name}", http.StatusOK, 2},
+		{"/mgmt/tm/ltm/pool/{name}", http.StatusNotFound, 1},
+	} {
+		assert.Equal(t, http.MethodGet, summary.Calls[i].Method)
+		assert.Equal(t, want.uri, summary.Calls[i].URI)
+		assert.Equal(t, want.status, summary.Calls[i].Status)
+		assert.Equal(t, want.count, summary.Calls[i].Count)
+	}
+	// only the first flush writes the file
+	assert.NoError(t, os.Remove(path))
+	assert.NoError(t, metrics.flush())
+	assert.NoFileExists(t, path)
+
+	metrics = newAPIMetrics(path)
+	metrics.record(httptest.NewRequest(http.MethodGet, server.URL+"/mgmt/tm/ltm/pool/~Common~pool1", nil), &http.Response{StatusCode: http.StatusOK}, 5*time.Millisecond)
+	// FlushMetrics writes the metrics not flushed when the provider stopped
+	FlushMetrics()
+	b, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	summary = apiMetricsSummary{}
+	assert.NoError(t, json.Unmarshal(b, &summary))
+	assert.Equal(t, 1, summary.TotalCalls)
+	assert.Equal(t, int64(5), summary.TotalDurationMs)
+	assert.NotEmpty(t, summary.Start)
+	assert.NotEmpty(t, summary.End)
+	assert.Equal(t, []apiCallMetrics{{
+		Method: http.MethodGet, URI: "/mgmt/tm/ltm/pool/{name}", Status: http.StatusOK,
+		Count: 1, TotalMs: 5, MinMs: 5, MaxMs: 5, P50Ms: 5, P90Ms: 5, P99Ms: 5,
+	}}, summary.Calls)
+}
+
+func TestAPIMetricsSummary(t *testing.T) {
+	m := newAPIMetrics(filepath.Join(t.TempDir(), "metrics.json"))
+	defer runMetrics.Delete(m)
+	get := func(path string) *http.Request {
+		return httptest.NewRequest(http.MethodGet, "https://bigip"+path, nil)
+	}
+	for i := 1; i <= 10; i++ {
+		m.record(get("/mgmt/tm/ltm/pool/~Common~pool"), &http.Response{StatusCode: http.StatusOK}, time.Duration(i)*time.Millisecond)
+	}
+	m.record(get("/mgmt/tm/ltm/pool/~Common~missing"), &http.Response{StatusCode: http.StatusNotFound}, time.Millisecond)
+	m.record(get("/mgmt/tm/ltm/pool"), nil, 2*time.Millisecond)
+
+	s := m.summary()
+	assert.Equal(t, 12, s.TotalCalls)
+	assert.Equal(t, int64(58), s.TotalDurationMs)
+	assert.Equal(t, []apiCallMetrics{
+		{Method: http.MethodGet, URI: "/mgmt/tm/ltm/pool", Status: 0, Count: 1, TotalMs: 2, MinMs: 2, MaxMs: 2, P50Ms: 2, P90Ms: 2, P99Ms: 2},
+		{Method: http.MethodGet, URI: "/mgmt/tm/ltm/pool/{name}", Status: http.StatusOK, Count: 10, TotalMs: 55, MinMs: 1, MaxMs: 10, P50Ms: 5, P90Ms: 9, P99Ms: 10},
+		{Method: http.MethodGet, URI: "/mgmt/tm/ltm/pool/{name}", Status: http.StatusNotFound, Count: 1, TotalMs: 1, MinMs: 1, MaxMs: 1, P50Ms: 1, P90Ms: 1, P99Ms: 1},
+	}, s.Calls)
+}
+
+func TestAPIURIPattern(t *testing.T) {
+	for path, want := range map[string]string{
+		"/mgmt/tm/ltm/node": "/mgmt/tm/ltm/node",
+		"/mgmt/tm/ltm/pool/~Common~pool/members/~Common~10.0.0.1:80":     "/mgmt/tm/ltm/pool/{name}/members/{name}",
+		"/mgmt/shared/authz/tokens/ABCDEFGHIJKLMNOP":                     "/mgmt/shared/authz/tokens/{token}",
+		"/mgmt/shared/appsvcs/task/4a0b2c9e-1d2f-4e5a-8b6c-7d8e9f0a1b2c": "/mgmt/shared/appsvcs/task/{id}",
+		"/mgmt/tm/asm/policies/W-_t9SZfnDkJ0X7nbvVR3A/urls":              "/mgmt/tm/asm/policies/{id}/urls",
+		"/mgmt/tm/asm/policies":                                          "/mgmt/tm/asm/policies",
+		"/mgmt/tm/sys/file/ssl-cert/a-certificate-named-22c":             "/mgmt/tm/sys/file/ssl-cert/{name}",
+		"/mgmt/tm/sys/file/ssl-cert/~Common~web.crt":                     "/mgmt/tm/sys/file/ssl-cert/{name}",
+		"/mgmt/tm/ltm/pool/web_pool":                                     "/mgmt/tm/ltm/pool/{name}",
+		"/mgmt/tm/ltm/pool/web_pool/members/10.0.0.1:80":                 "/mgmt/tm/ltm/pool/{name}/members/{name}",
+		"/mgmt/tm/ltm/profile/http/web":                                  "/mgmt/tm/ltm/profile/http/{name}",
+		"/mgmt/tm/ltm/policy/~Common~Drafts~pol/rules/r1/actions/0":      "/mgmt/tm/ltm/policy/{name}/rules/{name}/actions/{name}",
+		"/mgmt/tm/sys/icall/handler/periodic/h1":                         "/mgmt/tm/sys/icall/handler/periodic/{name}",
+		"/mgmt/tm/sys/icall/script/s1":                                   "/mgmt/tm/sys/icall/script/{name}",
+		"/mgmt/tm/sys/version":                                           "/mgmt/tm/sys/version",
+		"/mgmt/tm/util/bash":                                             "/mgmt/tm/util/bash",
+		"/mgmt/shared/appsvcs/declare/Tenant1,Tenant2":                   "/mgmt/shared/appsvcs/declare/{name}",
+		"/mgmt/shared/file-transfer/uploads/web.crt":                     "/mgmt/shared/file-transfer/uploads/{name}",
+		"/mgmt/shared/authn/login":                                       "/mgmt/shared/authn/login",
+		"/api/v1/system/infos":                                           "/api/v1/system/infos",
+	} {
+		assert.Equal(t, want, apiURIPattern(path), path)
+	}
+}
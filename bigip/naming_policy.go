@@ -0,0 +1,137 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// namingPolicy holds the enforce_partition and name_prefix provider settings.
+type namingPolicy struct {
+	partition string
+	prefix    string
+}
+
+// namingPolicies maps a configured client to its naming policy, the provider meta being the
+// go-bigip session itself.
+var namingPolicies sync.Map
+
+func setNamingPolicy(client *bigip.BigIP, policy *namingPolicy) {
+	if policy.partition == "" && policy.prefix == "" {
+		namingPolicies.Delete(client)
+		return
+	}
+	namingPolicies.Store(client, policy)
+}
+
+func getNamingPolicy(meta interface{}) *namingPolicy {
+	client, ok := meta.(*bigip.BigIP)
+	if !ok || client == nil {
+		return nil
+	}
+	policy, ok := namingPolicies.Load(client)
+	if !ok {
+		return nil
+	}
+	return policy.(*namingPolicy)
+}
+
+// apply returns name with the name prefix applied to its base name, and fails when the object would
+// be created outside the enforced partition. partition is the value of the resource's partition
+// attribute, empty when it has none and the name is a full path.
+func (p *namingPolicy) apply(name, partition string) (string, error) {
+	base := name
+	if strings.HasPrefix(name, "/") {
+		parts := strings.Split(strings.TrimPrefix(name, "/"), "/")
+		partition = parts[0]
+		base = parts[len(parts)-1]
+	}
+	if p.partition != "" && partition != "" && partition != p.partition {
+		return "", fmt.Errorf("must be created in partition %s, not %s", p.partition, partition)
+	}
+	if p.prefix != "" && !strings.HasPrefix(base, p.prefix) {
+		return strings.TrimSuffix(name, base) + p.prefix + base, nil
+	}
+	return name, nil
+}
+
+// namingPolicyObject returns the full path of the object name in partition, as named in the errors.
+func namingPolicyObject(name, partition string) string {
+	if strings.HasPrefix(name, "/") || partition == "" {
+		return name
+	}
+	return "/" + partition + "/" + name
+}
+
+// namingPolicyCustomizeDiff wraps the CustomizeDiff of resourceType so that objects planned for
+// creation, or renamed, comply with the naming policy of the provider: a name without the name
+// prefix gets it in the plan, an object outside the enforced partition fails it. Only objects named
+// by full path or having a partition attribute are checked, bare names such as modules or devices
+// are not partitioned.
+//
+// The prefixed name is planned with SetNew, which only operates on computed attributes, so the name
+// attribute is made computed; a required name becomes optional and its absence is reported here.
+func namingPolicyCustomizeDiff(resourceType string, r *schema.Resource, next schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	nameSchema, hasName := r.Schema["name"]
+	if !hasName || nameSchema.Type != schema.TypeString {
+		return next
+	}
+	rewritable := nameSchema.Computed || nameSchema.Default == nil && nameSchema.DefaultFunc == nil
+	required := nameSchema.Required
+	if rewritable && !nameSchema.Computed {
+		nameSchema.Required = false
+		nameSchema.Optional = true
+		nameSchema.Computed = true
+	}
+	_, hasPartition := r.Schema["partition"]
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if required && namingPolicyNameMissing(d) {
+			return fmt.Errorf("%s: the argument \"name\" is required, but no definition was found", resourceType)
+		}
+		if policy := getNamingPolicy(meta); policy != nil && d.NewValueKnown("name") {
+			created := d.Id() == "" || d.HasChange("name") || (hasPartition && d.HasChange("partition"))
+			name := d.Get("name").(string)
+			partition := ""
+			if hasPartition && d.NewValueKnown("partition") {
+				partition, _ = d.Get("partition").(string)
+			}
+			if created && name != "" && (strings.HasPrefix(name, "/") || hasPartition) {
+				prefixed, err := policy.apply(name, partition)
+				if err != nil {
+					return fmt.Errorf("%s %s: %v", resourceType, namingPolicyObject(name, partition), err)
+				}
+				if prefixed != name {
+					if !rewritable {
+						return fmt.Errorf("%s %s: must start with the name prefix %s, e.g. %s", resourceType,
+							namingPolicyObject(name, partition), policy.prefix, prefixed)
+					}
+					if err := d.SetNew("name", prefixed); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		if next != nil {
+			return next(ctx, d, meta)
+		}
+		return nil
+	}
+}
+
+// namingPolicyNameMissing reports whether the configuration of d leaves the name out.
+func namingPolicyNameMissing(d *schema.ResourceDiff) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute("name") {
+		return false
+	}
+	return config.GetAttr("name").IsNull()
+}
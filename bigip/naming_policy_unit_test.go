@@ -0,0 +1,113 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamingPolicyApply(t *testing.T) {
+	policy := &namingPolicy{partition: "TeamA", prefix: "teama-"}
+	for _, tc := range []struct {
+		name, partition, expected string
+	}{
+		{"/TeamA/teama-pool", "", "/TeamA/teama-pool"},
+		{"/TeamA/app/teama-pool", "", "/TeamA/app/teama-pool"},
+		{"/TeamA/pool", "", "/TeamA/teama-pool"},
+		{"/TeamA/app/pool", "", "/TeamA/app/teama-pool"},
+		{"teama-key", "TeamA", "teama-key"},
+		{"key", "TeamA", "teama-key"},
+	} {
+		name, err := policy.apply(tc.name, tc.partition)
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, tc.expected, name, tc.name)
+	}
+	_, err := policy.apply("/Common/teama-pool", "")
+	assert.EqualError(t, err, "must be created in partition TeamA, not Common")
+	_, err = policy.apply("key", "Common")
+	assert.EqualError(t, err, "must be created in partition TeamA, not Common")
+
+	name, err := (&namingPolicy{partition: "TeamA"}).apply("/TeamA/pool", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "/TeamA/pool", name)
+	name, err = (&namingPolicy{prefix: "teama-"}).apply("/Common/pool", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "/Common/teama-pool", name)
+}
+
+func TestNamingPolicyCustomizeDiff(t *testing.T) {
+	p := Provider()
+	client := bigip.NewSession(&bigip.Config{Address: "127.0.0.1", Username: "xxxx", Password: "xxxx"})
+	setNamingPolicy(client, &namingPolicy{partition: "TeamA", prefix: "teama-"})
+	defer setNamingPolicy(client, &namingPolicy{})
+
+	pool := p.ResourcesMap["bigip_ltm_pool"]
+	_, err := pool.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "/Common/teama-foo",
+	}), client)
+	assert.EqualError(t, err, "bigip_ltm_pool /Common/teama-foo: must be created in partition TeamA, not Common")
+
+	diff, err := pool.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "/TeamA/teama-foo",
+	}), client)
+	assert.NoError(t, err)
+	assert.Equal(t, "/TeamA/teama-foo", diff.Attributes["name"].New)
+
+	// a name without the prefix gets it
+	diff, err = pool.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "/TeamA/foo",
+	}), client)
+	assert.NoError(t, err)
+	assert.Equal(t, "/TeamA/teama-foo", diff.Attributes["name"].New)
+
+	// and keeps it once created
+	diff, err = pool.Diff(context.Background(), &terraform.InstanceState{
+		ID:         "/TeamA/teama-foo",
+		Attributes: map[string]string{"id": "/TeamA/teama-foo", "name": "/TeamA/teama-foo"},
+	}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "/TeamA/foo",
+	}), client)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Attributes["name"] == nil, "unexpected diff: %v", diff)
+
+	// existing objects are not checked again unless they are renamed
+	_, err = pool.Diff(context.Background(), &terraform.InstanceState{
+		ID:         "/Common/foo",
+		Attributes: map[string]string{"id": "/Common/foo", "name": "/Common/foo"},
+	}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "/Common/foo",
+	}), client)
+	assert.NoError(t, err)
+
+	key := p.ResourcesMap["bigip_ssl_key"]
+	diff, err = key.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":      "foo.key",
+		"content":   "key",
+		"partition": "TeamA",
+	}), client)
+	assert.NoError(t, err)
+	assert.Equal(t, "teama-foo.key", diff.Attributes["name"].New)
+
+	_, err = key.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":      "teama-foo.key",
+		"content":   "key",
+		"partition": "Common",
+	}), client)
+	assert.EqualError(t, err, "bigip_ssl_key /Common/teama-foo.key: must be created in partition TeamA, not Common")
+
+	// without a naming policy nothing is enforced
+	other := bigip.NewSession(&bigip.Config{Address: "127.0.0.1", Username: "xxxx", Password: "xxxx"})
+	diff, err = pool.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "/Common/foo",
+	}), other)
+	assert.NoError(t, err)
+	assert.Equal(t, "/Common/foo", diff.Attributes["name"].New)
+}
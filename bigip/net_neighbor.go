@@ -0,0 +1,209 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NetNeighbor mirrors the net arp and net ndp objects, the static entries of the ARP and IPv6
+// neighbor discovery tables.
+type NetNeighbor struct {
+	Name       string `json:"name,omitempty"`
+	Partition  string `json:"partition,omitempty"`
+	FullPath   string `json:"fullPath,omitempty"`
+	IPAddress  string `json:"ipAddress,omitempty"`
+	MacAddress string `json:"macAddress,omitempty"`
+}
+
+// netNeighbor holds what differs between bigip_net_arp and bigip_net_ndp: the collection uri and the
+// address family of the entries. The resource is identified by the full path of the entry.
+type netNeighbor struct {
+	resourceType string
+	label        string
+	uri          string
+	ipv6         bool
+}
+
+func (n *netNeighbor) resource() *schema.Resource {
+	family := "IPv4"
+	if n.ipv6 {
+		family = "IPv6"
+	}
+	return &schema.Resource{
+		CreateContext: n.create,
+		ReadContext:   n.read,
+		UpdateContext: n.update,
+		DeleteContext: n.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importProfileFullPath,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^/\s]+$`), "must be a name without partition, set partition instead"),
+				Description:  fmt.Sprintf("Name of the static %s entry", n.label),
+			},
+			"partition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Common",
+				ValidateFunc: validatePartitionName,
+				Description:  "Partition of the entry, Common by default",
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateNeighborAddress(n.ipv6),
+				Description:  fmt.Sprintf("%s address of the neighbor, with an optional route domain e.g. %%2", family),
+			},
+			"mac_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateMacAddress,
+				DiffSuppressFunc: suppressMacAddressDiff,
+				Description:      "MAC address of the neighbor, e.g. 00:50:56:a1:0c:2b",
+			},
+		},
+	}
+}
+
+func (n *netNeighbor) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := profileFullPath(d.Get("name").(string), d.Get("partition").(string))
+	apiLog := newAPICallLogger(ctx, n.resourceType, name, "create", icontrolURI(n.uri, name))
+
+	config := &NetNeighbor{
+		Name:       d.Get("name").(string),
+		Partition:  d.Get("partition").(string),
+		IPAddress:  d.Get("ip_address").(string),
+		MacAddress: d.Get("mac_address").(string),
+	}
+	apiLog.payload(config)
+	err := postRestEntity(client, config, n.uri)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating %s (%s): %s", n.label, name, err))
+	}
+	d.SetId(name)
+	return n.read(ctx, d, meta)
+}
+
+func (n *netNeighbor) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, n.resourceType, name, "read", icontrolURI(n.uri, name))
+
+	entry := &NetNeighbor{}
+	found, err := getRestEntity(client, entry, restObjectPath(n.uri, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, fmt.Sprintf("%s not found, removing from state", n.label))
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", entry.Name)
+	_ = d.Set("partition", entry.Partition)
+	_ = d.Set("ip_address", entry.IPAddress)
+	_ = d.Set("mac_address", entry.MacAddress)
+	return nil
+}
+
+func (n *netNeighbor) update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, n.resourceType, name, "update", icontrolURI(n.uri, name))
+
+	config := &NetNeighbor{
+		MacAddress: d.Get("mac_address").(string),
+	}
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(n.uri, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying %s (%s): %s", n.label, name, err))
+	}
+	return n.read(ctx, d, meta)
+}
+
+func (n *netNeighbor) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, n.resourceType, name, "delete", icontrolURI(n.uri, name))
+
+	err := deleteRestEntity(client, restObjectPath(n.uri, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// validateNeighborAddress returns a ValidateFunc accepting an IPv6 address when ipv6 is set and an IPv4
+// address otherwise, each with an optional route domain suffix.
+func validateNeighborAddress(ipv6 bool) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) ([]string, []error) {
+		value := v.(string)
+		address, rd, hasRd := strings.Cut(value, "%")
+		ip := net.ParseIP(address)
+		if ip == nil || (hasRd && !regexp.MustCompile(`^\d+$`).MatchString(rd)) {
+			return nil, []error{fmt.Errorf("%s: %q is not an IP address", k, value)}
+		}
+		if isIPv4 := ip.To4() != nil && !strings.Contains(address, ":"); isIPv4 == ipv6 {
+			if ipv6 {
+				return nil, []error{fmt.Errorf("%s: %q is not an IPv6 address, use bigip_net_arp for IPv4 neighbors", k, value)}
+			}
+			return nil, []error{fmt.Errorf("%s: %q is not an IPv4 address, use bigip_net_ndp for IPv6 neighbors", k, value)}
+		}
+		return nil, nil
+	}
+}
+
+// macAddressOctets matches a MAC address of six octets separated by colons or dashes. The BIG-IP
+// drops the leading zero of the octets, so octets of a single digit are accepted.
+var macAddressOctets = regexp.MustCompile(`^[0-9A-Fa-f]{1,2}([:-][0-9A-Fa-f]{1,2}){5}$`)
+
+func validateMacAddress(v interface{}, k string) ([]string, []error) {
+	if value := v.(string); !macAddressOctets.MatchString(value) {
+		return nil, []error{fmt.Errorf("%s: %q is not a MAC address, expected six octets e.g. 00:50:56:a1:0c:2b", k, value)}
+	}
+	return nil, nil
+}
+
+// normalizeMacAddress returns mac as six lower case, zero padded octets separated by colons.
+func normalizeMacAddress(mac string) string {
+	octets := strings.FieldsFunc(strings.ToLower(mac), func(r rune) bool { return r == ':' || r == '-' })
+	for i, o := range octets {
+		if len(o) == 1 {
+			octets[i] = "0" + o
+		}
+	}
+	return strings.Join(octets, ":")
+}
+
+// suppressMacAddressDiff ignores the case, separators and leading zeros of MAC addresses, so that the
+// 0:50:56:a1:c:2b the BIG-IP reports matches 00:50:56:A1:0C:2B.
+func suppressMacAddressDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeMacAddress(old) == normalizeMacAddress(new)
+}
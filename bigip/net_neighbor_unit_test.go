@@ -0,0 +1,161 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetNeighborCRUD(t *testing.T) {
+	for _, tc := range []struct {
+		resourceType string
+		uri          string
+		ip           string
+	}{
+		{"bigip_net_arp", "net/arp", "10.1.1.10"},
+		{"bigip_net_ndp", "net/ndp", "2001:db8::10%2"},
+	} {
+		t.Run(tc.resourceType, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			var methods []string
+			var bodies []map[string]interface{}
+			mux.HandleFunc("/mgmt/tm/"+tc.uri, func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				body := map[string]interface{}{}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				bodies = append(bodies, body)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"name":"neighbor"}`)
+			})
+			mux.HandleFunc("/mgmt/tm/"+tc.uri+"/~Common~neighbor", func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				if r.Method == "PATCH" {
+					body := map[string]interface{}{}
+					_ = json.NewDecoder(r.Body).Decode(&body)
+					bodies = append(bodies, body)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"name":"neighbor","partition":"Common","fullPath":"/Common/neighbor","ipAddress":%q,"macAddress":"0:50:56:a1:c:2b"}`, tc.ip)
+			})
+
+			client := bigip.NewSession(&bigip.Config{
+				Address:  server.URL,
+				Username: "xxxx",
+				Password: "xxxx",
+			})
+			r := Provider().ResourcesMap[tc.resourceType]
+			d := r.TestResourceData()
+			_ = d.Set("name", "neighbor")
+			_ = d.Set("partition", "Common")
+			_ = d.Set("ip_address", tc.ip)
+			_ = d.Set("mac_address", "00:50:56:A1:0C:2B")
+			assert.False(t, r.CreateContext(context.Background(), d, client).HasError())
+			assert.Equal(t, "/Common/neighbor", d.Id())
+			assert.Equal(t, tc.ip, d.Get("ip_address"))
+			assert.Equal(t, "0:50:56:a1:c:2b", d.Get("mac_address"))
+			assert.False(t, r.UpdateContext(context.Background(), d, client).HasError())
+			assert.False(t, r.DeleteContext(context.Background(), d, client).HasError())
+			assert.Equal(t, "", d.Id())
+
+			assert.Equal(t, []string{"POST", "GET", "PATCH", "GET", "DELETE"}, methods)
+			assert.Equal(t, map[string]interface{}{"name": "neighbor", "partition": "Common", "ipAddress": tc.ip, "macAddress": "00:50:56:A1:0C:2B"}, bodies[0])
+			assert.Equal(t, map[string]interface{}{"macAddress": "0:50:56:a1:c:2b"}, bodies[1])
+		})
+	}
+}
+
+func TestNetNeighborImportAndNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/net/arp/~TeamA~neighbor", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"neighbor","partition":"TeamA","fullPath":"/TeamA/neighbor","ipAddress":"10.1.1.10","macAddress":"0:50:56:a1:c:2b"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/arp/~Common~missing", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested ARP entry (/Common/missing) was not found."}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipNetArp()
+	d := r.TestResourceData()
+	d.SetId("/TeamA/neighbor")
+	states, err := r.Importer.StateContext(context.Background(), d, client)
+	assert.NoError(t, err)
+	if assert.Len(t, states, 1) {
+		d = states[0]
+	}
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "/TeamA/neighbor", d.Id())
+	assert.Equal(t, "neighbor", d.Get("name"))
+	assert.Equal(t, "TeamA", d.Get("partition"))
+	assert.Equal(t, "10.1.1.10", d.Get("ip_address"))
+
+	d = r.TestResourceData()
+	d.SetId("/Common/missing")
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "", d.Id())
+}
+
+func TestValidateNeighborAddress(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		ipv6  bool
+		valid bool
+	}{
+		{"10.1.1.10", false, true},
+		{"10.1.1.10%2", false, true},
+		{"2001:db8::10", true, true},
+		{"fe80::1%0", true, true},
+		{"2001:db8::10", false, false},
+		{"::ffff:10.1.1.10", false, false},
+		{"10.1.1.10", true, false},
+		{"10.1.1.10%rd", false, false},
+		{"host.example.com", false, false},
+		{"10.1.1.10/24", false, false},
+	} {
+		_, errs := validateNeighborAddress(tc.ipv6)(tc.value, "ip_address")
+		assert.Equal(t, tc.valid, len(errs) == 0, "%s (ipv6 %v): %v", tc.value, tc.ipv6, errs)
+	}
+}
+
+func TestValidateMacAddress(t *testing.T) {
+	for value, valid := range map[string]bool{
+		"00:50:56:a1:0c:2b":    true,
+		"00-50-56-A1-0C-2B":    true,
+		"0:50:56:a1:c:2b":      true,
+		"00:50:56:a1:0c":       false,
+		"00:50:56:a1:0c:2b:00": false,
+		"0050.56a1.0c2b":       false,
+		"00:50:56:a1:0g:2b":    false,
+		"":                     false,
+	} {
+		_, errs := validateMacAddress(value, "mac_address")
+		assert.Equal(t, valid, len(errs) == 0, "%q: %v", value, errs)
+	}
+}
+
+func TestSuppressMacAddressDiff(t *testing.T) {
+	assert.True(t, suppressMacAddressDiff("mac_address", "0:50:56:a1:c:2b", "00:50:56:A1:0C:2B", nil))
+	assert.True(t, suppressMacAddressDiff("mac_address", "0:50:56:a1:c:2b", "00-50-56-a1-0c-2b", nil))
+	assert.False(t, suppressMacAddressDiff("mac_address", "0:50:56:a1:c:2b", "00:50:56:a1:0c:2c", nil))
+	assert.False(t, suppressMacAddressDiff("mac_address", "", "00:50:56:a1:0c:2b", nil))
+}
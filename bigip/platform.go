@@ -0,0 +1,155 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	bigip "github.com/f5devcentral/go-bigip"
+)
+
+const (
+	uriSysVersion = "sys/version"
+	// uriNextLogin and uriNextSystemInfos are the login and the version of the BIG-IP Next API.
+	uriNextLogin       = "/api/login"
+	uriNextSystemInfos = "/api/v1/system/infos"
+	// productBigipNext is the product reported for a BIG-IP Next device.
+	productBigipNext = "BIG-IP Next"
+)
+
+// bigipPlatform is the product and version of the device the provider talks to.
+type bigipPlatform struct {
+	Product string
+	Version string
+	Build   string
+	Edition string
+	Next    bool
+}
+
+// getBigipPlatform reads the product and version of a classic BIG-IP from sys/version.
+func getBigipPlatform(client *bigip.BigIP) (*bigipPlatform, error) {
+	stats := &struct {
+		Entries ltmStats `json:"entries"`
+	}{}
+	found, err := getRestEntity(client, stats, uriSysVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the BIG-IP version: %v", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("error reading the BIG-IP version: %s not found", uriSysVersion)
+	}
+	platform := &bigipPlatform{}
+	for _, entry := range stats.Entries {
+		fields := entry.NestedStats.Entries
+		platform.Product = fields["Product"].Description
+		platform.Version = fields["Version"].Description
+		platform.Build = fields["Build"].Description
+		platform.Edition = fields["Edition"].Description
+	}
+	return platform, nil
+}
+
+// detectBigipNext reports whether the device at the address of client is BIG-IP Next, which has no
+// /mgmt/tm namespace. A classic BIG-IP answers the unauthenticated request with 401 while BIG-IP
+// Next does not know the path. The Server header of the answer is returned to name what was found.
+func detectBigipNext(client *bigip.BigIP) (bool, string, error) {
+	resp, err := nextAPICall(client, http.MethodGet, "/mgmt/tm/"+uriSysVersion, "", nil)
+	if err != nil {
+		return false, "", err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode == http.StatusNotFound, resp.Header.Get("Server"), nil
+}
+
+// getBigipNextVersion returns the version of the BIG-IP Next device of client, or "" when its API
+// does not tell: the credentials of the provider, or its token, are used to log in.
+func getBigipNextVersion(client *bigip.BigIP) string {
+	token := client.Token
+	if token == "" {
+		credentials, _ := json.Marshal(map[string]string{"username": client.User, "password": client.Password})
+		resp, err := nextAPICall(client, http.MethodPost, uriNextLogin, "", credentials)
+		if err != nil {
+			return ""
+		}
+		defer resp.Body.Close()
+		login := &struct {
+			AccessToken string `json:"access_token"`
+		}{}
+		if resp.StatusCode != http.StatusOK || json.NewDecoder(resp.Body).Decode(login) != nil {
+			return ""
+		}
+		token = login.AccessToken
+	}
+	resp, err := nextAPICall(client, http.MethodGet, uriNextSystemInfos, token, nil)
+	if err != nil {
+		return ""
+	}
+	defer resp.Body.Close()
+	infos := &struct {
+		Version string `json:"version"`
+	}{}
+	if resp.StatusCode != http.StatusOK || json.NewDecoder(resp.Body).Decode(infos) != nil {
+		return ""
+	}
+	return infos.Version
+}
+
+// nextAPICall sends a request to path on the device of client outside the iControl REST session,
+// with the bearer token when one is given.
+func nextAPICall(client *bigip.BigIP, method, path, token string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, client.Host+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	httpClient := &http.Client{Transport: client.Transport}
+	if client.ConfigOptions != nil {
+		httpClient.Timeout = client.ConfigOptions.APICallTimeout
+	}
+	return httpClient.Do(req)
+}
+
+// getBigipPlatformOrNext reads the platform of a classic BIG-IP, or of BIG-IP Next when the device
+// has no /mgmt/tm namespace.
+func getBigipPlatformOrNext(client *bigip.BigIP) (*bigipPlatform, error) {
+	platform, err := getBigipPlatform(client)
+	if err == nil {
+		return platform, nil
+	}
+	if next, _, probeErr := detectBigipNext(client); probeErr != nil || !next {
+		return nil, err
+	}
+	return &bigipPlatform{Product: productBigipNext, Version: getBigipNextVersion(client), Next: true}, nil
+}
+
+// classicBigipError returns a clear error in place of err, the failure of the first call of the
+// provider, when the device turns out to be BIG-IP Next: its API only answers the requests of the
+// provider with errors that do not say the product is not supported.
+func classicBigipError(client *bigip.BigIP, err error) error {
+	next, server, probeErr := detectBigipNext(client)
+	if probeErr != nil || !next {
+		return err
+	}
+	detected := productBigipNext
+	if version := getBigipNextVersion(client); version != "" {
+		detected += " " + version
+	}
+	if server != "" {
+		detected += fmt.Sprintf(" (server %s)", server)
+	}
+	return fmt.Errorf("the device at %s is %s: the /mgmt/tm iControl REST API is not available. "+
+		"This provider supports classic BIG-IP (TMOS) only", client.Host, detected)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBigipPlatform(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/sys/version", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"kind":"tm:sys:version:versionstats","entries":{"https://localhost/mgmt/tm/sys/version/0":{"nestedStats":{"entries":{
+			"Build":{"description":"0.0.6"},"Date":{"description":"Tue Jun 13 12:00:00 PDT 2023"},"Edition":{"description":"Final"},
+			"Product":{"description":"BIG-IP"},"Title":{"description":"Main Package"},"Version":{"description":"17.1.0"}}}}}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+	platform, err := getBigipPlatform(client)
+	assert.NoError(t, err)
+	assert.Equal(t, &bigipPlatform{Product: "BIG-IP", Version: "17.1.0", Build: "0.0.6", Edition: "Final"}, platform)
+
+	r := dataSourceBigipSysVersion()
+	d := r.TestResourceData()
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "17.1.0", d.Get("version"))
+	assert.Equal(t, false, d.Get("is_next"))
+	assert.Equal(t, "classic", d.Get("platform"))
+	assert.Equal(t, uriSysVersion, d.Id())
+}
+
+// mockBigipNext serves the BIG-IP Next API, which logs in xxxx and reports version 20.2.0.
+func mockBigipNext(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/login", func(w http.ResponseWriter, r *http.Request) {
+		credentials := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&credentials))
+		w.Header().Set("Content-Type", "application/json")
+		if credentials["username"] != "xxxx" || credentials["password"] != "xxxx" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"access_token":"next-token","refresh_token":"refresh"}`)
+	})
+	mux.HandleFunc("/api/v1/system/infos", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer next-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"version":"20.2.0-2.375.1"}`)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Server", "BIG-IP Next")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"status":404,"message":"resource not found"}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestNewClientBigipNext(t *testing.T) {
+	server := mockBigipNext(t)
+	defer server.Close()
+
+	newConfig := func() *bigip.Config {
+		return &bigip.Config{
+			Address:           server.URL,
+			Username:          "xxxx",
+			Password:          "xxxx",
+			CertVerifyDisable: true,
+			ConfigOptions:     &bigip.ConfigOptions{TokenTimeout: 1200 * time.Second, APICallTimeout: 60 * time.Second, APICallRetries: 1},
+		}
+	}
+	_, err := newClient(newConfig(), nil, nil)
+	assert.EqualError(t, err, fmt.Sprintf("the device at %s is BIG-IP Next 20.2.0-2.375.1 (server BIG-IP Next): the /mgmt/tm iControl REST API is not available. "+
+		"This provider supports classic BIG-IP (TMOS) only", server.URL))
+
+	config := newConfig()
+	config.LoginReference = "tmos"
+	_, err = newClient(config, nil, nil)
+	assert.ErrorContains(t, err, "This provider supports classic BIG-IP (TMOS) only")
+}
+
+// TestSysVersionBigipNext checks the data source reports BIG-IP Next, which a provider configured
+// with a token reaches without validating the connection.
+func TestSysVersionBigipNext(t *testing.T) {
+	server := mockBigipNext(t)
+	defer server.Close()
+
+	client := bigip.NewSession(&bigip.Config{Address: server.URL})
+	client.Token = "next-token"
+	r := dataSourceBigipSysVersion()
+	d := r.TestResourceData()
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "BIG-IP Next", d.Get("product"))
+	assert.Equal(t, "20.2.0-2.375.1", d.Get("version"))
+	assert.Equal(t, true, d.Get("is_next"))
+	assert.Equal(t, "next", d.Get("platform"))
+
+	// The version is left empty when the API does not tell it.
+	client.Token = "expired"
+	d = r.TestResourceData()
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "", d.Get("version"))
+	assert.Equal(t, true, d.Get("is_next"))
+}
+
+func TestNewClientClassicBigipError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mgmt/tm/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = fmt.Fprintf(w, `{"code":401,"message":"Authorization failed: user=xxxx resource=/mgmt/tm/net/self"}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := &bigip.Config{
+		Address:           server.URL,
+		Username:          "xxxx",
+		Password:          "xxxx",
+		CertVerifyDisable: true,
+		ConfigOptions:     &bigip.ConfigOptions{TokenTimeout: 1200 * time.Second, APICallTimeout: 60 * time.Second, APICallRetries: 1},
+	}
+	_, err := newClient(config, nil, nil)
+	assert.ErrorContains(t, err, "Authorization failed")
+}
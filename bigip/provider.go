@@ -11,6 +11,8 @@ import (
 	"crypto/sha1"
 	"encoding/hex"
 	"fmt"
+	"log"
+	"os"
 	"reflect"
 	"regexp"
 	"strings"
@@ -47,12 +49,14 @@ func Provider() *schema.Provider {
 			"password": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				Description: "The user's password. Leave empty if using token_value",
 				DefaultFunc: schema.EnvDefaultFunc("BIGIP_PASSWORD", nil),
 			},
 			"token_value": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				Description: "A token generated outside the provider, in place of password",
 				DefaultFunc: schema.EnvDefaultFunc("BIGIP_TOKEN_VALUE", nil),
 			},
@@ -74,11 +78,50 @@ func Provider() *schema.Provider {
 				Description: "Valid Trusted Certificate path",
 				DefaultFunc: schema.EnvDefaultFunc("BIGIP_TRUSTED_CERT_PATH", nil),
 			},
+			"tls_server_fingerprint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SHA-256 fingerprint of the BIG-IP certificate. When set, the connection is only accepted if the device presents this certificate, in place of the CA validation",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_TLS_SERVER_FINGERPRINT", nil),
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					if _, err := normalizeCertificateFingerprint(v.(string)); err != nil {
+						return nil, []error{fmt.Errorf("%s: %v", k, err)}
+					}
+					return nil, nil
+				},
+			},
+			"client_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path of a PEM client certificate to authenticate to iControl REST with mutual TLS instead of username and password",
+				DefaultFunc:   schema.EnvDefaultFunc("BIGIP_CLIENT_CERT_FILE", nil),
+				ConflictsWith: []string{"client_cert_pem"},
+			},
+			"client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path of the PEM private key of client_cert_file",
+				DefaultFunc:   schema.EnvDefaultFunc("BIGIP_CLIENT_KEY_FILE", nil),
+				ConflictsWith: []string{"client_key_pem"},
+			},
+			"client_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM content of the client certificate, in place of client_cert_file",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_CLIENT_CERT_PEM", nil),
+			},
+			"client_key_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM content of the private key of the client certificate, in place of client_key_file",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_CLIENT_KEY_PEM", nil),
+			},
 			"teem_disable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "If this flag set to true,sending telemetry data to TEEM will be disabled",
-				DefaultFunc: schema.EnvDefaultFunc("TEEM_DISABLE", false),
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"BIGIP_TEEM_DISABLE", "TEEM_DISABLE"}, false),
 			},
 			"login_ref": {
 				Type:        schema.TypeString,
@@ -90,19 +133,67 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Description: "A timeout for AS3 requests, represented as a number of seconds. Default: 60",
-				DefaultFunc: schema.EnvDefaultFunc("API_TIMEOUT", 60),
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"BIGIP_API_TIMEOUT", "API_TIMEOUT"}, 60),
 			},
 			"token_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Description: "A lifespan to request for the AS3 auth token, represented as a number of seconds. Default: 1200",
-				DefaultFunc: schema.EnvDefaultFunc("TOKEN_TIMEOUT", 1200),
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"BIGIP_TOKEN_TIMEOUT", "TOKEN_TIMEOUT"}, 1200),
 			},
 			"api_retries": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Description: "Amount of times to retry AS3 API requests. Default: 10.",
-				DefaultFunc: schema.EnvDefaultFunc("API_RETRIES", 10),
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"BIGIP_API_RETRIES", "API_RETRIES"}, 10),
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set to true, the provider refuses every create, update and delete on the BIG-IP while reads keep working. Default: false",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_READ_ONLY", false),
+			},
+			"enforce_partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set, planning fails for every object created outside this partition",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_ENFORCE_PARTITION", nil),
+			},
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set, the name of every object created without this prefix is planned with it",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_NAME_PREFIX", nil),
+			},
+			"extra_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional HTTP headers sent with every request to the BIG-IP, e.g. request signing headers",
+			},
+			"shared_credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a credentials file (ini or JSON) holding connection settings per profile, used for settings not set as attributes or environment variables",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_SHARED_CREDENTIALS_FILE", nil),
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Profile of shared_credentials_file to use. Default: default",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_PROFILE", nil),
+			},
+			"audit_log_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a file every request to the BIG-IP is appended to as a JSON line (method, URI, status and duration)",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_AUDIT_LOG_FILE", nil),
+			},
+			"metrics_output_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a file a JSON summary of the requests sent to the BIG-IP (count and duration by method, URI pattern and status) is written to when the provider stops",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_METRICS_OUTPUT_PATH", nil),
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
@@ -111,8 +202,11 @@ func Provider() *schema.Provider {
 			"bigip_ltm_irule":                     dataSourceBigipLtmIrule(),
 			"bigip_ssl_certificate":               dataSourceBigipSslCertificate(),
 			"bigip_ltm_pool":                      dataSourceBigipLtmPool(),
+			"bigip_ltm_pool_stats":                dataSourceBigipLtmPoolStats(),
+			"bigip_ltm_virtual_server_stats":      dataSourceBigipLtmVirtualServerStats(),
 			"bigip_ltm_policy":                    dataSourceBigipLtmPolicy(),
 			"bigip_ltm_node":                      dataSourceBigipLtmNode(),
+			"bigip_ltm_nodes":                     dataSourceBigipLtmNodes(),
 			"bigip_vwan_config":                   dataSourceBigipVwanconfig(),
 			"bigip_waf_signatures":                dataSourceBigipWafSignatures(),
 			"bigip_waf_policy":                    dataSourceBigipWafPolicy(),
@@ -123,79 +217,128 @@ func Provider() *schema.Provider {
 			"bigip_fast_aws_service_discovery":    dataSourceBigipFastAwsServiceDiscovery(),
 			"bigip_fast_azure_service_discovery":  dataSourceBigipFastAzureServiceDiscovery(),
 			"bigip_fast_gce_service_discovery":    dataSourceBigipFastGceServiceDiscovery(),
+			"bigip_as3_info":                      dataSourceBigipAs3Info(),
+			"bigip_do_info":                       dataSourceBigipDoInfo(),
+			"bigip_ts_info":                       dataSourceBigipTsInfo(),
+			"bigip_fast_info":                     dataSourceBigipFastInfo(),
+			"bigip_net_vlan":                      dataSourceBigipNetVlan(),
+			"bigip_net_vlans":                     dataSourceBigipNetVlans(),
+			"bigip_net_selfip":                    dataSourceBigipNetSelfIP(),
+			"bigip_net_selfips":                   dataSourceBigipNetSelfIPs(),
+			"bigip_partition_inventory":           dataSourceBigipPartitionInventory(),
+			"bigip_gtm_wideip":                    dataSourceBigipGtmWideIP(),
+			"bigip_gtm_pool":                      dataSourceBigipGtmPool(),
+			"bigip_sys_version":                   dataSourceBigipSysVersion(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"bigip_cm_device":                       resourceBigipCmDevice(),
-			"bigip_cm_devicegroup":                  resourceBigipCmDevicegroup(),
-			"bigip_net_route":                       resourceBigipNetRoute(),
-			"bigip_net_selfip":                      resourceBigipNetSelfIP(),
-			"bigip_net_vlan":                        resourceBigipNetVlan(),
-			"bigip_ltm_irule":                       resourceBigipLtmIRule(),
-			"bigip_ltm_datagroup":                   resourceBigipLtmDataGroup(),
-			"bigip_ltm_monitor":                     resourceBigipLtmMonitor(),
-			"bigip_ltm_node":                        resourceBigipLtmNode(),
-			"bigip_ltm_pool":                        resourceBigipLtmPool(),
-			"bigip_ltm_pool_attachment":             resourceBigipLtmPoolAttachment(),
-			"bigip_ltm_policy":                      resourceBigipLtmPolicy(),
-			"bigip_ltm_profile_fasthttp":            resourceBigipLtmProfileFasthttp(),
-			"bigip_ltm_profile_fastl4":              resourceBigipLtmProfileFastl4(),
-			"bigip_ltm_profile_http2":               resourceBigipLtmProfileHttp2(),
-			"bigip_ltm_profile_httpcompress":        resourceBigipLtmProfileHttpcompress(),
-			"bigip_ltm_profile_oneconnect":          resourceBigipLtmProfileOneconnect(),
-			"bigip_ltm_profile_tcp":                 resourceBigipLtmProfileTcp(),
-			"bigip_ltm_profile_ftp":                 resourceBigipLtmProfileFtp(),
-			"bigip_ltm_profile_http":                resourceBigipLtmProfileHttp(),
-			"bigip_ltm_profile_web_acceleration":    resourceBigipLtmProfileWebAcceleration(),
-			"bigip_ltm_persistence_profile_srcaddr": resourceBigipLtmPersistenceProfileSrcAddr(),
-			"bigip_ltm_persistence_profile_dstaddr": resourceBigipLtmPersistenceProfileDstAddr(),
-			"bigip_ltm_persistence_profile_ssl":     resourceBigipLtmPersistenceProfileSSL(),
-			"bigip_ltm_persistence_profile_cookie":  resourceBigipLtmPersistenceProfileCookie(),
-			"bigip_ltm_profile_server_ssl":          resourceBigipLtmProfileServerSsl(),
-			"bigip_ltm_profile_client_ssl":          resourceBigipLtmProfileClientSsl(),
-			"bigip_ltm_snat":                        resourceBigipLtmSnat(),
-			"bigip_ltm_snatpool":                    resourceBigipLtmSnatpool(),
-			"bigip_ltm_virtual_address":             resourceBigipLtmVirtualAddress(),
-			"bigip_ltm_virtual_server":              resourceBigipLtmVirtualServer(),
-			"bigip_sys_dns":                         resourceBigipSysDns(),
-			"bigip_sys_iapp":                        resourceBigipSysIapp(),
-			"bigip_sys_ntp":                         resourceBigipSysNtp(),
-			"bigip_sys_ocsp":                        resourceBigipSysOcsp(),
-			"bigip_sys_provision":                   resourceBigipSysProvision(),
-			"bigip_sys_snmp":                        resourceBigipSysSnmp(),
-			"bigip_sys_snmp_traps":                  resourceBigipSysSnmpTraps(),
-			"bigip_sys_bigiplicense":                resourceBigipSysBigiplicense(),
-			"bigip_as3":                             resourceBigipAs3(),
-			"bigip_do":                              resourceBigipDo(),
-			"bigip_fast_template":                   resourceBigipFastTemplate(),
-			"bigip_fast_application":                resourceBigipFastApp(),
-			"bigip_fast_http_app":                   resourceBigipHttpFastApp(),
-			"bigip_fast_https_app":                  resourceBigipFastHTTPSApp(),
-			"bigip_fast_tcp_app":                    resourceBigipFastTcpApp(),
-			"bigip_fast_udp_app":                    resourceBigipFastUdpApp(),
-			"bigip_ssl_certificate":                 resourceBigipSslCertificate(),
-			"bigip_ssl_key":                         resourceBigipSslKey(),
-			"bigip_ssl_key_cert":                    resourceBigipSSLKeyCert(),
-			"bigip_command":                         resourceBigipCommand(),
-			"bigip_common_license_manage_bigiq":     resourceBigiqLicenseManage(),
-			"bigip_bigiq_as3":                       resourceBigiqAs3(),
-			"bigip_event_service_discovery":         resourceServiceDiscovery(),
-			"bigip_traffic_selector":                resourceBigipTrafficselector(),
-			"bigip_ipsec_policy":                    resourceBigipIpsecPolicy(),
-			"bigip_net_tunnel":                      resourceBigipNetTunnel(),
-			"bigip_net_ike_peer":                    resourceBigipNetIkePeer(),
-			"bigip_ipsec_profile":                   resourceBigipIpsecProfile(),
-			"bigip_waf_policy":                      resourceBigipAwafPolicy(),
-			"bigip_vcmp_guest":                      resourceBigipVcmpGuest(),
-			"bigip_ltm_cipher_rule":                 resourceBigipLtmCipherRule(),
-			"bigip_ltm_cipher_group":                resourceBigipLtmCipherGroup(),
-			"bigip_partition":                       resourceBigipPartition(),
-			"bigip_ltm_request_log_profile":         resourceBigipLtmProfileRequestLog(),
-			"bigip_ltm_profile_bot_defense":         resourceBigipLtmProfileBotDefense(),
-			"bigip_ltm_profile_rewrite":             resourceBigipLtmRewriteProfile(),
-			"bigip_ltm_profile_rewrite_uri_rules":   resourceBigipLtmRewriteProfileUriRules(),
-			"bigip_saas_bot_defense_profile":        resourceBigipSaasBotDefenseProfile(),
+			"bigip_cm_device":                            resourceBigipCmDevice(),
+			"bigip_cm_devicegroup":                       resourceBigipCmDevicegroup(),
+			"bigip_cm_trust":                             resourceBigipCmTrust(),
+			"bigip_net_arp":                              resourceBigipNetArp(),
+			"bigip_net_ndp":                              resourceBigipNetNdp(),
+			"bigip_net_route":                            resourceBigipNetRoute(),
+			"bigip_net_selfip":                           resourceBigipNetSelfIP(),
+			"bigip_net_vlan":                             resourceBigipNetVlan(),
+			"bigip_ltm_irule":                            resourceBigipLtmIRule(),
+			"bigip_ltm_datagroup":                        resourceBigipLtmDataGroup(),
+			"bigip_ltm_monitor":                          resourceBigipLtmMonitor(),
+			"bigip_ltm_node":                             resourceBigipLtmNode(),
+			"bigip_ltm_pool":                             resourceBigipLtmPool(),
+			"bigip_ltm_pool_attachment":                  resourceBigipLtmPoolAttachment(),
+			"bigip_ltm_policy":                           resourceBigipLtmPolicy(),
+			"bigip_ltm_profile_fasthttp":                 resourceBigipLtmProfileFasthttp(),
+			"bigip_ltm_profile_fastl4":                   resourceBigipLtmProfileFastl4(),
+			"bigip_ltm_profile_http2":                    resourceBigipLtmProfileHttp2(),
+			"bigip_ltm_profile_httpcompress":             resourceBigipLtmProfileHttpcompress(),
+			"bigip_ltm_profile_oneconnect":               resourceBigipLtmProfileOneconnect(),
+			"bigip_ltm_profile_tcp":                      resourceBigipLtmProfileTcp(),
+			"bigip_ltm_profile_ftp":                      resourceBigipLtmProfileFtp(),
+			"bigip_ltm_profile_http":                     resourceBigipLtmProfileHttp(),
+			"bigip_ltm_profile_web_acceleration":         resourceBigipLtmProfileWebAcceleration(),
+			"bigip_ltm_persistence_profile_srcaddr":      resourceBigipLtmPersistenceProfileSrcAddr(),
+			"bigip_ltm_persistence_profile_dstaddr":      resourceBigipLtmPersistenceProfileDstAddr(),
+			"bigip_ltm_persistence_profile_ssl":          resourceBigipLtmPersistenceProfileSSL(),
+			"bigip_ltm_persistence_profile_cookie":       resourceBigipLtmPersistenceProfileCookie(),
+			"bigip_ltm_profile_server_ssl":               resourceBigipLtmProfileServerSsl(),
+			"bigip_ltm_profile_client_ssl":               resourceBigipLtmProfileClientSsl(),
+			"bigip_ltm_snat":                             resourceBigipLtmSnat(),
+			"bigip_ltm_snatpool":                         resourceBigipLtmSnatpool(),
+			"bigip_ltm_virtual_address":                  resourceBigipLtmVirtualAddress(),
+			"bigip_ltm_virtual_server":                   resourceBigipLtmVirtualServer(),
+			"bigip_ltm_virtual_toggle":                   resourceBigipLtmVirtualToggle(),
+			"bigip_sys_dns":                              resourceBigipSysDns(),
+			"bigip_sys_iapp":                             resourceBigipSysIapp(),
+			"bigip_sys_ntp":                              resourceBigipSysNtp(),
+			"bigip_sys_ocsp":                             resourceBigipSysOcsp(),
+			"bigip_sys_provision":                        resourceBigipSysProvision(),
+			"bigip_sys_global_settings":                  resourceBigipSysGlobalSettings(),
+			"bigip_sys_snmp":                             resourceBigipSysSnmp(),
+			"bigip_sys_snmp_traps":                       resourceBigipSysSnmpTraps(),
+			"bigip_sys_bigiplicense":                     resourceBigipSysBigiplicense(),
+			"bigip_as3":                                  resourceBigipAs3(),
+			"bigip_do":                                   resourceBigipDo(),
+			"bigip_fast_template":                        resourceBigipFastTemplate(),
+			"bigip_fast_application":                     resourceBigipFastApp(),
+			"bigip_fast_http_app":                        resourceBigipHttpFastApp(),
+			"bigip_fast_https_app":                       resourceBigipFastHTTPSApp(),
+			"bigip_fast_tcp_app":                         resourceBigipFastTcpApp(),
+			"bigip_fast_udp_app":                         resourceBigipFastUdpApp(),
+			"bigip_ssl_certificate":                      resourceBigipSslCertificate(),
+			"bigip_ssl_key":                              resourceBigipSslKey(),
+			"bigip_ssl_key_cert":                         resourceBigipSSLKeyCert(),
+			"bigip_ssl_cert_key_pair":                    resourceBigipSslCertKeyPair(),
+			"bigip_command":                              resourceBigipCommand(),
+			"bigip_common_license_manage_bigiq":          resourceBigiqLicenseManage(),
+			"bigip_bigiq_as3":                            resourceBigiqAs3(),
+			"bigip_event_service_discovery":              resourceServiceDiscovery(),
+			"bigip_traffic_selector":                     resourceBigipTrafficselector(),
+			"bigip_ipsec_policy":                         resourceBigipIpsecPolicy(),
+			"bigip_net_tunnel":                           resourceBigipNetTunnel(),
+			"bigip_net_ike_peer":                         resourceBigipNetIkePeer(),
+			"bigip_net_bwc_policy":                       resourceBigipNetBwcPolicy(),
+			"bigip_ipsec_profile":                        resourceBigipIpsecProfile(),
+			"bigip_waf_policy":                           resourceBigipAwafPolicy(),
+			"bigip_vcmp_guest":                           resourceBigipVcmpGuest(),
+			"bigip_ltm_cipher_rule":                      resourceBigipLtmCipherRule(),
+			"bigip_ltm_cipher_group":                     resourceBigipLtmCipherGroup(),
+			"bigip_partition":                            resourceBigipPartition(),
+			"bigip_ltm_request_log_profile":              resourceBigipLtmProfileRequestLog(),
+			"bigip_ltm_profile_bot_defense":              resourceBigipLtmProfileBotDefense(),
+			"bigip_ltm_profile_rewrite":                  resourceBigipLtmRewriteProfile(),
+			"bigip_ltm_profile_rewrite_uri_rules":        resourceBigipLtmRewriteProfileUriRules(),
+			"bigip_saas_bot_defense_profile":             resourceBigipSaasBotDefenseProfile(),
+			"bigip_ltm_profile_ocsp_stapling_params":     resourceBigipLtmProfileOcspStaplingParams(),
+			"bigip_ltm_profile_xml":                      resourceBigipLtmProfileXml(),
+			"bigip_ltm_profile_icap":                     resourceBigipLtmProfileIcap(),
+			"bigip_ltm_profile_mqtt":                     resourceBigipLtmProfileMqtt(),
+			"bigip_ltm_profile_sctp":                     resourceBigipLtmProfileSctp(),
+			"bigip_ltm_message_routing_peer":             resourceBigipLtmMessageRoutingPeer(),
+			"bigip_ltm_message_routing_route":            resourceBigipLtmMessageRoutingRoute(),
+			"bigip_ltm_message_routing_router_profile":   resourceBigipLtmMessageRoutingRouterProfile(),
+			"bigip_ltm_message_routing_protocol_profile": resourceBigipLtmMessageRoutingProtocolProfile(),
+			"bigip_ltm_message_routing_transport_config": resourceBigipLtmMessageRoutingTransportConfig(),
+			"bigip_ltm_profile_request_adapt":            resourceBigipLtmProfileRequestAdapt(),
+			"bigip_ltm_profile_httprouter":               resourceBigipLtmProfileHttpRouter(),
+			"bigip_ltm_profile_certificate_authority":    resourceBigipLtmProfileCertificateAuthority(),
+			"bigip_ltm_traffic_class":                    resourceBigipLtmTrafficClass(),
+			"bigip_ilx_workspace":                        resourceBigipIlxWorkspace(),
+			"bigip_ilx_plugin":                           resourceBigipIlxPlugin(),
+			"bigip_sys_smtp_server":                      resourceBigipSysSmtpServer(),
+			"bigip_sys_outbound_smtp":                    resourceBigipSysOutboundSmtp(),
+			"bigip_sys_daemon_log_settings":              resourceBigipSysDaemonLogSettings(),
+			"bigip_sys_icall_script":                     resourceBigipSysIcallScript(),
+			"bigip_sys_icall_handler_periodic":           resourceBigipSysIcallHandlerPeriodic(),
+			"bigip_sys_device_cert":                      resourceBigipSysDeviceCert(),
+			"bigip_gtm_prober_pool":                      resourceBigipGtmProberPool(),
+			"bigip_gtm_global_settings":                  resourceBigipGtmGlobalSettings(),
+			"bigip_config_sync":                          resourceBigipConfigSync(),
+			"bigip_security_nat_source_translation":      resourceBigipSecurityNatSourceTranslation(),
+			"bigip_security_nat_policy":                  resourceBigipSecurityNatPolicy(),
 		},
 	}
+	for resourceType, r := range p.ResourcesMap {
+		r.CustomizeDiff = namingPolicyCustomizeDiff(resourceType, r, r.CustomizeDiff)
+	}
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
@@ -203,12 +346,12 @@ func Provider() *schema.Provider {
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigure(d, terraformVersion)
+		return providerConfigure(ctx, d, terraformVersion)
 	}
 	return p
 }
 
-func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	configOptions := &bigip.ConfigOptions{
 		APICallTimeout: time.Duration(d.Get("api_timeout").(int)) * time.Second,
 		TokenTimeout:   time.Duration(d.Get("token_timeout").(int)) * time.Second,
@@ -224,24 +367,80 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		CertVerifyDisable: d.Get("validate_certs_disable").(bool),
 		ConfigOptions:     configOptions,
 	}
+	// Attributes and their environment variables take precedence over the shared credentials file.
+	if err := applySharedCredentials(d, config); err != nil {
+		return nil, diag.FromErr(err)
+	}
 	if d.Get("token_auth").(bool) {
 		config.LoginReference = d.Get("login_ref").(string)
 	}
-	if !d.Get("validate_certs_disable").(bool) {
+	serverFingerprint := d.Get("tls_server_fingerprint").(string)
+	// a pinned certificate is verified by its fingerprint, no CA is needed
+	if !d.Get("validate_certs_disable").(bool) && serverFingerprint == "" {
 		if d.Get("trusted_cert_path").(string) == "" {
 			return nil, diag.FromErr(fmt.Errorf("valid Trust Certificate path not provided using :%+v ", "trusted_cert_path"))
 		}
 		config.TrustedCertificate = d.Get("trusted_cert_path").(string)
 	}
-	cfg, err := Client(config)
+	clientCert, err := loadClientCertificate(d.Get("client_cert_file").(string), d.Get("client_key_file").(string),
+		d.Get("client_cert_pem").(string), d.Get("client_key_pem").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	// The hooks are installed before the session logs in, so the token login and the
+	// connection check carry the extra headers and show up in the audit log.
+	hooks := &transportHooks{
+		readOnly:          d.Get("read_only").(bool),
+		extraHeaders:      make(map[string]string),
+		serverFingerprint: serverFingerprint,
+	}
+	for k, v := range d.Get("extra_headers").(map[string]interface{}) {
+		hooks.extraHeaders[k] = v.(string)
+	}
+	if path := d.Get("audit_log_file").(string); path != "" {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("unable to open audit log file %s: %v", path, err))
+		}
+		hooks.audit = newAuditLog(f)
+	}
+	if path := d.Get("metrics_output_path").(string); path != "" {
+		hooks.metrics = newAPIMetrics(path)
+	}
+	cfg, err := newClient(config, hooks, clientCert)
 	if err != nil {
+		if hooks.audit != nil {
+			_ = hooks.audit.close()
+		}
 		return cfg, diag.FromErr(err)
 	}
+	if hooks.audit != nil || hooks.metrics != nil {
+		// Close the audit log and write the metrics once Terraform stops the provider.
+		if stopCtx, ok := schema.StopContext(ctx); ok {
+			go func() {
+				<-stopCtx.Done()
+				if hooks.audit != nil {
+					_ = hooks.audit.close()
+				}
+				if hooks.metrics != nil {
+					if err := hooks.metrics.flush(); err != nil {
+						log.Printf("[ERROR] Unable to write API metrics to %s: %v", hooks.metrics.path, err)
+					}
+				}
+			}()
+		}
+	}
 	if cfg != nil {
 		cfg.UserAgent = fmt.Sprintf("Terraform/%s", terraformVersion)
 		cfg.UserAgent += fmt.Sprintf("/terraform-provider-bigip/%s", getVersion())
 		cfg.Teem = d.Get("teem_disable").(bool)
-		cfg.Transport.TLSClientConfig.InsecureSkipVerify = d.Get("validate_certs_disable").(bool)
+		if serverFingerprint == "" {
+			cfg.Transport.TLSClientConfig.InsecureSkipVerify = d.Get("validate_certs_disable").(bool)
+		}
+		setNamingPolicy(cfg, &namingPolicy{
+			partition: d.Get("enforce_partition").(string),
+			prefix:    d.Get("name_prefix").(string),
+		})
 	}
 	return cfg, diag.FromErr(err)
 }
@@ -355,3 +554,24 @@ func hashForState(value string) string {
 	hash := sha1.Sum([]byte(strings.TrimSpace(value)))
 	return hex.EncodeToString(hash[:])
 }
+
+// isObfuscatedSecret reports whether value is a secret as echoed back by the BIG-IP,
+// encrypted with the master key ($M$...).
+func isObfuscatedSecret(value string) bool {
+	return strings.HasPrefix(value, "$M$")
+}
+
+// setSecret stores a secret read from the BIG-IP. The obfuscated form never matches the clear
+// text of the configuration, so the value in state is kept unless there is none yet (import).
+func setSecret(d *schema.ResourceData, key, value string) {
+	if isObfuscatedSecret(value) && d.Get(key).(string) != "" {
+		return
+	}
+	_ = d.Set(key, value)
+}
+
+// suppressObfuscatedSecretDiff suppresses the diff between two obfuscated forms of a secret,
+// the BIG-IP re-encrypts a secret with a new salt each time it is saved.
+func suppressObfuscatedSecretDiff(k, old, new string, d *schema.ResourceData) bool {
+	return isObfuscatedSecret(old) && isObfuscatedSecret(new)
+}
@@ -7,10 +7,19 @@ If a copy of the MPL was not distributed with this file,You can obtain one at ht
 package bigip
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var TestPartition = "Common"
@@ -71,3 +80,235 @@ func loadFixtureBytes(path string) []byte {
 func loadFixtureString(path string) string {
 	return string(loadFixtureBytes(path))
 }
+
+func TestSecretAttributesAreSensitive(t *testing.T) {
+	secret := regexp.MustCompile(`password|passphrase|secret|preshared_key|token_value|encoded_token|encoded_credentials`)
+	// attributes matching the pattern that do not hold a secret
+	notSecret := map[string]bool{"password_prompt": true}
+	var walk func(prefix string, s map[string]*schema.Schema)
+	walk = func(prefix string, s map[string]*schema.Schema) {
+		for k, v := range s {
+			if r, ok := v.Elem.(*schema.Resource); ok {
+				walk(prefix+"."+k, r.Schema)
+			}
+			if v.Type == schema.TypeString && secret.MatchString(k) && !notSecret[k] && !v.Sensitive {
+				t.Errorf("%s.%s holds a secret but is not marked Sensitive", prefix, k)
+			}
+		}
+	}
+	p := Provider()
+	walk("provider", p.Schema)
+	for name, r := range p.ResourcesMap {
+		walk(name, r.Schema)
+	}
+	for name, r := range p.DataSourcesMap {
+		walk(name, r.Schema)
+	}
+}
+
+func TestSetSecret(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"password": {Type: schema.TypeString, Optional: true, Sensitive: true},
+	}
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{"password": "clear"})
+	setSecret(d, "password", "$M$Ab$c2VjcmV0")
+	if v := d.Get("password").(string); v != "clear" {
+		t.Errorf("obfuscated value replaced the secret in state: %q", v)
+	}
+	setSecret(d, "password", "changed")
+	if v := d.Get("password").(string); v != "changed" {
+		t.Errorf("clear text value not stored, got %q", v)
+	}
+
+	d = schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+	setSecret(d, "password", "$M$Ab$c2VjcmV0")
+	if v := d.Get("password").(string); v != "$M$Ab$c2VjcmV0" {
+		t.Errorf("obfuscated value not stored on import, got %q", v)
+	}
+}
+
+func TestSuppressObfuscatedSecretDiff(t *testing.T) {
+	data := map[[2]string]bool{
+		{"$M$Ab$c2VjcmV0", "$M$Cd$c2VjcmV0"}: true,
+		{"$M$Ab$c2VjcmV0", "clear"}:          false,
+		{"clear", "other"}:                   false,
+		{"", "$M$Ab$c2VjcmV0"}:               false,
+	}
+	for v, expected := range data {
+		if got := suppressObfuscatedSecretDiff("password", v[0], v[1], nil); got != expected {
+			t.Errorf("suppressObfuscatedSecretDiff(%q, %q) = %v, expected %v", v[0], v[1], got, expected)
+		}
+	}
+}
+
+func TestSuppressCaseDiff(t *testing.T) {
+	data := map[[2]string]bool{
+		{"Preserve", "preserve"}: true,
+		{"append", "append"}:     true,
+		{"preserve", "remove"}:   false,
+		{"", "preserve"}:         false,
+	}
+	for v, expected := range data {
+		if got := suppressCaseDiff("via_request", v[0], v[1], nil); got != expected {
+			t.Errorf("suppressCaseDiff(%q, %q) = %v, expected %v", v[0], v[1], got, expected)
+		}
+	}
+}
+
+// forceNewAttributes are the attributes, besides name, that replace the object when they change
+// because the BIG-IP cannot modify them in place: they pick the REST collection of the object
+// (monitor parent, data group internal, virtual server type), identify it (node address, self IP
+// address, pool attachment pool and node) or are only applied when the object is created.
+var forceNewAttributes = map[string][]string{
+	"bigip_as3":                           {"per_app_mode"},
+	"bigip_cm_trust":                      {"ca_device", "peer_address", "peer_name"},
+	"bigip_event_service_discovery":       {"taskid"},
+	"bigip_fast_http_app":                 {"application", "tenant"},
+	"bigip_fast_https_app":                {"application", "tenant"},
+	"bigip_fast_tcp_app":                  {"application", "tenant"},
+	"bigip_fast_template":                 {"source"},
+	"bigip_fast_udp_app":                  {"application", "tenant"},
+	"bigip_ltm_datagroup":                 {"internal", "type"},
+	"bigip_ltm_monitor":                   {"parent"},
+	"bigip_ltm_node":                      {"address"},
+	"bigip_ltm_pool_attachment":           {"fqdn_autopopulate", "node", "pool"},
+	"bigip_ltm_profile_bot_defense":       {"template"},
+	"bigip_ltm_profile_rewrite_uri_rules": {"profile_name", "rule_name"},
+	"bigip_ltm_virtual_server":            {"type"},
+	"bigip_ltm_virtual_toggle":            {"virtual_server"},
+	"bigip_net_arp":                       {"ip_address", "partition"},
+	"bigip_net_ndp":                       {"ip_address", "partition"},
+	"bigip_net_selfip":                    {"ip"},
+	"bigip_ssl_cert_key_pair":             {"partition"},
+	"bigip_ssl_key":                       {"security_type"},
+	"bigip_ssl_key_cert":                  {"cert_name"},
+	"bigip_waf_policy":                    {"partition", "template_name"},
+}
+
+// TestForceNewAttributes keeps the attributes replacing their object to the audited list, an attribute
+// the BIG-IP can modify in place must be updated instead: replacing a shared object fails as long as
+// it is referenced.
+func TestForceNewAttributes(t *testing.T) {
+	var walk func(prefix string, s map[string]*schema.Schema) []string
+	walk = func(prefix string, s map[string]*schema.Schema) []string {
+		var attrs []string
+		for k, v := range s {
+			if r, ok := v.Elem.(*schema.Resource); ok {
+				attrs = append(attrs, walk(prefix+k+".", r.Schema)...)
+			}
+			if v.ForceNew && prefix+k != "name" {
+				attrs = append(attrs, prefix+k)
+			}
+		}
+		return attrs
+	}
+	for name, r := range Provider().ResourcesMap {
+		attrs := walk("", r.Schema)
+		sort.Strings(attrs)
+		if !reflect.DeepEqual(attrs, forceNewAttributes[name]) && (len(attrs) > 0 || len(forceNewAttributes[name]) > 0) {
+			t.Errorf("%s: ForceNew attributes %v, expected %v", name, attrs, forceNewAttributes[name])
+		}
+	}
+}
+
+// testAccDeletes counts the deletes made by the provider under test, by resource type and ID. The
+// IDs of most resources are their names, which a replacement keeps, so a replacement is told from
+// an update in place by the delete it makes.
+var testAccDeletes = struct {
+	sync.Mutex
+	recorded map[string]bool
+	counts   map[string]int
+}{recorded: map[string]bool{}, counts: map[string]int{}}
+
+// testAccRecordDeletes makes the provider under test count the deletes of resourceType.
+func testAccRecordDeletes(resourceType string) error {
+	testAccDeletes.Lock()
+	defer testAccDeletes.Unlock()
+	if testAccDeletes.recorded[resourceType] {
+		return nil
+	}
+	r, ok := testAccProvider.ResourcesMap[resourceType]
+	if !ok || r.DeleteContext == nil {
+		return fmt.Errorf("cannot record the deletes of %s", resourceType)
+	}
+	deleteContext := r.DeleteContext
+	r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		key := resourceType + "/" + d.Id()
+		diags := deleteContext(ctx, d, meta)
+		if !diags.HasError() {
+			testAccDeletes.Lock()
+			testAccDeletes.counts[key]++
+			testAccDeletes.Unlock()
+		}
+		return diags
+	}
+	testAccDeletes.recorded[resourceType] = true
+	return nil
+}
+
+// testAccInstance follows a resource across the steps of an acceptance test.
+type testAccInstance struct {
+	id      string
+	deletes int
+}
+
+// testCheckResourceNotReplaced records the resourceName instance on its first call and, on the next
+// steps, fails when the resource got another ID or was deleted since, i.e. it was replaced instead
+// of updated in place.
+func testCheckResourceNotReplaced(resourceName string, instance *testAccInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found in state", resourceName)
+		}
+		key := rs.Type + "/" + rs.Primary.ID
+		if instance.id == "" {
+			if err := testAccRecordDeletes(rs.Type); err != nil {
+				return err
+			}
+			instance.id = rs.Primary.ID
+			testAccDeletes.Lock()
+			instance.deletes = testAccDeletes.counts[key]
+			testAccDeletes.Unlock()
+			return nil
+		}
+		if rs.Primary.ID != instance.id {
+			return fmt.Errorf("%s was replaced: ID %s, expected %s", resourceName, rs.Primary.ID, instance.id)
+		}
+		testAccDeletes.Lock()
+		deletes := testAccDeletes.counts[key]
+		testAccDeletes.Unlock()
+		if deletes != instance.deletes {
+			return fmt.Errorf("%s was replaced: %s was deleted and created again", resourceName, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func TestCheckResourceNotReplaced(t *testing.T) {
+	testAccProvider.ResourcesMap["bigip_test_instance"] = &schema.Resource{
+		DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil },
+	}
+	defer delete(testAccProvider.ResourcesMap, "bigip_test_instance")
+	state := terraform.NewState()
+	state.RootModule().Resources["bigip_test_instance.test"] = &terraform.ResourceState{
+		Type:    "bigip_test_instance",
+		Primary: &terraform.InstanceState{ID: "/Common/test"},
+	}
+	var instance testAccInstance
+	check := testCheckResourceNotReplaced("bigip_test_instance.test", &instance)
+	if err := check(state); err != nil {
+		t.Fatal(err)
+	}
+	if err := check(state); err != nil {
+		t.Fatalf("updated in place: %s", err)
+	}
+
+	// a replacement keeps the name, and so the ID
+	d := testAccProvider.ResourcesMap["bigip_test_instance"].TestResourceData()
+	d.SetId("/Common/test")
+	testAccProvider.ResourcesMap["bigip_test_instance"].DeleteContext(context.Background(), d, nil)
+	if err := check(state); err == nil || err.Error() != "bigip_test_instance.test was replaced: /Common/test was deleted and created again" {
+		t.Fatalf("replacement not detected: %v", err)
+	}
+}
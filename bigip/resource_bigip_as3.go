@@ -14,13 +14,16 @@ import (
 	"math/big"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/f5devcentral/go-bigip/f5teem"
 	uuid "github.com/google/uuid"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -36,6 +39,7 @@ func resourceBigipAs3() *schema.Resource {
 		ReadContext:   resourceBigipAs3Read,
 		UpdateContext: resourceBigipAs3Update,
 		DeleteContext: resourceBigipAs3Delete,
+		CustomizeDiff: declarationDiffCustomizeDiff("as3_json", 3),
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 				// d.Id() here is the last argument passed to the `terraform import RESOURCE_TYPE.RESOURCE_NAME RESOURCE_ID` command
@@ -57,61 +61,11 @@ func resourceBigipAs3() *schema.Resource {
 					return jsonString
 				},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					oldResp := []byte(old)
-					newResp := []byte(new)
-					oldJsonref := make(map[string]interface{})
-					newJsonref := make(map[string]interface{})
-					_ = json.Unmarshal(oldResp, &oldJsonref)
-					_ = json.Unmarshal(newResp, &newJsonref)
-					jsonEqualityBefore := reflect.DeepEqual(oldJsonref, newJsonref)
-					if jsonEqualityBefore {
-						return true
+					var ignoreKeys []string
+					if d.Get("ignore_metadata").(bool) {
+						ignoreKeys = as3IgnoreKeys(d)
 					}
-					for key, value := range oldJsonref {
-						if rec, ok := value.(map[string]interface{}); ok && key == "declaration" {
-							for range rec {
-								delete(rec, "updateMode")
-								delete(rec, "schemaVersion")
-								delete(rec, "id")
-								delete(rec, "label")
-								delete(rec, "remark")
-								delete(rec, "Common")
-							}
-						}
-						if key == "persist" {
-							delete(oldJsonref, "persist")
-						}
-					}
-					for key, value := range newJsonref {
-						if rec, ok := value.(map[string]interface{}); ok && key == "declaration" {
-							for range rec {
-								delete(rec, "updateMode")
-								delete(rec, "schemaVersion")
-								delete(rec, "id")
-								delete(rec, "label")
-								delete(rec, "remark")
-								delete(rec, "Common")
-							}
-						}
-						if key == "persist" {
-							delete(newJsonref, "persist")
-						}
-					}
-					ignoreMetadata := d.Get("ignore_metadata").(bool)
-					jsonEqualityAfter := reflect.DeepEqual(oldJsonref, newJsonref)
-					if ignoreMetadata {
-						if jsonEqualityAfter {
-							return true
-						} else {
-							return false
-						}
-
-					} else {
-						if !jsonEqualityBefore {
-							return false
-						}
-					}
-					return true
+					return reflect.DeepEqual(as3NormalizeDeclaration(old, ignoreKeys), as3NormalizeDeclaration(new, ignoreKeys))
 				},
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					if _, err := structure.NormalizeJsonString(v); err != nil {
@@ -148,9 +102,20 @@ func resourceBigipAs3() *schema.Resource {
 			"ignore_metadata": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Set True if you want to ignore metadata update",
+				Description: "Set True to compare as3_json without the ignore_keys, so the metadata the BIG-IP rewrites shows no change. The declarations are otherwise compared as whole JSON documents",
 				Default:     false,
 			},
+			"ignore_keys": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Keys left out of the comparison of as3_json when ignore_metadata is set, at the top of the request and declaration and in every tenant and application. Defaults to the metadata keys the BIG-IP rewrites",
+			},
+			"normalized_declaration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The stored as3_json as it is compared, with the ignored keys removed",
+			},
 			"tenant_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -181,10 +146,15 @@ func resourceBigipAs3() *schema.Resource {
 				Optional:    true,
 				Description: "ID of AS3 post declaration async task",
 			},
+			"diff_summary":       diffSummarySchema(),
+			"declaration_sha256": declarationSha256Schema(),
+			"last_applied":       lastAppliedSchema(),
 			"per_app_mode": {
 				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
-				Description: "Will define Perapp mode enabled on BIG-IP or not",
+				ForceNew:    true,
+				Description: "Deploy as3_json as a Per-Application declaration on tenant_name. Requires AS3 3.50 or later; when not set, it is enabled automatically for declarations without tenants",
 			},
 		},
 	}
@@ -304,6 +274,11 @@ func resourceBigipAs3Create(ctx context.Context, d *schema.ResourceData, meta in
 
 	log.Printf("[DEBUG] perApplication:%+v", perApplication)
 
+	if as3PerAppRequested(d) {
+		if err := validateAs3PerAppMode(client, perApplication, tenantList, d.Get("tenant_name").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	if perApplication && len(tenantList) == 0 {
 		log.Printf("[INFO] Creating As3 config perApplication : tenant name :%+v", d.Get("tenant_name").(string))
 		var tenant string
@@ -365,6 +340,7 @@ func resourceBigipAs3Create(ctx context.Context, d *schema.ResourceData, meta in
 		_ = d.Set("per_app_mode", false)
 		_ = d.Set("tenant_name", tenantList)
 	}
+	setDeclarationAudit(d, "as3_json", d.Get("task_id").(string))
 
 	if !client.Teem {
 		id := uuid.New()
@@ -454,6 +430,16 @@ func resourceBigipAs3Read(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		_ = d.Set("tenant_list", name)
+		// AS3 only records the time of the last deployment of the whole declaration, which the
+		// deployments of the other tenants change too: it is only used when the resource did not
+		// deploy the declaration itself, e.g. after an import.
+		if d.Get("last_applied").(string) == "" {
+			if lastApplied, err := as3LastApplied(client, name); err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Unable to read the deployment time of tenants %s: %v", name, err))
+			} else {
+				_ = d.Set("last_applied", lastApplied)
+			}
+		}
 	} else if d.Get("task_id") != nil {
 		taskResponse, err := client.Getas3TaskResponse(d.Get("task_id").(string))
 		if err != nil {
@@ -463,6 +449,12 @@ func resourceBigipAs3Read(ctx context.Context, d *schema.ResourceData, meta inte
 		_ = d.Set("as3_json", taskResponse)
 		_ = d.Set("tenant_list", name)
 	}
+	var ignoreKeys []string
+	if d.Get("ignore_metadata").(bool) {
+		ignoreKeys = as3IgnoreKeys(d)
+	}
+	normalized, _ := json.Marshal(as3NormalizeDeclaration(d.Get("as3_json").(string), ignoreKeys))
+	_ = d.Set("normalized_declaration", string(normalized))
 	return nil
 }
 
@@ -565,6 +557,7 @@ func resourceBigipAs3Update(ctx context.Context, d *schema.ResourceData, meta in
 		_ = d.Set("task_id", taskID)
 		_ = d.Set("tenant_name", tenantList)
 	}
+	setDeclarationAudit(d, "as3_json", d.Get("task_id").(string))
 	if d.Get("tenant_filter").(string) != "" {
 		createdTenants = d.Get("tenant_filter").(string)
 	} else {
@@ -624,6 +617,125 @@ func resourceBigipAs3Delete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
+// as3LastApplied returns the time AS3 archived the declaration of tenants, from the controls of the
+// declaration that GetAs3 leaves out, or "" when AS3 does not report it.
+func as3LastApplied(client *bigip.BigIP, tenants string) (string, error) {
+	decl := &struct {
+		Controls struct {
+			ArchiveTimestamp string `json:"archiveTimestamp"`
+		} `json:"controls"`
+	}{}
+	found, err := getRestEntity(client, decl, "mgmt/shared/appsvcs/declare/"+tenants)
+	if err != nil || !found || decl.Controls.ArchiveTimestamp == "" {
+		return "", err
+	}
+	archived, err := time.Parse(time.RFC3339, decl.Controls.ArchiveTimestamp)
+	if err != nil {
+		return "", err
+	}
+	return archived.UTC().Format(time.RFC3339), nil
+}
+
+// as3PerAppRequested reports whether per_app_mode is explicitly set to true in the configuration,
+// as opposed to being detected from the declaration.
+func as3PerAppRequested(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return false
+	}
+	v := rawConfig.GetAttr("per_app_mode")
+	return v.IsKnown() && !v.IsNull() && v.True()
+}
+
+// as3DefaultIgnoreKeys are the keys the BIG-IP adds or rewrites in a deployed declaration, left out of
+// the comparison when ignore_metadata is set and ignore_keys is not.
+var as3DefaultIgnoreKeys = []string{"id", "label", "remark", "schemaVersion", "updateMode", "optimisticLockKey", "persist", "Common"}
+
+func as3IgnoreKeys(d *schema.ResourceData) []string {
+	keys := listToStringSlice(d.Get("ignore_keys").([]interface{}))
+	if len(keys) == 0 {
+		return as3DefaultIgnoreKeys
+	}
+	return keys
+}
+
+// as3NormalizeDeclaration parses an AS3 request or declaration and removes the ignored keys from the
+// request, the declaration and each of its tenants and applications, so that documents differing only
+// in metadata compare equal. A document that is not a JSON object is returned as parsed.
+func as3NormalizeDeclaration(declaration string, ignoreKeys []string) interface{} {
+	var doc interface{}
+	_ = json.Unmarshal([]byte(declaration), &doc)
+	request, ok := doc.(map[string]interface{})
+	if !ok || len(ignoreKeys) == 0 {
+		return doc
+	}
+	strip := func(m map[string]interface{}) {
+		for _, key := range ignoreKeys {
+			delete(m, key)
+		}
+	}
+	strip(request)
+	decl := request
+	if wrapped, ok := request["declaration"].(map[string]interface{}); ok {
+		strip(wrapped)
+		decl = wrapped
+	}
+	for _, t := range decl {
+		tenant, ok := t.(map[string]interface{})
+		if !ok || tenant["class"] != "Tenant" {
+			continue
+		}
+		strip(tenant)
+		for _, a := range tenant {
+			if app, ok := a.(map[string]interface{}); ok && app["class"] == "Application" {
+				strip(app)
+			}
+		}
+	}
+	return doc
+}
+
+// validateAs3PerAppMode checks that a declaration explicitly deployed with per_app_mode can be
+// posted to /appsvcs/declare/<tenant>/applications on this BIG-IP.
+func validateAs3PerAppMode(client *bigip.BigIP, perAppAllowed bool, tenantList, tenantName string) error {
+	if tenantName == "" {
+		return fmt.Errorf("tenant_name is required when per_app_mode is true")
+	}
+	if tenantList != "" {
+		return fmt.Errorf("as3_json must contain only applications when per_app_mode is true, found tenants: %s", tenantList)
+	}
+	info := &struct {
+		Version string `json:"version"`
+	}{}
+	if _, err := getRestEntity(client, info, "mgmt/shared/appsvcs/info"); err != nil {
+		return fmt.Errorf("unable to read the AS3 version: %v", err)
+	}
+	if !as3VersionAtLeast(info.Version, 3, 50) {
+		return fmt.Errorf("per_app_mode requires AS3 3.50 or later, detected AS3 version %s", info.Version)
+	}
+	if !perAppAllowed {
+		return fmt.Errorf("per_app_mode requires perAppDeploymentAllowed to be enabled in the AS3 settings (AS3 version %s)", info.Version)
+	}
+	return nil
+}
+
+// as3VersionAtLeast compares the major and minor components of an AS3 version such as "3.50.1".
+func as3VersionAtLeast(version string, major, minor int) bool {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	gotMajor, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false
+	}
+	gotMinor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false
+	}
+	return gotMajor > major || (gotMajor == major && gotMinor >= minor)
+}
+
 func contains(s []string, str string) bool {
 	for _, v := range s {
 		if v == str {
@@ -111,6 +111,19 @@ resource "bigip_as3"  "as3-example1" {
     as3_json = "${file("` + dir + `/../examples/as3/as3_per_app_example1.json")}"
 }
 `
+var TestAs3PerAppModeResource = `
+resource "bigip_as3"  "as3-example1" {
+	tenant_name  = "dmz"
+	per_app_mode = true
+    as3_json = "${file("` + dir + `/../examples/as3/as3_per_app_example1.json")}"
+}
+`
+var TestAs3PerAppModeNoTenantResource = `
+resource "bigip_as3"  "as3-example1" {
+	per_app_mode = true
+    as3_json = "${file("` + dir + `/../examples/as3/as3_per_app_example1.json")}"
+}
+`
 var TestAs3PerAppResource2 = `
 resource "bigip_as3"  "as3-example1" {
 	tenant_name = "dmz"
@@ -681,3 +694,43 @@ func TestAccBigipPer_AppAs3_update_invalidJson(t *testing.T) {
 		},
 	})
 }
+
+func TestAccBigipPer_AppAs3_perAppMode(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAs3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: TestAs3PerAppModeResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAs3Exists("dmz", true),
+					testCheckAS3AppExists("dmz", "path_app1", true),
+					resource.TestCheckResourceAttr("bigip_as3.as3-example1", "per_app_mode", "true"),
+					resource.TestCheckResourceAttr("bigip_as3.as3-example1", "tenant_name", "dmz"),
+				),
+			},
+			{
+				Config:   TestAs3PerAppModeResource,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipPer_AppAs3_perAppModeNoTenant(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      TestAs3PerAppModeNoTenantResource,
+				ExpectError: regexp.MustCompile("tenant_name is required when per_app_mode is true"),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAs3VersionAtLeast(t *testing.T) {
+	data := map[string]bool{
+		"3.50.0": true,
+		"3.50":   true,
+		"3.52.1": true,
+		"4.0.0":  true,
+		"3.49.2": false,
+		"3.5.1":  false,
+		"2.99.0": false,
+		"":       false,
+		"3":      false,
+	}
+	for v, want := range data {
+		assert.Equal(t, want, as3VersionAtLeast(v, 3, 50), "version %q", v)
+	}
+}
+
+func TestValidateAs3PerAppMode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	version := "3.48.0"
+	mux.HandleFunc("/mgmt/shared/appsvcs/info", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"version":"%s","release":"4","schemaCurrent":"%s"}`, version, version)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	assert.ErrorContains(t, validateAs3PerAppMode(client, true, "", ""), "tenant_name is required")
+	assert.ErrorContains(t, validateAs3PerAppMode(client, true, "Sample_01", "dmz"), "found tenants: Sample_01")
+	assert.ErrorContains(t, validateAs3PerAppMode(client, true, "", "dmz"), "detected AS3 version 3.48.0")
+
+	version = "3.50.1"
+	assert.ErrorContains(t, validateAs3PerAppMode(client, false, "", "dmz"), "perAppDeploymentAllowed")
+	assert.NoError(t, validateAs3PerAppMode(client, true, "", "dmz"))
+}
+
+func TestAs3NormalizeDeclaration(t *testing.T) {
+	deployed := `{"class":"AS3","persist":true,"declaration":{"class":"ADC","id":"autogen_1","schemaVersion":"3.50.0","updateMode":"selective",
+		"Tenant1":{"class":"Tenant","optimisticLockKey":"abc","app1":{"class":"Application","label":"x","pool":{"class":"Pool","remark":"kept"}}}}}`
+	normalized := as3NormalizeDeclaration(deployed, as3DefaultIgnoreKeys)
+	assert.Equal(t, map[string]interface{}{
+		"class": "AS3",
+		"declaration": map[string]interface{}{
+			"class": "ADC",
+			"Tenant1": map[string]interface{}{
+				"class": "Tenant",
+				"app1": map[string]interface{}{
+					"class": "Application",
+					"pool":  map[string]interface{}{"class": "Pool", "remark": "kept"},
+				},
+			},
+		},
+	}, normalized)
+
+	// without ignored keys the document is only parsed
+	assert.Equal(t, map[string]interface{}{"class": "ADC", "id": "x"}, as3NormalizeDeclaration(`{"class":"ADC","id":"x"}`, nil))
+	// a bare declaration is normalized as well
+	assert.Equal(t, map[string]interface{}{"class": "ADC"}, as3NormalizeDeclaration(`{"class":"ADC","id":"x"}`, []string{"id"}))
+}
+
+func TestResourceBigipAs3JsonDiffSuppress(t *testing.T) {
+	r := resourceBigipAs3()
+	suppress := r.Schema["as3_json"].DiffSuppressFunc
+	old := `{"class":"AS3","declaration":{"class":"ADC","schemaVersion":"3.50.0","id":"a","Tenant1":{"class":"Tenant","optimisticLockKey":"k1"}}}`
+	new := `{"class":"AS3","declaration":{"class":"ADC","schemaVersion":"3.0.0","Tenant1":{"class":"Tenant"}}}`
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"as3_json": new})
+	assert.False(t, suppress("as3_json", old, new, d))
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"as3_json": new, "ignore_metadata": true})
+	assert.True(t, suppress("as3_json", old, new, d))
+	assert.False(t, suppress("as3_json", old, `{"class":"AS3","declaration":{"class":"ADC","Tenant2":{"class":"Tenant"}}}`, d))
+
+	// a custom ignore list replaces the default one
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"as3_json": new, "ignore_metadata": true, "ignore_keys": []interface{}{"schemaVersion", "id"}})
+	assert.False(t, suppress("as3_json", old, new, d))
+}
@@ -179,6 +179,12 @@ func resourceBigipAwafPolicy() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validation.StringInSlice([]string{"automatic", "disabled", "manual"}, false),
 						},
+						"learning_speed": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"fast", "medium", "slow"}, false),
+							Description:  "How quickly the policy builder turns traffic into learning suggestions: fast, medium or slow",
+						},
 					},
 				},
 			},
@@ -490,6 +496,18 @@ func resourceBigipAwafPolicyRead(ctx context.Context, d *schema.ResourceData, me
 	// _ = d.Set("template_link", policyJson.Policy.Template.Link)
 	_ = d.Set("policy_export_json", plJson)
 
+	settings := &wafPolicySettings{}
+	if err := json.Unmarshal([]byte(*plJson), settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding exported waf policy `%+v`: %v", name, err))
+	}
+	_ = d.Set("case_insensitive", settings.Policy.CaseInsensitive)
+	if _, ok := d.GetOk("server_technologies"); ok {
+		_ = d.Set("server_technologies", wafServerTechnologyNames(d, settings.Policy.ServerTechnologies))
+	}
+	if val, ok := d.GetOk("policy_builder"); ok {
+		_ = d.Set("policy_builder", flattenWafPolicyBuilder(val.(*schema.Set).List(), settings.Policy.PolicyBuilder))
+	}
+
 	return nil
 }
 
@@ -572,10 +590,11 @@ func getpolicyConfig(d *schema.ResourceData) (string, error) {
 			policyWaf.SignatureSettings.SignatureStaging = item.(map[string]interface{})["signature_staging"].(bool)
 		}
 	}
+	var policyBuilder wafPolicyBuilder
 	if val, ok := d.GetOk("policy_builder"); ok {
 		for _, item := range val.(*schema.Set).List() {
-			log.Printf("Value:%+v", item.(map[string]interface{})["learning_mode"].(string))
-			policyWaf.PolicyBuilder.LearningMode = item.(map[string]interface{})["learning_mode"].(string)
+			policyBuilder.LearningMode = item.(map[string]interface{})["learning_mode"].(string)
+			policyBuilder.LearningSpeed = item.(map[string]interface{})["learning_speed"].(string)
 		}
 	}
 	var graphProfles []bigip.GraphqlProfile
@@ -711,7 +730,7 @@ func getpolicyConfig(d *schema.ResourceData) (string, error) {
 
 	// policyJson := &bigip.PolicyStruct{}
 	policyJson := &bigip.PolicyStructobject{}
-	policyJson.Policy = policyWaf
+	policyJson.Policy = wafPolicyDeclaration{WafPolicy: policyWaf, PolicyBuilder: policyBuilder}
 
 	if val, ok := d.GetOk("policy_import_json"); ok {
 		var polJsn bigip.PolicyStruct
@@ -728,6 +747,22 @@ func getpolicyConfig(d *schema.ResourceData) (string, error) {
 		if appLang, ok := d.GetOk("application_language"); ok {
 			polJsn1.Policy.(map[string]interface{})["applicationLanguage"] = appLang
 		}
+		if policyWaf.CaseInsensitive {
+			polJsn1.Policy.(map[string]interface{})["caseInsensitive"] = true
+		}
+		if policyBuilder != (wafPolicyBuilder{}) {
+			builder, _ := polJsn1.Policy.(map[string]interface{})["policy-builder"].(map[string]interface{})
+			if builder == nil {
+				builder = make(map[string]interface{})
+			}
+			if policyBuilder.LearningMode != "" {
+				builder["learningMode"] = policyBuilder.LearningMode
+			}
+			if policyBuilder.LearningSpeed != "" {
+				builder["learningSpeed"] = policyBuilder.LearningSpeed
+			}
+			polJsn1.Policy.(map[string]interface{})["policy-builder"] = builder
+		}
 		// if policyWaf.ApplicationLanguage != "" {
 		//	polJsn1.Policy.(map[string]interface{})["applicationLanguage"] = policyWaf.ApplicationLanguage
 		// }
@@ -805,9 +840,13 @@ func getpolicyConfig(d *schema.ResourceData) (string, error) {
 		if policyWaf.Description != "" {
 			polJsn1.Policy.(map[string]interface{})["description"] = policyWaf.Description
 		}
-		serverTech := make([]interface{}, len(policyWaf.ServerTechnologies))
-		for i, v := range policyWaf.ServerTechnologies {
-			serverTech[i] = v
+		// The technologies already in policy_import_json are not added a second time.
+		imported := wafImportedServerTechnologies(val.(string))
+		serverTech := make([]interface{}, 0, len(policyWaf.ServerTechnologies))
+		for _, v := range policyWaf.ServerTechnologies {
+			if !imported[v.ServerTechnologyName] {
+				serverTech = append(serverTech, v)
+			}
 		}
 		_, srvrTCOK := polJsn1.Policy.(map[string]interface{})["server-technologies"]
 		if srvrTCOK {
@@ -901,3 +940,75 @@ func getpolicyConfig(d *schema.ResourceData) (string, error) {
 	}
 	return string(data), nil
 }
+
+// wafPolicyBuilder is the policy-builder section of the declarative policy, which bigip.WafPolicy
+// models without the learning speed.
+type wafPolicyBuilder struct {
+	LearningMode  string `json:"learningMode,omitempty"`
+	LearningSpeed string `json:"learningSpeed,omitempty"`
+}
+
+// wafPolicyDeclaration is the declarative policy sent on create and update, its policy-builder
+// section replacing the one of bigip.WafPolicy.
+type wafPolicyDeclaration struct {
+	bigip.WafPolicy
+	PolicyBuilder wafPolicyBuilder `json:"policy-builder"`
+}
+
+// wafPolicySettings holds the settings read back from the exported policy.
+type wafPolicySettings struct {
+	Policy struct {
+		CaseInsensitive    bool               `json:"caseInsensitive"`
+		ServerTechnologies []bigip.ServerTech `json:"server-technologies"`
+		PolicyBuilder      wafPolicyBuilder   `json:"policy-builder"`
+	} `json:"policy"`
+}
+
+// wafImportedServerTechnologies returns the names of the server technologies of a policy_import_json.
+func wafImportedServerTechnologies(importJson string) map[string]bool {
+	var policy struct {
+		Policy struct {
+			ServerTechnologies []bigip.ServerTech `json:"server-technologies"`
+		} `json:"policy"`
+	}
+	names := make(map[string]bool)
+	if json.Unmarshal([]byte(importJson), &policy) != nil {
+		return names
+	}
+	for _, t := range policy.Policy.ServerTechnologies {
+		names[t.ServerTechnologyName] = true
+	}
+	return names
+}
+
+// wafServerTechnologyNames returns the server technologies of the deployed policy, leaving out
+// those that only come from policy_import_json.
+func wafServerTechnologyNames(d *schema.ResourceData, technologies []bigip.ServerTech) []string {
+	configured := make(map[string]bool)
+	for _, name := range d.Get("server_technologies").([]interface{}) {
+		configured[name.(string)] = true
+	}
+	imported := wafImportedServerTechnologies(d.Get("policy_import_json").(string))
+	names := make([]string, 0, len(technologies))
+	for _, t := range technologies {
+		if configured[t.ServerTechnologyName] || !imported[t.ServerTechnologyName] {
+			names = append(names, t.ServerTechnologyName)
+		}
+	}
+	return names
+}
+
+// flattenWafPolicyBuilder returns the policy_builder block with the settings of the deployed
+// policy, for the ones that are configured.
+func flattenWafPolicyBuilder(configured []interface{}, builder wafPolicyBuilder) []interface{} {
+	item := map[string]interface{}{}
+	for _, c := range configured {
+		if c.(map[string]interface{})["learning_mode"].(string) != "" {
+			item["learning_mode"] = builder.LearningMode
+		}
+		if c.(map[string]interface{})["learning_speed"].(string) != "" {
+			item["learning_speed"] = builder.LearningSpeed
+		}
+	}
+	return []interface{}{item}
+}
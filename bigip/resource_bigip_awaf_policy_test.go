@@ -7,6 +7,7 @@ If a copy of the MPL was not distributed with this file,You can obtain one at ht
 package bigip
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -34,6 +35,50 @@ func TestAccBigipLtmWafPolicyTestCases(t *testing.T) {
 	})
 }
 
+func TestAccBigipWafPolicy_serverTechnologies(t *testing.T) {
+	resourceName := "bigip_waf_policy.test-awaf"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipWafPolicyServerTechnologies(`"MySQL", "Unix/Linux"`, "slow"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "case_insensitive", "true"),
+					resource.TestCheckResourceAttr(resourceName, "server_technologies.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "server_technologies.1", "Unix/Linux"),
+					resource.TestCheckResourceAttr(resourceName, "policy_builder.0.learning_speed", "slow"),
+				),
+			},
+			{
+				Config: testAccBigipWafPolicyServerTechnologies(`"MySQL", "Unix/Linux", "Node.js"`, "fast"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "server_technologies.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "server_technologies.2", "Node.js"),
+					resource.TestCheckResourceAttr(resourceName, "policy_builder.0.learning_speed", "fast"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBigipWafPolicyServerTechnologies(technologies, learningSpeed string) string {
+	return fmt.Sprintf(`
+resource "bigip_waf_policy" "test-awaf" {
+  name                = "test-awaf-server-technologies"
+  template_name       = "POLICY_TEMPLATE_RAPID_DEPLOYMENT"
+  case_insensitive    = true
+  server_technologies = [%s]
+  policy_builder {
+    learning_mode  = "automatic"
+    learning_speed = "%s"
+  }
+}
+`, technologies, learningSpeed)
+}
+
 // func testCheckMonitorExists(name string) resource.TestCheckFunc {
 // 	return func(s *terraform.State) error {
 // 		client := testAccProvider.Meta().(*bigip.BigIP)
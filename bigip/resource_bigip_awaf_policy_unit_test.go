@@ -0,0 +1,81 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPolicyConfigPolicyBuilder(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipAwafPolicy().Schema, map[string]interface{}{
+		"name":                "test-policy",
+		"template_name":       "POLICY_TEMPLATE_RAPID_DEPLOYMENT",
+		"case_insensitive":    true,
+		"server_technologies": []interface{}{"MySQL", "Unix/Linux", "Node.js"},
+		"policy_builder": []interface{}{map[string]interface{}{
+			"learning_mode":  "automatic",
+			"learning_speed": "slow",
+		}},
+	})
+	config, err := getpolicyConfig(d)
+	assert.NoError(t, err)
+
+	var policy map[string]map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(config), &policy))
+	assert.Equal(t, true, policy["policy"]["caseInsensitive"])
+	assert.Equal(t, map[string]interface{}{"learningMode": "automatic", "learningSpeed": "slow"}, policy["policy"]["policy-builder"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"serverTechnologyName": "MySQL"},
+		map[string]interface{}{"serverTechnologyName": "Unix/Linux"},
+		map[string]interface{}{"serverTechnologyName": "Node.js"},
+	}, policy["policy"]["server-technologies"])
+	assert.Equal(t, map[string]interface{}{"name": "POLICY_TEMPLATE_RAPID_DEPLOYMENT"}, policy["policy"]["template"])
+}
+
+func TestGetPolicyConfigImportJsonMerge(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipAwafPolicy().Schema, map[string]interface{}{
+		"name":                "test-policy",
+		"template_name":       "POLICY_TEMPLATE_RAPID_DEPLOYMENT",
+		"case_insensitive":    true,
+		"server_technologies": []interface{}{"MySQL", "Node.js"},
+		"policy_builder":      []interface{}{map[string]interface{}{"learning_speed": "fast"}},
+		"policy_import_json": `{"policy":{"name":"imported","policy-builder":{"learningMode":"manual"},
+			"server-technologies":[{"serverTechnologyName":"MySQL"}]}}`,
+	})
+	config, err := getpolicyConfig(d)
+	assert.NoError(t, err)
+
+	var policy map[string]map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(config), &policy))
+	assert.Equal(t, true, policy["policy"]["caseInsensitive"])
+	assert.Equal(t, map[string]interface{}{"learningMode": "manual", "learningSpeed": "fast"}, policy["policy"]["policy-builder"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"serverTechnologyName": "MySQL"},
+		map[string]interface{}{"serverTechnologyName": "Node.js"},
+	}, policy["policy"]["server-technologies"])
+}
+
+func TestWafServerTechnologyNames(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipAwafPolicy().Schema, map[string]interface{}{
+		"name":                "test-policy",
+		"template_name":       "POLICY_TEMPLATE_RAPID_DEPLOYMENT",
+		"server_technologies": []interface{}{"Node.js"},
+		"policy_import_json":  `{"policy":{"server-technologies":[{"serverTechnologyName":"MySQL"},{"serverTechnologyName":"Node.js"}]}}`,
+	})
+	deployed := []bigip.ServerTech{{ServerTechnologyName: "MySQL"}, {ServerTechnologyName: "Node.js"}, {ServerTechnologyName: "Unix/Linux"}}
+	assert.Equal(t, []string{"Node.js", "Unix/Linux"}, wafServerTechnologyNames(d, deployed))
+}
+
+func TestFlattenWafPolicyBuilder(t *testing.T) {
+	builder := wafPolicyBuilder{LearningMode: "automatic", LearningSpeed: "medium"}
+	configured := []interface{}{map[string]interface{}{"learning_mode": "manual", "learning_speed": ""}}
+	assert.Equal(t, []interface{}{map[string]interface{}{"learning_mode": "automatic"}}, flattenWafPolicyBuilder(configured, builder))
+}
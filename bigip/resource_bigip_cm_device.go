@@ -8,14 +8,53 @@ package bigip
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"log"
 
 	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const uriCmDevice = "cm/device"
+
+// cmDevice holds the high availability settings of a cm device.
+type cmDevice struct {
+	Name              string             `json:"name,omitempty"`
+	ManagementIp      string             `json:"managementIp,omitempty"`
+	ConfigsyncIp      string             `json:"configsyncIp,omitempty"`
+	MirrorIp          string             `json:"mirrorIp,omitempty"`
+	MirrorSecondaryIp string             `json:"mirrorSecondaryIp,omitempty"`
+	UnicastAddress    cmUnicastAddresses `json:"unicastAddress,omitempty"`
+	HaCapacity        *int               `json:"haCapacity,omitempty"`
+}
+
+// cmUnicastAddress is an address the device sends and receives the failover heartbeats on.
+type cmUnicastAddress struct {
+	Ip   string `json:"ip"`
+	Port int    `json:"port"`
+}
+
+// cmUnicastAddresses is the unicastAddress list of a device, which the BIG-IP returns as the
+// keyword none when it is empty.
+type cmUnicastAddresses []cmUnicastAddress
+
+func (a *cmUnicastAddresses) UnmarshalJSON(b []byte) error {
+	var keyword string
+	if json.Unmarshal(b, &keyword) == nil {
+		*a = nil
+		return nil
+	}
+	var addresses []cmUnicastAddress
+	if err := json.Unmarshal(b, &addresses); err != nil {
+		return err
+	}
+	*a = addresses
+	return nil
+}
+
 func resourceBigipCmDevice() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceBigipCmDeviceCreate,
@@ -36,7 +75,8 @@ func resourceBigipCmDevice() *schema.Resource {
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Address of the Device which needs to be Deviceensed",
+				ForceNew:    true,
+				Description: "Name of the device, as listed by cm device, e.g. bigip1.example.com",
 			},
 
 			"mirror_ip": {
@@ -49,6 +89,38 @@ func resourceBigipCmDevice() *schema.Resource {
 				Optional:    true,
 				Description: "Secondary IP address used for state mirroring",
 			},
+			"unicast_addresses": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Addresses the device sends and receives the network failover heartbeats on",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Self IP address of the heartbeats",
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1026,
+							ValidateFunc: validation.IsPortNumber,
+							Description:  "UDP port of the heartbeats",
+						},
+					},
+				},
+			},
+			"ha_capacity": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Relative capacity of the device, used to pick the next active device of a traffic group",
+			},
+			"management_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Management address of the device",
+			},
 		},
 	}
 
@@ -56,95 +128,127 @@ func resourceBigipCmDevice() *schema.Resource {
 
 func resourceBigipCmDeviceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
-
-	configsyncIp := d.Get("configsync_ip").(string)
 	name := d.Get("name").(string)
-	mirrorIp := d.Get("mirror_ip").(string)
-	mirrorSecondaryIp := d.Get("mirror_secondary_ip").(string)
-
-	log.Println("[INFO] Creating Device ")
-
-	err := client.CreateDevice(
-		name,
-		configsyncIp,
-		mirrorIp,
-		mirrorSecondaryIp,
-	)
+	apiLog := newAPICallLogger(ctx, "bigip_cm_device", name, "create", icontrolURI(uriCmDevice, name))
 
+	// A device cannot be created, it joins the list through device trust: its settings are set.
+	config := getCmDeviceConfig(d)
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriCmDevice, name))
+	apiLog.done(err)
 	if err != nil {
-		log.Printf("[ERROR] Unable to Create Device %s %v ", name, err)
-		return diag.FromErr(err)
+		return diag.FromErr(fmt.Errorf("error setting the addresses of device (%s): %s", name, err))
 	}
 	d.SetId(name)
 	return resourceBigipCmDeviceRead(ctx, d, meta)
-
 }
 
 func resourceBigipCmDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
-
 	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_cm_device", name, "update", icontrolURI(uriCmDevice, name))
 
-	log.Println("[INFO] Updating Device " + name)
-
-	r := &bigip.Device{
-		Name:              name,
-		ConfigsyncIp:      d.Get("configsync_ip").(string),
-		MirrorIp:          d.Get("mirror_ip").(string),
-		MirrorSecondaryIp: d.Get("mirror_secondary_ip").(string),
-	}
-
-	err := client.ModifyDevice(r)
+	config := getCmDeviceConfig(d)
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriCmDevice, name))
+	apiLog.done(err)
 	if err != nil {
-		log.Printf("[ERROR] Unable to Modidy Device (%s) (%v) ", name, err)
-		return diag.FromErr(err)
+		return diag.FromErr(fmt.Errorf("error modifying the addresses of device (%s): %s", name, err))
 	}
 	return resourceBigipCmDeviceRead(ctx, d, meta)
 }
 
 func resourceBigipCmDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
-
 	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_cm_device", name, "read", icontrolURI(uriCmDevice, name))
 
-	log.Println("[INFO] Reading Device " + name)
-
-	members, err := client.Devices(name)
+	device := &cmDevice{}
+	found, err := getRestEntity(client, device, restObjectPath(uriCmDevice, name))
+	apiLog.done(err)
 	if err != nil {
-		log.Printf("[ERROR] Unable to retrieve Device (%s) (%v) ", name, err)
 		return diag.FromErr(err)
 	}
-	if members == nil {
-		log.Printf("[WARN] Device (%s) not found, removing from state", d.Id())
+	if !found {
+		tflog.Warn(apiLog.ctx, "Device not found, removing from state")
 		d.SetId("")
 		return nil
 	}
 
-	_ = d.Set("name", members.Name)
-
-	if err := d.Set("mirror_ip", members.MirrorIp); err != nil {
-		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving mirror_ip  to state for Device (%s): %s", d.Id(), err))
-	}
-
-	if err := d.Set("configsync_ip", members.ConfigsyncIp); err != nil {
-		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving configsync_ip  to state for Device (%s): %s", d.Id(), err))
+	_ = d.Set("name", name)
+	_ = d.Set("configsync_ip", cmDeviceAddress(device.ConfigsyncIp))
+	_ = d.Set("mirror_ip", cmDeviceAddress(device.MirrorIp))
+	_ = d.Set("mirror_secondary_ip", cmDeviceAddress(device.MirrorSecondaryIp))
+	_ = d.Set("management_ip", device.ManagementIp)
+	if device.HaCapacity != nil {
+		_ = d.Set("ha_capacity", *device.HaCapacity)
 	}
-
-	if err := d.Set("mirror_secondary_ip", members.MirrorSecondaryIp); err != nil {
-		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving mirror_secondary_ip  to state for Device (%s): %s", d.Id(), err))
+	unicast := make([]interface{}, 0, len(device.UnicastAddress))
+	for _, a := range device.UnicastAddress {
+		unicast = append(unicast, map[string]interface{}{
+			"ip":   a.Ip,
+			"port": a.Port,
+		})
 	}
-
+	_ = d.Set("unicast_addresses", unicast)
 	return nil
 }
 
+// resourceBigipCmDeviceDelete resets the addresses of the device to their defaults, the device
+// itself leaves the list only when it is removed from the trust.
 func resourceBigipCmDeviceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
-	err := client.DeleteDevice(name)
-	if err != nil {
-		log.Printf("[ERROR] Unable to Delete Device (%s)  (%v) ", name, err)
-		return diag.FromErr(err)
+	apiLog := newAPICallLogger(ctx, "bigip_cm_device", name, "delete", icontrolURI(uriCmDevice, name))
+
+	reset := map[string]string{
+		"configsyncIp":      "none",
+		"unicastAddress":    "none",
+		"mirrorIp":          "any6",
+		"mirrorSecondaryIp": "any6",
+	}
+	apiLog.payload(reset)
+	resp, err := restCall(client, "patch", restObjectPath(uriCmDevice, name), reset)
+	apiLog.done(err)
+	if err != nil && !isRestNotFound(resp, err) {
+		return diag.FromErr(fmt.Errorf("error resetting the addresses of device (%s): %s", name, err))
 	}
 	d.SetId("")
 	return nil
 }
+
+func getCmDeviceConfig(d *schema.ResourceData) map[string]interface{} {
+	config := map[string]interface{}{
+		"configsyncIp":      d.Get("configsync_ip").(string),
+		"mirrorIp":          "any6",
+		"mirrorSecondaryIp": "any6",
+		"unicastAddress":    "none",
+	}
+	if v := d.Get("mirror_ip").(string); v != "" {
+		config["mirrorIp"] = v
+	}
+	if v := d.Get("mirror_secondary_ip").(string); v != "" {
+		config["mirrorSecondaryIp"] = v
+	}
+	var unicast []cmUnicastAddress
+	for _, a := range d.Get("unicast_addresses").([]interface{}) {
+		address := a.(map[string]interface{})
+		unicast = append(unicast, cmUnicastAddress{Ip: address["ip"].(string), Port: address["port"].(int)})
+	}
+	if len(unicast) > 0 {
+		config["unicastAddress"] = unicast
+	}
+	if v, ok := d.GetOk("ha_capacity"); ok {
+		config["haCapacity"] = v.(int)
+	}
+	return config
+}
+
+// cmDeviceAddress returns the address of a device setting, without the keywords the BIG-IP
+// reports for an unset one.
+func cmDeviceAddress(address string) string {
+	if address == "none" || address == "any6" {
+		return ""
+	}
+	return address
+}
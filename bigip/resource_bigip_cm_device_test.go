@@ -14,15 +14,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-var TEST_DEVICE_NAME = "test-device"
+// TEST_DEVICE_NAME is the self device of the BIG-IP under test, a device cannot be created.
+var TEST_DEVICE_NAME = "bigip1"
 
 var TEST_DEVICE_RESOURCE = `
 resource "bigip_cm_device" "test-device" {
-            name = "` + TEST_DEVICE_NAME + `"
-            configsync_ip = "2.2.2.2"
-            mirror_ip = "10.10.10.10"
-            mirror_secondary_ip = "11.11.11.11"
-        }
+  name                = "` + TEST_DEVICE_NAME + `"
+  configsync_ip       = "10.10.10.10"
+  mirror_ip           = "10.10.10.10"
+  mirror_secondary_ip = "11.11.11.11"
+  ha_capacity         = 10
+  unicast_addresses {
+    ip = "10.10.10.10"
+  }
+}
 `
 
 func TestAccBigipCmDevice_create(t *testing.T) {
@@ -36,11 +41,15 @@ func TestAccBigipCmDevice_create(t *testing.T) {
 			{
 				Config: TEST_DEVICE_RESOURCE,
 				Check: resource.ComposeTestCheckFunc(
-					testCheckdeviceExists(TEST_DEVICE_NAME, true),
+					testCheckdeviceExists(TEST_DEVICE_NAME),
 					resource.TestCheckResourceAttr("bigip_cm_device.test-device", "name", TEST_DEVICE_NAME),
-					resource.TestCheckResourceAttr("bigip_cm_device.test-device", "configsync_ip", "2.2.2.2"),
+					resource.TestCheckResourceAttr("bigip_cm_device.test-device", "configsync_ip", "10.10.10.10"),
 					resource.TestCheckResourceAttr("bigip_cm_device.test-device", "mirror_ip", "10.10.10.10"),
 					resource.TestCheckResourceAttr("bigip_cm_device.test-device", "mirror_secondary_ip", "11.11.11.11"),
+					resource.TestCheckResourceAttr("bigip_cm_device.test-device", "ha_capacity", "10"),
+					resource.TestCheckResourceAttr("bigip_cm_device.test-device", "unicast_addresses.#", "1"),
+					resource.TestCheckResourceAttr("bigip_cm_device.test-device", "unicast_addresses.0.ip", "10.10.10.10"),
+					resource.TestCheckResourceAttr("bigip_cm_device.test-device", "unicast_addresses.0.port", "1026"),
 				),
 			},
 		},
@@ -58,33 +67,35 @@ func TestAccBigipCmDevice_import(t *testing.T) {
 			{
 				Config: TEST_DEVICE_RESOURCE,
 				Check: resource.ComposeTestCheckFunc(
-					testCheckdeviceExists(TEST_DEVICE_NAME, true),
+					testCheckdeviceExists(TEST_DEVICE_NAME),
 				),
-				ResourceName:      TEST_DEVICE_NAME,
-				ImportState:       false,
+			},
+			{
+				ResourceName:      "bigip_cm_device.test-device",
+				ImportState:       true,
+				ImportStateId:     TEST_DEVICE_NAME,
 				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func testCheckdeviceExists(name string, exists bool) resource.TestCheckFunc {
+func testCheckdeviceExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
-		device, err := client.Devices(name)
+		found, err := getRestEntity(client, &cmDevice{}, restObjectPath(uriCmDevice, name))
 		if err != nil {
 			return err
 		}
-		if exists && device == nil {
-			return fmt.Errorf("device %s was not created.", name)
-		}
-		if !exists && device != nil {
-			return fmt.Errorf("device %s still exists.", name)
+		if !found {
+			return fmt.Errorf("device %s not found", name)
 		}
 		return nil
 	}
 }
 
+// testCheckdevicesDestroyed checks the addresses of the devices are reset, the devices themselves
+// stay in the list.
 func testCheckdevicesDestroyed(s *terraform.State) error {
 	client := testAccProvider.Meta().(*bigip.BigIP)
 
@@ -94,12 +105,12 @@ func testCheckdevicesDestroyed(s *terraform.State) error {
 		}
 
 		name := rs.Primary.ID
-		device, err := client.Devices(name)
-		if err != nil {
+		device := &cmDevice{}
+		if _, err := getRestEntity(client, device, restObjectPath(uriCmDevice, name)); err != nil {
 			return err
 		}
-		if device == nil {
-			return fmt.Errorf("device %s not destroyed.", name)
+		if cmDeviceAddress(device.ConfigsyncIp) != "" || len(device.UnicastAddress) > 0 {
+			return fmt.Errorf("addresses of device %s not reset", name)
 		}
 	}
 	return nil
@@ -0,0 +1,113 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCmDeviceConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipCmDevice().Schema, map[string]interface{}{
+		"name":          "bigip1.example.com",
+		"configsync_ip": "10.2.2.1",
+		"mirror_ip":     "10.2.2.1",
+		"unicast_addresses": []interface{}{
+			map[string]interface{}{"ip": "10.2.2.1"},
+			map[string]interface{}{"ip": "10.1.1.1", "port": 1027},
+		},
+		"ha_capacity": 10,
+	})
+	assert.Equal(t, map[string]interface{}{
+		"configsyncIp":      "10.2.2.1",
+		"mirrorIp":          "10.2.2.1",
+		"mirrorSecondaryIp": "any6",
+		"unicastAddress":    []cmUnicastAddress{{Ip: "10.2.2.1", Port: 1026}, {Ip: "10.1.1.1", Port: 1027}},
+		"haCapacity":        10,
+	}, getCmDeviceConfig(d))
+
+	d = schema.TestResourceDataRaw(t, resourceBigipCmDevice().Schema, map[string]interface{}{
+		"name":          "bigip1.example.com",
+		"configsync_ip": "10.2.2.1",
+	})
+	assert.Equal(t, map[string]interface{}{
+		"configsyncIp":      "10.2.2.1",
+		"mirrorIp":          "any6",
+		"mirrorSecondaryIp": "any6",
+		"unicastAddress":    "none",
+	}, getCmDeviceConfig(d))
+}
+
+func TestCmUnicastAddressesUnmarshal(t *testing.T) {
+	var device cmDevice
+	assert.NoError(t, json.Unmarshal([]byte(`{"unicastAddress":"none"}`), &device))
+	assert.Empty(t, device.UnicastAddress)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"unicastAddress":[{"effectiveIp":"10.2.2.1","ip":"10.2.2.1","port":1026}]}`), &device))
+	assert.Equal(t, cmUnicastAddresses{{Ip: "10.2.2.1", Port: 1026}}, device.UnicastAddress)
+}
+
+func TestResourceBigipCmDeviceRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/cm/device/bigip1.example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"bigip1.example.com","managementIp":"10.1.1.1","configsyncIp":"10.2.2.1",
+			"mirrorIp":"10.2.2.1","mirrorSecondaryIp":"any6","haCapacity":0,
+			"unicastAddress":[{"effectiveIp":"10.2.2.1","effectivePort":1026,"ip":"10.2.2.1","port":1026}]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipCmDevice()
+	d := r.TestResourceData()
+	d.SetId("bigip1.example.com")
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "10.2.2.1", d.Get("configsync_ip"))
+	assert.Equal(t, "10.2.2.1", d.Get("mirror_ip"))
+	assert.Equal(t, "", d.Get("mirror_secondary_ip"))
+	assert.Equal(t, "10.1.1.1", d.Get("management_ip"))
+	assert.Equal(t, 0, d.Get("ha_capacity"))
+	assert.Equal(t, []interface{}{map[string]interface{}{"ip": "10.2.2.1", "port": 1026}}, d.Get("unicast_addresses"))
+}
+
+func TestResourceBigipCmDeviceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	mux.HandleFunc("/mgmt/tm/cm/device/bigip1.example.com", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"bigip1.example.com"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipCmDevice()
+	d := r.TestResourceData()
+	d.SetId("bigip1.example.com")
+	assert.False(t, r.DeleteContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "", d.Id())
+	assert.JSONEq(t, `{"configsyncIp":"none","unicastAddress":"none","mirrorIp":"any6","mirrorSecondaryIp":"any6"}`, body)
+}
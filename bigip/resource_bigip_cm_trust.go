@@ -0,0 +1,183 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	uriCmAddToTrust      = "cm/add-to-trust"
+	uriCmRemoveFromTrust = "cm/remove-from-trust"
+)
+
+// cmTrustPollInterval is the delay between two reads of the device list while the trust is set up.
+var cmTrustPollInterval = 5 * time.Second
+
+func resourceBigipCmTrust() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipCmTrustCreate,
+		ReadContext:   resourceBigipCmTrustRead,
+		UpdateContext: resourceBigipCmTrustUpdate,
+		DeleteContext: resourceBigipCmTrustDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"peer_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the peer device, as listed by cm device once in the trust, e.g. bigip2.example.com",
+			},
+			"peer_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Management address of the peer device",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Administrator account of the peer, only used to establish the trust",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of the administrator account of the peer, only used to establish the trust. Its hash is kept in state",
+				StateFunc: func(v interface{}) string {
+					return hashForState(v.(string))
+				},
+			},
+			"ca_device": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Whether the peer is added as a certificate signing authority (true) or as a subordinate, non-authority device",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of seconds to wait for the peer to appear in the device list",
+			},
+		},
+	}
+}
+
+func resourceBigipCmTrustCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("peer_name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_cm_trust", name, "create", "/mgmt/tm/"+uriCmAddToTrust)
+
+	body := map[string]interface{}{
+		"command":    "run",
+		"name":       "Root",
+		"caDevice":   d.Get("ca_device").(bool),
+		"device":     d.Get("peer_address").(string),
+		"deviceName": name,
+		"username":   d.Get("username").(string),
+		"password":   d.Get("password").(string),
+	}
+	apiLog.payload(body)
+	err := postRestEntity(client, body, uriCmAddToTrust)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error adding %s to the device trust: %s", name, err))
+	}
+	timeout := time.Duration(d.Get("timeout").(int)) * time.Second
+	if err := waitCmTrustDevice(apiLog.ctx, client, name, timeout); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipCmTrustRead(ctx, d, meta)
+}
+
+func resourceBigipCmTrustRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_cm_trust", name, "read", icontrolURI(uriCmDevice, name))
+
+	device := &cmDevice{}
+	found, err := getRestEntity(client, device, restObjectPath(uriCmDevice, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "Peer device not in the trust, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("peer_name", name)
+	if _, ok := d.GetOk("peer_address"); !ok {
+		_ = d.Set("peer_address", device.ManagementIp)
+	}
+	return nil
+}
+
+// resourceBigipCmTrustUpdate only stores the new credentials, they are not used once the trust is
+// established.
+func resourceBigipCmTrustUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceBigipCmTrustRead(ctx, d, meta)
+}
+
+func resourceBigipCmTrustDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_cm_trust", name, "delete", "/mgmt/tm/"+uriCmRemoveFromTrust)
+
+	body := map[string]string{
+		"command":    "run",
+		"name":       "Root",
+		"deviceName": name,
+	}
+	apiLog.payload(body)
+	err := postRestEntity(client, body, uriCmRemoveFromTrust)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error removing %s from the device trust: %s", name, err))
+	}
+	d.SetId("")
+	return nil
+}
+
+// waitCmTrustDevice polls the device list until the peer name is in it, adding a device to the
+// trust runs in the background.
+func waitCmTrustDevice(ctx context.Context, client *bigip.BigIP, name string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		devices, err := getRestItems[cmDevice](client, uriCmDevice)
+		if err != nil {
+			return fmt.Errorf("error reading the device list: %v", err)
+		}
+		for _, device := range devices {
+			if device.Name == name {
+				return nil
+			}
+		}
+		tflog.Debug(ctx, "waiting for the peer to join the device trust")
+		if time.Now().After(deadline) {
+			return fmt.Errorf("device %s not in the device trust after %s", name, timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(cmTrustPollInterval):
+		}
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TEST_PEER_NAME and TEST_PEER_ADDRESS are the second BIG-IP of the test bed, with the credentials
+// of the BIG-IP under test.
+var (
+	TEST_PEER_NAME    = "bigip2"
+	TEST_PEER_ADDRESS = "10.192.74.74"
+)
+
+var TEST_TRUST_RESOURCE = `
+resource "bigip_cm_trust" "test-trust" {
+  peer_name    = "` + TEST_PEER_NAME + `"
+  peer_address = "` + TEST_PEER_ADDRESS + `"
+  username     = "admin"
+  password     = "admin"
+}
+`
+
+func TestAccBigipCmTrust_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTrustDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_TRUST_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckdeviceExists(TEST_PEER_NAME),
+					resource.TestCheckResourceAttr("bigip_cm_trust.test-trust", "peer_name", TEST_PEER_NAME),
+					resource.TestCheckResourceAttr("bigip_cm_trust.test-trust", "peer_address", TEST_PEER_ADDRESS),
+					resource.TestCheckResourceAttr("bigip_cm_trust.test-trust", "ca_device", "true"),
+					resource.TestCheckResourceAttr("bigip_cm_trust.test-trust", "password", hashForState("admin")),
+				),
+			},
+		},
+	})
+}
+
+func testCheckTrustDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_cm_trust" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		found, err := getRestEntity(client, &cmDevice{}, restObjectPath(uriCmDevice, name))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("device %s still in the device trust", name)
+		}
+	}
+	return nil
+}
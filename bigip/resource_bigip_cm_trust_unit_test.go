@@ -0,0 +1,109 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceBigipCmTrustCreateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+	cmTrustPollInterval = 10 * time.Millisecond
+	defer func() { cmTrustPollInterval = 5 * time.Second }()
+
+	var added, removed map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/cm/add-to-trust", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		b, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(b, &added))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"kind":"tm:cm:add-to-trust:runstate","command":"run"}`)
+	})
+	reads := 0
+	mux.HandleFunc("/mgmt/tm/cm/device", func(w http.ResponseWriter, r *http.Request) {
+		reads++
+		w.Header().Set("Content-Type", "application/json")
+		if reads < 3 {
+			_, _ = fmt.Fprintf(w, `{"items":[{"name":"bigip1.example.com","managementIp":"10.1.1.1"}]}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"bigip1.example.com","managementIp":"10.1.1.1"},
+			{"name":"bigip2.example.com","managementIp":"10.1.1.2"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/device/bigip2.example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"bigip2.example.com","managementIp":"10.1.1.2","unicastAddress":"none"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/remove-from-trust", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		b, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(b, &removed))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"kind":"tm:cm:remove-from-trust:runstate","command":"run"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipCmTrust()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"peer_name":    "bigip2.example.com",
+		"peer_address": "10.1.1.2",
+		"username":     "admin",
+		"password":     "secret",
+	})
+	assert.False(t, r.CreateContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "bigip2.example.com", d.Id())
+	assert.Equal(t, 3, reads)
+	assert.Equal(t, map[string]interface{}{
+		"command":    "run",
+		"name":       "Root",
+		"caDevice":   true,
+		"device":     "10.1.1.2",
+		"deviceName": "bigip2.example.com",
+		"username":   "admin",
+		"password":   "secret",
+	}, added)
+	assert.Equal(t, hashForState("secret"), r.Schema["password"].StateFunc("secret"))
+
+	assert.False(t, r.DeleteContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "", d.Id())
+	assert.Equal(t, map[string]interface{}{"command": "run", "name": "Root", "deviceName": "bigip2.example.com"}, removed)
+}
+
+func TestWaitCmTrustDeviceTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+	cmTrustPollInterval = 10 * time.Millisecond
+	defer func() { cmTrustPollInterval = 5 * time.Second }()
+
+	mux.HandleFunc("/mgmt/tm/cm/device", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"bigip1.example.com"}]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	err := waitCmTrustDevice(context.Background(), client, "bigip2.example.com", 30*time.Millisecond)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "device bigip2.example.com not in the device trust")
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriCmSyncStatus = "cm/sync-status"
+
+// configSyncPollInterval is the delay between two reads of the sync status.
+var configSyncPollInterval = 5 * time.Second
+
+func resourceBigipConfigSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipConfigSyncCreate,
+		ReadContext:   resourceBigipConfigSyncRead,
+		UpdateContext: resourceBigipConfigSyncUpdate,
+		DeleteContext: resourceBigipConfigSyncDelete,
+		Schema: map[string]*schema.Schema{
+			"device_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the device group to sync",
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "to-group",
+				ValidateFunc: validation.StringInSlice([]string{"to-group", "from-group"}, false),
+				Description:  "Sync the configuration of this device to the group (to-group) or of the group to this device (from-group)",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values, a change of any of them runs the sync again",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of seconds to wait for the device group to be in sync",
+			},
+		},
+	}
+}
+
+func resourceBigipConfigSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	deviceGroup := d.Get("device_group").(string)
+	if err := runConfigSync(ctx, client, deviceGroup, d.Get("direction").(string), time.Duration(d.Get("timeout").(int))*time.Second); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(deviceGroup)
+	return resourceBigipConfigSyncRead(ctx, d, meta)
+}
+
+// resourceBigipConfigSyncRead does not read anything back: the sync is an action, which only runs
+// again when its arguments change.
+func resourceBigipConfigSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceBigipConfigSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	if !d.HasChanges("device_group", "direction", "triggers") {
+		return nil
+	}
+	deviceGroup := d.Get("device_group").(string)
+	if err := runConfigSync(ctx, client, deviceGroup, d.Get("direction").(string), time.Duration(d.Get("timeout").(int))*time.Second); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(deviceGroup)
+	return resourceBigipConfigSyncRead(ctx, d, meta)
+}
+
+func resourceBigipConfigSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// runConfigSync syncs deviceGroup in direction, then waits for it to be in sync.
+func runConfigSync(ctx context.Context, client *bigip.BigIP, deviceGroup, direction string, timeout time.Duration) error {
+	body := map[string]string{
+		"command":     "run",
+		"utilCmdArgs": fmt.Sprintf("config-sync %s %s", direction, deviceGroup),
+	}
+	apiLog := newAPICallLogger(ctx, "bigip_config_sync", deviceGroup, "create", "/mgmt/tm/cm")
+	apiLog.payload(body)
+	err := postRestEntity(client, body, "cm")
+	apiLog.done(err)
+	if err != nil {
+		return fmt.Errorf("error running config-sync %s %s: %v", direction, deviceGroup, err)
+	}
+	return waitConfigSync(apiLog.ctx, client, deviceGroup, timeout)
+}
+
+// waitConfigSync polls the sync status until it is In Sync. Once timeout expires the last status
+// is reported with its summary and details.
+func waitConfigSync(ctx context.Context, client *bigip.BigIP, deviceGroup string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := getConfigSyncStatus(client)
+		if err != nil {
+			return fmt.Errorf("error reading sync status: %v", err)
+		}
+		if status.Status == "In Sync" {
+			return nil
+		}
+		tflog.Debug(ctx, "waiting for device group to be in sync", map[string]interface{}{"status": status.Status})
+		if time.Now().After(deadline) {
+			return fmt.Errorf("device group %s not in sync after %s: %s", deviceGroup, timeout, status)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(configSyncPollInterval):
+		}
+	}
+}
+
+// configSyncStatus is the flattened content of cm/sync-status.
+type configSyncStatus struct {
+	Status  string
+	Summary string
+	Details []string
+}
+
+func (s *configSyncStatus) String() string {
+	msg := s.Status
+	if s.Summary != "" {
+		msg += " (" + s.Summary + ")"
+	}
+	if len(s.Details) > 0 {
+		msg += ": " + strings.Join(s.Details, "; ")
+	}
+	return msg
+}
+
+// syncStatsEntry is one level of the nested stats returned by cm/sync-status.
+type syncStatsEntry struct {
+	Description string `json:"description"`
+	NestedStats struct {
+		Entries map[string]syncStatsEntry `json:"entries"`
+	} `json:"nestedStats"`
+}
+
+func getConfigSyncStatus(client *bigip.BigIP) (*configSyncStatus, error) {
+	resp, err := restCall(client, "get", uriCmSyncStatus, nil)
+	if err != nil {
+		return nil, err
+	}
+	var stats struct {
+		Entries map[string]syncStatsEntry `json:"entries"`
+	}
+	if err := json.Unmarshal(resp, &stats); err != nil {
+		return nil, err
+	}
+	status := &configSyncStatus{}
+	for _, entry := range stats.Entries {
+		fields := entry.NestedStats.Entries
+		status.Status = fields["status"].Description
+		status.Summary = fields["summary"].Description
+		for key, value := range fields {
+			if !strings.HasSuffix(key, "/details") {
+				continue
+			}
+			for _, detail := range value.NestedStats.Entries {
+				status.Details = append(status.Details, detail.NestedStats.Entries["details"].Description)
+			}
+		}
+	}
+	sort.Strings(status.Details)
+	return status, nil
+}
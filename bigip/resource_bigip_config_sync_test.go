@@ -0,0 +1,36 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testConfigSyncUnknownGroup = `
+resource "bigip_config_sync" "test-sync" {
+  device_group = "tf-missing-device-group"
+  timeout      = 10
+}
+`
+
+// The test devices are standalone, so only the failure of a sync is checked.
+func TestAccBigipConfigSync_unknownGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfigSyncUnknownGroup,
+				ExpectError: regexp.MustCompile("error running config-sync to-group tf-missing-device-group"),
+			},
+		},
+	})
+}
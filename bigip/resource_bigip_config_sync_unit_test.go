@@ -0,0 +1,83 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSyncStatusResponse(status, summary, detail string) string {
+	return fmt.Sprintf(`{"kind":"tm:cm:sync-status:sync-statusstats","entries":{"https://localhost/mgmt/tm/cm/sync-status/0":{"nestedStats":{"entries":{
+		"color":{"description":"green"},
+		"status":{"description":"%s"},
+		"summary":{"description":"%s"},
+		"https://localhost/mgmt/tm/cm/syncStatus/0/details":{"nestedStats":{"entries":{
+			"https://localhost/mgmt/tm/cm/syncStatus/0/details/0":{"nestedStats":{"entries":{"details":{"description":"%s"}}}}}}}}}}}}`, status, summary, detail)
+}
+
+func TestRunConfigSync(t *testing.T) {
+	setup()
+	defer teardown()
+	configSyncPollInterval = 10 * time.Millisecond
+	defer func() { configSyncPollInterval = 5 * time.Second }()
+
+	var command map[string]string
+	mux.HandleFunc("/mgmt/tm/cm", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&command)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	reads := 0
+	mux.HandleFunc("/mgmt/tm/cm/sync-status", func(w http.ResponseWriter, r *http.Request) {
+		reads++
+		w.Header().Set("Content-Type", "application/json")
+		if reads < 3 {
+			_, _ = fmt.Fprint(w, testSyncStatusResponse("Syncing", "Syncing", "bigip2: connected"))
+			return
+		}
+		_, _ = fmt.Fprint(w, testSyncStatusResponse("In Sync", "All devices in the device group are in sync", "bigip2: connected"))
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	err := runConfigSync(context.Background(), client, "sync-failover-group", "to-group", time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"command": "run", "utilCmdArgs": "config-sync to-group sync-failover-group"}, command)
+	assert.Equal(t, 3, reads)
+}
+
+func TestWaitConfigSyncTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+	configSyncPollInterval = 10 * time.Millisecond
+	defer func() { configSyncPollInterval = 5 * time.Second }()
+
+	mux.HandleFunc("/mgmt/tm/cm/sync-status", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, testSyncStatusResponse("Changes Pending", "There is a possible change conflict", "sync-failover-group (Changes Pending): bigip1 and bigip2 have different changes"))
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	err := waitConfigSync(context.Background(), client, "sync-failover-group", 50*time.Millisecond)
+	assert.EqualError(t, err, "device group sync-failover-group not in sync after 50ms: Changes Pending (There is a possible change conflict): sync-failover-group (Changes Pending): bigip1 and bigip2 have different changes")
+}
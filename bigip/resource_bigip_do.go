@@ -32,6 +32,7 @@ func resourceBigipDo() *schema.Resource {
 		ReadContext:   resourceBigipDoRead,
 		UpdateContext: resourceBigipDoUpdate,
 		DeleteContext: resourceBigipDoDelete,
+		CustomizeDiff: doCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -46,6 +47,25 @@ func resourceBigipDo() *schema.Resource {
 					return jsonString
 				},
 			},
+			"diff_summary":       diffSummarySchema(),
+			"declaration_sha256": declarationSha256Schema(),
+			"last_applied":       lastAppliedSchema(),
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the DO task that deployed the declaration",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, plan runs a changed declaration through DO in dry-run mode and reports the changes DO would apply in dry_run_changes",
+			},
+			"dry_run_changes": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Changes DO reported for the last planned declaration when dry_run is set, one per line",
+			},
 			"timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -125,8 +145,9 @@ func resourceBigipDoCreate(ctx context.Context, d *schema.ResourceData, meta int
 	log.Printf("[DEBUG]timeout_sec is :%d", timeoutSec)
 	log.Printf("[INFO] Creating do config in bigip:%s", doJson)
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-	client := &http.Client{Transport: tr}
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true, Certificates: clientBigip.Transport.TLSClientConfig.Certificates,
+			VerifyPeerCertificate: clientBigip.Transport.TLSClientConfig.VerifyPeerCertificate}}
+	client := &http.Client{Transport: hookTransport(clientBigip, tr)}
 	url := clientBigip.Host + "/mgmt/shared/declarative-onboarding/"
 	req, err := http.NewRequest("POST", url, strings.NewReader(doJson))
 	if err != nil {
@@ -279,6 +300,7 @@ func resourceBigipDoCreate(ctx context.Context, d *schema.ResourceData, meta int
 		d.SetId("")
 		return diag.FromErr(fmt.Errorf("timeout while polling the DO task id with result:%v", resultMap))
 	}
+	setDeclarationAudit(d, "do_json", respID)
 
 	return resourceBigipDoRead(ctx, d, meta)
 }
@@ -296,8 +318,9 @@ func resourceBigipDoRead(ctx context.Context, d *schema.ResourceData, meta inter
 	log.Printf("[INFO] Reading Do config")
 	ID := d.Id()
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-	client := &http.Client{Transport: tr}
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true, Certificates: clientBigip.Transport.TLSClientConfig.Certificates,
+			VerifyPeerCertificate: clientBigip.Transport.TLSClientConfig.VerifyPeerCertificate}}
+	client := &http.Client{Transport: hookTransport(clientBigip, tr)}
 	url := clientBigip.Host + "/mgmt/shared/declarative-onboarding/task/" + ID
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -360,8 +383,9 @@ func resourceBigipDoUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	log.Printf("[DEBUG]timeout_sec is :%d", timeoutSec)
 	log.Printf("[INFO] Updating do config in bigip:%s", doJson)
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-	client := &http.Client{Transport: tr}
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true, Certificates: clientBigip.Transport.TLSClientConfig.Certificates,
+			VerifyPeerCertificate: clientBigip.Transport.TLSClientConfig.VerifyPeerCertificate}}
+	client := &http.Client{Transport: hookTransport(clientBigip, tr)}
 	url := clientBigip.Host + "/mgmt/shared/declarative-onboarding/"
 	req, err := http.NewRequest("POST", url, strings.NewReader(doJson))
 	if err != nil {
@@ -513,6 +537,7 @@ func resourceBigipDoUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		d.SetId("")
 		return diag.FromErr(fmt.Errorf("timeout while polling the DO task id with result:%v", resultMap))
 	}
+	setDeclarationAudit(d, "do_json", respID)
 
 	return resourceBigipDoRead(ctx, d, meta)
 }
@@ -524,7 +549,14 @@ func resourceBigipDoDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return nil
 }
 
-func connectBigIP(d *schema.ResourceData) (*bigip.BigIP, error) {
+// doConnectionAttrs is implemented by schema.ResourceData and schema.ResourceDiff, so that the DO
+// dry-run at plan time connects to the same BIG-IP as apply.
+type doConnectionAttrs interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+func connectBigIP(d doConnectionAttrs) (*bigip.BigIP, error) {
 	var portVal string
 	if _, ok := d.GetOk("bigip_port"); ok {
 		portVal = d.Get("bigip_port").(string)
@@ -133,8 +133,9 @@ func resourceServiceDiscoveryDelete(ctx context.Context, d *schema.ResourceData,
 	payload := strings.NewReader("[ ]\n")
 	log.Printf("[DEBUG] url Complete :%v", url)
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-	client := &http.Client{Transport: tr}
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true, Certificates: clientBigip.Transport.TLSClientConfig.Certificates,
+			VerifyPeerCertificate: clientBigip.Transport.TLSClientConfig.VerifyPeerCertificate}}
+	client := &http.Client{Transport: hookTransport(clientBigip, tr)}
 	req, err := http.NewRequest("POST", url, payload)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error while creating http request for Delete operation:%+v ", err))
@@ -6,6 +6,7 @@ If a copy of the MPL was not distributed with this file, You can obtain one at h
 package bigip
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -13,10 +14,12 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"time"
 
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/f5devcentral/go-bigip/f5teem"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -87,6 +90,7 @@ func resourceBigipFastApp() *schema.Resource {
 				Computed:    true,
 				Description: "Name of FAST application.",
 			},
+			"delete_tenant_if_empty": fastDeleteTenantSchema(),
 		},
 	}
 }
@@ -198,10 +202,149 @@ func resourceBigipFastAppDelete(ctx context.Context, d *schema.ResourceData, met
 	defer m.Unlock()
 	name := d.Id()
 	tenant := d.Get("tenant").(string)
-	err := client.DeleteFastAppBigip(tenant, name)
+	err := deleteFastApp(ctx, client, "bigip_fast_application", tenant, name, d.Get("delete_tenant_if_empty").(bool))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
+
+// fastTaskPollInterval is the delay between two reads of a FAST task.
+var fastTaskPollInterval = 3 * time.Second
+
+// fastDeleteTenantSchema is the delete_tenant_if_empty attribute shared by the FAST application resources.
+func fastDeleteTenantSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether deleting the last application of the tenant deletes the tenant as well",
+	}
+}
+
+// deleteFastApp deletes the FAST application name of tenant and waits for its task to complete, so
+// the application is gone from the device when the resource is. With deleteTenant the tenant is
+// deleted afterwards when no application is left in it.
+func deleteFastApp(ctx context.Context, client *bigip.BigIP, resourceType, tenant, name string, deleteTenant bool) error {
+	appPath := fmt.Sprintf("mgmt/shared/fast/applications/%s/%s", tenant, name)
+	apiLog := newAPICallLogger(ctx, resourceType, tenant+"/"+name, "delete", "/"+appPath)
+	resp, err := restCall(client, "delete", appPath, nil)
+	apiLog.done(err)
+	if err != nil && !isRestNotFound(resp, err) {
+		return fmt.Errorf("error deleting FAST application %s of tenant %s: %v", name, tenant, err)
+	}
+	if err == nil {
+		taskID, err := fastTaskID(resp)
+		if err != nil {
+			return fmt.Errorf("error deleting FAST application %s of tenant %s: %v", name, tenant, err)
+		}
+		if err := waitFastTask(apiLog.ctx, client, taskID); err != nil {
+			return fmt.Errorf("error deleting FAST application %s of tenant %s: %v", name, tenant, err)
+		}
+	}
+	if !deleteTenant {
+		return nil
+	}
+	return deleteFastTenantIfEmpty(apiLog.ctx, client, tenant)
+}
+
+// fastTaskID returns the id of the task FAST started for a request. Depending on the FAST version
+// the id is at the top of the response or in its message list.
+func fastTaskID(resp []byte) (string, error) {
+	var task struct {
+		ID      string          `json:"id"`
+		Message json.RawMessage `json:"message"`
+	}
+	if err := json.Unmarshal(resp, &task); err != nil {
+		return "", fmt.Errorf("unexpected FAST response %s: %v", resp, err)
+	}
+	if task.ID != "" {
+		return task.ID, nil
+	}
+	var messages []struct {
+		ID string `json:"id"`
+	}
+	if json.Unmarshal(task.Message, &messages) == nil && len(messages) > 0 && messages[0].ID != "" {
+		return messages[0].ID, nil
+	}
+	return "", fmt.Errorf("no task id in FAST response %s", resp)
+}
+
+// waitFastTask polls the FAST task id until it completes and returns its message if it failed.
+func waitFastTask(ctx context.Context, client *bigip.BigIP, id string) error {
+	for {
+		task := &bigip.FastTask{}
+		found, err := getRestEntity(client, task, "mgmt/shared/fast/tasks/"+id)
+		if err != nil {
+			return fmt.Errorf("error reading FAST task %s: %v", id, err)
+		}
+		if !found {
+			return fmt.Errorf("FAST task %s not found", id)
+		}
+		if task.Code >= 400 {
+			return fmt.Errorf("FAST task %s failed: %s", id, task.Message)
+		}
+		if task.Code == 200 && task.Message != "in progress" && task.Message != "pending" {
+			return nil
+		}
+		tflog.Debug(ctx, "waiting for FAST task to complete", map[string]interface{}{"task": id, "message": task.Message})
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("FAST task %s not complete: %v", id, ctx.Err())
+		case <-time.After(fastTaskPollInterval):
+		}
+	}
+}
+
+// deleteFastTenantIfEmpty deletes tenant when FAST lists no application in it. FAST has no tenant
+// endpoint, so the tenant is removed through AS3, and only when its declaration holds no
+// application either: the applications declared with AS3 directly are not listed by FAST.
+func deleteFastTenantIfEmpty(ctx context.Context, client *bigip.BigIP, tenant string) error {
+	var apps []struct {
+		Name   string `json:"name"`
+		Tenant string `json:"tenant"`
+	}
+	if _, err := getRestEntity(client, &apps, "mgmt/shared/fast/applications"); err != nil {
+		return fmt.Errorf("error listing FAST applications: %v", err)
+	}
+	for _, app := range apps {
+		if app.Tenant == tenant {
+			tflog.Debug(ctx, fmt.Sprintf("tenant %s still holds FAST application %s, keeping it", tenant, app.Name))
+			return nil
+		}
+	}
+
+	declarePath := "mgmt/shared/appsvcs/declare/" + tenant
+	resp, err := restCall(client, "get", declarePath, nil)
+	if err != nil {
+		if isRestNotFound(resp, err) {
+			return nil
+		}
+		return fmt.Errorf("error reading tenant %s: %v", tenant, err)
+	}
+	if len(bytes.TrimSpace(resp)) == 0 {
+		return nil
+	}
+	var declaration map[string]interface{}
+	if err := json.Unmarshal(resp, &declaration); err != nil {
+		return fmt.Errorf("error reading tenant %s: %v", tenant, err)
+	}
+	if tenantDecl, ok := declaration[tenant].(map[string]interface{}); ok {
+		declaration = tenantDecl
+	}
+	for appName, value := range declaration {
+		if app, ok := value.(map[string]interface{}); ok && app["class"] == "Application" {
+			tflog.Warn(ctx, fmt.Sprintf("tenant %s still holds application %s declared outside FAST, keeping it", tenant, appName))
+			return nil
+		}
+	}
+
+	apiLog := newAPICallLogger(ctx, "bigip_fast_application", tenant, "delete", "/"+declarePath)
+	resp, err = restCall(client, "delete", declarePath, nil)
+	apiLog.done(err)
+	if err != nil && !isRestNotFound(resp, err) {
+		return fmt.Errorf("error deleting empty tenant %s: %v", tenant, err)
+	}
+	return nil
+}
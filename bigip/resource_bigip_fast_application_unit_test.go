@@ -0,0 +1,106 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFastTaskID(t *testing.T) {
+	id, err := fastTaskID([]byte(`{"id":"task1","code":202}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "task1", id)
+
+	id, err = fastTaskID([]byte(`{"code":202,"message":[{"id":"task2","name":"app1"}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "task2", id)
+
+	_, err = fastTaskID([]byte(`{"code":202,"message":""}`))
+	assert.ErrorContains(t, err, "no task id")
+}
+
+func TestDeleteFastApp(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(interval time.Duration) { fastTaskPollInterval = interval }(fastTaskPollInterval)
+	fastTaskPollInterval = time.Millisecond
+
+	var taskReads int
+	var tenantDeleted bool
+	fastApps := `[]`
+	declaration := `{"class":"Tenant"}`
+	mux.HandleFunc("/mgmt/shared/fast/applications/tenant1/app1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		_, _ = fmt.Fprintf(w, `{"id":"task1","code":202,"message":""}`)
+	})
+	mux.HandleFunc("/mgmt/shared/fast/tasks/task1", func(w http.ResponseWriter, r *http.Request) {
+		taskReads++
+		if taskReads < 3 {
+			_, _ = fmt.Fprintf(w, `{"id":"task1","code":0,"message":"in progress"}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"id":"task1","code":200,"message":"success"}`)
+	})
+	mux.HandleFunc("/mgmt/shared/fast/applications", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, 3, taskReads, "the tenant is checked once the application task completed")
+		_, _ = fmt.Fprint(w, fastApps)
+	})
+	mux.HandleFunc("/mgmt/shared/appsvcs/declare/tenant1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			_, _ = fmt.Fprint(w, declaration)
+			return
+		}
+		assert.Equal(t, "DELETE", r.Method)
+		tenantDeleted = true
+		_, _ = fmt.Fprintf(w, `{"results":[{"code":200,"message":"success","tenant":"tenant1"}]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+	deleteApp := func(deleteTenant bool) error {
+		taskReads = 0
+		tenantDeleted = false
+		return deleteFastApp(context.Background(), client, "bigip_fast_http_app", "tenant1", "app1", deleteTenant)
+	}
+
+	assert.NoError(t, deleteApp(false))
+	assert.Equal(t, 3, taskReads)
+	assert.False(t, tenantDeleted)
+
+	assert.NoError(t, deleteApp(true))
+	assert.True(t, tenantDeleted)
+
+	fastApps = `[{"name":"app2","tenant":"tenant1"},{"name":"app3","tenant":"tenant2"}]`
+	assert.NoError(t, deleteApp(true))
+	assert.False(t, tenantDeleted, "the tenant holds another FAST application")
+
+	fastApps = `[{"name":"app3","tenant":"tenant2"}]`
+	declaration = `{"class":"Tenant","app4":{"class":"Application","template":"generic"}}`
+	assert.NoError(t, deleteApp(true))
+	assert.False(t, tenantDeleted, "the tenant holds an application declared with AS3")
+}
+
+func TestDeleteFastAppTaskFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/shared/fast/applications/tenant1/app1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"code":202,"message":[{"id":"task1","name":"app1"}]}`)
+	})
+	mux.HandleFunc("/mgmt/shared/fast/tasks/task1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"id":"task1","code":422,"message":"declaration failed"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+	err := deleteFastApp(context.Background(), client, "bigip_fast_http_app", "tenant1", "app1", true)
+	assert.ErrorContains(t, err, "FAST task task1 failed: declaration failed")
+}
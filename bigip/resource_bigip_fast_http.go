@@ -34,6 +34,7 @@ func resourceBigipHttpFastApp() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			"delete_tenant_if_empty": fastDeleteTenantSchema(),
 			"tenant": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -258,6 +259,18 @@ func resourceBigipHttpFastApp() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Existing security log profiles to enable.",
 			},
+			"irules": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Existing iRules to attach to the virtual server.",
+			},
+			"enable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable or disable the virtual server created by the application.",
+			},
 			"fast_http_json": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -269,7 +282,11 @@ func resourceBigipHttpFastApp() *schema.Resource {
 
 func resourceBigipFastHttpAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
-	fastJson, err := getFastHttpConfig(d)
+	accepted, err := fastTemplateParameters(client, fastTmpl)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fastJson, err := getFastHttpConfig(d, accepted)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -321,7 +338,7 @@ func resourceBigipFastHttpAppCreate(ctx context.Context, d *schema.ResourceData,
 
 func resourceBigipFastHttpAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
-	var fastHttp bigip.FastHttpJson
+	var fastHttp fastHttpAppParams
 	log.Printf("[INFO] Reading FastApp HTTP config")
 	name := d.Id()
 	tenant := d.Get("tenant").(string)
@@ -347,7 +364,11 @@ func resourceBigipFastHttpAppRead(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = setFastHttpData(d, fastHttp)
+	err = setFastHttpData(d, fastHttp.FastHttpJson)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = setFastAppParams(d, fastHttp)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -356,7 +377,11 @@ func resourceBigipFastHttpAppRead(ctx context.Context, d *schema.ResourceData, m
 
 func resourceBigipFastHttpAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
-	fastJson, e := getFastHttpConfig(d)
+	accepted, e := fastTemplateParameters(client, fastTmpl)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+	fastJson, e := getFastHttpConfig(d, accepted)
 	if e != nil {
 		return diag.FromErr(e)
 	}
@@ -378,7 +403,7 @@ func resourceBigipFastHttpAppDelete(ctx context.Context, d *schema.ResourceData,
 	defer m.Unlock()
 	name := d.Id()
 	tenant := d.Get("tenant").(string)
-	err := client.DeleteFastAppBigip(tenant, name)
+	err := deleteFastApp(ctx, client, "bigip_fast_http_app", tenant, name, d.Get("delete_tenant_if_empty").(bool))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -397,9 +422,14 @@ func setFastHttpData(d *schema.ResourceData, data bigip.FastHttpJson) error {
 	_ = d.Set("existing_snat_pool", data.SnatPoolName)
 	_ = d.Set("snat_pool_address", data.SnatAddresses)
 	_ = d.Set("security_log_profiles", data.LogProfileNames)
-	_ = d.Set("existing_pool", data.PoolName)
-	members := flattenFastPoolMembers(data.PoolMembers)
-	_ = d.Set("pool_members", members)
+	if data.MakePool {
+		_ = d.Set("existing_pool", "")
+		members := flattenFastPoolMembers(data.PoolMembers)
+		_ = d.Set("pool_members", members)
+	} else {
+		_ = d.Set("existing_pool", data.PoolName)
+		_ = d.Set("pool_members", nil)
+	}
 	_ = d.Set("load_balancing_mode", data.LoadBalancingMode)
 	if _, ok := d.GetOk("slow_ramp_time"); ok {
 		_ = d.Set("slow_ramp_time", data.SlowRampTime)
@@ -417,10 +447,12 @@ func setFastHttpData(d *schema.ResourceData, data bigip.FastHttpJson) error {
 	if _, ok := d.GetOk("persistence_profile"); ok {
 		_ = d.Set("persistence_profile", data.PersistenceProfile)
 	}
-	if _, ok := d.GetOk("monitor"); ok {
-		if err := d.Set("monitor", []interface{}{flattenFastMonitor(data)}); err != nil {
+	if data.MakeMonitor {
+		if err := d.Set("monitor", []interface{}{flattenFastMonitor(d, data)}); err != nil {
 			return fmt.Errorf("error setting monitor: %w", err)
 		}
+	} else {
+		_ = d.Set("monitor", nil)
 	}
 	// if _, ok := d.GetOk("service_discovery"); ok {
 	//	//_ = d.Set("service_discovery", data.ServiceDiscovery)
@@ -436,13 +468,17 @@ func flattenFastVirtualServers(data bigip.FastHttpJson) map[string]interface{} {
 	return tfMap
 }
 
-func flattenFastMonitor(data bigip.FastHttpJson) map[string]interface{} {
+func flattenFastMonitor(d *schema.ResourceData, data bigip.FastHttpJson) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 	if data.MonitorAuth {
 		tfMap["monitor_auth"] = data.MonitorAuth
 	}
 	tfMap["username"] = data.MonitorUsername
+	// FAST does not always hand the passphrase back, keep the configured one in that case
 	tfMap["password"] = data.MonitorPassword
+	if data.MonitorPassword == "" || isObfuscatedSecret(data.MonitorPassword) {
+		tfMap["password"] = d.Get("monitor.0.password").(string)
+	}
 	if data.MonitorInterval > 0 {
 		tfMap["interval"] = data.MonitorInterval
 	}
@@ -514,11 +550,14 @@ func flattenFastPoolMembers(members []bigip.FastHttpPool) []interface{} {
 	return att
 }
 
-func getFastHttpConfig(d *schema.ResourceData) (string, error) {
-	httpJson := &bigip.FastHttpJson{
-		Tenant:      d.Get("tenant").(string),
-		Application: d.Get("application").(string),
+func getFastHttpConfig(d *schema.ResourceData, accepted map[string]bool) (string, error) {
+	params := &fastHttpAppParams{
+		FastHttpJson: bigip.FastHttpJson{
+			Tenant:      d.Get("tenant").(string),
+			Application: d.Get("application").(string),
+		},
 	}
+	httpJson := &params.FastHttpJson
 	httpJson.TlsServerEnable = false
 	httpJson.TlsServerProfileCreate = false
 	if v, ok := d.GetOk("virtual_server"); ok {
@@ -688,9 +727,76 @@ func getFastHttpConfig(d *schema.ResourceData) (string, error) {
 		}
 		httpJson.LogProfileNames = logProfiles
 	}
-	data, err := json.Marshal(httpJson)
+	if err := getFastAppParams(d, params, accepted); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(params)
 	if err != nil {
 		return "", err
 	}
 	return string(data), nil
 }
+
+// fastHttpAppParams adds the FAST HTTP/HTTPS template parameters that
+// bigip.FastHttpJson does not model.
+type fastHttpAppParams struct {
+	bigip.FastHttpJson
+	IruleNames    []string `json:"irule_names,omitempty"`
+	EnableVirtual *bool    `json:"enable_virtual,omitempty"`
+}
+
+type fastTemplate struct {
+	ParametersSchema struct {
+		Properties map[string]interface{} `json:"properties"`
+	} `json:"_parametersSchema"`
+}
+
+// fastTemplateParameters returns the names of the parameters the installed FAST template accepts.
+func fastTemplateParameters(client *bigip.BigIP, template string) (map[string]bool, error) {
+	tmpl := &fastTemplate{}
+	found, err := getRestEntity(client, tmpl, "mgmt/shared/fast/templates/"+template)
+	if err != nil {
+		return nil, fmt.Errorf("error reading FAST template %s: %v", template, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("FAST template %s is not installed", template)
+	}
+	accepted := make(map[string]bool, len(tmpl.ParametersSchema.Properties))
+	for name := range tmpl.ParametersSchema.Properties {
+		accepted[name] = true
+	}
+	return accepted, nil
+}
+
+func getFastAppParams(d *schema.ResourceData, params *fastHttpAppParams, accepted map[string]bool) error {
+	if s, ok := d.GetOk("irules"); ok {
+		if !accepted["irule_names"] {
+			return fmt.Errorf("the installed FAST template %s does not accept the irule_names parameter, 'irules' cannot be used", fastTmpl)
+		}
+		var irules []string
+		for _, irule := range s.([]interface{}) {
+			irules = append(irules, irule.(string))
+		}
+		params.IruleNames = irules
+	}
+	enable := d.Get("enable").(bool)
+	if accepted["enable_virtual"] {
+		params.EnableVirtual = &enable
+	} else if !enable {
+		return fmt.Errorf("the installed FAST template %s does not accept the enable_virtual parameter, 'enable' cannot be set to false", fastTmpl)
+	}
+	return nil
+}
+
+func setFastAppParams(d *schema.ResourceData, params fastHttpAppParams) error {
+	if err := d.Set("irules", params.IruleNames); err != nil {
+		return fmt.Errorf("error setting irules: %w", err)
+	}
+	// templates without the parameter always deploy an enabled virtual server
+	enable := true
+	if params.EnableVirtual != nil {
+		enable = *params.EnableVirtual
+	}
+	_ = d.Set("enable", enable)
+	return nil
+}
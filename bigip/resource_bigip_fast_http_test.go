@@ -91,6 +91,40 @@ func TestAccFastHTTPAppCreateTC03(t *testing.T) {
 	})
 }
 
+func TestAccFastHTTPAppCreateTC04(t *testing.T) {
+	var httpApp4Name = "fast_http_apptc4"
+	var httpTenant4Name = "fast_http_tenanttc4"
+	resName := "bigip_fast_http_app.fast_http_app_tc4"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckFastHTTPAppDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getFastHTTPAppConfigTC04(httpTenant4Name, httpApp4Name, `["/Common/_sys_https_redirect"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFastAppExists(httpApp4Name, httpTenant4Name, true),
+					resource.TestCheckResourceAttr(resName, "irules.#", "1"),
+					resource.TestCheckResourceAttr(resName, "irules.0", "/Common/_sys_https_redirect"),
+					resource.TestCheckResourceAttr(resName, "enable", "true"),
+					resource.TestCheckResourceAttr(resName, "monitor.0.interval", "10"),
+					resource.TestCheckResourceAttr(resName, "monitor.0.send_string", "GET /health HTTP/1.1\\r\\nHost: example.com\\r\\n\\r\\n"),
+					resource.TestCheckResourceAttr(resName, "monitor.0.response", "200 OK"),
+				),
+			},
+			{
+				Config: getFastHTTPAppConfigTC04(httpTenant4Name, httpApp4Name, "[]"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFastAppExists(httpApp4Name, httpTenant4Name, true),
+					resource.TestCheckResourceAttr(resName, "irules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func getFastHTTPAppConfig() string {
 	return fmt.Sprintf(`
 resource "bigip_fast_http_app" "fast_http_app" {
@@ -144,6 +178,29 @@ resource "bigip_fast_http_app" "fast_http_app_tc3" {
 `, httpTenantName, httpAppName)
 }
 
+func getFastHTTPAppConfigTC04(httpTenantName, httpAppName, irules string) string {
+	return fmt.Sprintf(`
+resource "bigip_fast_http_app" "fast_http_app_tc4" {
+  tenant      = "%v"
+  application = "%v"
+  virtual_server {
+    ip   = "10.200.21.4"
+    port = 80
+  }
+  pool_members {
+    addresses = ["10.1.20.120", "10.1.10.121"]
+    port      = 80
+  }
+  monitor {
+    interval    = 10
+    send_string = "GET /health HTTP/1.1\\r\\nHost: example.com\\r\\n\\r\\n"
+    response    = "200 OK"
+  }
+  irules = %s
+}
+`, httpTenantName, httpAppName, irules)
+}
+
 func testCheckFastHTTPAppDestroyed(s *terraform.State) error {
 	client := testAccProvider.Meta().(*bigip.BigIP)
 	for _, rs := range s.RootModule().Resources {
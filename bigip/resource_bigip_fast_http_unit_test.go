@@ -0,0 +1,80 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFastTemplateParameters(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/shared/fast/templates/bigip-fast-templates/http", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"title":"HTTP Application Template","_parametersSchema":{"properties":{"tenant_name":{},"app_name":{},"irule_names":{}}}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	accepted, err := fastTemplateParameters(client, fastTmpl)
+	assert.NoError(t, err)
+	assert.True(t, accepted["irule_names"])
+	assert.False(t, accepted["enable_virtual"])
+}
+
+func TestGetFastHttpConfigParams(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipHttpFastApp().Schema, map[string]interface{}{
+		"tenant":      "tenant1",
+		"application": "app1",
+		"irules":      []interface{}{"/Common/irule1"},
+	})
+
+	_, err := getFastHttpConfig(d, map[string]bool{})
+	assert.ErrorContains(t, err, "does not accept the irule_names parameter")
+
+	payload, err := getFastHttpConfig(d, map[string]bool{"irule_names": true})
+	assert.NoError(t, err)
+	var params map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(payload), &params))
+	assert.Equal(t, []interface{}{"/Common/irule1"}, params["irule_names"])
+	assert.NotContains(t, params, "enable_virtual")
+
+	_ = d.Set("enable", false)
+	_, err = getFastHttpConfig(d, map[string]bool{"irule_names": true})
+	assert.ErrorContains(t, err, "'enable' cannot be set to false")
+
+	payload, err = getFastHttpConfig(d, map[string]bool{"irule_names": true, "enable_virtual": true})
+	assert.NoError(t, err)
+	params = map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal([]byte(payload), &params))
+	assert.Equal(t, false, params["enable_virtual"])
+}
+
+func TestSetFastAppParams(t *testing.T) {
+	d := resourceBigipFastHTTPSApp().TestResourceData()
+	var params fastHttpAppParams
+	assert.NoError(t, json.Unmarshal([]byte(`{"tenant_name":"t","app_name":"a","irule_names":["/Common/irule1"]}`), &params))
+	assert.NoError(t, setFastAppParams(d, params))
+	assert.Equal(t, []interface{}{"/Common/irule1"}, d.Get("irules"))
+	assert.Equal(t, true, d.Get("enable"))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"enable_virtual":false}`), &params))
+	assert.NoError(t, setFastAppParams(d, params))
+	assert.Equal(t, false, d.Get("enable"))
+}
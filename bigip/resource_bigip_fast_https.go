@@ -32,6 +32,7 @@ func resourceBigipFastHTTPSApp() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			"delete_tenant_if_empty": fastDeleteTenantSchema(),
 			"tenant": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -307,6 +308,18 @@ func resourceBigipFastHTTPSApp() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"irules": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Existing iRules to attach to the virtual server.",
+			},
+			"enable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable or disable the virtual server created by the application.",
+			},
 			"fast_https_json": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -318,7 +331,11 @@ func resourceBigipFastHTTPSApp() *schema.Resource {
 
 func resourceBigipFastHTTPSAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
-	fastJson, err := getFastHTTPSConfig(d)
+	accepted, err := fastTemplateParameters(client, fastTmpl)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fastJson, err := getFastHTTPSConfig(d, accepted)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -370,7 +387,7 @@ func resourceBigipFastHTTPSAppCreate(ctx context.Context, d *schema.ResourceData
 
 func resourceBigipFastHTTPSAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
-	var fastHttp bigip.FastHttpJson
+	var fastHttp fastHttpAppParams
 	name := d.Id()
 	tenant := d.Get("tenant").(string)
 	log.Printf("[INFO][READ] FAST HTTPS application get call : %s", name)
@@ -395,7 +412,11 @@ func resourceBigipFastHTTPSAppRead(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = setFastHTTPSData(d, fastHttp)
+	err = setFastHTTPSData(d, fastHttp.FastHttpJson)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = setFastAppParams(d, fastHttp)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -404,7 +425,11 @@ func resourceBigipFastHTTPSAppRead(ctx context.Context, d *schema.ResourceData,
 
 func resourceBigipFastHTTPSAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
-	fastJson, e := getFastHTTPSConfig(d)
+	accepted, e := fastTemplateParameters(client, fastTmpl)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+	fastJson, e := getFastHTTPSConfig(d, accepted)
 	if e != nil {
 		return diag.FromErr(e)
 	}
@@ -426,7 +451,7 @@ func resourceBigipFastHTTPSAppDelete(ctx context.Context, d *schema.ResourceData
 	defer m.Unlock()
 	name := d.Id()
 	tenant := d.Get("tenant").(string)
-	err := client.DeleteFastAppBigip(tenant, name)
+	err := deleteFastApp(ctx, client, "bigip_fast_https_app", tenant, name, d.Get("delete_tenant_if_empty").(bool))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -441,9 +466,14 @@ func setFastHTTPSData(d *schema.ResourceData, data bigip.FastHttpJson) error {
 	_ = d.Set("existing_snat_pool", data.SnatPoolName)
 	_ = d.Set("snat_pool_address", data.SnatAddresses)
 	_ = d.Set("security_log_profiles", data.LogProfileNames)
-	_ = d.Set("existing_pool", data.PoolName)
-	members := flattenFastPoolMembers(data.PoolMembers)
-	_ = d.Set("pool_members", members)
+	if data.MakePool {
+		_ = d.Set("existing_pool", "")
+		members := flattenFastPoolMembers(data.PoolMembers)
+		_ = d.Set("pool_members", members)
+	} else {
+		_ = d.Set("existing_pool", data.PoolName)
+		_ = d.Set("pool_members", nil)
+	}
 	// if _, ok := d.GetOk("service_discovery"); ok {
 	//	_ = d.Set("service_discovery", flattenFastServiceDiscovery(data.ServiceDiscovery))
 	// }
@@ -472,10 +502,12 @@ func setFastHTTPSData(d *schema.ResourceData, data bigip.FastHttpJson) error {
 		_ = d.Set("slow_ramp_time", data.SlowRampTime)
 	}
 	_ = d.Set("existing_monitor", data.HTTPMonitor)
-	if _, ok := d.GetOk("monitor"); ok {
-		if err := d.Set("monitor", []interface{}{flattenFastMonitor(data)}); err != nil {
+	if data.MakeMonitor {
+		if err := d.Set("monitor", []interface{}{flattenFastMonitor(d, data)}); err != nil {
 			return fmt.Errorf("error setting monitor: %w", err)
 		}
+	} else {
+		_ = d.Set("monitor", nil)
 	}
 	return nil
 }
@@ -487,11 +519,14 @@ func flattenFastTlsServerProfile(data bigip.FastHttpJson) map[string]interface{}
 	return tfMap
 }
 
-func getFastHTTPSConfig(d *schema.ResourceData) (string, error) {
-	httpJson := &bigip.FastHttpJson{
-		Tenant:      d.Get("tenant").(string),
-		Application: d.Get("application").(string),
+func getFastHTTPSConfig(d *schema.ResourceData, accepted map[string]bool) (string, error) {
+	params := &fastHttpAppParams{
+		FastHttpJson: bigip.FastHttpJson{
+			Tenant:      d.Get("tenant").(string),
+			Application: d.Get("application").(string),
+		},
 	}
+	httpJson := &params.FastHttpJson
 	httpJson.TlsServerEnable = true
 	httpJson.TlsClientEnable = false
 	httpJson.WafPolicyEnable = false
@@ -687,7 +722,10 @@ func getFastHTTPSConfig(d *schema.ResourceData) (string, error) {
 		}
 		httpJson.LogProfileNames = logProfiles
 	}
-	data, err := json.Marshal(httpJson)
+	if err := getFastAppParams(d, params, accepted); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(params)
 	if err != nil {
 		return "", err
 	}
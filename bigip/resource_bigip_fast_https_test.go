@@ -187,6 +187,71 @@ func TestAccFastHTTPSAppProfileTC6(t *testing.T) {
 	})
 }
 
+func TestAccFastHTTPSAppMonitorIrulesTC7(t *testing.T) {
+	var httpsApp7Name = "fast_https_apptc7"
+	var httpsTenant7Name = "fast_https_tenanttc7"
+	resName := "bigip_fast_https_app.fast_https_app_tc7"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckFastHTTPSAppDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getFastHTTPSAppConfigTC7(httpsTenant7Name, httpsApp7Name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFastAppExists(httpsApp7Name, httpsTenant7Name, true),
+					resource.TestCheckResourceAttr(resName, "irules.#", "1"),
+					resource.TestCheckResourceAttr(resName, "irules.0", "/Common/_sys_https_redirect"),
+					resource.TestCheckResourceAttr(resName, "enable", "true"),
+					resource.TestCheckResourceAttr(resName, "monitor.0.interval", "10"),
+					resource.TestCheckResourceAttr(resName, "monitor.0.send_string", "GET /health HTTP/1.1\\r\\nHost: example.com\\r\\n\\r\\n"),
+					resource.TestCheckResourceAttr(resName, "monitor.0.response", "200 OK"),
+				),
+			},
+			{
+				Config: getFastHTTPSAppConfigTC7(httpsTenant7Name, httpsApp7Name, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFastAppExists(httpsApp7Name, httpsTenant7Name, true),
+					resource.TestCheckResourceAttr(resName, "monitor.0.interval", "20"),
+				),
+			},
+			{
+				Config:   getFastHTTPSAppConfigTC7(httpsTenant7Name, httpsApp7Name, 20),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccFastHTTPSAppExistingPoolTC8(t *testing.T) {
+	var httpsApp8Name = "fast_https_apptc8"
+	var httpsTenant8Name = "fast_https_tenanttc8"
+	resName := "bigip_fast_https_app.fast_https_app_tc8"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckFastHTTPSAppDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getFastHTTPSAppConfigTC8(httpsTenant8Name, httpsApp8Name),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFastAppExists(httpsApp8Name, httpsTenant8Name, true),
+					resource.TestCheckResourceAttr(resName, "existing_pool", "/Common/fast-https-existing-pool"),
+					resource.TestCheckResourceAttr(resName, "pool_members.#", "0"),
+				),
+			},
+			{
+				Config:   getFastHTTPSAppConfigTC8(httpsTenant8Name, httpsApp8Name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func getFastHTTPSAppConfig() string {
 	return fmt.Sprintf(`
 resource "bigip_fast_https_app" "fast_https_app" {
@@ -329,6 +394,47 @@ resource "bigip_fast_https_app" "fast_https_app" {
 `, httpsTenantName, httpsAppName)
 }
 
+func getFastHTTPSAppConfigTC7(httpsTenantName, httpsAppName string, interval int) string {
+	return fmt.Sprintf(`
+resource "bigip_fast_https_app" "fast_https_app_tc7" {
+  tenant      = "%v"
+  application = "%v"
+  virtual_server {
+    ip   = "10.30.41.47"
+    port = 443
+  }
+  pool_members {
+    addresses = ["10.11.40.120", "10.11.30.121"]
+    port      = 80
+  }
+  monitor {
+    interval    = %d
+    send_string = "GET /health HTTP/1.1\\r\\nHost: example.com\\r\\n\\r\\n"
+    response    = "200 OK"
+  }
+  irules = ["/Common/_sys_https_redirect"]
+}
+`, httpsTenantName, httpsAppName, interval)
+}
+
+func getFastHTTPSAppConfigTC8(httpsTenantName, httpsAppName string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_pool" "fast_existing_pool" {
+  name                = "/Common/fast-https-existing-pool"
+  load_balancing_mode = "round-robin"
+}
+resource "bigip_fast_https_app" "fast_https_app_tc8" {
+  tenant      = "%v"
+  application = "%v"
+  virtual_server {
+    ip   = "10.30.41.48"
+    port = 443
+  }
+  existing_pool = bigip_ltm_pool.fast_existing_pool.name
+}
+`, httpsTenantName, httpsAppName)
+}
+
 func testCheckFastHTTPSAppDestroyed(s *terraform.State) error {
 	client := testAccProvider.Meta().(*bigip.BigIP)
 	for _, rs := range s.RootModule().Resources {
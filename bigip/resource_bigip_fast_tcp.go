@@ -32,6 +32,7 @@ func resourceBigipFastTcpApp() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			"delete_tenant_if_empty": fastDeleteTenantSchema(),
 			"application": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -317,12 +318,12 @@ func resourceBigipFastTcpAppDelete(ctx context.Context, d *schema.ResourceData,
 	defer m.Unlock()
 	name := d.Id()
 	tenant := d.Get("tenant").(string)
-	err := client.DeleteFastAppBigip(tenant, name)
+	err := deleteFastApp(ctx, client, "bigip_fast_tcp_app", tenant, name, d.Get("delete_tenant_if_empty").(bool))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	// d.SetId("")
-	return resourceBigipFastTcpAppRead(ctx, d, meta)
+	d.SetId("")
+	return nil
 }
 
 func setFastTcpData(d *schema.ResourceData, data bigip.FastTCPJson) error {
@@ -31,6 +31,7 @@ func resourceBigipFastUdpApp() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			"delete_tenant_if_empty": fastDeleteTenantSchema(),
 			"application": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -348,12 +349,12 @@ func resourceBigipFastUdpAppDelete(ctx context.Context, d *schema.ResourceData,
 	defer m.Unlock()
 	name := d.Id()
 	tenant := d.Get("tenant").(string)
-	err := client.DeleteFastAppBigip(tenant, name)
+	err := deleteFastApp(ctx, client, "bigip_fast_udp_app", tenant, name, d.Get("delete_tenant_if_empty").(bool))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId("")
-	return resourceBigipFastUdpAppRead(ctx, d, meta)
+	return nil
 }
 
 func setFastUdpData(d *schema.ResourceData, data bigip.FastUDPJson) error {
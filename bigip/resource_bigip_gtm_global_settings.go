@@ -0,0 +1,258 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	uriGtmGlobalSettingsGeneral       = "gtm/global-settings/general"
+	uriGtmGlobalSettingsLoadBalancing = "gtm/global-settings/load-balancing"
+)
+
+// GtmGlobalSettingsGeneral mirrors the gtm global-settings general singleton.
+type GtmGlobalSettingsGeneral struct {
+	Synchronization          string `json:"synchronization,omitempty"`
+	SynchronizationGroupName string `json:"synchronizationGroupName,omitempty"`
+	SynchronizeZoneFiles     string `json:"synchronizeZoneFiles,omitempty"`
+	DrainPersistentRequests  string `json:"drainPersistentRequests,omitempty"`
+	HeartbeatInterval        int    `json:"heartbeatInterval,omitempty"`
+	MonitorDisabledObjects   string `json:"monitorDisabledObjects,omitempty"`
+}
+
+// GtmGlobalSettingsLoadBalancing mirrors the gtm global-settings load-balancing singleton.
+type GtmGlobalSettingsLoadBalancing struct {
+	TopologyLongestMatch      string `json:"topologyLongestMatch,omitempty"`
+	TopologyAllowZeroScores   string `json:"topologyAllowZeroScores,omitempty"`
+	VerifyVsAvailability      string `json:"verifyVsAvailability,omitempty"`
+	RespectFallbackDependency string `json:"respectFallbackDependency,omitempty"`
+	IgnorePathTtl             string `json:"ignorePathTtl,omitempty"`
+	FailureRcode              string `json:"failureRcode,omitempty"`
+	FailureRcodeResponse      string `json:"failureRcodeResponse,omitempty"`
+	FailureRcodeTtl           int    `json:"failureRcodeTtl"`
+}
+
+// attribute name -> REST property of each sub-object
+var (
+	gtmGlobalSettingsGeneralAttrs = map[string]string{
+		"synchronization":            "synchronization",
+		"synchronization_group_name": "synchronizationGroupName",
+		"synchronize_zone_files":     "synchronizeZoneFiles",
+		"drain_persistent_requests":  "drainPersistentRequests",
+		"heartbeat_interval":         "heartbeatInterval",
+		"monitor_disabled_objects":   "monitorDisabledObjects",
+	}
+	gtmGlobalSettingsLoadBalancingAttrs = map[string]string{
+		"topology_longest_match":      "topologyLongestMatch",
+		"topology_allow_zero_scores":  "topologyAllowZeroScores",
+		"verify_vs_availability":      "verifyVsAvailability",
+		"respect_fallback_dependency": "respectFallbackDependency",
+		"ignore_path_ttl":             "ignorePathTtl",
+		"failure_rcode":               "failureRcode",
+		"failure_rcode_response":      "failureRcodeResponse",
+		"failure_rcode_ttl":           "failureRcodeTtl",
+	}
+)
+
+func gtmYesNoSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+		Description:  description,
+	}
+}
+
+// this module does not have a DELETE API; destroy only removes the resource from state.
+func resourceBigipGtmGlobalSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipGtmGlobalSettingsCreate,
+		ReadContext:   resourceBigipGtmGlobalSettingsRead,
+		UpdateContext: resourceBigipGtmGlobalSettingsUpdate,
+		DeleteContext: resourceBigipGtmGlobalSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"general": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Settings of gtm global-settings general",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"synchronization": gtmYesNoSchema("Specifies whether the system synchronizes GTM configuration with the synchronization group"),
+						"synchronization_group_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Name of the GTM synchronization group",
+						},
+						"synchronize_zone_files":    gtmYesNoSchema("Specifies whether the system synchronizes zone files in the synchronization group"),
+						"drain_persistent_requests": gtmYesNoSchema("Specifies whether persistent connections are kept on disabled virtual servers until they expire"),
+						"heartbeat_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Seconds between the heartbeat messages of the GTM systems",
+						},
+						"monitor_disabled_objects": gtmYesNoSchema("Specifies whether disabled objects are still monitored"),
+					},
+				},
+			},
+			"load_balancing": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Settings of gtm global-settings load-balancing",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topology_longest_match":      gtmYesNoSchema("Specifies whether topology records are matched by the longest prefix rather than by order"),
+						"topology_allow_zero_scores":  gtmYesNoSchema("Specifies whether topology load balancing may select objects with a score of zero"),
+						"verify_vs_availability":      gtmYesNoSchema("Specifies whether the availability of virtual servers is verified before they are selected"),
+						"respect_fallback_dependency": gtmYesNoSchema("Specifies whether the fallback method respects the dependencies of virtual servers"),
+						"ignore_path_ttl":             gtmYesNoSchema("Specifies whether the TTL of path metrics is ignored"),
+						"failure_rcode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"noerror", "formerr", "servfail", "nxdomain", "notimpl", "refused"}, false),
+							Description:  "DNS return code sent when load balancing fails and failure_rcode_response is yes",
+						},
+						"failure_rcode_response": gtmYesNoSchema("Specifies whether failure_rcode is returned when load balancing fails"),
+						"failure_rcode_ttl": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "TTL of the SOA record returned with failure_rcode",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipGtmGlobalSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setGtmGlobalSettings(ctx, d, meta.(*bigip.BigIP), "create"); err != nil {
+		return diag.FromErr(fmt.Errorf("error configuring gtm global-settings: %s", err))
+	}
+	d.SetId("gtm-global-settings")
+	return resourceBigipGtmGlobalSettingsRead(ctx, d, meta)
+}
+
+func resourceBigipGtmGlobalSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	apiLog := newAPICallLogger(ctx, "bigip_gtm_global_settings", "general", "read", "/mgmt/tm/"+uriGtmGlobalSettingsGeneral)
+	general := &GtmGlobalSettingsGeneral{}
+	_, err := getRestEntity(client, general, uriGtmGlobalSettingsGeneral)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	apiLog = newAPICallLogger(ctx, "bigip_gtm_global_settings", "load-balancing", "read", "/mgmt/tm/"+uriGtmGlobalSettingsLoadBalancing)
+	lb := &GtmGlobalSettingsLoadBalancing{}
+	_, err = getRestEntity(client, lb, uriGtmGlobalSettingsLoadBalancing)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("general", []interface{}{map[string]interface{}{
+		"synchronization":            general.Synchronization,
+		"synchronization_group_name": general.SynchronizationGroupName,
+		"synchronize_zone_files":     general.SynchronizeZoneFiles,
+		"drain_persistent_requests":  general.DrainPersistentRequests,
+		"heartbeat_interval":         general.HeartbeatInterval,
+		"monitor_disabled_objects":   general.MonitorDisabledObjects,
+	}})
+	_ = d.Set("load_balancing", []interface{}{map[string]interface{}{
+		"topology_longest_match":      lb.TopologyLongestMatch,
+		"topology_allow_zero_scores":  lb.TopologyAllowZeroScores,
+		"verify_vs_availability":      lb.VerifyVsAvailability,
+		"respect_fallback_dependency": lb.RespectFallbackDependency,
+		"ignore_path_ttl":             lb.IgnorePathTtl,
+		"failure_rcode":               lb.FailureRcode,
+		"failure_rcode_response":      lb.FailureRcodeResponse,
+		"failure_rcode_ttl":           lb.FailureRcodeTtl,
+	}})
+	return nil
+}
+
+func resourceBigipGtmGlobalSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setGtmGlobalSettings(ctx, d, meta.(*bigip.BigIP), "update"); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating gtm global-settings: %s", err))
+	}
+	return resourceBigipGtmGlobalSettingsRead(ctx, d, meta)
+}
+
+func resourceBigipGtmGlobalSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// No API support for Delete, the settings are left as they are on the device
+	d.SetId("")
+	return nil
+}
+
+// setGtmGlobalSettings PATCHes each sub-object that has attributes in the configuration.
+func setGtmGlobalSettings(ctx context.Context, d *schema.ResourceData, client *bigip.BigIP, op string) error {
+	for _, sub := range []struct {
+		block, name, uri string
+		attrs            map[string]string
+	}{
+		{"general", "general", uriGtmGlobalSettingsGeneral, gtmGlobalSettingsGeneralAttrs},
+		{"load_balancing", "load-balancing", uriGtmGlobalSettingsLoadBalancing, gtmGlobalSettingsLoadBalancingAttrs},
+	} {
+		config := getGtmGlobalSettingsConfig(d, sub.block, sub.attrs)
+		if len(config) == 0 {
+			continue
+		}
+		apiLog := newAPICallLogger(ctx, "bigip_gtm_global_settings", sub.name, op, "/mgmt/tm/"+sub.uri)
+		apiLog.payload(config)
+		err := patchRestEntity(client, config, sub.uri)
+		apiLog.done(err)
+		if err != nil {
+			return fmt.Errorf("%s: %v", sub.name, err)
+		}
+	}
+	return nil
+}
+
+// getGtmGlobalSettingsConfig returns only the attributes of block present in the configuration so
+// that settings Terraform does not manage are left untouched by the PATCH.
+func getGtmGlobalSettingsConfig(d *schema.ResourceData, block string, attrs map[string]string) map[string]interface{} {
+	config := make(map[string]interface{})
+	if _, ok := d.GetOk(block); !ok {
+		return config
+	}
+	rawBlock := cty.NullVal(cty.DynamicPseudoType)
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+		rawBlock = rawConfig.GetAttr(block)
+		if rawBlock.IsNull() || rawBlock.LengthInt() == 0 {
+			return config
+		}
+		rawBlock = rawBlock.Index(cty.NumberIntVal(0))
+	}
+	for attr, key := range attrs {
+		if !rawBlock.IsNull() && rawBlock.GetAttr(attr).IsNull() {
+			continue
+		}
+		config[key] = d.Get(block + ".0." + attr)
+	}
+	return config
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TEST_GTM_GLOBAL_SETTINGS_RESOURCE = `
+resource "bigip_gtm_global_settings" "test-gtm-settings" {
+  general {
+    drain_persistent_requests = "yes"
+  }
+  load_balancing {
+    topology_longest_match = "yes"
+  }
+}
+`
+
+func TestAccBigipGtmGlobalSettings_create(t *testing.T) {
+	resName := "bigip_gtm_global_settings.test-gtm-settings"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_GLOBAL_SETTINGS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmTopologyLongestMatch("yes"),
+					resource.TestCheckResourceAttr(resName, "general.0.drain_persistent_requests", "yes"),
+					resource.TestCheckResourceAttr(resName, "load_balancing.0.topology_longest_match", "yes"),
+					resource.TestCheckResourceAttrSet(resName, "general.0.heartbeat_interval"),
+					resource.TestCheckResourceAttrSet(resName, "load_balancing.0.failure_rcode"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmGlobalSettings_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_GLOBAL_SETTINGS_RESOURCE,
+			},
+			{
+				ResourceName:      "bigip_gtm_global_settings.test-gtm-settings",
+				ImportStateId:     "gtm-global-settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckGtmTopologyLongestMatch(value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		settings := &GtmGlobalSettingsLoadBalancing{}
+		if _, err := getRestEntity(client, settings, uriGtmGlobalSettingsLoadBalancing); err != nil {
+			return err
+		}
+		if settings.TopologyLongestMatch != value {
+			return fmt.Errorf("gtm topology-longest-match is %q, expected %q", settings.TopologyLongestMatch, value)
+		}
+		return nil
+	}
+}
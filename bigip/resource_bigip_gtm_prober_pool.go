@@ -0,0 +1,197 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriGtmProberPool = "gtm/prober-pool"
+
+// GtmProberPool mirrors the gtm prober-pool object.
+type GtmProberPool struct {
+	Name              string                `json:"name,omitempty"`
+	Partition         string                `json:"partition,omitempty"`
+	FullPath          string                `json:"fullPath,omitempty"`
+	Description       string                `json:"description,omitempty"`
+	LoadBalancingMode string                `json:"loadBalancingMode,omitempty"`
+	Members           []GtmProberPoolMember `json:"members"`
+	MembersReference  *struct {
+		Items []GtmProberPoolMember `json:"items,omitempty"`
+	} `json:"membersReference,omitempty"`
+}
+
+// GtmProberPoolMember is a BIG-IP server of a prober pool, probed in ascending order.
+type GtmProberPoolMember struct {
+	Name     string `json:"name"`
+	Order    int    `json:"order"`
+	Enabled  bool   `json:"enabled,omitempty"`
+	Disabled bool   `json:"disabled,omitempty"`
+}
+
+func resourceBigipGtmProberPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipGtmProberPoolCreate,
+		ReadContext:   resourceBigipGtmProberPoolRead,
+		UpdateContext: resourceBigipGtmProberPoolUpdate,
+		DeleteContext: resourceBigipGtmProberPoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the prober pool, in full path format e.g. /Common/dc1-probers",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"load_balancing_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "global-availability",
+				ValidateFunc: validation.StringInSlice([]string{"global-availability", "round-robin"}, false),
+				Description:  "Specifies how probes are spread over the members: global-availability or round-robin",
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "BIG-IP servers that probe on behalf of the pool, in the order they are used",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the GTM server of type BIG-IP, e.g. /Common/dc1-bigip01",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Specifies whether the member probes",
+						},
+						"order": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Position of the member in the pool, from the position in the list",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipGtmProberPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_gtm_prober_pool", name, "create", icontrolURI(uriGtmProberPool, name))
+
+	config := getGtmProberPoolConfig(d, &GtmProberPool{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriGtmProberPool)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating GTM prober pool (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipGtmProberPoolRead(ctx, d, meta)
+}
+
+func resourceBigipGtmProberPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_gtm_prober_pool", name, "read", icontrolURI(uriGtmProberPool, name))
+
+	pool := &GtmProberPool{}
+	found, err := getRestEntity(client, pool, restObjectPath(uriGtmProberPool, name)+"?expandSubcollections=true")
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "GTM prober pool not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("description", pool.Description)
+	_ = d.Set("load_balancing_mode", pool.LoadBalancingMode)
+
+	var members []interface{}
+	if pool.MembersReference != nil {
+		items := pool.MembersReference.Items
+		sort.SliceStable(items, func(i, j int) bool { return items[i].Order < items[j].Order })
+		for _, m := range items {
+			members = append(members, map[string]interface{}{
+				"name":    m.Name,
+				"enabled": !m.Disabled,
+				"order":   m.Order,
+			})
+		}
+	}
+	_ = d.Set("members", members)
+	return nil
+}
+
+func resourceBigipGtmProberPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_gtm_prober_pool", name, "update", icontrolURI(uriGtmProberPool, name))
+
+	config := getGtmProberPoolConfig(d, &GtmProberPool{})
+	apiLog.payload(config)
+	err := putRestEntity(client, config, restObjectPath(uriGtmProberPool, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying GTM prober pool (%s): %s", name, err))
+	}
+	return resourceBigipGtmProberPoolRead(ctx, d, meta)
+}
+
+func resourceBigipGtmProberPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_gtm_prober_pool", name, "delete", icontrolURI(uriGtmProberPool, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriGtmProberPool, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getGtmProberPoolConfig(d *schema.ResourceData, config *GtmProberPool) *GtmProberPool {
+	config.Description = d.Get("description").(string)
+	config.LoadBalancingMode = d.Get("load_balancing_mode").(string)
+	config.Members = []GtmProberPoolMember{}
+	for i, m := range d.Get("members").([]interface{}) {
+		member := m.(map[string]interface{})
+		enabled := member["enabled"].(bool)
+		config.Members = append(config.Members, GtmProberPoolMember{
+			Name:     member["name"].(string),
+			Order:    i,
+			Enabled:  enabled,
+			Disabled: !enabled,
+		})
+	}
+	return config
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestGtmProberPoolName = fmt.Sprintf("/%s/test-prober-pool", TestPartition)
+
+func TestAccBigipGtmProberPool_create(t *testing.T) {
+	resName := "bigip_gtm_prober_pool.test-probers"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmProberPoolDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGtmProberPoolConfig(TestGtmProberPoolName, "global-availability"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmProberPoolExists(TestGtmProberPoolName),
+					resource.TestCheckResourceAttr(resName, "name", TestGtmProberPoolName),
+					resource.TestCheckResourceAttr(resName, "load_balancing_mode", "global-availability"),
+					resource.TestCheckResourceAttr(resName, "members.#", "0"),
+				),
+			},
+			{
+				Config: testAccGtmProberPoolConfig(TestGtmProberPoolName, "round-robin"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmProberPoolExists(TestGtmProberPoolName),
+					resource.TestCheckResourceAttr(resName, "load_balancing_mode", "round-robin"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmProberPool_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmProberPoolDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGtmProberPoolConfig(TestGtmProberPoolName, "global-availability"),
+			},
+			{
+				ResourceName:      "bigip_gtm_prober_pool.test-probers",
+				ImportStateId:     TestGtmProberPoolName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckGtmProberPoolExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &GtmProberPool{}, restObjectPath(uriGtmProberPool, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("GTM prober pool %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckGtmProberPoolDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_prober_pool" {
+			continue
+		}
+		name := rs.Primary.ID
+		found, err := getRestEntity(client, &GtmProberPool{}, restObjectPath(uriGtmProberPool, name))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("GTM prober pool %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func testAccGtmProberPoolConfig(name, mode string) string {
+	return fmt.Sprintf(`
+resource "bigip_gtm_prober_pool" "test-probers" {
+  name                = "%s"
+  description         = "terraform acceptance test"
+  load_balancing_mode = "%s"
+}
+`, name, mode)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriIlxPlugin = "ilx/plugin"
+
+type IlxPlugin struct {
+	Name            string `json:"name,omitempty"`
+	FullPath        string `json:"fullPath,omitempty"`
+	FromWorkspace   string `json:"fromWorkspace,omitempty"`
+	StagedDirectory string `json:"stagedDirectory,omitempty"`
+	NodeVersion     string `json:"nodeVersion,omitempty"`
+	Disabled        bool   `json:"disabled,omitempty"`
+	Enabled         bool   `json:"enabled,omitempty"`
+}
+
+func resourceBigipIlxPlugin() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipIlxPluginCreate,
+		ReadContext:   resourceBigipIlxPluginRead,
+		UpdateContext: resourceBigipIlxPluginUpdate,
+		DeleteContext: resourceBigipIlxPluginDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the iRules LX plugin, in full path format e.g. /Common/my_plugin",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"from_workspace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Full path of the iRules LX workspace the plugin is created from",
+				ExactlyOneOf: []string{"from_workspace", "staged_directory"},
+			},
+			"staged_directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Directory on the BIG-IP holding a staged workspace the plugin is created from",
+			},
+			"workspace_md5_hash": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Checksum of the workspace archive, e.g. bigip_ilx_workspace.md5_hash. A change deploys the workspace to the plugin again and restarts it",
+			},
+			"node_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Node.js version used by the plugin",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true to stop the plugin",
+			},
+		},
+	}
+}
+
+func resourceBigipIlxPluginCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ilx_plugin", name, "create", icontrolURI(uriIlxPlugin, name))
+
+	config := getIlxPluginConfig(d, &IlxPlugin{Name: name})
+	if d.Get("disabled").(bool) {
+		config.Disabled = true
+	}
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriIlxPlugin)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating iRules LX plugin (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipIlxPluginRead(ctx, d, meta)
+}
+
+func resourceBigipIlxPluginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ilx_plugin", name, "read", icontrolURI(uriIlxPlugin, name))
+
+	plugin := &IlxPlugin{}
+	found, err := getRestEntity(client, plugin, restObjectPath(uriIlxPlugin, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "iRules LX plugin not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("from_workspace", plugin.FromWorkspace)
+	_ = d.Set("staged_directory", plugin.StagedDirectory)
+	_ = d.Set("node_version", plugin.NodeVersion)
+	_ = d.Set("disabled", plugin.Disabled)
+	return nil
+}
+
+func resourceBigipIlxPluginUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+
+	redeploy := d.HasChanges("from_workspace", "staged_directory", "workspace_md5_hash", "node_version")
+	if redeploy {
+		// Setting the source again copies the workspace into the plugin.
+		apiLog := newAPICallLogger(ctx, "bigip_ilx_plugin", name, "update", icontrolURI(uriIlxPlugin, name))
+		config := getIlxPluginConfig(d, &IlxPlugin{})
+		apiLog.payload(config)
+		err := patchRestEntity(client, config, restObjectPath(uriIlxPlugin, name))
+		apiLog.done(err)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error modifying iRules LX plugin (%s): %s", name, err))
+		}
+	}
+	disabled := d.Get("disabled").(bool)
+	if d.HasChange("disabled") || (redeploy && !disabled) {
+		if err := setIlxPluginState(ctx, client, name, disabled); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceBigipIlxPluginRead(ctx, d, meta)
+}
+
+func resourceBigipIlxPluginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ilx_plugin", name, "delete", icontrolURI(uriIlxPlugin, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriIlxPlugin, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getIlxPluginConfig(d *schema.ResourceData, config *IlxPlugin) *IlxPlugin {
+	// Both sources are computed, only the configured one is sent.
+	useWorkspace := d.Get("from_workspace").(string) != ""
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+		useWorkspace = !rawConfig.GetAttr("from_workspace").IsNull()
+	}
+	if useWorkspace {
+		config.FromWorkspace = d.Get("from_workspace").(string)
+	} else {
+		config.StagedDirectory = d.Get("staged_directory").(string)
+	}
+	config.NodeVersion = d.Get("node_version").(string)
+	return config
+}
+
+// setIlxPluginState stops the plugin, and starts it again unless disabled, which restarts its
+// Node.js processes with the current workspace code.
+func setIlxPluginState(ctx context.Context, client *bigip.BigIP, name string, disabled bool) error {
+	apiLog := newAPICallLogger(ctx, "bigip_ilx_plugin", name, "update", icontrolURI(uriIlxPlugin, name))
+	err := patchRestEntity(client, &IlxPlugin{Disabled: true}, restObjectPath(uriIlxPlugin, name))
+	if err == nil && !disabled {
+		err = patchRestEntity(client, &IlxPlugin{Enabled: true}, restObjectPath(uriIlxPlugin, name))
+	}
+	apiLog.done(err)
+	if err != nil {
+		return fmt.Errorf("error restarting iRules LX plugin (%s): %s", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestIlxWorkspaceName = fmt.Sprintf("/%s/tf_test_workspace", TestPartition)
+var TestIlxPluginName = fmt.Sprintf("/%s/tf_test_plugin", TestPartition)
+
+func TestAccBigipIlxWorkspacePlugin_create(t *testing.T) {
+	archive := filepath.Join(t.TempDir(), "workspace.tgz")
+	writeTestIlxWorkspaceArchive(t, archive, "v1")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckIlxObjectDestroyed(uriIlxPlugin, "bigip_ilx_plugin"),
+			testCheckIlxObjectDestroyed(uriIlxWorkspace, "bigip_ilx_workspace"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIlxConfig(archive),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIlxObjectExists(uriIlxWorkspace, TestIlxWorkspaceName),
+					testCheckIlxObjectExists(uriIlxPlugin, TestIlxPluginName),
+					resource.TestCheckResourceAttr("bigip_ilx_workspace.test", "extensions.0", "tf_ext"),
+					resource.TestCheckResourceAttr("bigip_ilx_plugin.test", "from_workspace", TestIlxWorkspaceName),
+					resource.TestCheckResourceAttr("bigip_ilx_plugin.test", "disabled", "false"),
+				),
+			},
+			{
+				PreConfig: func() { writeTestIlxWorkspaceArchive(t, archive, "v2") },
+				Config:    testAccIlxConfig(archive),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIlxObjectExists(uriIlxWorkspace, TestIlxWorkspaceName),
+					testCheckIlxObjectExists(uriIlxPlugin, TestIlxPluginName),
+				),
+			},
+			{
+				Config:   testAccIlxConfig(archive),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccIlxConfig(archive string) string {
+	return fmt.Sprintf(`
+resource "bigip_ilx_workspace" "test" {
+  name     = "%s"
+  source   = "%s"
+  md5_hash = filemd5("%s")
+}
+
+resource "bigip_ilx_plugin" "test" {
+  name               = "%s"
+  from_workspace     = bigip_ilx_workspace.test.name
+  workspace_md5_hash = bigip_ilx_workspace.test.md5_hash
+}
+`, TestIlxWorkspaceName, archive, archive, TestIlxPluginName)
+}
+
+// writeTestIlxWorkspaceArchive writes a minimal workspace with one extension and one rule.
+func writeTestIlxWorkspaceArchive(t *testing.T, path, version string) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	files := map[string]string{
+		"extensions/tf_ext/index.js":     fmt.Sprintf("// %s\nvar f5 = require('f5-nodejs');\nvar ilx = new f5.ILXServer();\nilx.listen();\n", version),
+		"extensions/tf_ext/package.json": `{"name":"tf_ext","version":"1.0.0","main":"index.js"}`,
+		"rules/tf_rule.tcl":              "when HTTP_REQUEST {\n  set handle [ILX::init tf_test_plugin tf_ext]\n}\n",
+	}
+	for name, body := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(body))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testCheckIlxObjectExists(collection, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &struct{}{}, restObjectPath(collection, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("%s %s was not created", collection, name)
+		}
+		return nil
+	}
+}
+
+func testCheckIlxObjectDestroyed(collection, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			found, err := getRestEntity(client, &struct{}{}, restObjectPath(collection, rs.Primary.ID))
+			if err != nil {
+				return err
+			}
+			if found {
+				return fmt.Errorf("%s %s not destroyed", collection, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
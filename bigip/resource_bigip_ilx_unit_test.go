@@ -0,0 +1,47 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunTmshCommand(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var args string
+	output := ""
+	mux.HandleFunc("/mgmt/tm/util/bash", func(w http.ResponseWriter, r *http.Request) {
+		cmd := bigip.BigipCommand{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&cmd))
+		args = cmd.UtilCmdArgs
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(bigip.BigipCommand{Command: "run", UtilCmdArgs: cmd.UtilCmdArgs, CommandResult: output})
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	assert.NoError(t, runTmshCommand(client, "create ilx workspace /Common/ws from-archive /var/config/rest/downloads/ws.tgz"))
+	assert.Equal(t, "-c 'tmsh create ilx workspace /Common/ws from-archive /var/config/rest/downloads/ws.tgz'", args)
+
+	output = "01070711:3: Workspace /Common/ws already exists.\n"
+	err := runTmshCommand(client, "create ilx workspace /Common/ws from-archive /var/config/rest/downloads/ws.tgz")
+	assert.ErrorContains(t, err, "already exists")
+}
+
+func TestIlxWorkspaceArchiveName(t *testing.T) {
+	assert.Equal(t, "ilx-workspace-Common-my_ws.tgz", ilxWorkspaceArchiveName("/Common/my_ws"))
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	uriIlxWorkspace = "ilx/workspace"
+	// uriRestDownloads is where files uploaded through mgmt/shared/file-transfer/uploads land.
+	uriRestDownloads = "/var/config/rest/downloads"
+)
+
+type IlxWorkspace struct {
+	Name        string `json:"name,omitempty"`
+	FullPath    string `json:"fullPath,omitempty"`
+	NodeVersion string `json:"nodeVersion,omitempty"`
+	Extensions  []struct {
+		Name string `json:"name"`
+	} `json:"extensions,omitempty"`
+}
+
+func resourceBigipIlxWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipIlxWorkspaceCreate,
+		ReadContext:   resourceBigipIlxWorkspaceRead,
+		UpdateContext: resourceBigipIlxWorkspaceUpdate,
+		DeleteContext: resourceBigipIlxWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the iRules LX workspace, in full path format e.g. /Common/my_workspace",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the tgz archive of the workspace on the local disk",
+			},
+			"md5_hash": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "MD5 hash of the workspace archive, a change uploads the archive again",
+			},
+			"node_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Node.js version used by the workspace",
+			},
+			"extensions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the extensions contained in the workspace",
+			},
+		},
+	}
+}
+
+func resourceBigipIlxWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	if err := importIlxWorkspace(ctx, d, client, name); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	if err := setIlxWorkspaceNodeVersion(ctx, d, client, name); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipIlxWorkspaceRead(ctx, d, meta)
+}
+
+func resourceBigipIlxWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ilx_workspace", name, "read", icontrolURI(uriIlxWorkspace, name))
+
+	ws := &IlxWorkspace{}
+	found, err := getRestEntity(client, ws, restObjectPath(uriIlxWorkspace, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "iRules LX workspace not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	extensions := make([]string, 0, len(ws.Extensions))
+	for _, ext := range ws.Extensions {
+		extensions = append(extensions, ext.Name)
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("node_version", ws.NodeVersion)
+	_ = d.Set("extensions", extensions)
+	return nil
+}
+
+func resourceBigipIlxWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	if d.HasChanges("source", "md5_hash") {
+		// A workspace cannot be imported over an existing one, it is replaced with the new archive.
+		apiLog := newAPICallLogger(ctx, "bigip_ilx_workspace", name, "delete", icontrolURI(uriIlxWorkspace, name))
+		err := deleteRestEntity(client, restObjectPath(uriIlxWorkspace, name))
+		apiLog.done(err)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error removing iRules LX workspace (%s) before re-import: %s", name, err))
+		}
+		if err := importIlxWorkspace(ctx, d, client, name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := setIlxWorkspaceNodeVersion(ctx, d, client, name); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipIlxWorkspaceRead(ctx, d, meta)
+}
+
+func resourceBigipIlxWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ilx_workspace", name, "delete", icontrolURI(uriIlxWorkspace, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriIlxWorkspace, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// importIlxWorkspace uploads the archive in source and creates the workspace from it.
+func importIlxWorkspace(ctx context.Context, d *schema.ResourceData, client *bigip.BigIP, name string) error {
+	source := d.Get("source").(string)
+	data, err := os.ReadFile(source)
+	if err != nil {
+		return fmt.Errorf("error reading iRules LX workspace archive %s: %s", source, err)
+	}
+	fileName := ilxWorkspaceArchiveName(name)
+	if _, err := client.UploadBytes(data, fileName); err != nil {
+		return fmt.Errorf("error uploading iRules LX workspace archive %s: %s", source, err)
+	}
+
+	command := fmt.Sprintf("create ilx workspace %s from-archive %s/%s", name, uriRestDownloads, fileName)
+	apiLog := newAPICallLogger(ctx, "bigip_ilx_workspace", name, "create", "/mgmt/tm/util/bash")
+	apiLog.payload(command)
+	err = runTmshCommand(client, command)
+	apiLog.done(err)
+	if err != nil {
+		return fmt.Errorf("error creating iRules LX workspace (%s): %s", name, err)
+	}
+	return nil
+}
+
+// setIlxWorkspaceNodeVersion applies node_version when it is configured and differs from the
+// version the archive was imported with.
+func setIlxWorkspaceNodeVersion(ctx context.Context, d *schema.ResourceData, client *bigip.BigIP, name string) error {
+	nodeVersion := d.Get("node_version").(string)
+	if nodeVersion == "" || (!d.HasChange("node_version") && !d.HasChanges("source", "md5_hash")) {
+		return nil
+	}
+	apiLog := newAPICallLogger(ctx, "bigip_ilx_workspace", name, "update", icontrolURI(uriIlxWorkspace, name))
+	config := &IlxWorkspace{NodeVersion: nodeVersion}
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriIlxWorkspace, name))
+	apiLog.done(err)
+	if err != nil {
+		return fmt.Errorf("error setting node_version of iRules LX workspace (%s): %s", name, err)
+	}
+	return nil
+}
+
+// ilxWorkspaceArchiveName returns the upload file name of the archive of workspace name.
+func ilxWorkspaceArchiveName(name string) string {
+	return "ilx-workspace-" + strings.ReplaceAll(strings.TrimPrefix(name, "/"), "/", "-") + ".tgz"
+}
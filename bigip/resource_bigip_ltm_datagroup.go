@@ -12,6 +12,7 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 
 	bigip "github.com/f5devcentral/go-bigip"
@@ -26,7 +27,7 @@ func resourceBigipLtmDataGroup() *schema.Resource {
 		UpdateContext: resourceBigipLtmDataGroupUpdate,
 		DeleteContext: resourceBigipLtmDataGroupDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceBigipLtmDataGroupImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -48,6 +49,7 @@ func resourceBigipLtmDataGroup() *schema.Resource {
 				Optional:    true,
 				Description: "Set flase if you want to create External Datagroup",
 				Default:     true,
+				ForceNew:    true,
 			},
 			"records_src": {
 				Type:          schema.TypeString,
@@ -87,21 +89,16 @@ func resourceBigipLtmDataGroupCreate(ctx context.Context, d *schema.ResourceData
 	name = d.Get("name").(string)
 	log.Printf("[DEBUG] Creating Data Group List %s", name)
 	if d.Get("internal").(bool) {
-		var records []bigip.DataGroupRecord
-		if rs.Len() > 0 {
-			for _, r := range rs.List() {
-				record := r.(map[string]interface{})
-				records = append(records, bigip.DataGroupRecord{Name: record["name"].(string), Data: record["data"].(string)})
-			}
-		} else {
-			records = nil
+		records, err := dataGroupRecords(dgtype, rs)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 		dg := &bigip.DataGroup{
 			Name:    name,
 			Type:    dgtype,
 			Records: records,
 		}
-		err := client.AddInternalDataGroup(dg)
+		err = client.AddInternalDataGroup(dg)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("Error creating Data Group List %s: %v ", name, err))
 		}
@@ -135,6 +132,8 @@ func resourceBigipLtmDataGroupRead(ctx context.Context, d *schema.ResourceData,
 	if datagroup != nil {
 		_ = d.Set("name", datagroup.FullPath)
 		_ = d.Set("type", datagroup.Type)
+		_ = d.Set("internal", true)
+		// records without a value come back without a data field, an empty data group has no records at all
 		for _, record := range datagroup.Records {
 			dgRecord := map[string]interface{}{
 				"name": record.Name,
@@ -158,6 +157,7 @@ func resourceBigipLtmDataGroupRead(ctx context.Context, d *schema.ResourceData,
 		}
 		_ = d.Set("name", datagroup.FullPath)
 		_ = d.Set("type", datagroup.Type)
+		_ = d.Set("internal", false)
 	}
 
 	return nil
@@ -173,22 +173,18 @@ func resourceBigipLtmDataGroupUpdate(ctx context.Context, d *schema.ResourceData
 	dgtype := d.Get("type").(string)
 
 	if d.Get("internal").(bool) {
-		var records []bigip.DataGroupRecord
-		if rs.Len() > 0 {
-			for _, r := range rs.List() {
-				record := r.(map[string]interface{})
-				records = append(records, bigip.DataGroupRecord{Name: record["name"].(string), Data: record["data"].(string)})
-			}
-		} else {
-			records = nil
+		records, err := dataGroupRecords(dgtype, rs)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		dgver := &bigip.DataGroup{
+		// records is always sent, so that removing every record empties the data group
+		dgver := &dataGroupRecordsPayload{
 			Name:    name,
 			Type:    dgtype,
 			Records: records,
 		}
-		dgver1213 := &bigip.DataGroup{
+		dgver1213 := &dataGroupRecordsPayload{
 			Name:    name,
 			Records: records,
 		}
@@ -204,12 +200,12 @@ func resourceBigipLtmDataGroupUpdate(ctx context.Context, d *schema.ResourceData
 		regversion := re.FindAllString(bigipversion, -1)
 		if matchresult {
 			log.Printf("[DEBUG] Bigip version is : %s", regversion)
-			if err := client.ModifyInternalDataGroupRecords(dgver1213); err != nil {
+			if err := putRestEntity(client, dgver1213, restObjectPath(uriInternalDataGroup, name)); err != nil {
 				return diag.FromErr(fmt.Errorf("Error modifying Data Group List %s: %v ", name, err))
 			}
 		} else {
 			log.Printf("[DEBUG] Bigip version is : %s", regversion)
-			if err := client.ModifyInternalDataGroupRecords(dgver); err != nil {
+			if err := putRestEntity(client, dgver, restObjectPath(uriInternalDataGroup, name)); err != nil {
 				return diag.FromErr(fmt.Errorf("Error modifying Data Group List %s: %v ", name, err))
 			}
 		}
@@ -253,3 +249,58 @@ func resourceBigipLtmDataGroupDelete(ctx context.Context, d *schema.ResourceData
 	d.SetId("")
 	return nil
 }
+
+// resourceBigipLtmDataGroupImport looks the data group up on the internal data-group endpoint and
+// sets its type, so that Read and the next plan work from the imported ID alone. External data groups
+// are rejected: their records live in a file the provider uploads from records_src, which cannot be
+// recovered from the device.
+func resourceBigipLtmDataGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	datagroup := &bigip.DataGroup{}
+	found, err := getRestEntity(client, datagroup, restObjectPath(uriInternalDataGroup, name))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Data Group List %s: %v", name, err)
+	}
+	if found {
+		_ = d.Set("name", datagroup.FullPath)
+		_ = d.Set("type", datagroup.Type)
+		_ = d.Set("internal", true)
+		return []*schema.ResourceData{d}, nil
+	}
+	found, err = getRestEntity(client, &bigip.ExternalDG{}, restObjectPath(uriExternalDataGroup, name))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Data Group List %s: %v", name, err)
+	}
+	if found {
+		return nil, fmt.Errorf("Data Group List %s is an external data group, which cannot be imported as its records come from a file; "+
+			"manage it with a bigip_ltm_datagroup with internal = false and records_src set to the records file instead", name)
+	}
+	return nil, fmt.Errorf("Data Group List %s not found", name)
+}
+
+const uriInternalDataGroup = "ltm/data-group/internal"
+const uriExternalDataGroup = "ltm/data-group/external"
+
+type dataGroupRecordsPayload struct {
+	Name    string                  `json:"name"`
+	Type    string                  `json:"type,omitempty"`
+	Records []bigip.DataGroupRecord `json:"records"`
+}
+
+// dataGroupRecords converts the configured records. Records without data are sent without a data
+// field, and the keys of integer data groups are validated as 64 bit integers.
+func dataGroupRecords(dgtype string, rs *schema.Set) ([]bigip.DataGroupRecord, error) {
+	records := []bigip.DataGroupRecord{}
+	for _, r := range rs.List() {
+		record := r.(map[string]interface{})
+		name := record["name"].(string)
+		if dgtype == "integer" {
+			if _, err := strconv.ParseInt(name, 10, 64); err != nil {
+				return nil, fmt.Errorf("record %q of integer Data Group List must be a 64 bit integer: %v", name, err)
+			}
+		}
+		records = append(records, bigip.DataGroupRecord{Name: name, Data: record["data"].(string)})
+	}
+	return records, nil
+}
@@ -8,6 +8,7 @@ package bigip
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	bigip "github.com/f5devcentral/go-bigip"
@@ -65,6 +66,37 @@ var TestDatagroupIntegerResource = `
                 }
         }`
 
+var TestDatagroupInt64Resource = `
+        resource "bigip_ltm_datagroup" "test-datagroup-integer" {
+                name = "` + TestDatagroupName + `"
+                type = "integer"
+                record {
+                        name = "4294967296"
+                        data = "above int32"
+                }
+                record {
+                        name = "9223372036854775807"
+                }
+        }`
+
+var TestDatagroupEmptyResource = `
+        resource "bigip_ltm_datagroup" "test-datagroup-integer" {
+                name = "` + TestDatagroupName + `"
+                type = "integer"
+        }`
+
+var TestDatagroupIpHostResource = `
+	resource "bigip_ltm_datagroup" "test-datagroup-ip" {
+		name = "` + TestDatagroupName + `"
+		type = "ip"
+		record {
+			name = "10.10.10.10/32"
+		}
+		record {
+			name = "10.10.10.11/32"
+		}
+	}`
+
 func TestAccBigipLtmDataGroup_Create_TypeString(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -83,6 +115,41 @@ func TestAccBigipLtmDataGroup_Create_TypeString(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmDataGroup_updateInPlace(t *testing.T) {
+	var instance testAccInstance
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckDataGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestDatagroupStringResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDataGroupExists(TestDatagroupName),
+					testCheckResourceNotReplaced("bigip_ltm_datagroup.test-datagroup-string", &instance),
+				),
+			},
+			{
+				Config: `
+resource "bigip_ltm_datagroup" "test-datagroup-string" {
+  name = "` + TestDatagroupName + `"
+  type = "string"
+  record {
+    name = "test-name3"
+    data = "test-data3"
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceNotReplaced("bigip_ltm_datagroup.test-datagroup-string", &instance),
+					resource.TestCheckResourceAttr("bigip_ltm_datagroup.test-datagroup-string", "record.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmDataGroup_Create_External(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -136,7 +203,8 @@ func TestAccBigipLtmDataGroup_Create_TypeInteger(t *testing.T) {
 	})
 }
 
-func TestAccBigipLtmDataGroup_import(t *testing.T) {
+func TestAccBigipLtmDataGroup_Int64RecordsToEmpty(t *testing.T) {
+	resName := "bigip_ltm_datagroup.test-datagroup-integer"
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAcctPreCheck(t)
@@ -145,17 +213,24 @@ func TestAccBigipLtmDataGroup_import(t *testing.T) {
 		CheckDestroy: testCheckDataGroupDestroyed,
 		Steps: []resource.TestStep{
 			{
-				Config: TestDatagroupStringResource,
+				Config: TestDatagroupInt64Resource,
 				Check: resource.ComposeTestCheckFunc(
 					testCheckDataGroupExists(TestDatagroupName),
+					resource.TestCheckResourceAttr(resName, "record.#", "2"),
+				),
+			},
+			{
+				Config: TestDatagroupEmptyResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDataGroupExists(TestDatagroupName),
+					resource.TestCheckResourceAttr(resName, "record.#", "0"),
 				),
-				ResourceName:      TestDatagroupName,
-				ImportState:       false,
-				ImportStateVerify: true,
 			},
 		},
 	})
+}
 
+func TestAccBigipLtmDataGroup_importIpHostRecords(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAcctPreCheck(t)
@@ -164,17 +239,63 @@ func TestAccBigipLtmDataGroup_import(t *testing.T) {
 		CheckDestroy: testCheckDataGroupDestroyed,
 		Steps: []resource.TestStep{
 			{
-				Config: TestDatagroupIpResource,
+				Config: TestDatagroupIpHostResource,
 				Check: resource.ComposeTestCheckFunc(
 					testCheckDataGroupExists(TestDatagroupName),
 				),
-				ResourceName:      TestDatagroupName,
-				ImportState:       false,
+			},
+			{
+				ResourceName:      "bigip_ltm_datagroup.test-datagroup-ip",
+				ImportStateId:     TestDatagroupName,
+				ImportState:       true,
 				ImportStateVerify: true,
 			},
 		},
 	})
+}
 
+func TestAccBigipLtmDataGroup_import(t *testing.T) {
+	for dgtype, tc := range map[string]struct {
+		config       string
+		resourceName string
+	}{
+		"string":  {TestDatagroupStringResource, "bigip_ltm_datagroup.test-datagroup-string"},
+		"ip":      {TestDatagroupIpResource, "bigip_ltm_datagroup.test-datagroup-ip"},
+		"integer": {TestDatagroupIntegerResource, "bigip_ltm_datagroup.test-datagroup-integer"},
+	} {
+		t.Run(dgtype, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAcctPreCheck(t)
+				},
+				Providers:    testAccProviders,
+				CheckDestroy: testCheckDataGroupDestroyed,
+				Steps: []resource.TestStep{
+					{
+						Config: tc.config,
+						Check: resource.ComposeTestCheckFunc(
+							testCheckDataGroupExists(TestDatagroupName),
+						),
+					},
+					{
+						ResourceName:      tc.resourceName,
+						ImportStateId:     TestDatagroupName,
+						ImportState:       true,
+						ImportStateVerify: true,
+						ImportStateCheck: func(states []*terraform.InstanceState) error {
+							if got := states[0].Attributes["type"]; got != dgtype {
+								return fmt.Errorf("imported type is %q, expected %q", got, dgtype)
+							}
+							return nil
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccBigipLtmDataGroup_importExternal(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAcctPreCheck(t)
@@ -183,13 +304,16 @@ func TestAccBigipLtmDataGroup_import(t *testing.T) {
 		CheckDestroy: testCheckDataGroupDestroyed,
 		Steps: []resource.TestStep{
 			{
-				Config: TestDatagroupIntegerResource,
+				Config: TestExternalDatagroupResource,
 				Check: resource.ComposeTestCheckFunc(
-					testCheckDataGroupExists(TestDatagroupName),
+					testCheckExternalDataGroupExists(TestDatagroupName),
 				),
-				ResourceName:      TestDatagroupName,
-				ImportState:       false,
-				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "bigip_ltm_datagroup.test-datagroup-string",
+				ImportStateId: TestDatagroupName,
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("is an external data group"),
 			},
 		},
 	})
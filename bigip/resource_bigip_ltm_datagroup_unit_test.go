@@ -0,0 +1,63 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceBigipLtmDataGroupImport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/data-group/internal/~Common~hosts", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"name":"hosts","partition":"Common","fullPath":"/Common/hosts","type":"ip","records":[{"name":"10.1.1.1/32"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/data-group/internal/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested data group was not found."}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/data-group/external/~Common~from-file", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"name":"from-file","partition":"Common","fullPath":"/Common/from-file","type":"string","externalFileName":"/Common/from-file"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/data-group/external/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested data group was not found."}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipLtmDataGroup()
+
+	d := r.TestResourceData()
+	d.SetId("/Common/hosts")
+	imported, err := resourceBigipLtmDataGroupImport(context.Background(), d, client)
+	assert.NoError(t, err)
+	assert.Len(t, imported, 1)
+	assert.Equal(t, "ip", d.Get("type"))
+	assert.Equal(t, "/Common/hosts", d.Get("name"))
+	assert.Equal(t, true, d.Get("internal"))
+
+	d = r.TestResourceData()
+	d.SetId("/Common/from-file")
+	_, err = resourceBigipLtmDataGroupImport(context.Background(), d, client)
+	assert.ErrorContains(t, err, "is an external data group")
+	assert.ErrorContains(t, err, "records_src")
+
+	d = r.TestResourceData()
+	d.SetId("/Common/missing")
+	_, err = resourceBigipLtmDataGroupImport(context.Background(), d, client)
+	assert.EqualError(t, err, "Data Group List /Common/missing not found")
+}
@@ -17,6 +17,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const uriLtmIRule = "ltm/rule"
+
+// LtmIRule is the payload creating an iRule in its partition and folder, the go-bigip type has no
+// subPath and sends the full path as name.
+type LtmIRule struct {
+	Name      string `json:"name"`
+	Partition string `json:"partition,omitempty"`
+	SubPath   string `json:"subPath,omitempty"`
+	Rule      string `json:"apiAnonymous"`
+}
+
 func resourceBigipLtmIRule() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceBigipLtmIRuleCreate,
@@ -24,16 +35,16 @@ func resourceBigipLtmIRule() *schema.Resource {
 		UpdateContext: resourceBigipLtmIRuleUpdate,
 		DeleteContext: resourceBigipLtmIRuleDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importProfileFullPath,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Name of the iRule",
+				Description:  "Name of the iRule, as its full path /Partition/Name or /Partition/Folder/Name",
 				ForceNew:     true,
-				ValidateFunc: validateF5Name,
+				ValidateFunc: validateF5NameWithDirectory,
 			},
 
 			"irule": {
@@ -54,7 +65,16 @@ func resourceBigipLtmIRuleCreate(ctx context.Context, d *schema.ResourceData, me
 	name := d.Get("name").(string)
 	log.Printf("[INFO] Creating iRule %s", name)
 
-	err := client.CreateIRule(name, d.Get("irule").(string))
+	partition, subPath, ruleName := splitFullPath(name)
+	irule := &LtmIRule{
+		Name:      ruleName,
+		Partition: partition,
+		SubPath:   subPath,
+		Rule:      d.Get("irule").(string),
+	}
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_irule", name, "create", "/mgmt/tm/"+uriLtmIRule)
+	err := postRestEntity(client, irule, uriLtmIRule)
+	apiLog.done(err)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating iRule %s: %v", name, err))
 	}
@@ -67,7 +87,7 @@ func resourceBigipLtmIRuleCreate(ctx context.Context, d *schema.ResourceData, me
 func resourceBigipLtmIRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
 
-	name := d.Id()
+	name := profileFullPath(d.Id(), "")
 	log.Printf("[INFO] Retrieving iRule %s", name)
 
 	irule, err := client.IRule(name)
@@ -80,6 +100,7 @@ func resourceBigipLtmIRuleRead(ctx context.Context, d *schema.ResourceData, meta
 		return nil
 	}
 
+	d.SetId(irule.FullPath)
 	_ = d.Set("name", irule.FullPath)
 	_ = d.Set("irule", irule.Rule)
 
@@ -105,11 +126,52 @@ func resourceBigipLtmIRuleUpdate(ctx context.Context, d *schema.ResourceData, me
 
 func resourceBigipLtmIRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
-	name := d.Id()
+	name := profileFullPath(d.Id(), "")
 	err := client.DeleteIRule(name)
 	if err != nil {
+		// The BIG-IP refuses to delete an iRule in use with a 400 that does not say where it is used.
+		virtuals, lookupErr := virtualServersUsingIRule(client, name)
+		if lookupErr == nil && len(virtuals) > 0 {
+			return diag.Errorf("error deleting iRule %s: it is still attached to virtual servers %s: %v", name, strings.Join(virtuals, ", "), err)
+		}
 		return diag.FromErr(fmt.Errorf("error deleting iRule %s: %v", name, err))
 	}
 	d.SetId("")
 	return nil
 }
+
+// splitFullPath splits the full path of an object, /partition[/folder...]/name, into its partition,
+// folder and name. A bare name is taken as a /Common object.
+func splitFullPath(fullPath string) (partition, subPath, name string) {
+	parts := strings.Split(strings.TrimPrefix(profileFullPath(fullPath, ""), "/"), "/")
+	partition = parts[0]
+	name = parts[len(parts)-1]
+	if len(parts) > 2 {
+		subPath = strings.Join(parts[1:len(parts)-1], "/")
+	}
+	return partition, subPath, name
+}
+
+type virtualServerRules struct {
+	FullPath string   `json:"fullPath"`
+	Rules    []string `json:"rules"`
+}
+
+// virtualServersUsingIRule returns the full paths of the virtual servers the iRule fullPath is
+// attached to.
+func virtualServersUsingIRule(client *bigip.BigIP, fullPath string) ([]string, error) {
+	virtuals, err := getRestItems[virtualServerRules](client, "ltm/virtual?$select=fullPath,rules")
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, vs := range virtuals {
+		for _, rule := range vs.Rules {
+			if rule == fullPath {
+				names = append(names, vs.FullPath)
+				break
+			}
+		}
+	}
+	return names, nil
+}
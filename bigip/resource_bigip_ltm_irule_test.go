@@ -66,6 +66,54 @@ func TestAccBigipLtmIRule_import(t *testing.T) {
 	})
 }
 
+var TEST_IRULE_TENANT_RESOURCE = `
+resource "bigip_partition" "irule-tenant" {
+  name = "irule-tenant"
+}
+
+resource "bigip_ltm_irule" "tenant-rule" {
+  name  = "/${bigip_partition.irule-tenant.name}/tenant-rule"
+  irule = <<EOF
+when CLIENT_ACCEPTED {
+     log local0. "tenant"
+}
+EOF
+}
+
+resource "bigip_ltm_virtual_server" "tenant-vs" {
+  name        = "/${bigip_partition.irule-tenant.name}/tenant-vs"
+  destination = "10.255.255.254"
+  port        = 80
+  irules      = [bigip_ltm_irule.tenant-rule.name]
+}
+`
+
+func TestAccBigipLtmIRule_tenantPartition(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckIRulesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_IRULE_TENANT_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_irule.tenant-rule", "id", "/irule-tenant/tenant-rule"),
+					resource.TestCheckResourceAttr("bigip_ltm_irule.tenant-rule", "name", "/irule-tenant/tenant-rule"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.tenant-vs", "irules.0", "/irule-tenant/tenant-rule"),
+				),
+			},
+			{
+				ResourceName:      "bigip_ltm_irule.tenant-rule",
+				ImportState:       true,
+				ImportStateId:     "/irule-tenant/tenant-rule",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckIRuleExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
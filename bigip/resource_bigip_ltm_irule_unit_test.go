@@ -0,0 +1,81 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitFullPath(t *testing.T) {
+	for _, tc := range []struct {
+		fullPath, partition, subPath, name string
+	}{
+		{"/Common/rule1", "Common", "", "rule1"},
+		{"/Tenant/rule1", "Tenant", "", "rule1"},
+		{"/Tenant/App/rule1", "Tenant", "App", "rule1"},
+		{"rule1", "Common", "", "rule1"},
+	} {
+		partition, subPath, name := splitFullPath(tc.fullPath)
+		assert.Equal(t, tc.partition, partition, tc.fullPath)
+		assert.Equal(t, tc.subPath, subPath, tc.fullPath)
+		assert.Equal(t, tc.name, name, tc.fullPath)
+	}
+}
+
+func TestLtmIRulePartition(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var created map[string]interface{}
+	var methods []string
+	mux.HandleFunc("/mgmt/tm/ltm/rule", func(w http.ResponseWriter, r *http.Request) {
+		created = map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&created)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/rule/~Tenant~App~rule1", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintf(w, `{"code":400,"message":"01070265:3: The rule (/Tenant/App/rule1) cannot be deleted because it is in use by a virtual server (/Tenant/App/vs1)."}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"name":"rule1","partition":"Tenant","subPath":"App","fullPath":"/Tenant/App/rule1","apiAnonymous":"when HTTP_REQUEST {}"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"fullPath":"/Tenant/App/vs1","rules":["/Tenant/App/rule1"]},{"fullPath":"/Common/vs2","rules":["/Common/other"]},{"fullPath":"/Tenant/vs3","rules":["/Common/other","/Tenant/App/rule1"]}]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := Provider().ResourcesMap["bigip_ltm_irule"]
+	d := r.TestResourceData()
+	_ = d.Set("name", "/Tenant/App/rule1")
+	_ = d.Set("irule", "when HTTP_REQUEST {}")
+	assert.False(t, r.CreateContext(context.Background(), d, client).HasError())
+	assert.Equal(t, map[string]interface{}{"name": "rule1", "partition": "Tenant", "subPath": "App", "apiAnonymous": "when HTTP_REQUEST {}"}, created)
+	assert.Equal(t, "/Tenant/App/rule1", d.Id())
+
+	diags := r.DeleteContext(context.Background(), d, client)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Summary, "still attached to virtual servers /Tenant/App/vs1, /Tenant/vs3")
+	}
+	assert.Equal(t, []string{"GET", "DELETE"}, methods)
+	assert.Equal(t, "/Tenant/App/rule1", d.Id())
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriMessageRoutingPeer = "ltm/message-routing/generic/peer"
+
+// MessageRoutingPeer mirrors the ltm message-routing generic peer object.
+type MessageRoutingPeer struct {
+	Name               string `json:"name,omitempty"`
+	Partition          string `json:"partition,omitempty"`
+	FullPath           string `json:"fullPath,omitempty"`
+	Description        string `json:"description,omitempty"`
+	Pool               string `json:"pool,omitempty"`
+	TransportConfig    string `json:"transportConfig,omitempty"`
+	ConnectionMode     string `json:"connectionMode,omitempty"`
+	NumberConnections  int    `json:"numberConnections,omitempty"`
+	Ratio              int    `json:"ratio,omitempty"`
+	AutoInitialization string `json:"autoInitialization,omitempty"`
+}
+
+func resourceBigipLtmMessageRoutingPeer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmMessageRoutingPeerCreate,
+		ReadContext:   resourceBigipLtmMessageRoutingPeerRead,
+		UpdateContext: resourceBigipLtmMessageRoutingPeerUpdate,
+		DeleteContext: resourceBigipLtmMessageRoutingPeerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the peer, in full path format e.g. /Common/mqtt-peer",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"pool": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Pool the peer connects to",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"transport_config": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Transport config used to connect to the pool members, the virtual server's profiles are used when not set",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"connection_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "per-peer",
+				ValidateFunc: validation.StringInSlice([]string{"per-blade", "per-client", "per-peer", "per-tmm"}, false),
+				Description:  "How connections to the peer are shared: per-blade, per-client, per-peer or per-tmm",
+			},
+			"number_of_connections": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of connections opened to the peer for each connection_mode scope",
+			},
+			"ratio": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Ratio of the peer when the route selects peers by ratio",
+			},
+			"auto_initialization": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Whether connections to the peer are opened before any message is routed to it",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmMessageRoutingPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_peer", name, "create", icontrolURI(uriMessageRoutingPeer, name))
+
+	if err := checkMessageRoutingPeerReferences(client, d, name); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getMessageRoutingPeerConfig(d, &MessageRoutingPeer{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriMessageRoutingPeer)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating message routing peer (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmMessageRoutingPeerRead(ctx, d, meta)
+}
+
+func resourceBigipLtmMessageRoutingPeerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_peer", name, "read", icontrolURI(uriMessageRoutingPeer, name))
+
+	obj := &MessageRoutingPeer{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriMessageRoutingPeer, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "Message Routing Peer not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("pool", noneToEmpty(obj.Pool))
+	_ = d.Set("transport_config", noneToEmpty(obj.TransportConfig))
+	_ = d.Set("connection_mode", obj.ConnectionMode)
+	_ = d.Set("number_of_connections", obj.NumberConnections)
+	_ = d.Set("ratio", obj.Ratio)
+	_ = d.Set("auto_initialization", obj.AutoInitialization)
+	return nil
+}
+
+func resourceBigipLtmMessageRoutingPeerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_peer", name, "update", icontrolURI(uriMessageRoutingPeer, name))
+
+	if err := checkMessageRoutingPeerReferences(client, d, name); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getMessageRoutingPeerConfig(d, &MessageRoutingPeer{})
+	// detach a removed pool or transport config
+	if config.Pool == "" {
+		config.Pool = "none"
+	}
+	if config.TransportConfig == "" {
+		config.TransportConfig = "none"
+	}
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriMessageRoutingPeer, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying message routing peer (%s): %s", name, err))
+	}
+	return resourceBigipLtmMessageRoutingPeerRead(ctx, d, meta)
+}
+
+func resourceBigipLtmMessageRoutingPeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_peer", name, "delete", icontrolURI(uriMessageRoutingPeer, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriMessageRoutingPeer, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getMessageRoutingPeerConfig(d *schema.ResourceData, config *MessageRoutingPeer) *MessageRoutingPeer {
+	config.Description = d.Get("description").(string)
+	config.Pool = d.Get("pool").(string)
+	config.TransportConfig = d.Get("transport_config").(string)
+	config.ConnectionMode = d.Get("connection_mode").(string)
+	config.NumberConnections = d.Get("number_of_connections").(int)
+	config.Ratio = d.Get("ratio").(int)
+	config.AutoInitialization = d.Get("auto_initialization").(string)
+	return config
+}
+
+func checkMessageRoutingPeerReferences(client *bigip.BigIP, d *schema.ResourceData, name string) error {
+	if err := checkRestReferences(client, "ltm/pool", "pool", d.Get("pool").(string)); err != nil {
+		return fmt.Errorf("message routing peer (%s): %s", name, err)
+	}
+	if err := checkRestReferences(client, uriMessageRoutingTransportConfig, "transport config", d.Get("transport_config").(string)); err != nil {
+		return fmt.Errorf("message routing peer (%s): %s", name, err)
+	}
+	return nil
+}
+
+// noneToEmpty returns "" for the "none" value the BIG-IP reports for an unset reference.
+func noneToEmpty(s string) string {
+	if s == "none" {
+		return ""
+	}
+	return s
+}
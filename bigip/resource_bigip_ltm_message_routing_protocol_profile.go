@@ -0,0 +1,178 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriMessageRoutingProtocol = "ltm/message-routing/generic/protocol"
+
+// MessageRoutingProtocolProfile mirrors the ltm message-routing generic protocol object.
+type MessageRoutingProtocolProfile struct {
+	Name              string `json:"name,omitempty"`
+	Partition         string `json:"partition,omitempty"`
+	FullPath          string `json:"fullPath,omitempty"`
+	DefaultsFrom      string `json:"defaultsFrom,omitempty"`
+	Description       string `json:"description,omitempty"`
+	MessageTerminator string `json:"messageTerminator,omitempty"`
+	DisableParser     string `json:"disableParser,omitempty"`
+	MaxEgressBuffer   *int   `json:"maxEgressBuffer,omitempty"`
+	MaxMessageSize    *int   `json:"maxMessageSize,omitempty"`
+}
+
+func resourceBigipLtmMessageRoutingProtocolProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmMessageRoutingProtocolProfileCreate,
+		ReadContext:   resourceBigipLtmMessageRoutingProtocolProfileRead,
+		UpdateContext: resourceBigipLtmMessageRoutingProtocolProfileUpdate,
+		DeleteContext: resourceBigipLtmMessageRoutingProtocolProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the generic protocol profile, in full path format e.g. /Common/mqtt-generic",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the profile that you want to use as the parent profile",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined description",
+			},
+			"message_terminator": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "String marking the end of a message",
+			},
+			"disable_parser": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+				Description:  "Whether the message terminator parser is disabled, leaving the messages to be delimited by an iRule",
+			},
+			"max_egress_buffer": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of bytes buffered before the connection stops reading",
+			},
+			"max_message_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum size of a message in bytes",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmMessageRoutingProtocolProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_protocol_profile", name, "create", icontrolURI(uriMessageRoutingProtocol, name))
+
+	config := getMessageRoutingProtocolProfileConfig(d, &MessageRoutingProtocolProfile{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriMessageRoutingProtocol)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating message routing protocol profile (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmMessageRoutingProtocolProfileRead(ctx, d, meta)
+}
+
+func resourceBigipLtmMessageRoutingProtocolProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_protocol_profile", name, "read", icontrolURI(uriMessageRoutingProtocol, name))
+
+	obj := &MessageRoutingProtocolProfile{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriMessageRoutingProtocol, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "Message Routing Protocol Profile not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("message_terminator", obj.MessageTerminator)
+	_ = d.Set("disable_parser", obj.DisableParser)
+	if obj.MaxEgressBuffer != nil {
+		_ = d.Set("max_egress_buffer", *obj.MaxEgressBuffer)
+	}
+	if obj.MaxMessageSize != nil {
+		_ = d.Set("max_message_size", *obj.MaxMessageSize)
+	}
+	return nil
+}
+
+func resourceBigipLtmMessageRoutingProtocolProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_protocol_profile", name, "update", icontrolURI(uriMessageRoutingProtocol, name))
+
+	config := getMessageRoutingProtocolProfileConfig(d, &MessageRoutingProtocolProfile{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriMessageRoutingProtocol, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying message routing protocol profile (%s): %s", name, err))
+	}
+	return resourceBigipLtmMessageRoutingProtocolProfileRead(ctx, d, meta)
+}
+
+func resourceBigipLtmMessageRoutingProtocolProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_protocol_profile", name, "delete", icontrolURI(uriMessageRoutingProtocol, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriMessageRoutingProtocol, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getMessageRoutingProtocolProfileConfig(d *schema.ResourceData, config *MessageRoutingProtocolProfile) *MessageRoutingProtocolProfile {
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.MessageTerminator = d.Get("message_terminator").(string)
+	config.DisableParser = d.Get("disable_parser").(string)
+	config.MaxEgressBuffer = configuredIntPtr(d, "max_egress_buffer")
+	config.MaxMessageSize = configuredIntPtr(d, "max_message_size")
+	return config
+}
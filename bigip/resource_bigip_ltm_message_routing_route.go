@@ -0,0 +1,166 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriMessageRoutingRoute = "ltm/message-routing/generic/route"
+
+// MessageRoutingRoute mirrors the ltm message-routing generic route object.
+type MessageRoutingRoute struct {
+	Name               string   `json:"name,omitempty"`
+	Partition          string   `json:"partition,omitempty"`
+	FullPath           string   `json:"fullPath,omitempty"`
+	Description        string   `json:"description,omitempty"`
+	Peers              []string `json:"peers"`
+	PeerSelectionMode  string   `json:"peerSelectionMode,omitempty"`
+	DestinationAddress string   `json:"destinationAddress,omitempty"`
+	SourceAddress      string   `json:"sourceAddress,omitempty"`
+}
+
+func resourceBigipLtmMessageRoutingRoute() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmMessageRoutingRouteCreate,
+		ReadContext:   resourceBigipLtmMessageRoutingRouteRead,
+		UpdateContext: resourceBigipLtmMessageRoutingRouteUpdate,
+		DeleteContext: resourceBigipLtmMessageRoutingRouteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the route, in full path format e.g. /Common/mqtt-route",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"peers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateF5NameWithDirectory},
+				Description: "Peers the messages matching the route are sent to, in order",
+			},
+			"peer_selection_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "sequential",
+				ValidateFunc: validation.StringInSlice([]string{"ratio", "sequential"}, false),
+				Description:  "How a peer is selected: sequential, the first available peer, or ratio",
+			},
+			"destination_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Destination address of the messages matching the route",
+			},
+			"source_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Source address of the messages matching the route",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmMessageRoutingRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_route", name, "create", icontrolURI(uriMessageRoutingRoute, name))
+
+	config := getMessageRoutingRouteConfig(d, &MessageRoutingRoute{Name: name})
+	if err := checkRestReferences(client, uriMessageRoutingPeer, "peer", config.Peers...); err != nil {
+		return diag.FromErr(fmt.Errorf("message routing route (%s): %s", name, err))
+	}
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriMessageRoutingRoute)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating message routing route (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmMessageRoutingRouteRead(ctx, d, meta)
+}
+
+func resourceBigipLtmMessageRoutingRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_route", name, "read", icontrolURI(uriMessageRoutingRoute, name))
+
+	obj := &MessageRoutingRoute{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriMessageRoutingRoute, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "Message Routing Route not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("peers", obj.Peers)
+	_ = d.Set("peer_selection_mode", obj.PeerSelectionMode)
+	_ = d.Set("destination_address", obj.DestinationAddress)
+	_ = d.Set("source_address", obj.SourceAddress)
+	return nil
+}
+
+func resourceBigipLtmMessageRoutingRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_route", name, "update", icontrolURI(uriMessageRoutingRoute, name))
+
+	config := getMessageRoutingRouteConfig(d, &MessageRoutingRoute{})
+	if err := checkRestReferences(client, uriMessageRoutingPeer, "peer", config.Peers...); err != nil {
+		return diag.FromErr(fmt.Errorf("message routing route (%s): %s", name, err))
+	}
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriMessageRoutingRoute, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying message routing route (%s): %s", name, err))
+	}
+	return resourceBigipLtmMessageRoutingRouteRead(ctx, d, meta)
+}
+
+func resourceBigipLtmMessageRoutingRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_route", name, "delete", icontrolURI(uriMessageRoutingRoute, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriMessageRoutingRoute, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getMessageRoutingRouteConfig(d *schema.ResourceData, config *MessageRoutingRoute) *MessageRoutingRoute {
+	config.Description = d.Get("description").(string)
+	config.Peers = listToStringSlice(d.Get("peers").([]interface{}))
+	config.PeerSelectionMode = d.Get("peer_selection_mode").(string)
+	config.DestinationAddress = d.Get("destination_address").(string)
+	config.SourceAddress = d.Get("source_address").(string)
+	return config
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriMessageRoutingRouter = "ltm/message-routing/generic/router"
+
+// MessageRoutingRouterProfile mirrors the ltm message-routing generic router object.
+type MessageRoutingRouterProfile struct {
+	Name               string   `json:"name,omitempty"`
+	Partition          string   `json:"partition,omitempty"`
+	FullPath           string   `json:"fullPath,omitempty"`
+	DefaultsFrom       string   `json:"defaultsFrom,omitempty"`
+	Description        string   `json:"description,omitempty"`
+	Routes             []string `json:"routes"`
+	MaxPendingMessages *int     `json:"maxPendingMessages,omitempty"`
+	MaxPendingBytes    *int     `json:"maxPendingBytes,omitempty"`
+}
+
+func resourceBigipLtmMessageRoutingRouterProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmMessageRoutingRouterProfileCreate,
+		ReadContext:   resourceBigipLtmMessageRoutingRouterProfileRead,
+		UpdateContext: resourceBigipLtmMessageRoutingRouterProfileUpdate,
+		DeleteContext: resourceBigipLtmMessageRoutingRouterProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the router profile, in full path format e.g. /Common/mqtt-router",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the profile that you want to use as the parent profile",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined description",
+			},
+			"routes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateF5NameWithDirectory},
+				Description: "Static routes of the router, in order",
+			},
+			"max_pending_messages": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of messages held while waiting for a connection to a peer",
+			},
+			"max_pending_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of bytes held while waiting for a connection to a peer",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmMessageRoutingRouterProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_router_profile", name, "create", icontrolURI(uriMessageRoutingRouter, name))
+
+	config := getMessageRoutingRouterProfileConfig(d, &MessageRoutingRouterProfile{Name: name})
+	if err := checkRestReferences(client, uriMessageRoutingRoute, "route", config.Routes...); err != nil {
+		return diag.FromErr(fmt.Errorf("message routing router profile (%s): %s", name, err))
+	}
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriMessageRoutingRouter)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating message routing router profile (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmMessageRoutingRouterProfileRead(ctx, d, meta)
+}
+
+func resourceBigipLtmMessageRoutingRouterProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_router_profile", name, "read", icontrolURI(uriMessageRoutingRouter, name))
+
+	obj := &MessageRoutingRouterProfile{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriMessageRoutingRouter, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "Message Routing Router Profile not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("routes", obj.Routes)
+	if obj.MaxPendingMessages != nil {
+		_ = d.Set("max_pending_messages", *obj.MaxPendingMessages)
+	}
+	if obj.MaxPendingBytes != nil {
+		_ = d.Set("max_pending_bytes", *obj.MaxPendingBytes)
+	}
+	return nil
+}
+
+func resourceBigipLtmMessageRoutingRouterProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_router_profile", name, "update", icontrolURI(uriMessageRoutingRouter, name))
+
+	config := getMessageRoutingRouterProfileConfig(d, &MessageRoutingRouterProfile{})
+	if err := checkRestReferences(client, uriMessageRoutingRoute, "route", config.Routes...); err != nil {
+		return diag.FromErr(fmt.Errorf("message routing router profile (%s): %s", name, err))
+	}
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriMessageRoutingRouter, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying message routing router profile (%s): %s", name, err))
+	}
+	return resourceBigipLtmMessageRoutingRouterProfileRead(ctx, d, meta)
+}
+
+func resourceBigipLtmMessageRoutingRouterProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_router_profile", name, "delete", icontrolURI(uriMessageRoutingRouter, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriMessageRoutingRouter, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getMessageRoutingRouterProfileConfig(d *schema.ResourceData, config *MessageRoutingRouterProfile) *MessageRoutingRouterProfile {
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.Routes = listToStringSlice(d.Get("routes").([]interface{}))
+	config.MaxPendingMessages = configuredIntPtr(d, "max_pending_messages")
+	config.MaxPendingBytes = configuredIntPtr(d, "max_pending_bytes")
+	return config
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+
+package bigip
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBigipLtmMessageRoutingChain(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckRestEntityDestroyed("bigip_ltm_message_routing_router_profile", uriMessageRoutingRouter),
+			testCheckRestEntityDestroyed("bigip_ltm_message_routing_route", uriMessageRoutingRoute),
+			testCheckRestEntityDestroyed("bigip_ltm_message_routing_peer", uriMessageRoutingPeer),
+			testCheckRestEntityDestroyed("bigip_ltm_message_routing_transport_config", uriMessageRoutingTransportConfig),
+			testCheckRestEntityDestroyed("bigip_ltm_message_routing_protocol_profile", uriMessageRoutingProtocol),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipLtmMessageRoutingChainConfig("sequential"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckMessageRoutingExists(uriMessageRoutingRouter, "/Common/test-mr-router"),
+					resource.TestCheckResourceAttr("bigip_ltm_message_routing_protocol_profile.mqtt", "defaults_from", "/Common/genericmsg"),
+					resource.TestCheckResourceAttr("bigip_ltm_message_routing_transport_config.mqtt", "profiles.#", "2"),
+					resource.TestCheckResourceAttr("bigip_ltm_message_routing_peer.mqtt", "pool", "/Common/test-mr-pool"),
+					resource.TestCheckResourceAttr("bigip_ltm_message_routing_peer.mqtt", "connection_mode", "per-tmm"),
+					resource.TestCheckResourceAttr("bigip_ltm_message_routing_peer.mqtt", "number_of_connections", "2"),
+					resource.TestCheckResourceAttr("bigip_ltm_message_routing_route.mqtt", "peers.0", "/Common/test-mr-peer"),
+					resource.TestCheckResourceAttr("bigip_ltm_message_routing_route.mqtt", "peer_selection_mode", "sequential"),
+					resource.TestCheckResourceAttr("bigip_ltm_message_routing_router_profile.mqtt", "routes.0", "/Common/test-mr-route"),
+				),
+			},
+			{
+				Config: testAccBigipLtmMessageRoutingChainConfig("ratio"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_message_routing_route.mqtt", "peer_selection_mode", "ratio"),
+				),
+			},
+			{
+				ResourceName:      "bigip_ltm_message_routing_route.mqtt",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "bigip_ltm_message_routing_peer.mqtt",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmMessageRoutingRoute_missingPeer(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckRestEntityDestroyed("bigip_ltm_message_routing_route", uriMessageRoutingRoute),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "bigip_ltm_message_routing_route" "missing" {
+  name  = "/Common/test-mr-route-missing"
+  peers = ["/Common/test-mr-no-such-peer"]
+}
+`,
+				ExpectError: regexp.MustCompile("peer /Common/test-mr-no-such-peer not found"),
+			},
+		},
+	})
+}
+
+func testCheckMessageRoutingExists(collection, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &struct{}{}, restObjectPath(collection, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("%s %s not found", collection, name)
+		}
+		return nil
+	}
+}
+
+func testAccBigipLtmMessageRoutingChainConfig(peerSelectionMode string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_pool" "mqtt" {
+  name = "/Common/test-mr-pool"
+}
+resource "bigip_ltm_message_routing_protocol_profile" "mqtt" {
+  name          = "/Common/test-mr-generic"
+  defaults_from = "/Common/genericmsg"
+}
+resource "bigip_ltm_message_routing_transport_config" "mqtt" {
+  name     = "/Common/test-mr-transport"
+  profiles = ["/Common/tcp", bigip_ltm_message_routing_protocol_profile.mqtt.name]
+}
+resource "bigip_ltm_message_routing_peer" "mqtt" {
+  name                  = "/Common/test-mr-peer"
+  pool                  = bigip_ltm_pool.mqtt.name
+  transport_config      = bigip_ltm_message_routing_transport_config.mqtt.name
+  connection_mode       = "per-tmm"
+  number_of_connections = 2
+}
+resource "bigip_ltm_message_routing_route" "mqtt" {
+  name                = "/Common/test-mr-route"
+  peers               = [bigip_ltm_message_routing_peer.mqtt.name]
+  peer_selection_mode = "%s"
+}
+resource "bigip_ltm_message_routing_router_profile" "mqtt" {
+  name   = "/Common/test-mr-router"
+  routes = [bigip_ltm_message_routing_route.mqtt.name]
+}
+`, peerSelectionMode)
+}
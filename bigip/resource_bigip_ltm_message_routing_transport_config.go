@@ -0,0 +1,170 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriMessageRoutingTransportConfig = "ltm/message-routing/generic/transport-config"
+
+// MessageRoutingTransportConfig mirrors the ltm message-routing generic transport-config object.
+type MessageRoutingTransportConfig struct {
+	Name                     string   `json:"name,omitempty"`
+	Partition                string   `json:"partition,omitempty"`
+	FullPath                 string   `json:"fullPath,omitempty"`
+	Description              string   `json:"description,omitempty"`
+	Profiles                 []string `json:"profiles"`
+	Rules                    []string `json:"rules"`
+	SourceAddressTranslation struct {
+		Type string `json:"type,omitempty"`
+		Pool string `json:"pool,omitempty"`
+	} `json:"sourceAddressTranslation,omitempty"`
+}
+
+func resourceBigipLtmMessageRoutingTransportConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmMessageRoutingTransportConfigCreate,
+		ReadContext:   resourceBigipLtmMessageRoutingTransportConfigRead,
+		UpdateContext: resourceBigipLtmMessageRoutingTransportConfigUpdate,
+		DeleteContext: resourceBigipLtmMessageRoutingTransportConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the transport config, in full path format e.g. /Common/mqtt-transport",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"profiles": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateF5NameWithDirectory},
+				Description: "Profiles of the outgoing connections, e.g. a TCP profile and a generic protocol profile",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateF5NameWithDirectory},
+				Description: "iRules run on the outgoing connections, in order",
+			},
+			"source_address_translation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "none",
+				ValidateFunc: validation.StringInSlice([]string{"none", "automap", "snat"}, false),
+				Description:  "Source address translation of the outgoing connections: none, automap or snat",
+			},
+			"snat_pool": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "SNAT pool used when source_address_translation is snat",
+				ValidateFunc: validateF5NameWithDirectory,
+				RequiredWith: []string{"source_address_translation"},
+			},
+		},
+	}
+}
+
+func resourceBigipLtmMessageRoutingTransportConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_transport_config", name, "create", icontrolURI(uriMessageRoutingTransportConfig, name))
+
+	config := getMessageRoutingTransportConfigConfig(d, &MessageRoutingTransportConfig{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriMessageRoutingTransportConfig)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating message routing transport config (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmMessageRoutingTransportConfigRead(ctx, d, meta)
+}
+
+func resourceBigipLtmMessageRoutingTransportConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_transport_config", name, "read", icontrolURI(uriMessageRoutingTransportConfig, name))
+
+	obj := &MessageRoutingTransportConfig{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriMessageRoutingTransportConfig, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "Message Routing Transport Config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("profiles", obj.Profiles)
+	_ = d.Set("rules", obj.Rules)
+	snatType := obj.SourceAddressTranslation.Type
+	if snatType == "" {
+		snatType = "none"
+	}
+	_ = d.Set("source_address_translation", snatType)
+	_ = d.Set("snat_pool", obj.SourceAddressTranslation.Pool)
+	return nil
+}
+
+func resourceBigipLtmMessageRoutingTransportConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_transport_config", name, "update", icontrolURI(uriMessageRoutingTransportConfig, name))
+
+	config := getMessageRoutingTransportConfigConfig(d, &MessageRoutingTransportConfig{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriMessageRoutingTransportConfig, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying message routing transport config (%s): %s", name, err))
+	}
+	return resourceBigipLtmMessageRoutingTransportConfigRead(ctx, d, meta)
+}
+
+func resourceBigipLtmMessageRoutingTransportConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_message_routing_transport_config", name, "delete", icontrolURI(uriMessageRoutingTransportConfig, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriMessageRoutingTransportConfig, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getMessageRoutingTransportConfigConfig(d *schema.ResourceData, config *MessageRoutingTransportConfig) *MessageRoutingTransportConfig {
+	config.Description = d.Get("description").(string)
+	config.Profiles = setToStringSlice(d.Get("profiles").(*schema.Set))
+	config.Rules = listToStringSlice(d.Get("rules").([]interface{}))
+	config.SourceAddressTranslation.Type = d.Get("source_address_translation").(string)
+	if config.SourceAddressTranslation.Type == "snat" {
+		config.SourceAddressTranslation.Pool = d.Get("snat_pool").(string)
+	}
+	return config
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func notFoundHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested object was not found."}`)
+}
+
+func TestCheckRestReferences(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/message-routing/generic/peer/~Common~peer1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "name", r.URL.Query().Get("$select"))
+		_, _ = fmt.Fprintf(w, `{"name":"peer1"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/message-routing/generic/peer/~Common~peer2", notFoundHandler)
+	mux.HandleFunc("/mgmt/tm/ltm/message-routing/generic/peer/~Common~peer3", notFoundHandler)
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	assert.NoError(t, checkRestReferences(client, uriMessageRoutingPeer, "peer", "/Common/peer1", "", "none"))
+	assert.EqualError(t, checkRestReferences(client, uriMessageRoutingPeer, "peer", "/Common/peer2", "/Common/peer1", "/Common/peer3"),
+		"peer /Common/peer2, /Common/peer3 not found")
+}
+
+func TestResourceBigipLtmMessageRoutingRouteCreateMissingPeer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/message-routing/generic/peer/~Common~missing", notFoundHandler)
+	mux.HandleFunc("/mgmt/tm/ltm/message-routing/generic/route", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("route must not be created when a peer is missing")
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmMessageRoutingRoute().Schema, map[string]interface{}{
+		"name":  "/Common/route1",
+		"peers": []interface{}{"/Common/missing"},
+	})
+	diags := resourceBigipLtmMessageRoutingRouteCreate(context.Background(), d, client)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "message routing route (/Common/route1): peer /Common/missing not found", diags[0].Summary)
+	assert.Equal(t, "", d.Id())
+}
+
+func TestResourceBigipLtmMessageRoutingPeerUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/ltm/message-routing/generic/peer/~Common~peer1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPatch {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+		}
+		_, _ = fmt.Fprintf(w, `{"name":"peer1","connectionMode":"per-tmm","numberConnections":2,"ratio":1,"autoInitialization":"disabled","pool":"none","transportConfig":"none"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmMessageRoutingPeer().Schema, map[string]interface{}{
+		"name":                  "/Common/peer1",
+		"connection_mode":       "per-tmm",
+		"number_of_connections": 2,
+	})
+	d.SetId("/Common/peer1")
+	diags := resourceBigipLtmMessageRoutingPeerUpdate(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "none", body["pool"])
+	assert.Equal(t, "none", body["transportConfig"])
+	assert.Equal(t, "per-tmm", body["connectionMode"])
+	assert.Equal(t, float64(2), body["numberConnections"])
+	assert.Equal(t, "", d.Get("pool"))
+	assert.Equal(t, "", d.Get("transport_config"))
+}
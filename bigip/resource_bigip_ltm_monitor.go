@@ -11,11 +11,14 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var parentMonitors = map[string]bool{
@@ -32,8 +35,28 @@ var parentMonitors = map[string]bool{
 	"/Common/ftp":           true,
 	"/Common/ldap":          true,
 	"/Common/smtp":          true,
+	"/Common/sip":           true,
 }
 
+// monitorParentAttributes are the attributes only the monitors with one of the listed parents use.
+var monitorParentAttributes = map[string][]string{
+	"database":             {"/Common/mysql", "/Common/postgresql", "/Common/mssql"},
+	"db_count":             {"/Common/mysql", "/Common/postgresql", "/Common/mssql"},
+	"receive_row":          {"/Common/mysql", "/Common/postgresql", "/Common/mssql"},
+	"receive_column":       {"/Common/mysql", "/Common/postgresql", "/Common/mssql"},
+	"base":                 {"/Common/ldap"},
+	"mandatory_attributes": {"/Common/ldap"},
+	"chase_referrals":      {"/Common/ldap"},
+	"security":             {"/Common/ldap"},
+	"filter":               {"/Common/ldap", "/Common/sip"},
+	"filter_negative":      {"/Common/sip"},
+	"headers":              {"/Common/sip"},
+	"request":              {"/Common/sip"},
+}
+
+// sipMonitorModes are the protocols a /Common/sip monitor can check the target with.
+var sipMonitorModes = []string{"udp", "tcp", "tls", "sips"}
+
 func resourceBigipLtmMonitor() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceBigipLtmMonitorCreate,
@@ -57,7 +80,18 @@ func resourceBigipLtmMonitor() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validateParent,
 				ForceNew:     true,
-				Description:  "Existing monitor to inherit from. Must be one of /Common/http, /Common/https, /Common/icmp, /Common/gateway_icmp or /Common/tcp_half_open or /Common/smtp.",
+				Description:  "Existing monitor to inherit from. Must be one of /Common/http, /Common/https, /Common/icmp, /Common/gateway_icmp, /Common/tcp, /Common/tcp_half_open, /Common/udp, /Common/ftp, /Common/smtp, /Common/mysql, /Common/postgresql, /Common/mssql, /Common/ldap or /Common/sip.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description of the monitor",
+			},
+			"app_service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The application service (iApp) the object belongs to. Read from the BIG-IP when not configured, so adopted iApp objects keep their owner",
 			},
 			"custom_parent": {
 				Type:        schema.TypeString,
@@ -88,8 +122,9 @@ func resourceBigipLtmMonitor() *schema.Resource {
 				Computed:    true,
 				Description: "Specifies the text string that the monitor sends to the target object.",
 				StateFunc: func(s interface{}) string {
-					return strings.ReplaceAll(s.(string), "\r\n", "\\r\\n")
+					return monitorSendString(s.(string))
 				},
+				DiffSuppressFunc: suppressMonitorSendDiff,
 			},
 			"receive": {
 				Type:        schema.TypeString,
@@ -138,10 +173,11 @@ func resourceBigipLtmMonitor() *schema.Resource {
 				Description: "Specifies the number of seconds to wait after a resource first responds correctly to the monitor before setting the resource to up.",
 			},
 			"destination": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Alias for the destination",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Alias for the destination. For /Common/icmp and /Common/gateway_icmp monitors only the address is used, e.g. 10.10.10.10",
+				DiffSuppressFunc: suppressIcmpMonitorDestinationDiff,
 			},
 			"compatibility": {
 				Type:     schema.TypeString,
@@ -160,7 +196,7 @@ func resourceBigipLtmMonitor() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "Specifies the data transfer process (DTP) mode. The default value is passive.",
+				Description: "Specifies the data transfer process (DTP) mode of /Common/ftp monitors, the default value is passive, or the protocol of /Common/sip monitors, one of udp, tcp, tls or sips.",
 			},
 			"adaptive": {
 				Type:        schema.TypeString,
@@ -175,10 +211,11 @@ func resourceBigipLtmMonitor() *schema.Resource {
 				Description: "Specifies the absolute number of milliseconds that may not be exceeded by a monitor probe, regardless of Allowed Divergence",
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "Specifies the password if the monitored target requires authentication",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressObfuscatedSecretDiff,
+				Description:      "Specifies the password if the monitored target requires authentication",
 			},
 			"username": {
 				Type:        schema.TypeString,
@@ -190,6 +227,25 @@ func resourceBigipLtmMonitor() *schema.Resource {
 				Optional:    true,
 				Description: "the database in which your user is created",
 			},
+			"db_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Specifies the number of health checks a database monitor runs on the same connection before it opens a new one, 0 keeps the connection open",
+			},
+			"receive_row": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the row of the query result a database monitor matches receive against",
+			},
+			"receive_column": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the column of the query result a database monitor matches receive against",
+			},
 
 			"ssl_profile": {
 				Type:        schema.TypeString,
@@ -205,12 +261,28 @@ func resourceBigipLtmMonitor() *schema.Resource {
 			"filter": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Specifies an LDAP key for which the monitor searches",
+				Description: "Specifies an LDAP key for which the monitor searches, or the SIP status codes a /Common/sip monitor marks the target up for",
 			},
-			"mandatory_attributes": {
+			"filter_negative": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the SIP status codes a /Common/sip monitor marks the target down for",
+			},
+			"headers": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the headers a /Common/sip monitor adds to its request",
+			},
+			"request": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Specifies whether the target must include attributes in its response to be considered up. The options are no (Specifies that the system performs only a one-level search (based on the Filter setting), and does not require that the target returns any attributes.) and yes (Specifies that the system performs a sub-tree search, and if the target returns no attributes, the target is considered down.)",
+				Description: "Specifies the SIP request line a /Common/sip monitor sends, e.g. OPTIONS",
+			},
+			"mandatory_attributes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+				Description:  "Specifies whether the target must include attributes in its response to be considered up. The options are no (Specifies that the system performs only a one-level search (based on the Filter setting), and does not require that the target returns any attributes.) and yes (Specifies that the system performs a sub-tree search, and if the target returns no attributes, the target is considered down.)",
 			},
 			"chase_referrals": {
 				Type:        schema.TypeString,
@@ -219,10 +291,12 @@ func resourceBigipLtmMonitor() *schema.Resource {
 				Description: "Specifies whether the system will query the LDAP servers pointed to by any referrals in the query results.",
 			},
 			"security": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Specifies the secure communications protocol that the monitor uses to communicate with the target. The options are none (Specifies that the system does not use a security protocol for communications with the target.), ssl (Specifies that the system uses the SSL protocol for communications with the target.), and tls (Specifies that the system uses the TLS protocol for communications with the target.)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "ssl", "tls"}, false),
+				Description:  "Specifies the secure communications protocol that the monitor uses to communicate with the target. The options are none (Specifies that the system does not use a security protocol for communications with the target.), ssl (Specifies that the system uses the SSL protocol for communications with the target.), and tls (Specifies that the system uses the TLS protocol for communications with the target.)",
 			},
+			"force_delete": forceDeleteSchema("removes the monitor from the monitor rules of the pools and nodes using it"),
 		},
 	}
 }
@@ -233,6 +307,12 @@ func resourceBigipLtmMonitorCreate(ctx context.Context, d *schema.ResourceData,
 	parent := monitorParent(d.Get("parent").(string))
 
 	log.Println("[INFO] Creating LTM Monitor " + name + " :: " + parent)
+	if err := checkIcmpMonitorAttributes(d); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkMonitorParentAttributes(d); err != nil {
+		return diag.FromErr(err)
+	}
 	pss := &bigip.Monitor{
 		Name: name,
 	}
@@ -253,6 +333,12 @@ func resourceBigipLtmMonitorCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(name)
+	if err := setSipMonitorSettings(d, client, restObjectPath("ltm/monitor/"+parent, name)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setLtmObjectMeta(d, client, restObjectPath("ltm/monitor/"+parent, name), "description", "app_service"); err != nil {
+		return diag.FromErr(err)
+	}
 	return resourceBigipLtmMonitorRead(ctx, d, meta)
 }
 
@@ -267,7 +353,7 @@ func resourceBigipLtmMonitorRead(ctx context.Context, d *schema.ResourceData, me
 	re := regexp.MustCompile("/.*/https$")
 	matchresult := re.MatchString(parentMonitor)
 
-	monitors, err := client.Monitors()
+	monitors, err := listLtmMonitors(client)
 	if err != nil {
 		log.Printf("[ERROR] Unable to retrieve Monitor (%s) (%v) ", name, err)
 		return diag.FromErr(err)
@@ -311,15 +397,35 @@ func resourceBigipLtmMonitorRead(ctx context.Context, d *schema.ResourceData, me
 			_ = d.Set("adaptive", m.Adaptive)
 			_ = d.Set("adaptive_limit", m.AdaptiveLimit)
 			_ = d.Set("username", m.Username)
-			_ = d.Set("password", m.Password)
+			setSecret(d, "password", m.Password)
 			_ = d.Set("name", name)
 			_ = d.Set("database", m.Database)
+			if count, err := strconv.Atoi(m.Count); err == nil {
+				_ = d.Set("db_count", count)
+			}
+			_ = d.Set("receive_row", m.RecvRow)
+			_ = d.Set("receive_column", m.RecvColumn)
 
 			_ = d.Set("base", m.Base)
 			_ = d.Set("filter", m.Filter)
 			_ = d.Set("mandatory_attributes", m.MandatoryAttributes)
 			_ = d.Set("chase_referrals", m.ChaseReferrals)
 			_ = d.Set("security", m.Security)
+			_ = d.Set("description", m.Description)
+			monitorMeta, err := getLtmObjectMeta(client, restObjectPath("ltm/monitor/"+monitorURIType(d.Get("parent").(string)), name))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			_ = d.Set("app_service", monitorMeta.AppService)
+			if d.Get("parent").(string) == "/Common/sip" {
+				sip := &sipMonitorSettings{}
+				if _, err := getRestEntity(client, sip, restObjectPath("ltm/monitor/sip", name)); err != nil {
+					return diag.FromErr(err)
+				}
+				_ = d.Set("filter_negative", sip.FilterNeg)
+				_ = d.Set("headers", sip.Headers)
+				_ = d.Set("request", sip.Request)
+			}
 			return nil
 		}
 	}
@@ -334,6 +440,12 @@ func resourceBigipLtmMonitorUpdate(ctx context.Context, d *schema.ResourceData,
 	pss := &bigip.Monitor{
 		Name: name,
 	}
+	if err := checkIcmpMonitorAttributes(d); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkMonitorParentAttributes(d); err != nil {
+		return diag.FromErr(err)
+	}
 	config := getLtmMonitorConfig(d, pss)
 
 	parent := monitorParent(d.Get("parent").(string))
@@ -350,6 +462,12 @@ func resourceBigipLtmMonitorUpdate(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[ERROR] Unable to Update Monitor (%s) (%v) ", name, err)
 		return diag.FromErr(err)
 	}
+	if err := setSipMonitorSettings(d, client, restObjectPath("ltm/monitor/"+parent, name)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setLtmObjectMeta(d, client, restObjectPath("ltm/monitor/"+parent, name), "description", "app_service"); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return resourceBigipLtmMonitorRead(ctx, d, meta)
 }
@@ -358,6 +476,18 @@ func resourceBigipLtmMonitorDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
 	parent := monitorParent(d.Get("parent").(string))
+	referrers, err := monitorReferrers(client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(referrers) > 0 {
+		if !d.Get("force_delete").(bool) {
+			return referencedObjectError("monitor", name, referrers, true)
+		}
+		if err := detachMonitor(ctx, client, name, referrers); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	log.Println("[INFO] Deleting monitor " + name + "::" + parent)
 
 	if strings.Contains(parent, "gateway") {
@@ -367,10 +497,10 @@ func resourceBigipLtmMonitorDelete(ctx context.Context, d *schema.ResourceData,
 		parent = "tcp-half-open"
 	}
 
-	err := client.DeleteMonitor(name, parent)
+	err = client.DeleteMonitor(name, parent)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Delete Monitor (%s) (%v) ", name, err)
-		return diag.FromErr(err)
+		return diag.FromErr(fmt.Errorf("%v. The references from %s are not checked before the delete", err, monitorUncheckedReferences))
 	}
 	d.SetId("")
 	return nil
@@ -382,13 +512,148 @@ func validateParent(v interface{}, k string) ([]string, []error) {
 		return nil, nil
 	}
 
-	return nil, []error{fmt.Errorf("parent must be one of /Common/udp, /Common/postgresql, /Common/mysql,/Common/mssql, /Common/http, /Common/https, /Common/icmp, /Common/gateway_icmp, /Common/tcp_half_open, /Common/tcp, /Common/ftp. /Common/smtp")}
+	parents := make([]string, 0, len(parentMonitors))
+	for parent := range parentMonitors {
+		parents = append(parents, parent)
+	}
+	sort.Strings(parents)
+	return nil, []error{fmt.Errorf("parent must be one of %s", strings.Join(parents, ", "))}
 }
 
 func monitorParent(s string) string {
 	return strings.TrimPrefix(s, "/Common/")
 }
 
+// monitorURIType returns the monitor type used in the iControl REST path of a monitor with parent.
+func monitorURIType(parent string) string {
+	parent = monitorParent(parent)
+	if strings.Contains(parent, "gateway") {
+		return "gateway-icmp"
+	}
+	if strings.Contains(parent, "tcp_half_open") {
+		return "tcp-half-open"
+	}
+	return parent
+}
+
+// ltmMonitorTypes are the monitor types listLtmMonitors looks a monitor up in.
+var ltmMonitorTypes = []string{"http", "https", "icmp", "gateway-icmp", "tcp", "tcp-half-open", "ftp", "udp", "postgresql", "mysql", "mssql", "ldap", "smtp", "sip"}
+
+// listLtmMonitors returns the monitors of every type in ltmMonitorTypes, across the pages of each
+// collection.
+func listLtmMonitors(client *bigip.BigIP) ([]bigip.Monitor, error) {
+	var monitors []bigip.Monitor
+	for _, monitorType := range ltmMonitorTypes {
+		items, err := getRestItems[bigip.Monitor](client, "ltm/monitor/"+monitorType)
+		if err != nil {
+			return nil, err
+		}
+		monitors = append(monitors, items...)
+	}
+	return monitors, nil
+}
+
+// isIcmpMonitor reports whether parent is an ICMP based monitor, which only pings an address.
+func isIcmpMonitor(parent string) bool {
+	return parent == "/Common/icmp" || parent == "/Common/gateway_icmp"
+}
+
+// checkIcmpMonitorAttributes rejects the attributes an ICMP based monitor cannot use: the send and
+// receive strings and a destination port.
+func checkIcmpMonitorAttributes(d *schema.ResourceData) error {
+	parent := d.Get("parent").(string)
+	if !isIcmpMonitor(parent) {
+		return nil
+	}
+	for _, attr := range []string{"send", "receive", "receive_disable"} {
+		if monitorAttributeConfigured(d, attr) {
+			return fmt.Errorf("%s is not applicable to %s monitors", attr, parent)
+		}
+	}
+	destination := d.Get("destination").(string)
+	if i := strings.LastIndex(destination, ":"); i >= 0 && strings.Count(destination, ":") == 1 && destination[i+1:] != "*" {
+		return fmt.Errorf("destination of %s monitors is an address only, got %s", parent, destination)
+	}
+	return nil
+}
+
+// monitorAttributeConfigured reports whether attr is set in the configuration. The computed
+// attributes, like send, hold the value read from the BIG-IP otherwise, so only the configuration
+// tells whether they were set.
+func monitorAttributeConfigured(d *schema.ResourceData, attr string) bool {
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+		return !rawConfig.GetAttr(attr).IsNull()
+	}
+	_, set := d.GetOk(attr)
+	return set
+}
+
+// checkMonitorParentAttributes rejects the attributes of monitorParentAttributes configured on a
+// monitor with another parent, and a mode a /Common/sip monitor does not support.
+func checkMonitorParentAttributes(d *schema.ResourceData) error {
+	parent := d.Get("parent").(string)
+	attrs := make([]string, 0, len(monitorParentAttributes))
+	for attr := range monitorParentAttributes {
+		attrs = append(attrs, attr)
+	}
+	sort.Strings(attrs)
+	for _, attr := range attrs {
+		parents := monitorParentAttributes[attr]
+		if monitorAttributeConfigured(d, attr) && !contains(parents, parent) {
+			return fmt.Errorf("%s is only applicable to %s monitors, not %s", attr, strings.Join(parents, ", "), parent)
+		}
+	}
+	if mode := d.Get("mode").(string); parent == "/Common/sip" && monitorAttributeConfigured(d, "mode") && !contains(sipMonitorModes, mode) {
+		return fmt.Errorf("mode of %s monitors must be one of %s, got %s", parent, strings.Join(sipMonitorModes, ", "), mode)
+	}
+	return nil
+}
+
+// sipMonitorSettings are the attributes of a /Common/sip monitor the bigip.Monitor type does not hold.
+type sipMonitorSettings struct {
+	FilterNeg string `json:"filterNeg,omitempty"`
+	Headers   string `json:"headers,omitempty"`
+	Request   string `json:"request,omitempty"`
+}
+
+// setSipMonitorSettings PATCHes the filter_negative, headers and request attributes of a /Common/sip
+// monitor onto the monitor at path, when they are configured or changed.
+func setSipMonitorSettings(d *schema.ResourceData, client *bigip.BigIP, path string) error {
+	if d.Get("parent").(string) != "/Common/sip" {
+		return nil
+	}
+	payload := make(map[string]string)
+	for attr, key := range map[string]string{"filter_negative": "filterNeg", "headers": "headers", "request": "request"} {
+		if value := d.Get(attr).(string); value != "" || d.HasChange(attr) {
+			payload[key] = value
+		}
+	}
+	if len(payload) == 0 {
+		return nil
+	}
+	return patchRestEntity(client, payload, path)
+}
+
+// icmpMonitorDestination returns destination in the format the BIG-IP uses for the monitor type:
+// an address for icmp and address:* for gateway_icmp. Other monitors are returned as they are.
+func icmpMonitorDestination(parent, destination string) string {
+	if !isIcmpMonitor(parent) || destination == "" {
+		return destination
+	}
+	address := strings.TrimSuffix(destination, ":*")
+	if parent == "/Common/gateway_icmp" {
+		return address + ":*"
+	}
+	return address
+}
+
+// suppressIcmpMonitorDestinationDiff ignores the port wildcard of ICMP monitor destinations, so that
+// 10.10.10.10 and 10.10.10.10:* are the same destination.
+func suppressIcmpMonitorDestinationDiff(k, old, new string, d *schema.ResourceData) bool {
+	parent := d.Get("parent").(string)
+	return isIcmpMonitor(parent) && new != "" && icmpMonitorDestination(parent, old) == icmpMonitorDestination(parent, new)
+}
+
 func getLtmMonitorConfig(d *schema.ResourceData, config *bigip.Monitor) *bigip.Monitor {
 	config.ParentMonitor = d.Get("parent").(string)
 	if _, ok := d.GetOk("custom_parent"); ok {
@@ -398,7 +663,12 @@ func getLtmMonitorConfig(d *schema.ResourceData, config *bigip.Monitor) *bigip.M
 	config.AdaptiveLimit = d.Get("adaptive_limit").(int)
 	config.Compatibility = d.Get("compatibility").(string)
 	config.Database = d.Get("database").(string)
-	config.Destination = d.Get("destination").(string)
+	if monitorAttributeConfigured(d, "db_count") {
+		config.Count = strconv.Itoa(d.Get("db_count").(int))
+	}
+	config.RecvRow = d.Get("receive_row").(string)
+	config.RecvColumn = d.Get("receive_column").(string)
+	config.Destination = icmpMonitorDestination(d.Get("parent").(string), d.Get("destination").(string))
 	config.Interval = d.Get("interval").(int)
 	config.IPDSCP = d.Get("ip_dscp").(int)
 	config.Mode = d.Get("mode").(string)
@@ -406,7 +676,7 @@ func getLtmMonitorConfig(d *schema.ResourceData, config *bigip.Monitor) *bigip.M
 	config.ReceiveDisable = d.Get("receive_disable").(string)
 	config.ReceiveString = d.Get("receive").(string)
 	config.Reverse = d.Get("reverse").(string)
-	config.SendString = d.Get("send").(string)
+	config.SendString = monitorSendString(d.Get("send").(string))
 	config.Timeout = d.Get("timeout").(int)
 	config.TimeUntilUp = d.Get("time_until_up").(int)
 	config.ManualResume = d.Get("manual_resume").(string)
@@ -422,3 +692,37 @@ func getLtmMonitorConfig(d *schema.ResourceData, config *bigip.Monitor) *bigip.M
 	config.Security = d.Get("security").(string)
 	return config
 }
+
+// monitorSendString writes the CR and LF characters of s as the \r and \n escapes the BIG-IP keeps
+// in a send string. Escapes already in s are left alone, so the string is never escaped twice.
+func monitorSendString(s string) string {
+	return strings.NewReplacer("\r", `\r`, "\n", `\n`).Replace(s)
+}
+
+// canonicalMonitorString expands the \r and \n escapes of s. Any other escape, like an escaped
+// backslash, is kept as written.
+func canonicalMonitorString(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			switch s[i+1] {
+			case 'r':
+				b.WriteByte('\r')
+			case 'n':
+				b.WriteByte('\n')
+			default:
+				b.WriteString(s[i : i+2])
+			}
+			i++
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// suppressMonitorSendDiff ignores the difference between a send string and the same string with
+// its line breaks escaped, as the device may return either form.
+func suppressMonitorSendDiff(k, old, new string, d *schema.ResourceData) bool {
+	return canonicalMonitorString(old) == canonicalMonitorString(new)
+}
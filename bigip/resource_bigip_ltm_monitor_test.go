@@ -8,6 +8,7 @@ package bigip
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	bigip "github.com/f5devcentral/go-bigip"
@@ -23,6 +24,8 @@ var TestFtpMonitorName = fmt.Sprintf("/%s/test-ftp-monitor", TestPartition)
 var TestUdpMonitorName = fmt.Sprintf("/%s/test-udp-monitor", TestPartition)
 var TestPostgresqlMonitorName = fmt.Sprintf("/%s/test-postgresql-monitor", TestPartition)
 var TestLDAPMonitorName = fmt.Sprintf("/%s/test-ldap-monitor", TestPartition)
+var TestMysqlMonitorName = fmt.Sprintf("/%s/test-mysql-monitor", TestPartition)
+var TestSipMonitorName = fmt.Sprintf("/%s/test-sip-monitor", TestPartition)
 var TestGatewayIcmpMonitorName = fmt.Sprintf("/%s/test-gateway", TestPartition)
 var TestTcpHalfOpenMonitorName = fmt.Sprintf("/%s/test-tcp-half-open", TestPartition)
 
@@ -109,6 +112,31 @@ resource "bigip_ltm_monitor" "test-ldap-monitor" {
     security          = "ssl"
 }
 `
+var TestMysqlMonitorResource = `
+resource "bigip_ltm_monitor" "test-mysql-monitor" {
+    name           = "` + TestMysqlMonitorName + `"
+    parent         = "/Common/mysql"
+    send           = "SELECT 1"
+    receive        = "1"
+    database       = "app"
+    username       = "monitor"
+    password       = "m0n1t0r"
+    db_count       = 5
+    receive_row    = "1"
+    receive_column = "1"
+}
+`
+
+var TestSipMonitorResource = `
+resource "bigip_ltm_monitor" "test-sip-monitor" {
+    name            = "` + TestSipMonitorName + `"
+    parent          = "/Common/sip"
+    mode            = "tcp"
+    filter          = "200"
+    filter_negative = "503"
+    request         = "OPTIONS sip:monitor@example.com SIP/2.0"
+}
+`
 var TestGatewayIcmpMonitorResource = `
 resource "bigip_ltm_monitor" "test-gateway-icmp-monitor" {
   name        = "` + TestGatewayIcmpMonitorName + `"
@@ -151,6 +179,74 @@ func TestAccBigipLtmMonitor_GatewayIcmpCreate(t *testing.T) {
 	})
 }
 
+var TestGatewayIcmpPoolResource = `
+resource "bigip_ltm_monitor" "test-gateway-icmp-monitor" {
+  name        = "` + TestGatewayIcmpMonitorName + `"
+  parent      = "/Common/gateway_icmp"
+  timeout     = "16"
+  interval    = "5"
+  destination = "10.10.10.1"
+}
+
+resource "bigip_ltm_pool" "test-gateway-pool" {
+  name                = "/Common/test-gateway-pool"
+  load_balancing_mode = "round-robin"
+  monitors            = [bigip_ltm_monitor.test-gateway-icmp-monitor.name]
+}
+
+resource "bigip_ltm_pool_attachment" "test-gateway-router" {
+  pool = bigip_ltm_pool.test-gateway-pool.name
+  node = "10.10.10.1:0"
+}
+`
+
+func TestAccBigipLtmMonitor_GatewayIcmpPool(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testMonitorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestGatewayIcmpPoolResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckMonitorExists(TestGatewayIcmpMonitorName),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-gateway-icmp-monitor", "destination", "10.10.10.1:*"),
+					resource.TestCheckTypeSetElemAttr("bigip_ltm_pool.test-gateway-pool", "monitors.*", TestGatewayIcmpMonitorName),
+					testCheckPoolAttachment("/Common/test-gateway-pool", "/Common/10.10.10.1:0", true),
+				),
+			},
+			{
+				Config:   TestGatewayIcmpPoolResource,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmMonitor_IcmpSendRejected(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testMonitorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "bigip_ltm_monitor" "test-icmp-monitor" {
+  name   = "/Common/test-icmp-send"
+  parent = "/Common/icmp"
+  send   = "GET /\r\n"
+}
+`,
+				ExpectError: regexp.MustCompile("send is not applicable to /Common/icmp monitors"),
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmMonitor_TcpHalfOpenCreate(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -199,6 +295,40 @@ func TestAccBigipLtmMonitor_HttpCreate(t *testing.T) {
 		},
 	})
 }
+
+var TestMonitorHeadersResource = `
+resource "bigip_ltm_monitor" "test-monitor" {
+	name    = "` + TestMonitorName + `"
+	parent  = "/Common/http"
+	send    = "GET /health HTTP/1.1\r\nHost: app\r\nConnection: Close\r\n\r\n"
+	receive = "^HTTP/1\\.[01] (200|302)\\s.*$"
+}
+`
+
+func TestAccBigipLtmMonitor_sendHeaders(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testMonitorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestMonitorHeadersResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckMonitorExists(TestMonitorName),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-monitor", "send", `GET /health HTTP/1.1\r\nHost: app\r\nConnection: Close\r\n\r\n`),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-monitor", "receive", `^HTTP/1\.[01] (200|302)\s.*$`),
+				),
+			},
+			{
+				Config:   TestMonitorHeadersResource,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmMonitor_create(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -228,6 +358,49 @@ func TestAccBigipLtmMonitor_create(t *testing.T) {
 		},
 	})
 }
+func TestAccBigipLtmMonitor_description(t *testing.T) {
+	var instance testAccInstance
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testMonitorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipLtmMonitorDescriptionConfig(5),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckMonitorExists(TestMonitorName),
+					testCheckResourceNotReplaced("bigip_ltm_monitor.test-monitor", &instance),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-monitor", "description", "checks /health"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-monitor", "app_service", ""),
+				),
+			},
+			{
+				Config: testAccBigipLtmMonitorDescriptionConfig(10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceNotReplaced("bigip_ltm_monitor.test-monitor", &instance),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-monitor", "interval", "10"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-monitor", "description", "checks /health"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBigipLtmMonitorDescriptionConfig(interval int) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_monitor" "test-monitor" {
+  name        = "%s"
+  parent      = "/Common/http"
+  send        = "GET /health\r\n"
+  interval    = %d
+  timeout     = 31
+  description = "checks /health"
+}
+`, TestMonitorName, interval)
+}
+
 func TestAccBigipLtmMonitor_HttpsCreate(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -328,6 +501,60 @@ func TestAccBigipLtmMonitor_LDAPCreate(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmMonitor_MysqlCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testMonitorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestMysqlMonitorResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckMonitorExists(TestMysqlMonitorName),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-mysql-monitor", "parent", "/Common/mysql"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-mysql-monitor", "database", "app"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-mysql-monitor", "db_count", "5"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-mysql-monitor", "receive_row", "1"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-mysql-monitor", "receive_column", "1"),
+				),
+			},
+			{
+				Config:   TestMysqlMonitorResource,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmMonitor_SipCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testMonitorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestSipMonitorResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckMonitorExists(TestSipMonitorName),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-sip-monitor", "parent", "/Common/sip"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-sip-monitor", "mode", "tcp"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-sip-monitor", "filter", "200"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-sip-monitor", "filter_negative", "503"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-sip-monitor", "request", "OPTIONS sip:monitor@example.com SIP/2.0"),
+				),
+			},
+			{
+				Config:   TestSipMonitorResource,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmMonitor_PostgresqlCreate(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -351,6 +578,38 @@ func TestAccBigipLtmMonitor_PostgresqlCreate(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmMonitor_PasswordNoDiff(t *testing.T) {
+	config := `
+resource "bigip_ltm_monitor" "test-postgresql-monitor" {
+        name = "` + TestPostgresqlMonitorName + `"
+        parent = "/Common/postgresql"
+        database = "postgres"
+        username = "monitor"
+        password = "m0n1t0r"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testMonitorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckMonitorExists(TestPostgresqlMonitorName),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-postgresql-monitor", "password", "m0n1t0r"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmMonitorTestCases(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
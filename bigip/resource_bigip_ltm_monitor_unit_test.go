@@ -0,0 +1,339 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitorSendString(t *testing.T) {
+	send := "GET /health HTTP/1.1\r\nHost: app\r\nConnection: Close\r\n\r\n"
+	escaped := `GET /health HTTP/1.1\r\nHost: app\r\nConnection: Close\r\n\r\n`
+	assert.Equal(t, escaped, monitorSendString(send))
+	// already escaped strings are sent as written
+	assert.Equal(t, escaped, monitorSendString(escaped))
+	assert.Equal(t, "GET /\n", canonicalMonitorString(`GET /\n`))
+	assert.Equal(t, send, canonicalMonitorString(escaped))
+}
+
+func TestSuppressMonitorSendDiff(t *testing.T) {
+	assert.True(t, suppressMonitorSendDiff("send", `GET /\r\n`, "GET /\r\n", nil))
+	assert.True(t, suppressMonitorSendDiff("send", `GET /health HTTP/1.1\r\nHost: app\r\n\r\n`, "GET /health HTTP/1.1\r\nHost: app\r\n\r\n", nil))
+	assert.False(t, suppressMonitorSendDiff("send", `GET /\\r\\n`, `GET /\r\n`, nil))
+	assert.False(t, suppressMonitorSendDiff("send", `GET /\r\n`, `GET /other\r\n`, nil))
+}
+
+func TestLtmMonitorConfigPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmMonitor().Schema, map[string]interface{}{
+		"name":    "/Common/test-monitor",
+		"parent":  "/Common/http",
+		"send":    "GET /health HTTP/1.1\r\nHost: app\r\nConnection: Close\r\n\r\n",
+		"receive": `^HTTP/1\.[01] (200|302)\s.*$`,
+	})
+	config := getLtmMonitorConfig(d, &bigip.Monitor{Name: "/Common/test-monitor"})
+	payload, err := json.Marshal(config)
+	assert.NoError(t, err)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(payload, &body))
+	// the device receives each line break escaped once
+	assert.Equal(t, `GET /health HTTP/1.1\r\nHost: app\r\nConnection: Close\r\n\r\n`, body["send"])
+	// regex metacharacters of the receive string are left as they are
+	assert.Equal(t, `^HTTP/1\.[01] (200|302)\s.*$`, body["recv"])
+}
+
+func TestIcmpMonitorDestination(t *testing.T) {
+	assert.Equal(t, "10.10.10.10", icmpMonitorDestination("/Common/icmp", "10.10.10.10:*"))
+	assert.Equal(t, "10.10.10.10", icmpMonitorDestination("/Common/icmp", "10.10.10.10"))
+	assert.Equal(t, "10.10.10.10:*", icmpMonitorDestination("/Common/gateway_icmp", "10.10.10.10"))
+	assert.Equal(t, "10.10.10.10:*", icmpMonitorDestination("/Common/gateway_icmp", "10.10.10.10:*"))
+	assert.Equal(t, "10.10.10.10:80", icmpMonitorDestination("/Common/http", "10.10.10.10:80"))
+	assert.Equal(t, "", icmpMonitorDestination("/Common/icmp", ""))
+}
+
+func TestCheckIcmpMonitorAttributes(t *testing.T) {
+	cases := []struct {
+		config map[string]interface{}
+		err    string
+	}{
+		{map[string]interface{}{"parent": "/Common/gateway_icmp", "destination": "10.10.10.10"}, ""},
+		{map[string]interface{}{"parent": "/Common/gateway_icmp", "destination": "10.10.10.10:*"}, ""},
+		{map[string]interface{}{"parent": "/Common/icmp", "destination": "10.10.10.10:80"}, "destination of /Common/icmp monitors is an address only, got 10.10.10.10:80"},
+		{map[string]interface{}{"parent": "/Common/icmp", "receive": "up"}, "receive is not applicable to /Common/icmp monitors"},
+		{map[string]interface{}{"parent": "/Common/http", "destination": "10.10.10.10:80", "receive": "200"}, ""},
+	}
+	for _, c := range cases {
+		c.config["name"] = "/Common/test-monitor"
+		d := schema.TestResourceDataRaw(t, resourceBigipLtmMonitor().Schema, c.config)
+		err := checkIcmpMonitorAttributes(d)
+		if c.err == "" {
+			assert.NoError(t, err, "%v", c.config)
+		} else {
+			assert.EqualError(t, err, c.err)
+		}
+	}
+}
+
+func TestCheckMonitorParentAttributes(t *testing.T) {
+	cases := []struct {
+		config map[string]interface{}
+		err    string
+	}{
+		{map[string]interface{}{"parent": "/Common/mysql", "database": "app", "db_count": 5, "receive_row": "1", "receive_column": "1"}, ""},
+		{map[string]interface{}{"parent": "/Common/postgresql", "database": "postgres", "db_count": 1}, ""},
+		{map[string]interface{}{"parent": "/Common/ldap", "base": "dc=company,dc=com", "filter": "(cn=monitor)", "security": "tls", "mandatory_attributes": "yes"}, ""},
+		{map[string]interface{}{"parent": "/Common/sip", "mode": "tcp", "filter": "200", "filter_negative": "503", "request": "OPTIONS sip:monitor@example.com SIP/2.0"}, ""},
+		{map[string]interface{}{"parent": "/Common/http", "database": "app"}, "database is only applicable to /Common/mysql, /Common/postgresql, /Common/mssql monitors, not /Common/http"},
+		{map[string]interface{}{"parent": "/Common/mysql", "base": "dc=company,dc=com"}, "base is only applicable to /Common/ldap monitors, not /Common/mysql"},
+		{map[string]interface{}{"parent": "/Common/ldap", "headers": "X-Check: 1"}, "headers is only applicable to /Common/sip monitors, not /Common/ldap"},
+		{map[string]interface{}{"parent": "/Common/sip", "mode": "passive"}, "mode of /Common/sip monitors must be one of udp, tcp, tls, sips, got passive"},
+		{map[string]interface{}{"parent": "/Common/ftp", "mode": "passive"}, ""},
+	}
+	for _, c := range cases {
+		c.config["name"] = "/Common/test-monitor"
+		d := schema.TestResourceDataRaw(t, resourceBigipLtmMonitor().Schema, c.config)
+		err := checkMonitorParentAttributes(d)
+		if c.err == "" {
+			assert.NoError(t, err, "%v", c.config)
+		} else {
+			assert.EqualError(t, err, c.err)
+		}
+	}
+}
+
+func TestValidateParentSip(t *testing.T) {
+	_, errs := validateParent("/Common/sip", "parent")
+	assert.Empty(t, errs)
+	_, errs = validateParent("/Common/radius", "parent")
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "/Common/sip")
+}
+
+func TestLtmMonitorConfigDatabasePayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmMonitor().Schema, map[string]interface{}{
+		"name":           "/Common/test-mysql",
+		"parent":         "/Common/mysql",
+		"send":           "SELECT 1",
+		"receive":        "1",
+		"database":       "app",
+		"username":       "monitor",
+		"password":       "m0n1t0r",
+		"db_count":       5,
+		"receive_row":    "1",
+		"receive_column": "1",
+	})
+	config := getLtmMonitorConfig(d, &bigip.Monitor{Name: "/Common/test-mysql"})
+	payload, err := json.Marshal(config)
+	assert.NoError(t, err)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(payload, &body))
+	assert.Equal(t, "/Common/mysql", body["defaultsFrom"])
+	assert.Equal(t, "app", body["database"])
+	assert.Equal(t, "m0n1t0r", body["password"])
+	assert.Equal(t, "5", body["count"])
+	assert.Equal(t, "1", body["recvRow"])
+	assert.Equal(t, "1", body["recvColumn"])
+}
+
+func TestResourceBigipLtmMonitorSipCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	monitor := map[string]interface{}{}
+	var patched map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/ltm/monitor/sip", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&monitor))
+			monitor["fullPath"] = monitor["name"]
+			_ = json.NewEncoder(w).Encode(monitor)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": []interface{}{monitor}})
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/monitor/sip/~Common~test-sip", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPatch {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+			for k, v := range patched {
+				monitor[k] = v
+			}
+		}
+		_ = json.NewEncoder(w).Encode(monitor)
+	})
+
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmMonitor().Schema, map[string]interface{}{
+		"name":            "/Common/test-sip",
+		"parent":          "/Common/sip",
+		"mode":            "tcp",
+		"filter":          "200",
+		"filter_negative": "503",
+		"headers":         "X-Monitor: bigip",
+		"request":         "OPTIONS sip:monitor@example.com SIP/2.0",
+	})
+	diags := resourceBigipLtmMonitorCreate(context.Background(), d, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, "/Common/sip", monitor["defaultsFrom"])
+	assert.Equal(t, "tcp", monitor["mode"])
+	assert.Equal(t, "200", monitor["filter"])
+	// the attributes bigip.Monitor does not hold are patched onto the monitor
+	assert.Equal(t, map[string]interface{}{
+		"filterNeg": "503",
+		"headers":   "X-Monitor: bigip",
+		"request":   "OPTIONS sip:monitor@example.com SIP/2.0",
+	}, patched)
+	assert.Equal(t, "/Common/test-sip", d.Id())
+	assert.Equal(t, "503", d.Get("filter_negative"))
+	assert.Equal(t, "X-Monitor: bigip", d.Get("headers"))
+	assert.Equal(t, "OPTIONS sip:monitor@example.com SIP/2.0", d.Get("request"))
+}
+
+// mockLtmMonitor serves a single monitor of monitorType, created, read, modified and deleted by the
+// provider through the mux.
+func mockLtmMonitor(t *testing.T, monitorType, name string) {
+	var monitor map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/monitor/"+monitorType, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&monitor))
+			monitor["fullPath"] = monitor["name"]
+			_ = json.NewEncoder(w).Encode(monitor)
+			return
+		}
+		items := []interface{}{}
+		if monitor != nil {
+			items = append(items, monitor)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/monitor/"+monitorType+"/"+strings.ReplaceAll(name, "/", "~"), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPut, http.MethodPatch:
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			for k, v := range body {
+				monitor[k] = v
+			}
+		case http.MethodDelete:
+			monitor = nil
+			return
+		}
+		if monitor == nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprintf(w, `{"code":404,"message":"Object not found"}`)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(monitor)
+	})
+}
+
+func TestAccBigipLtmMonitorMysqlMocked(t *testing.T) {
+	setup()
+	defer teardown()
+	mockLtmMonitor(t, "mysql", "/Common/test-mysql")
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAcctUnitPreCheck(t, server.URL) },
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "bigip_ltm_monitor" "test-mysql" {
+  name           = "/Common/test-mysql"
+  parent         = "/Common/mysql"
+  send           = "SELECT 1"
+  receive        = "1"
+  database       = "app"
+  username       = "monitor"
+  password       = "m0n1t0r"
+  db_count       = 5
+  receive_row    = "1"
+  receive_column = "1"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-mysql", "parent", "/Common/mysql"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-mysql", "database", "app"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-mysql", "db_count", "5"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-mysql", "receive_row", "1"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-mysql", "receive_column", "1"),
+				),
+			},
+			{
+				Config: `
+resource "bigip_ltm_monitor" "test-mysql" {
+  name   = "/Common/test-mysql"
+  parent = "/Common/mysql"
+  base   = "dc=company,dc=com"
+}
+`,
+				ExpectError: regexp.MustCompile("base is only applicable to /Common/ldap monitors, not /Common/mysql"),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmMonitorLdapMocked(t *testing.T) {
+	setup()
+	defer teardown()
+	mockLtmMonitor(t, "ldap", "/Common/test-ldap")
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAcctUnitPreCheck(t, server.URL) },
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "bigip_ltm_monitor" "test-ldap" {
+  name                 = "/Common/test-ldap"
+  parent               = "/Common/ldap"
+  base                 = "dc=company,dc=com"
+  filter               = "(cn=monitor)"
+  security             = "tls"
+  mandatory_attributes = "yes"
+  username             = "cn=monitor,dc=company,dc=com"
+  password             = "m0n1t0r"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-ldap", "parent", "/Common/ldap"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-ldap", "base", "dc=company,dc=com"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-ldap", "filter", "(cn=monitor)"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-ldap", "security", "tls"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-ldap", "mandatory_attributes", "yes"),
+				),
+			},
+			{
+				Config: `
+resource "bigip_ltm_monitor" "test-ldap" {
+  name     = "/Common/test-ldap"
+  parent   = "/Common/ldap"
+  security = "starttls"
+}
+`,
+				ExpectError: regexp.MustCompile(`expected security to be one of`),
+			},
+		},
+	})
+}
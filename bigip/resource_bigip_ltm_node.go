@@ -77,6 +77,17 @@ func resourceBigipLtmNode() *schema.Resource {
 				Optional:    true,
 				Description: "User defined description of the node.",
 			},
+			"app_service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The application service (iApp) the object belongs to. Read from the BIG-IP when not configured, so adopted iApp objects keep their owner",
+			},
+			"generation": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Generation number of the node, increased by the BIG-IP on each change",
+			},
 			"state": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -186,6 +197,9 @@ func resourceBigipLtmNodeCreate(ctx context.Context, d *schema.ResourceData, met
 			return diag.FromErr(fmt.Errorf("error modifying node %s: %v", name, err))
 		}
 	}
+	if err := setLtmObjectMeta(d, client, restObjectPath("ltm/node", name), "description", "app_service"); err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying node %s: %v", name, err))
+	}
 	return resourceBigipLtmNodeRead(ctx, d, meta)
 }
 
@@ -222,19 +236,32 @@ func resourceBigipLtmNodeRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving Monitor to state for Node (%s): %s", d.Id(), err))
 	}
 
-	if (node.Session == "monitor-enabled") || (node.Session == "user-enabled") {
-		_ = d.Set("session", "user-enabled")
-	} else {
-		_ = d.Set("session", "user-disabled")
-	}
+	_ = d.Set("state", nodeStateIntent(node.State, d.Get("state").(string)))
+	_ = d.Set("session", nodeSessionIntent(node.Session))
 	_ = d.Set("connection_limit", node.ConnectionLimit)
 	_ = d.Set("description", node.Description)
+	_ = d.Set("generation", node.Generation)
+	nodeMeta, err := getLtmObjectMeta(client, restObjectPath("ltm/node", name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("app_service", nodeMeta.AppService)
 	_ = d.Set("dynamic_ratio", node.DynamicRatio)
-	_ = d.Set("monitor", strings.TrimSpace(node.Monitor))
+	monitor := strings.TrimSpace(node.Monitor)
+	if monitor == "" {
+		// nodes without monitors do not report one
+		monitor = "none"
+		if d.Get("monitor").(string) == "/Common/none" {
+			monitor = "/Common/none"
+		}
+	}
+	_ = d.Set("monitor", monitor)
 	_ = d.Set("ratio", node.Ratio)
-	if _, ok := d.GetOk("fqdn"); ok {
+	// the fqdn block is read whenever the node is an FQDN node, so an imported one has it too
+	if _, ok := d.GetOk("fqdn"); ok || node.FQDN.Name != "" {
 		var fqdn []map[string]interface{}
 		fqdnelements := map[string]interface{}{
+			"name":           d.Get("fqdn.0.name").(string),
 			"interval":       node.FQDN.Interval,
 			"downinterval":   node.FQDN.DownInterval,
 			"autopopulate":   node.FQDN.AutoPopulate,
@@ -246,6 +273,28 @@ func resourceBigipLtmNodeRead(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// nodeStateIntent returns the state to keep in the state file for the state reported by the
+// BIG-IP. Only user-down is set by the user, the other values (up, down, unchecked, unknown,
+// fqdn-up, ...) come from the monitors and keep the configured value.
+func nodeStateIntent(deviceState, configured string) string {
+	if deviceState == "user-down" {
+		return "user-down"
+	}
+	if configured == "" || configured == "user-down" {
+		return "user-up"
+	}
+	return configured
+}
+
+// nodeSessionIntent returns the session to keep in the state file for the session reported by
+// the BIG-IP, user-disabled being the only value that comes from the user.
+func nodeSessionIntent(deviceSession string) string {
+	if deviceSession == "user-disabled" {
+		return "user-disabled"
+	}
+	return "user-enabled"
+}
+
 func resourceBigipLtmNodeExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	client := meta.(*bigip.BigIP)
 
@@ -290,6 +339,9 @@ func resourceBigipLtmNodeUpdate(ctx context.Context, d *schema.ResourceData, met
 	if err := client.ModifyNode(name, nodeConfig); err != nil {
 		return diag.FromErr(fmt.Errorf("error modifying node %s: %v", name, err))
 	}
+	if err := setLtmObjectMeta(d, client, restObjectPath("ltm/node", name), "description", "app_service"); err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying node %s: %v", name, err))
+	}
 
 	return resourceBigipLtmNodeRead(ctx, d, meta)
 }
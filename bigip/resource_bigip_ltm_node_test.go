@@ -241,6 +241,59 @@ func TestAccBigipLtmNode_import(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmNode_importWithoutMonitor(t *testing.T) {
+	staticName := fmt.Sprintf("/%s/test-node-nomon", TestPartition)
+	fqdnName := fmt.Sprintf("/%s/test-fqdn-node-nomon", TestPartition)
+	config := `
+resource "bigip_ltm_node" "static" {
+  name    = "` + staticName + `"
+  address = "192.168.30.5"
+  monitor = "none"
+}
+resource "bigip_ltm_node" "fqdn" {
+  name    = "` + fqdnName + `"
+  address = "f5.com"
+  monitor = "none"
+  fqdn { interval = "3000" }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckNodesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckNodeExists(staticName),
+					testCheckNodeExists(fqdnName),
+					resource.TestCheckResourceAttr("bigip_ltm_node.static", "state", "user-up"),
+					resource.TestCheckResourceAttr("bigip_ltm_node.static", "session", "user-enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_node.fqdn", "state", "user-up"),
+				),
+			},
+			{
+				ResourceName:      "bigip_ltm_node.static",
+				ImportState:       true,
+				ImportStateId:     staticName,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "bigip_ltm_node.fqdn",
+				ImportState:       true,
+				ImportStateId:     fqdnName,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testCheckNodeExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
@@ -7,9 +7,12 @@ If a copy of the MPL was not distributed with this file,You can obtain one at ht
 package bigip
 
 import (
+	"context"
 	"fmt"
 
+	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"log"
@@ -89,6 +92,62 @@ func TestAccBigipLtmNodeCreate(t *testing.T) {
 	})
 }
 
+func TestNodeStateIntent(t *testing.T) {
+	assert.Equal(t, "user-up", nodeStateIntent("unchecked", ""))
+	assert.Equal(t, "user-up", nodeStateIntent("unknown", "user-up"))
+	assert.Equal(t, "user-up", nodeStateIntent("fqdn-up", ""))
+	assert.Equal(t, "user-up", nodeStateIntent("up", "user-down"))
+	assert.Equal(t, "user-down", nodeStateIntent("user-down", ""))
+	assert.Equal(t, "user-down", nodeStateIntent("user-down", "user-up"))
+
+	assert.Equal(t, "user-enabled", nodeSessionIntent("monitor-enabled"))
+	assert.Equal(t, "user-enabled", nodeSessionIntent("user-enabled"))
+	assert.Equal(t, "user-enabled", nodeSessionIntent(""))
+	assert.Equal(t, "user-disabled", nodeSessionIntent("user-disabled"))
+}
+
+func TestResourceBigipLtmNodeReadImport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/node/~Common~static-node", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"static-node","fullPath":"/Common/static-node","address":"10.10.10.10","session":"user-enabled","state":"unchecked","ratio":1}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/node/~Common~fqdn-node", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"fqdn-node","fullPath":"/Common/fqdn-node","address":"any6","session":"user-enabled","state":"fqdn-up","monitor":"/Common/icmp ",
+			"fqdn":{"addressFamily":"ipv4","autopopulate":"enabled","downInterval":5,"interval":"3600","tmName":"www.example.com"}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmNode().Schema, map[string]interface{}{})
+	d.SetId("/Common/static-node")
+	diags := resourceBigipLtmNodeRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "10.10.10.10", d.Get("address"))
+	assert.Equal(t, "user-up", d.Get("state"))
+	assert.Equal(t, "user-enabled", d.Get("session"))
+	assert.Equal(t, "none", d.Get("monitor"))
+	assert.Equal(t, 0, d.Get("fqdn.#"))
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmNode().Schema, map[string]interface{}{})
+	d.SetId("/Common/fqdn-node")
+	diags = resourceBigipLtmNodeRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "www.example.com", d.Get("address"))
+	assert.Equal(t, "user-up", d.Get("state"))
+	assert.Equal(t, "/Common/icmp", d.Get("monitor"))
+	assert.Equal(t, 1, d.Get("fqdn.#"))
+	assert.Equal(t, "enabled", d.Get("fqdn.0.autopopulate"))
+	assert.Equal(t, "ipv4", d.Get("fqdn.0.address_family"))
+}
+
 var (
 	// mux is the HTTP request multiplexer used with the test server.
 	mux *http.ServeMux
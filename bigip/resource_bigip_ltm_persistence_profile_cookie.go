@@ -48,24 +48,27 @@ func resourceBigipLtmPersistenceProfileCookie() *schema.Resource {
 			},
 
 			"match_across_pools": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "To enable _ disable match across pools with given persistence record",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "To enable _ disable match across pools with given persistence record",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"match_across_services": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "To enable _ disable match across services with given persistence record",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "To enable _ disable match across services with given persistence record",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"match_across_virtuals": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "To enable _ disable match across virtual servers with given persistence record",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "To enable _ disable match across virtual servers with given persistence record",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 			"method": {
 				Type:        schema.TypeString,
@@ -75,10 +78,11 @@ func resourceBigipLtmPersistenceProfileCookie() *schema.Resource {
 			},
 
 			"mirror": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "To enable _ disable",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "To enable _ disable",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"timeout": {
@@ -89,32 +93,37 @@ func resourceBigipLtmPersistenceProfileCookie() *schema.Resource {
 			},
 
 			"override_conn_limit": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "To enable _ disable that pool member connection limits are overridden for persisted clients. Per-virtual connection limits remain hard limits and are not overridden.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "To enable _ disable that pool member connection limits are overridden for persisted clients. Per-virtual connection limits remain hard limits and are not overridden.",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			// Specific to CookiePersistenceProfile
 			"always_send": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "To enable _ disable always sending cookies",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				Description:      "To enable _ disable always sending cookies",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"cookie_encryption": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "To required, preferred, or disabled policy for cookie encryption",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "To required, preferred, or disabled policy for cookie encryption",
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 
 			"cookie_encryption_passphrase": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Passphrase for encrypted cookies",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressObfuscatedSecretDiff,
+				Description:      "Passphrase for encrypted cookies",
 			},
 
 			"cookie_name": {
@@ -146,10 +155,11 @@ func resourceBigipLtmPersistenceProfileCookie() *schema.Resource {
 			},
 
 			"httponly": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable sending only over http",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable sending only over http",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 		},
 	}
@@ -222,7 +232,7 @@ func resourceBigipLtmPersistenceProfileCookieRead(ctx context.Context, d *schema
 		_ = d.Set("cookie_encryption", pp.CookieEncryption)
 	}
 	if _, ok := d.GetOk("cookie_encryption_passphrase"); ok {
-		_ = d.Set("cookie_encryption_passphrase", pp.CookieEncryptionPassphrase)
+		setSecret(d, "cookie_encryption_passphrase", pp.CookieEncryptionPassphrase)
 	}
 	if _, ok := d.GetOk("cookie_name"); ok {
 		_ = d.Set("cookie_name", pp.CookieName)
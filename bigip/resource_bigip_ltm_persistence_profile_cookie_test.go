@@ -36,6 +36,39 @@ resource "bigip_ltm_persistence_profile_cookie" "test_ppcookie" {
 
 `
 
+var TestPpcookieEncryptedResource = `
+resource "bigip_ltm_persistence_profile_cookie" "test_ppcookie" {
+	name = "` + TestPpcookieName + `"
+	defaults_from = "/Common/cookie"
+	cookie_encryption = "required"
+	cookie_encryption_passphrase = "iloveham"
+	cookie_name = "ham"
+}
+`
+
+func TestAccBigipLtmPersistenceProfileCookiePassphraseNoDiff(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileCookieDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestPpcookieEncryptedResource,
+				Check: resource.ComposeTestCheckFunc(
+					testBigipLtmPersistenceProfileCookieExists(TestPpcookieName, true),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_cookie.test_ppcookie", "cookie_encryption_passphrase", "iloveham"),
+				),
+			},
+			{
+				Config:   TestPpcookieEncryptedResource,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmPersistenceProfileCookieCreate(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -49,31 +49,35 @@ func resourceBigipLtmPersistenceProfileSrcAddr() *schema.Resource {
 			},
 
 			"match_across_pools": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable match across pools with given persistence record",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable match across pools with given persistence record",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"match_across_services": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable match across services with given persistence record",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable match across services with given persistence record",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"match_across_virtuals": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable match across services with given persistence record",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable match across services with given persistence record",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"mirror": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"timeout": {
@@ -84,25 +88,28 @@ func resourceBigipLtmPersistenceProfileSrcAddr() *schema.Resource {
 			},
 
 			"override_conn_limit": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable that pool member connection limits are overridden for persisted clients. Per-virtual connection limits remain hard limits and are not overridden.",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable that pool member connection limits are overridden for persisted clients. Per-virtual connection limits remain hard limits and are not overridden.",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			// Specific to SourceAddrPersistenceProfile
 			"hash_algorithm": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Specify the hash algorithm",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Specify the hash algorithm",
+				Computed:         true,
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 
 			"map_proxies": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable directs all to the same single pool member",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable directs all to the same single pool member",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"mask": {
@@ -48,31 +48,35 @@ func resourceBigipLtmPersistenceProfileSSL() *schema.Resource {
 			},
 
 			"match_across_pools": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable match across pools with given persistence record",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable match across pools with given persistence record",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"match_across_services": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable match across services with given persistence record",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable match across services with given persistence record",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"match_across_virtuals": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable match across services with given persistence record",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable match across services with given persistence record",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"mirror": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"timeout": {
@@ -82,10 +86,11 @@ func resourceBigipLtmPersistenceProfileSSL() *schema.Resource {
 			},
 
 			"override_conn_limit": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "To enable _ disable that pool member connection limits are overridden for persisted clients. Per-virtual connection limits remain hard limits and are not overridden.",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "To enable _ disable that pool member connection limits are overridden for persisted clients. Per-virtual connection limits remain hard limits and are not overridden.",
+				Computed:         true,
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 		},
 	}
@@ -25,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const uriLtmPolicy = "ltm/policy"
+
 var CONTROLS = schema.NewSet(schema.HashString, []interface{}{"caching", "compression", "classification", "forwarding", "request-adaptation", "response-adaptation", "server-ssl"})
 var REQUIRES = schema.NewSet(schema.HashString, []interface{}{"client-ssl", "ssl-persistence", "tcp", "http"})
 
@@ -55,7 +57,6 @@ func resourceBigipLtmPolicy() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Publish the Policy",
-				ForceNew:    true,
 				Deprecated:  "This attribute is not required anymore because the resource automatically publishes the policy, for that reason this field is deprecated and will be removed in a future release.",
 			},
 			"controls": {
@@ -98,6 +99,12 @@ func resourceBigipLtmPolicy() *schema.Resource {
 							Optional:    true,
 							Description: "Specifies descriptive text that identifies the irule attached to policy.",
 						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Specifies whether the rule is evaluated. A disabled rule keeps its place in the policy",
+						},
 						"action": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -1100,6 +1107,18 @@ func resourceBigipLtmPolicy() *schema.Resource {
 										Optional: true,
 										Computed: true,
 									},
+									"xml_content": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+										Description: "Matches on XML content, requires an XML profile on the virtual server",
+									},
+									"xpath_query": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+										Description: "Selects the XPath query of the XML profile named by tm_name",
+									},
 								},
 							},
 						},
@@ -1126,7 +1145,11 @@ func resourceBigipLtmPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 
 	p := dataToPolicy(name, d)
 
-	err := client.CreatePolicy(&p)
+	payload, err := ltmPolicyPayload(&p, policyXmlConditionsFromData(d), policyDisabledRulesFromData(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = postRestEntity(client, payload, "ltm/policy")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1189,7 +1212,11 @@ func resourceBigipLtmPolicyRead(ctx context.Context, d *schema.ResourceData, met
 		return nil
 	}
 
-	return policyToData(p, d)
+	xml, disabled, err := getPolicyRuleFields(client, partition+"~"+policyName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return policyToData(p, d, xml, disabled)
 }
 
 func resourceBigipLtmPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1216,7 +1243,20 @@ func resourceBigipLtmPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 			return diag.FromErr(err)
 		}
 	}
-	err := client.UpdatePolicy(policyName, partition2, &p)
+	payload, err := ltmPolicyPayload(&p, policyXmlConditionsFromData(d), policyDisabledRulesFromData(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	draft := "ltm/policy/" + partition2 + "~Drafts~" + policyName
+	// Only the rules that changed are sent, so inserting a rule does not rewrite the rules after it.
+	rulesRef, _ := payload["rulesReference"].(map[string]interface{})
+	delete(payload, "rulesReference")
+	err = patchRestEntity(client, payload, draft)
+	if err == nil && d.HasChange("rule") {
+		rules, _ := rulesRef["items"].([]interface{})
+		o, _ := d.GetChange("rule")
+		err = updateLtmPolicyDraftRules(client, draft, o.([]interface{}), d.Get("rule").([]interface{}), rules)
+	}
 	if err != nil {
 		log.Printf("[ERROR] Unable to Update Draft Policy   (%s) (%v) ", policyName, err)
 		return diag.FromErr(err)
@@ -1358,7 +1398,7 @@ func dataToPolicy(name string, d *schema.ResourceData) bigip.Policy {
 	return p
 }
 
-func policyToData(p *bigip.Policy, d *schema.ResourceData) diag.Diagnostics {
+func policyToData(p *bigip.Policy, d *schema.ResourceData, xml policyXmlConditions, disabled policyDisabledRules) diag.Diagnostics {
 
 	if p.Strategy != "" {
 		re := regexp.MustCompile("/([a-zA-z0-9? ,_-]+)/([a-zA-z0-9? ,._-]+)")
@@ -1390,7 +1430,7 @@ func policyToData(p *bigip.Policy, d *schema.ResourceData) diag.Diagnostics {
 			return p.Rules[i].Ordinal < p.Rules[j].Ordinal
 		})
 
-		rule := flattenPolicyRules(p.Rules)
+		rule := flattenPolicyRules(p.Rules, xml, disabled)
 
 		err := d.Set("rule", rule)
 		if err != nil {
@@ -1401,7 +1441,7 @@ func policyToData(p *bigip.Policy, d *schema.ResourceData) diag.Diagnostics {
 	return nil
 }
 
-func flattenPolicyRules(rules []bigip.PolicyRule) []interface{} {
+func flattenPolicyRules(rules []bigip.PolicyRule, xml policyXmlConditions, disabled policyDisabledRules) []interface{} {
 	att := make([]interface{}, len(rules))
 	for i, v := range rules {
 		obj := make(map[string]interface{})
@@ -1412,6 +1452,7 @@ func flattenPolicyRules(rules []bigip.PolicyRule) []interface{} {
 		if v.Description != "" {
 			obj["description"] = v.Description
 		}
+		obj["enabled"] = !disabled[v.Name]
 
 		if len(v.Actions) > 0 {
 			r := flattenPolicyRuleActions(v.Actions)
@@ -1419,7 +1460,7 @@ func flattenPolicyRules(rules []bigip.PolicyRule) []interface{} {
 		}
 
 		if len(v.Conditions) > 0 {
-			r := flattenPolicyRuleConditions(v.Conditions)
+			r := flattenPolicyRuleConditions(v.Conditions, xml[v.Name])
 			obj["condition"] = r
 		}
 
@@ -1436,10 +1477,15 @@ func flattenPolicyRuleActions(actions []bigip.PolicyRuleAction) []interface{} {
 	return att
 }
 
-func flattenPolicyRuleConditions(conditions []bigip.PolicyRuleCondition) []interface{} {
+func flattenPolicyRuleConditions(conditions []bigip.PolicyRuleCondition, xml map[string]policyXmlCondition) []interface{} {
 	att := make([]interface{}, len(conditions))
 	for x, a := range conditions {
-		att[x] = interfaceToResourceData(a)
+		obj := interfaceToResourceData(a)
+		if c, ok := xml[a.Name]; ok {
+			obj["xml_content"] = c.XmlContent
+			obj["xpath_query"] = c.XpathQuery
+		}
+		att[x] = obj
 	}
 	return att
 }
@@ -1450,11 +1496,238 @@ func interfaceToResourceData(a interface{}) map[string]interface{} {
 	for i := 0; i < v.NumField(); i++ {
 		fn := toSnakeCase(v.Type().Field(i).Name)
 		if fn != "name" && fn != "generation" {
+			if v.Field(i).Kind() == reflect.Slice {
+				// copy every element, the condition values of an imported rule must not be truncated
+				values := make([]interface{}, v.Field(i).Len())
+				for j := range values {
+					values[j] = v.Field(i).Index(j).Interface()
+				}
+				obj[fn] = values
+				continue
+			}
 			fv := v.Field(i).Interface()
-			if (v.Field(i).Kind() == reflect.Slice && fv != nil) || fv != reflect.Zero(v.Field(i).Type()).Interface() {
+			if fv != reflect.Zero(v.Field(i).Type()).Interface() {
 				obj[fn] = fv
 			}
 		}
 	}
 	return obj
 }
+
+// policyXmlCondition holds the xml-content operands of a rule condition, which go-bigip does not model.
+type policyXmlCondition struct {
+	Name       string `json:"name,omitempty"`
+	XmlContent bool   `json:"xmlContent,omitempty"`
+	XpathQuery bool   `json:"xpathQuery,omitempty"`
+}
+
+// policyXmlConditions indexes xml-content conditions by rule name and condition name.
+type policyXmlConditions map[string]map[string]policyXmlCondition
+
+// policyDisabledRules holds the names of the disabled rules, a flag go-bigip does not model either.
+type policyDisabledRules map[string]bool
+
+func policyDisabledRulesFromData(d *schema.ResourceData) policyDisabledRules {
+	disabled := make(policyDisabledRules)
+	for _, item := range d.Get("rule").([]interface{}) {
+		rule := item.(map[string]interface{})
+		if enabled, ok := rule["enabled"].(bool); ok && !enabled {
+			disabled[rule["name"].(string)] = true
+		}
+	}
+	return disabled
+}
+
+func policyXmlConditionsFromData(d *schema.ResourceData) policyXmlConditions {
+	xml := make(policyXmlConditions)
+	for _, item := range d.Get("rule").([]interface{}) {
+		rule := item.(map[string]interface{})
+		for ci, itemCondition := range rule["condition"].([]interface{}) {
+			condition := itemCondition.(map[string]interface{})
+			c := policyXmlCondition{
+				Name:       fmt.Sprintf("%d", ci),
+				XmlContent: condition["xml_content"].(bool),
+				XpathQuery: condition["xpath_query"].(bool),
+			}
+			if !c.XmlContent && !c.XpathQuery {
+				continue
+			}
+			ruleName := rule["name"].(string)
+			if xml[ruleName] == nil {
+				xml[ruleName] = make(map[string]policyXmlCondition)
+			}
+			xml[ruleName][c.Name] = c
+		}
+	}
+	return xml
+}
+
+// ltmPolicyPayload renders p the way go-bigip's CreatePolicy/UpdatePolicy would, then adds the
+// disabled flag of the rules and the xml-content operands to the matching rule conditions.
+func ltmPolicyPayload(p *bigip.Policy, xml policyXmlConditions, disabled policyDisabledRules) (map[string]interface{}, error) {
+	for ri := range p.Rules {
+		p.Rules[ri].Ordinal = ri
+		for ai := range p.Rules[ri].Actions {
+			p.Rules[ri].Actions[ai].Name = fmt.Sprintf("%d", ai)
+		}
+		for ci := range p.Rules[ri].Conditions {
+			p.Rules[ri].Conditions[ci].Name = fmt.Sprintf("%d", ci)
+		}
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil, err
+	}
+	rulesRef, _ := payload["rulesReference"].(map[string]interface{})
+	rules, _ := rulesRef["items"].([]interface{})
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		// always sent, a rule written with PUT to enable it again must not keep the flag
+		rule["disabled"] = disabled[rule["name"].(string)]
+		conditionsRef, _ := rule["conditionsReference"].(map[string]interface{})
+		conditions, _ := conditionsRef["items"].([]interface{})
+		for _, c := range conditions {
+			condition := c.(map[string]interface{})
+			x, ok := xml[rule["name"].(string)][condition["name"].(string)]
+			if !ok {
+				continue
+			}
+			if x.XmlContent {
+				condition["xmlContent"] = true
+			}
+			if x.XpathQuery {
+				condition["xpathQuery"] = true
+			}
+		}
+	}
+	return payload, nil
+}
+
+// getPolicyRuleFields reads the xml-content conditions and the disabled rules of the published
+// policy fullName, e.g. ~Common~my-policy.
+func getPolicyRuleFields(client *bigip.BigIP, fullName string) (policyXmlConditions, policyDisabledRules, error) {
+	policy := &struct {
+		RulesReference struct {
+			Items []struct {
+				Name                string `json:"name"`
+				Disabled            bool   `json:"disabled"`
+				ConditionsReference struct {
+					Items []policyXmlCondition `json:"items"`
+				} `json:"conditionsReference"`
+			} `json:"items"`
+		} `json:"rulesReference"`
+	}{}
+	if _, err := getRestEntity(client, policy, "ltm/policy/"+fullName+"?expandSubcollections=true"); err != nil {
+		return nil, nil, err
+	}
+	xml := make(policyXmlConditions)
+	disabled := make(policyDisabledRules)
+	for _, rule := range policy.RulesReference.Items {
+		if rule.Disabled {
+			disabled[rule.Name] = true
+		}
+		for _, c := range rule.ConditionsReference.Items {
+			if !c.XmlContent && !c.XpathQuery {
+				continue
+			}
+			if xml[rule.Name] == nil {
+				xml[rule.Name] = make(map[string]policyXmlCondition)
+			}
+			xml[rule.Name][c.Name] = c
+		}
+	}
+	return xml, disabled, nil
+}
+
+// ltmPolicyRuleChanges compares the rules in state with the configured ones by name. It returns
+// the indexes in newRules of the rules to create, of the rules whose content changed and of the
+// unchanged rules, and the names of the rules to remove.
+func ltmPolicyRuleChanges(oldRules, newRules []interface{}) (added, modified, unchanged []int, removed []string) {
+	oldIndex := make(map[string]int, len(oldRules))
+	for i, r := range oldRules {
+		oldIndex[r.(map[string]interface{})["name"].(string)] = i
+	}
+	newNames := make(map[string]bool, len(newRules))
+	for i, r := range newRules {
+		name := r.(map[string]interface{})["name"].(string)
+		newNames[name] = true
+		oi, ok := oldIndex[name]
+		switch {
+		case !ok:
+			added = append(added, i)
+		case !reflect.DeepEqual(oldRules[oi], r):
+			modified = append(modified, i)
+		default:
+			unchanged = append(unchanged, i)
+		}
+	}
+	for _, r := range oldRules {
+		if name := r.(map[string]interface{})["name"].(string); !newNames[name] {
+			removed = append(removed, name)
+		}
+	}
+	return added, modified, unchanged, removed
+}
+
+// updateLtmPolicyDraftRules applies the rule changes between oldRules and newRules to the draft
+// policy at path, items are the rendered rules of newRules. Unchanged rules only get their ordinal
+// updated when it differs from the draft, and new rules are created before old ones are removed.
+func updateLtmPolicyDraftRules(client *bigip.BigIP, path string, oldRules, newRules, items []interface{}) error {
+	existing, err := getRestItems[struct {
+		Name    string `json:"name"`
+		Ordinal int    `json:"ordinal"`
+	}](client, path+"/rules")
+	if err != nil {
+		return err
+	}
+	inDraft := make(map[string]int, len(existing))
+	for _, r := range existing {
+		inDraft[r.Name] = r.Ordinal
+	}
+	// a draft left over from an earlier run may not match the state, so rules are created or
+	// replaced depending on what the draft holds
+	writeRule := func(rule map[string]interface{}) error {
+		name := rule["name"].(string)
+		if _, ok := inDraft[name]; ok {
+			return putRestEntity(client, rule, path+"/rules/"+name)
+		}
+		return postRestEntity(client, rule, path+"/rules")
+	}
+
+	added, modified, unchanged, removed := ltmPolicyRuleChanges(oldRules, newRules)
+	for _, i := range unchanged {
+		rule := items[i].(map[string]interface{})
+		ordinal, ok := inDraft[rule["name"].(string)]
+		if !ok {
+			if err := writeRule(rule); err != nil {
+				return err
+			}
+			continue
+		}
+		if float64(ordinal) == rule["ordinal"] {
+			continue
+		}
+		body := map[string]interface{}{"ordinal": rule["ordinal"]}
+		if err := patchRestEntity(client, body, path+"/rules/"+rule["name"].(string)); err != nil {
+			return err
+		}
+	}
+	for _, i := range append(modified, added...) {
+		if err := writeRule(items[i].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+	for _, name := range removed {
+		if _, ok := inDraft[name]; !ok {
+			continue
+		}
+		if err := deleteRestEntity(client, path+"/rules/"+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ package bigip
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"testing"
 
@@ -568,6 +569,147 @@ func TestAccBigipLtmPolicy_import_newpoolbehavior(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmPolicy_importMultiValueCondition(t *testing.T) {
+	resFullName := "bigip_ltm_policy.test-policy-values"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPolicysDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipLtmPolicyMultiValueConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPolicyExists("/Common/test-policy-values"),
+					resource.TestCheckResourceAttr(resFullName, "rule.0.condition.0.values.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resFullName,
+				ImportState:       true,
+				ImportStateId:     "/Common/test-policy-values",
+				ImportStateVerify: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					attrs := states[0].Attributes
+					if attrs["rule.0.condition.0.values.#"] != "3" || attrs["rule.0.condition.0.values.2"] != "/static" {
+						return fmt.Errorf("imported condition values are incomplete: %v", attrs)
+					}
+					return nil
+				},
+			},
+			{
+				Config:   testAccBigipLtmPolicyMultiValueConfig,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccBigipLtmPolicyMultiValueConfig = `
+resource "bigip_ltm_pool" "test-policy-values" {
+  name = "/Common/test-policy-values-pool"
+}
+
+resource "bigip_ltm_policy" "test-policy-values" {
+  name     = "/Common/test-policy-values"
+  strategy = "first-match"
+  requires = ["http"]
+  controls = ["forwarding"]
+  rule {
+    name = "static"
+    condition {
+      http_uri    = true
+      path        = true
+      starts_with = true
+      request     = true
+      values      = ["/api", "/app", "/static"]
+    }
+    action {
+      forward = true
+      pool    = bigip_ltm_pool.test-policy-values.name
+      request = true
+    }
+  }
+}
+`
+
+func TestAccBigipLtmPolicy_insertRule(t *testing.T) {
+	name := "/Common/test-policy-insert"
+	resFullName := "bigip_ltm_policy.test-policy-insert"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPolicysDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmpolicyRules(name, "rule-a", "rule-b", "rule-c"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPolicyRuleOrder(name, "rule-a", "rule-b", "rule-c"),
+				),
+			},
+			{
+				Config: testaccbigipltmpolicyRules(name, "rule-a", "rule-x", "rule-b", "rule-c"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPolicyRuleOrder(name, "rule-a", "rule-x", "rule-b", "rule-c"),
+					resource.TestCheckResourceAttr(resFullName, "rule.1.name", "rule-x"),
+					resource.TestCheckResourceAttr(resFullName, "rule.#", "4"),
+				),
+			},
+			{
+				Config: testaccbigipltmpolicyRules(name, "rule-x", "rule-c"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPolicyRuleOrder(name, "rule-x", "rule-c"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmPolicy_disableRule(t *testing.T) {
+	name := "/Common/test-policy-disable"
+	resFullName := "bigip_ltm_policy.test-policy-disable"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPolicysDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmpolicyDisabledRule(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPolicyRuleOrder(name, "rule-a", "rule-b", "rule-c"),
+					resource.TestCheckResourceAttr(resFullName, "rule.1.enabled", "true"),
+					resource.TestCheckResourceAttr(resFullName, "rule.1.description", "maintenance redirect"),
+				),
+			},
+			{
+				Config: testaccbigipltmpolicyDisabledRule(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPolicyRuleOrder(name, "rule-a", "rule-b", "rule-c"),
+					resource.TestCheckResourceAttr(resFullName, "rule.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resFullName, "rule.1.enabled", "false"),
+					resource.TestCheckResourceAttr(resFullName, "rule.2.enabled", "true"),
+				),
+			},
+			{
+				Config:   testaccbigipltmpolicyDisabledRule(name, false),
+				PlanOnly: true,
+			},
+			{
+				Config: testaccbigipltmpolicyDisabledRule(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPolicyRuleOrder(name, "rule-a", "rule-b", "rule-c"),
+					resource.TestCheckResourceAttr(resFullName, "rule.1.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckPolicyExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
@@ -589,6 +731,33 @@ func testCheckPolicyExists(name string) resource.TestCheckFunc {
 	}
 }
 
+// testCheckPolicyRuleOrder checks the published policy holds exactly rules, in this order.
+func testCheckPolicyRuleOrder(name string, rules ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		polStr := strings.Split(name, "/")
+		partition := strings.Join(polStr[:len(polStr)-1], "/")
+		policy, err := client.GetPolicy(polStr[len(polStr)-1], partition)
+		if err != nil {
+			return fmt.Errorf("Error while fetching policy: %v ", err)
+		}
+		if policy == nil {
+			return fmt.Errorf("Policy %s not found ", name)
+		}
+		sort.Slice(policy.Rules, func(i, j int) bool {
+			return policy.Rules[i].Ordinal < policy.Rules[j].Ordinal
+		})
+		var got []string
+		for _, r := range policy.Rules {
+			got = append(got, r.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(rules, ",") {
+			return fmt.Errorf("Policy %s has rules %v, expected %v ", name, got, rules)
+		}
+		return nil
+	}
+}
+
 func testCheckPolicysDestroyed(s *terraform.State) error {
 	client := testAccProvider.Meta().(*bigip.BigIP)
 
@@ -873,3 +1042,61 @@ locals {
 `
 	return tfConfig
 }
+
+func testaccbigipltmpolicyRules(name string, rules ...string) string {
+	tfConfig := fmt.Sprintf(`
+		resource "bigip_ltm_policy" "test-policy-insert" {
+		  name     = "%s"
+		  strategy = "first-match"
+		  requires = ["http"]
+		  controls = ["forwarding"]`, name)
+	for _, rule := range rules {
+		tfConfig += fmt.Sprintf(`
+		  rule {
+		    name = "%[1]s"
+		    condition {
+		      http_uri    = true
+		      starts_with = true
+		      values      = ["/%[1]s"]
+		    }
+		    action {
+		      forward = true
+		      reset   = true
+		    }
+		  }`, rule)
+	}
+	return tfConfig + `
+		}`
+}
+
+func testaccbigipltmpolicyDisabledRule(name string, enabled bool) string {
+	tfConfig := fmt.Sprintf(`
+		resource "bigip_ltm_policy" "test-policy-disable" {
+		  name     = "%s"
+		  strategy = "first-match"
+		  requires = ["http"]
+		  controls = ["forwarding"]`, name)
+	for _, rule := range []string{"rule-a", "rule-b", "rule-c"} {
+		extra := ""
+		if rule == "rule-b" {
+			extra = fmt.Sprintf(`
+		    description = "maintenance redirect"
+		    enabled     = %t`, enabled)
+		}
+		tfConfig += fmt.Sprintf(`
+		  rule {
+		    name = "%[1]s"%[2]s
+		    condition {
+		      http_uri    = true
+		      starts_with = true
+		      values      = ["/%[1]s"]
+		    }
+		    action {
+		      forward = true
+		      reset   = true
+		    }
+		  }`, rule, extra)
+	}
+	return tfConfig + `
+		}`
+}
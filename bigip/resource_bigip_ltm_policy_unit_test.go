@@ -0,0 +1,172 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testPolicyRule(name, description string) map[string]interface{} {
+	return map[string]interface{}{"name": name, "description": description}
+}
+
+func TestLtmPolicyRuleChanges(t *testing.T) {
+	oldRules := []interface{}{testPolicyRule("a", ""), testPolicyRule("b", ""), testPolicyRule("c", ""), testPolicyRule("d", "")}
+	newRules := []interface{}{testPolicyRule("a", ""), testPolicyRule("x", ""), testPolicyRule("b", "changed"), testPolicyRule("c", "")}
+
+	added, modified, unchanged, removed := ltmPolicyRuleChanges(oldRules, newRules)
+	assert.Equal(t, []int{1}, added)
+	assert.Equal(t, []int{2}, modified)
+	assert.Equal(t, []int{0, 3}, unchanged)
+	assert.Equal(t, []string{"d"}, removed)
+}
+
+func TestUpdateLtmPolicyDraftRulesInsert(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Common~Drafts~test-policy/rules", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"items":[{"name":"a","ordinal":0},{"name":"b","ordinal":1},{"name":"c","ordinal":2}]}`)
+			return
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		calls = append(calls, fmt.Sprintf("%s %v %v", r.Method, body["name"], body["ordinal"]))
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Common~Drafts~test-policy/rules/", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		calls = append(calls, fmt.Sprintf("%s %s %v", r.Method, r.URL.Path[len("/mgmt/tm/ltm/policy/~Common~Drafts~test-policy/rules/"):], body["ordinal"]))
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	oldRules := []interface{}{testPolicyRule("a", ""), testPolicyRule("b", ""), testPolicyRule("c", "")}
+	newRules := []interface{}{testPolicyRule("a", ""), testPolicyRule("x", ""), testPolicyRule("b", ""), testPolicyRule("c", "")}
+	var items []interface{}
+	for i, r := range newRules {
+		items = append(items, map[string]interface{}{"name": r.(map[string]interface{})["name"], "ordinal": float64(i)})
+	}
+
+	err := updateLtmPolicyDraftRules(client, "ltm/policy/~Common~Drafts~test-policy", oldRules, newRules, items)
+	assert.NoError(t, err)
+	// a keeps its ordinal, b and c only move, x is created, nothing is removed or rewritten
+	assert.Equal(t, []string{"PATCH b 2", "PATCH c 3", "POST x 1"}, calls)
+}
+
+func TestResourceBigipLtmPolicyReadMultiValueCondition(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Common~test-policy", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"test-policy","partition":"Common","fullPath":"/Common/test-policy","strategy":"/Common/first-match","controls":["forwarding"],"requires":["http"]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Common~test-policy/rules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"static","ordinal":0}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Common~test-policy/rules/static/conditions", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"0","httpUri":true,"path":true,"startsWith":true,"request":true,"values":["/api","/app","/static"]}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Common~test-policy/rules/static/actions", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"0","forward":true,"pool":"/Common/static-pool","request":true}]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmPolicy().Schema, map[string]interface{}{})
+	d.SetId("/Common/test-policy")
+	diags := resourceBigipLtmPolicyRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []interface{}{"/api", "/app", "/static"}, d.Get("rule.0.condition.0.values"))
+	assert.Equal(t, true, d.Get("rule.0.condition.0.starts_with"))
+	assert.Equal(t, "/Common/static-pool", d.Get("rule.0.action.0.pool"))
+}
+
+func TestLtmPolicyPayloadDisabledRule(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmPolicy().Schema, map[string]interface{}{
+		"name": "/Common/test-policy",
+		"rule": []interface{}{
+			map[string]interface{}{"name": "a"},
+			map[string]interface{}{"name": "b", "description": "maintenance", "enabled": false},
+			map[string]interface{}{"name": "c"},
+		},
+	})
+	p := dataToPolicy("/Common/test-policy", d)
+	payload, err := ltmPolicyPayload(&p, policyXmlConditionsFromData(d), policyDisabledRulesFromData(d))
+	assert.NoError(t, err)
+	rules := payload["rulesReference"].(map[string]interface{})["items"].([]interface{})
+	if assert.Len(t, rules, 3) {
+		for i, name := range []string{"a", "b", "c"} {
+			rule := rules[i].(map[string]interface{})
+			assert.Equal(t, name, rule["name"])
+			// the disabled rule keeps its place
+			assert.Equal(t, float64(i), rule["ordinal"])
+			assert.Equal(t, name == "b", rule["disabled"])
+		}
+		assert.Equal(t, "maintenance", rules[1].(map[string]interface{})["description"])
+	}
+}
+
+func TestResourceBigipLtmPolicyReadDisabledRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Common~test-policy", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("expandSubcollections") == "true" {
+			_, _ = fmt.Fprintf(w, `{"name":"test-policy","rulesReference":{"items":[{"name":"a","ordinal":0},{"name":"b","ordinal":1,"disabled":true}]}}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"name":"test-policy","partition":"Common","fullPath":"/Common/test-policy","strategy":"/Common/first-match","controls":["forwarding"],"requires":["http"]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Common~test-policy/rules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"a","ordinal":0},{"name":"b","ordinal":1,"description":"maintenance"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Common~test-policy/rules/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmPolicy().Schema, map[string]interface{}{})
+	d.SetId("/Common/test-policy")
+	diags := resourceBigipLtmPolicyRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, true, d.Get("rule.0.enabled"))
+	assert.Equal(t, false, d.Get("rule.1.enabled"))
+	assert.Equal(t, "maintenance", d.Get("rule.1.description"))
+}
@@ -16,8 +16,10 @@ import (
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/f5devcentral/go-bigip/f5teem"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceBigipLtmPool() *schema.Resource {
@@ -47,22 +49,69 @@ func resourceBigipLtmPool() *schema.Resource {
 				Description: "Specifies an association between a health or performance monitor and an entire pool, rather than with individual pool members",
 			},
 			"allow_nat": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringInSlice([]string{"yes", "no", "enabled", "disabled"}, false),
+				DiffSuppressFunc: suppressYesNoDiff,
+				Description:      "Specifies whether NATs are automatically enabled or disabled for any connections using this pool, yes or no.",
+			},
+			"allow_snat": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringInSlice([]string{"yes", "no", "enabled", "disabled"}, false),
+				DiffSuppressFunc: suppressYesNoDiff,
+				Description:      "Specifies whether SNATs are automatically enabled or disabled for any connections using this pool, yes or no.",
+			},
+			"ignore_persisted_weight": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringInSlice([]string{"enabled", "disabled"}, true),
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
+				Description:      "Specifies whether the ratio of connections persisted to a pool member is ignored by the load balancing method",
+			},
+			"ip_tos_to_client": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "Specifies whether NATs are automatically enabled or disabled for any connections using this pool.",
+				Description: "Type of Service (ToS) level of the packets sent to clients: pass-through, mimic or a value between 0 and 255",
 			},
-			"allow_snat": {
+			"ip_tos_to_server": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "Specifies whether SNATs are automatically enabled or disabled for any connections using this pool.",
+				Description: "Type of Service (ToS) level of the packets sent to servers: pass-through, mimic or a value between 0 and 255",
+			},
+			"link_qos_to_client": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Link Quality of Service (QoS) level of the packets sent to clients: pass-through or a value between 0 and 7",
+			},
+			"link_qos_to_server": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Link Quality of Service (QoS) level of the packets sent to servers: pass-through or a value between 0 and 7",
 			},
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Specifies descriptive text that identifies the pool.",
 			},
+			"app_service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The application service (iApp) the object belongs to. Read from the BIG-IP when not configured, so adopted iApp objects keep their owner",
+			},
+			"generation": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Generation number of the pool, increased by the BIG-IP on each change",
+			},
 			"load_balancing_mode": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -93,6 +142,7 @@ func resourceBigipLtmPool() *schema.Resource {
 				Computed:    true,
 				Description: "Specifies the number of times the system tries to contact a new pool member after a passive failure.",
 			},
+			"force_delete": forceDeleteSchema("removes the pool from the virtual servers using it as default pool"),
 		},
 	}
 }
@@ -135,22 +185,41 @@ func resourceBigipLtmPoolRead(ctx context.Context, d *schema.ResourceData, meta
 	log.Println("[INFO] Reading pool " + name)
 	pool, err := client.GetPool(name)
 	if err != nil && strings.Contains(err.Error(), "not found") {
-		log.Printf("[WARN] Pool (%s) not found, removing from state", d.Id())
+		tflog.Warn(ctx, fmt.Sprintf("Pool (%s) not found, removing from state", d.Id()))
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if pool == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Pool (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
 	_ = d.Set("allow_nat", pool.AllowNAT)
 	_ = d.Set("allow_snat", pool.AllowSNAT)
+	_ = d.Set("ignore_persisted_weight", pool.IgnorePersistedWeight)
+	_ = d.Set("ip_tos_to_client", pool.IPTOSToClient)
+	_ = d.Set("ip_tos_to_server", pool.IPTOSToServer)
+	_ = d.Set("link_qos_to_client", pool.LinkQoSToClient)
+	_ = d.Set("link_qos_to_server", pool.LinkQoSToServer)
 	_ = d.Set("load_balancing_mode", pool.LoadBalancingMode)
 	_ = d.Set("slow_ramp_time", pool.SlowRampTime)
 	_ = d.Set("minimum_active_members", pool.MinActiveMembers)
 	_ = d.Set("service_down_action", pool.ServiceDownAction)
 	_ = d.Set("reselect_tries", pool.ReselectTries)
 	_ = d.Set("description", pool.Description)
-	monitors := strings.Split(strings.TrimSpace(pool.Monitor), " and ")
+	_ = d.Set("generation", pool.Generation)
+	poolMeta, err := getLtmObjectMeta(client, restObjectPath("ltm/pool", name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("app_service", poolMeta.AppService)
+	var monitors []string
+	if monitor := strings.TrimSpace(pool.Monitor); monitor != "" {
+		monitors = strings.Split(monitor, " and ")
+	}
 	_ = d.Set("monitors", makeStringSet(&monitors))
 	return nil
 }
@@ -165,7 +234,7 @@ func resourceBigipLtmPoolRead(ctx context.Context, d *schema.ResourceData, meta
 //		return false, err
 //	}
 //	if pool == nil {
-//		log.Printf("[WARN] Pool (%s) not found, removing from state", d.Id())
+//		tflog.Warn(ctx, fmt.Sprintf("Pool (%s) not found, removing from state", d.Id()))
 //		d.SetId("")
 //	}
 //	return pool != nil, nil
@@ -181,15 +250,20 @@ func resourceBigipLtmPoolUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	pool := &bigip.Pool{
-		AllowNAT:          d.Get("allow_nat").(string),
-		AllowSNAT:         d.Get("allow_snat").(string),
-		LoadBalancingMode: d.Get("load_balancing_mode").(string),
-		Description:       d.Get("description").(string),
-		MinActiveMembers:  d.Get("minimum_active_members").(int),
-		SlowRampTime:      d.Get("slow_ramp_time").(int),
-		ServiceDownAction: d.Get("service_down_action").(string),
-		ReselectTries:     d.Get("reselect_tries").(int),
-		Monitor:           strings.Join(monitors, " and "),
+		AllowNAT:              normalizeEnum(d.Get("allow_nat").(string), yesNoSynonyms),
+		AllowSNAT:             normalizeEnum(d.Get("allow_snat").(string), yesNoSynonyms),
+		IgnorePersistedWeight: normalizeEnum(d.Get("ignore_persisted_weight").(string), enabledDisabledSynonyms),
+		IPTOSToClient:         d.Get("ip_tos_to_client").(string),
+		IPTOSToServer:         d.Get("ip_tos_to_server").(string),
+		LinkQoSToClient:       d.Get("link_qos_to_client").(string),
+		LinkQoSToServer:       d.Get("link_qos_to_server").(string),
+		LoadBalancingMode:     d.Get("load_balancing_mode").(string),
+		Description:           d.Get("description").(string),
+		MinActiveMembers:      d.Get("minimum_active_members").(int),
+		SlowRampTime:          d.Get("slow_ramp_time").(int),
+		ServiceDownAction:     d.Get("service_down_action").(string),
+		ReselectTries:         d.Get("reselect_tries").(int),
+		Monitor:               strings.Join(monitors, " and "),
 	}
 	err := client.ModifyPool(name, pool)
 	if err != nil {
@@ -200,16 +274,36 @@ func resourceBigipLtmPoolUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 		return diag.FromErr(err)
 	}
+	if err := setLtmObjectMeta(d, client, restObjectPath("ltm/pool", name), "description", "app_service"); err != nil {
+		return diag.FromErr(err)
+	}
 	return resourceBigipLtmPoolRead(ctx, d, meta)
 }
 func resourceBigipLtmPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
+	referrers, err := poolReferrers(client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(referrers) > 0 {
+		// force_delete only removes the pool from virtual servers, policies and iRules are left to the user
+		kept := referrersOfKind(referrers, uriLtmPolicy, uriLtmIRule)
+		if !d.Get("force_delete").(bool) {
+			return referencedObjectError("pool", name, referrers, len(kept) == 0)
+		}
+		if len(kept) > 0 {
+			return referencedObjectError("pool", name, kept, false)
+		}
+		if err := detachPool(ctx, client, name, referrers); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	log.Println("[INFO] Deleting pool " + name)
-	err := client.DeletePool(name)
+	err = client.DeletePool(name)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Delete Pool   (%s) (%v) ", name, err)
-		return diag.FromErr(err)
+		return diag.FromErr(fmt.Errorf("%v. The references from %s are not checked before the delete", err, poolUncheckedReferences))
 	}
 	d.SetId("")
 	return nil
@@ -14,8 +14,10 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,6 +29,7 @@ func resourceBigipLtmPoolAttachment() *schema.Resource {
 		ReadContext:   resourceBigipLtmPoolAttachmentRead,
 		UpdateContext: resourceBigipLtmPoolAttachmentUpdate,
 		DeleteContext: resourceBigipLtmPoolAttachmentDelete,
+		CustomizeDiff: validatePoolMemberSession,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceBigipLtmPoolAttachmentImport,
 		},
@@ -82,8 +85,16 @@ func resourceBigipLtmPoolAttachment() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "enabled",
-				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled", "forced_offline"}, false),
-				Description:  "Specifies the state the pool member should be in, value can be `enabled` (or) `disabled` (or) forced_offline",
+				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled", "forced_offline", "user-up", "user-down"}, false),
+				Description:  "Specifies the state the pool member should be in, value can be `enabled` (or) `disabled` (or) forced_offline, or `user-up` (or) `user-down` together with session",
+			},
+			"session": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"user-enabled", "user-disabled"}, false),
+				Description:  "Enables or disables the pool member for new sessions, value can be `user-enabled` (or) `user-disabled`. Can only be set with state `user-up` (or) `user-down`",
+				RequiredWith: []string{"state"},
 			},
 			"dynamic_ratio": {
 				Type:        schema.TypeInt,
@@ -91,12 +102,56 @@ func resourceBigipLtmPoolAttachment() *schema.Resource {
 				Description: "Sets the dynamic ratio number for the node. Used for dynamic ratio load balancing. ",
 				Computed:    true,
 			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description of the pool member",
+			},
+			"app_service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The application service (iApp) the object belongs to. Read from the BIG-IP when not configured, so adopted iApp objects keep their owner",
+			},
 			"fqdn_autopopulate": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				ForceNew:    true,
 				Description: "Specifies whether the node should scale to the IP address set returned by DNS.",
 			},
+			"wait_for_members_up": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, create and update wait until the pool member is marked up by its health monitor",
+			},
+			"members_up_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of seconds to wait for the pool member to come up when wait_for_members_up is set. Default: 300",
+			},
+			"drain_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, destroy disables the pool member and waits for its connections to drain before removing it",
+			},
+			"drain_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validation.StringInSlice([]string{"disabled", "forced_offline"}, false),
+				Description:  "State the pool member is put in while draining, `disabled` keeps persistent connections, `forced_offline` stops them too. Default: disabled",
+			},
+			"drain_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of seconds to wait for the connections to drain when drain_on_destroy is set. Default: 300",
+			},
 		},
 	}
 }
@@ -205,19 +260,7 @@ func resourceBigipLtmPoolAttachmentUpdate(ctx context.Context, d *schema.Resourc
 			Monitor:         d.Get("monitor").(string),
 		}
 
-		userState := d.Get("state").(string)
-		if userState == "enabled" {
-			config.Session = "user-enabled"
-			config.State = "user-up"
-		}
-		if userState == "disabled" {
-			config.Session = "user-disabled"
-			config.State = "user-up"
-		}
-		if userState == "forced_offline" {
-			config.Session = "user-disabled"
-			config.State = "user-down"
-		}
+		config.State, config.Session = poolMemberStateSession(d)
 		if node1.FQDN.Name != "" {
 			log.Printf("[DEBUG] adding autopopulate for fqdn ")
 			var autoPopulate string
@@ -254,19 +297,7 @@ func resourceBigipLtmPoolAttachmentUpdate(ctx context.Context, d *schema.Resourc
 			Monitor:         d.Get("monitor").(string),
 		}
 		log.Printf("[DEBUG] Modifying pool member config:%+v", config)
-		userState := d.Get("state").(string)
-		if userState == "enabled" {
-			config.Session = "user-enabled"
-			config.State = "user-up"
-		}
-		if userState == "disabled" {
-			config.Session = "user-disabled"
-			config.State = "user-up"
-		}
-		if userState == "forced_offline" {
-			config.Session = "user-disabled"
-			config.State = "user-down"
-		}
+		config.State, config.Session = poolMemberStateSession(d)
 		if !IsValidIP(ipNode) {
 			var autoPopulate string
 			if d.Get("fqdn_autopopulate").(string) == "" {
@@ -283,7 +314,190 @@ func resourceBigipLtmPoolAttachmentUpdate(ctx context.Context, d *schema.Resourc
 			return diag.FromErr(fmt.Errorf("failure adding node %s to pool %s: %s", nodeName, poolName, err))
 		}
 	}
-	return resourceBigipLtmPoolAttachmentRead(ctx, d, meta)
+	if err := setLtmObjectMeta(d, client, poolMemberURI(d.Get("pool").(string), poolMemberFullPath(d)), "description", "app_service"); err != nil {
+		return diag.FromErr(fmt.Errorf("failure updating pool member %s: %s", nodeName, err))
+	}
+	var diags diag.Diagnostics
+	if d.Get("wait_for_members_up").(bool) {
+		diags = waitForPoolMemberUp(ctx, client, d.Get("pool").(string), poolMemberFullPath(d), time.Duration(d.Get("members_up_timeout").(int))*time.Second)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	return append(diags, resourceBigipLtmPoolAttachmentRead(ctx, d, meta)...)
+}
+
+// poolMemberStateSession returns the state and session sent for the state and session attributes.
+// The enabled, disabled and forced_offline states set both, the user-up and user-down states are
+// sent as is with the configured session, user-enabled by default.
+func poolMemberStateSession(d *schema.ResourceData) (string, string) {
+	switch state := d.Get("state").(string); state {
+	case "disabled":
+		return "user-up", "user-disabled"
+	case "forced_offline":
+		return "user-down", "user-disabled"
+	case "user-up", "user-down":
+		session := d.Get("session").(string)
+		if session == "" {
+			session = "user-enabled"
+		}
+		return state, session
+	default:
+		return "user-up", "user-enabled"
+	}
+}
+
+// poolMemberStateIntent returns the state attribute for the state and session reported by the BIG-IP,
+// in the form of the configured state. Monitor-derived values (up, down, unchecked, monitor-enabled,
+// ...) are reported as the user set ones they come from, so they never cause a diff.
+func poolMemberStateIntent(deviceState, deviceSession, configured string) string {
+	if configured == "user-up" || configured == "user-down" {
+		return nodeStateIntent(deviceState, configured)
+	}
+	switch {
+	case deviceState == "user-down":
+		return "forced_offline"
+	case deviceSession == "user-disabled":
+		return "disabled"
+	default:
+		return "enabled"
+	}
+}
+
+// validatePoolMemberSession rejects session with the enabled, disabled and forced_offline states, which
+// already set the session.
+func validatePoolMemberSession(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	session := rawConfig.GetAttr("session")
+	if session.IsNull() {
+		return nil
+	}
+	if state := d.Get("state").(string); state != "user-up" && state != "user-down" {
+		return fmt.Errorf("session can only be set with state user-up or user-down, state %s already sets the session", state)
+	}
+	return nil
+}
+
+// poolMemberFullPath returns the member name in full path format, the node may be given as /partition/address:port or address:port.
+func poolMemberFullPath(d *schema.ResourceData) string {
+	nodeName := d.Get("node").(string)
+	if strings.HasPrefix(nodeName, "/") {
+		return nodeName
+	}
+	poolPartition := strings.Split(d.Get("pool").(string), "/")[1]
+	return fmt.Sprintf("/%s/%s", poolPartition, nodeName)
+}
+
+// poolMemberPollInterval is the delay between two reads of the pool member stats.
+var poolMemberPollInterval = 5 * time.Second
+
+type poolMemberStats struct {
+	Entries map[string]struct {
+		NestedStats struct {
+			Entries map[string]struct {
+				Description string `json:"description"`
+				Value       int64  `json:"value"`
+			} `json:"entries"`
+		} `json:"nestedStats"`
+	} `json:"entries"`
+}
+
+func poolMemberURI(poolName, member string) string {
+	return fmt.Sprintf("%s/members/%s", restObjectPath("ltm/pool", poolName), strings.ReplaceAll(member, "/", "~"))
+}
+
+// getPoolMembers returns all the members of the pool, across the pages of the members collection.
+func getPoolMembers(client *bigip.BigIP, poolName string) ([]bigip.PoolMember, error) {
+	return getRestItems[bigip.PoolMember](client, restObjectPath("ltm/pool", poolName)+"/members")
+}
+
+// waitForPoolMemberUp polls the member stats until the member is available and its monitor reports it up,
+// failing with the monitor status once timeout expires. Members without a monitor only produce a warning.
+func waitForPoolMemberUp(ctx context.Context, client *bigip.BigIP, poolName, member string, timeout time.Duration) diag.Diagnostics {
+	uri := poolMemberURI(poolName, member) + "/stats"
+	deadline := time.Now().Add(timeout)
+	for {
+		stats := &poolMemberStats{}
+		if _, err := getRestEntity(client, stats, uri); err != nil {
+			return diag.FromErr(fmt.Errorf("error retrieving stats of pool member %s in pool %s: %v", member, poolName, err))
+		}
+		status := make(map[string]string)
+		for _, entry := range stats.Entries {
+			for k, v := range entry.NestedStats.Entries {
+				status[k] = v.Description
+			}
+		}
+		if status["monitorRule"] == "" || status["monitorRule"] == "none" {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  "Pool member has no health monitor",
+					Detail:   fmt.Sprintf("wait_for_members_up is set but no monitor is assigned to pool member %s in pool %s, not waiting for it to come up.", member, poolName),
+				},
+			}
+		}
+		tflog.Debug(ctx, "Pool member status", map[string]interface{}{"member": member, "availability": status["status.availabilityState"], "monitor_status": status["monitorStatus"]})
+		if status["status.availabilityState"] == "available" && status["monitorStatus"] == "up" {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return diag.FromErr(fmt.Errorf("timed out after %s waiting for pool member %s in pool %s to come up, monitor status: %s (%s)",
+				timeout, member, poolName, status["monitorStatus"], status["status.statusReason"]))
+		}
+		select {
+		case <-ctx.Done():
+			return diag.FromErr(ctx.Err())
+		case <-time.After(poolMemberPollInterval):
+		}
+	}
+}
+
+// drainPoolMember disables the member, or forces it offline when mode is forced_offline, then polls its
+// server side connections until there are none left. Once timeout expires a warning is returned and the
+// member is left to be removed with its remaining connections.
+func drainPoolMember(ctx context.Context, client *bigip.BigIP, poolName, member, mode string, timeout time.Duration) diag.Diagnostics {
+	body := map[string]string{
+		"session": "user-disabled",
+		"state":   "user-up",
+	}
+	if mode == "forced_offline" {
+		body["state"] = "user-down"
+	}
+	if err := patchRestEntity(client, body, poolMemberURI(poolName, member)); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling pool member %s in pool %s: %v", member, poolName, err))
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		stats := &poolMemberStats{}
+		if _, err := getRestEntity(client, stats, poolMemberURI(poolName, member)+"/stats"); err != nil {
+			return diag.FromErr(fmt.Errorf("error retrieving stats of pool member %s in pool %s: %v", member, poolName, err))
+		}
+		var conns int64
+		for _, entry := range stats.Entries {
+			conns += entry.NestedStats.Entries["serverside.curConns"].Value
+		}
+		tflog.Debug(ctx, "Draining pool member", map[string]interface{}{"member": member, "connections": conns})
+		if conns == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  "Pool member not drained",
+					Detail:   fmt.Sprintf("pool member %s in pool %s still has %d connections after %s, removing it anyway.", member, poolName, conns, timeout),
+				},
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return diag.FromErr(ctx.Err())
+		case <-time.After(poolMemberPollInterval):
+		}
+	}
 }
 
 func resourceBigipLtmPoolAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -312,7 +526,7 @@ func resourceBigipLtmPoolAttachmentRead(ctx context.Context, d *schema.ResourceD
 		d.SetId("")
 		return nil
 	}
-	nodes, err := client.PoolMembers(poolName)
+	nodes, err := getPoolMembers(client, poolName)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error retrieving pool (%s) members: %s", poolName, err))
 	}
@@ -325,7 +539,7 @@ func resourceBigipLtmPoolAttachmentRead(ctx context.Context, d *schema.ResourceD
 	found := false
 
 	if match != nil {
-		for _, node := range nodes.PoolMembers {
+		for _, node := range nodes {
 			if expected == node.FullPath {
 				_ = d.Set("node", expected)
 				_ = d.Set("priority_group", node.PriorityGroup)
@@ -334,13 +548,15 @@ func resourceBigipLtmPoolAttachmentRead(ctx context.Context, d *schema.ResourceD
 				_ = d.Set("connection_rate_limit", node.RateLimit)
 				_ = d.Set("dynamic_ratio", node.DynamicRatio)
 				_ = d.Set("monitor", node.Monitor)
+				_ = d.Set("state", poolMemberStateIntent(node.State, node.Session, d.Get("state").(string)))
+				_ = d.Set("session", nodeSessionIntent(node.Session))
 				found = true
 				break
 			}
 		}
 	} else {
 
-		for _, node := range nodes.PoolMembers {
+		for _, node := range nodes {
 			if expected == node.Name {
 				_ = d.Set("node", expected)
 				_ = d.Set("priority_group", node.PriorityGroup)
@@ -349,6 +565,8 @@ func resourceBigipLtmPoolAttachmentRead(ctx context.Context, d *schema.ResourceD
 				_ = d.Set("connection_rate_limit", node.RateLimit)
 				_ = d.Set("dynamic_ratio", node.DynamicRatio)
 				_ = d.Set("monitor", node.Monitor)
+				_ = d.Set("state", poolMemberStateIntent(node.State, node.Session, d.Get("state").(string)))
+				_ = d.Set("session", nodeSessionIntent(node.Session))
 				found = true
 				break
 			}
@@ -358,7 +576,14 @@ func resourceBigipLtmPoolAttachmentRead(ctx context.Context, d *schema.ResourceD
 	if !found {
 		log.Printf("[WARN] Node %s is not a member of pool %s", expected, poolName)
 		d.SetId("")
+		return nil
 	}
+	memberMeta, err := getLtmObjectMeta(client, poolMemberURI(poolName, poolMemberFullPath(d)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("description", memberMeta.Description)
+	_ = d.Set("app_service", memberMeta.AppService)
 	return nil
 }
 
@@ -368,15 +593,23 @@ func resourceBigipLtmPoolAttachmentDelete(ctx context.Context, d *schema.Resourc
 	poolName := d.Get("pool").(string)
 	nodeName := d.Get("node").(string)
 
+	var diags diag.Diagnostics
+	if d.Get("drain_on_destroy").(bool) {
+		diags = drainPoolMember(ctx, client, poolName, poolMemberFullPath(d), d.Get("drain_mode").(string), time.Duration(d.Get("drain_timeout").(int))*time.Second)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	log.Printf("[INFO] Removing node %s from pool: %s", nodeName, poolName)
 
 	err := client.DeletePoolMember(poolName, nodeName)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Delete PoolMember (%s)  (%s) ", nodeName, err)
-		return diag.FromErr(fmt.Errorf("failure removing node %s from pool %s: %s ", nodeName, poolName, err))
+		return append(diags, diag.FromErr(fmt.Errorf("failure removing node %s from pool %s: %s ", nodeName, poolName, err))...)
 	}
 	d.SetId("")
-	return nil
+	return diags
 }
 
 func resourceBigipLtmPoolAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -405,14 +638,14 @@ func resourceBigipLtmPoolAttachmentImport(ctx context.Context, d *schema.Resourc
 		return nil, fmt.Errorf("unable to find the pool %s in bigip", poolName)
 	}
 
-	nodes, err := client.PoolMembers(poolName)
+	nodes, err := getPoolMembers(client, poolName)
 	if err != nil {
 		return nil, errors.New("error retrieving pool members")
 	}
 
 	// only set the instance Id that this resource manages
 	found := false
-	for _, node := range nodes.PoolMembers {
+	for _, node := range nodes {
 		if expectedNode == node.FullPath {
 			_ = d.Set("node", expectedNode)
 			_ = d.Set("priority_group", node.PriorityGroup)
@@ -430,6 +663,8 @@ func resourceBigipLtmPoolAttachmentImport(ctx context.Context, d *schema.Resourc
 		return nil, fmt.Errorf("cannot locate node %s in pool %s", expectedNode, poolName)
 	}
 	_ = d.Set("pool", poolName)
+	_ = d.Set("wait_for_members_up", false)
+	_ = d.Set("members_up_timeout", 300)
 
 	d.SetId(id)
 
@@ -8,6 +8,7 @@ package bigip
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	bigip "github.com/f5devcentral/go-bigip"
@@ -325,6 +326,26 @@ func TestAccBigipLtmPoolAttachment_Delete(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmPoolAttachment_DrainOnDestroy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPoolsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmPoolattachDrain(),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPoolAttachment("/Common/test_pool_pa_drain", "/Common/10.10.100.21:80", true),
+					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.pa_drain", "drain_on_destroy", "true"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.pa_drain", "drain_mode", "forced_offline"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmPoolAttachment_StateSet(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -351,6 +372,33 @@ func TestAccBigipLtmPoolAttachment_StateSet(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmPoolAttachment_Description(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPoolsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmPoolattachDescription(1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPoolAttachment("/Common/test_pool_pa_desc", "/Common/10.10.100.21:80", true),
+					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.pa_desc", "description", "web-01"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.pa_desc", "app_service", ""),
+				),
+			},
+			{
+				Config: testaccbigipltmPoolattachDescription(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.pa_desc", "ratio", "2"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.pa_desc", "description", "web-01"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmPoolAttachment_ModifyState(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -377,6 +425,63 @@ func TestAccBigipLtmPoolAttachment_ModifyState(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmPoolAttachment_Session(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPoolsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmPoolattachSession("user-up", "user-enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPoolMemberSession("/Common/test_pool_pa_session", "/Common/10.10.100.31:80", "user-enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.pa_session", "state", "user-up"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.pa_session", "session", "user-enabled"),
+				),
+			},
+			{
+				Config: testaccbigipltmPoolattachSession("user-up", "user-disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPoolMemberSession("/Common/test_pool_pa_session", "/Common/10.10.100.31:80", "user-disabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.pa_session", "state", "user-up"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.pa_session", "session", "user-disabled"),
+				),
+			},
+			{
+				Config: testaccbigipltmPoolattachSession("user-up", "user-enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPoolMemberSession("/Common/test_pool_pa_session", "/Common/10.10.100.31:80", "user-enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.pa_session", "session", "user-enabled"),
+				),
+			},
+			{
+				Config:      testaccbigipltmPoolattachSession("enabled", "user-disabled"),
+				ExpectError: regexp.MustCompile("session can only be set with state user-up or user-down"),
+			},
+		},
+	})
+}
+
+func testCheckPoolMemberSession(poolName, member, session string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		obj := &bigip.PoolMember{}
+		found, err := getRestEntity(client, obj, poolMemberURI(poolName, member))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Pool member %s does not exist in pool %s", member, poolName)
+		}
+		if obj.Session != session {
+			return fmt.Errorf("Pool member %s session is %s, expected %s", member, obj.Session, session)
+		}
+		return nil
+	}
+}
+
 func TestAccBigipLtmPoolAttachmentTestCases(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -608,3 +713,49 @@ func testaccbigipltmPoolattachStateChange() string {
 		}`
 	return tfConfig
 }
+
+func testaccbigipltmPoolattachDrain() string {
+	tfConfig := `
+		resource "bigip_ltm_pool" "pa_drain" {
+			name = "/Common/test_pool_pa_drain"
+			load_balancing_mode = "round-robin"
+		}
+		resource "bigip_ltm_pool_attachment" "pa_drain" {
+		  pool             = bigip_ltm_pool.pa_drain.name
+		  node             = "10.10.100.21:80"
+		  drain_on_destroy = true
+		  drain_mode       = "forced_offline"
+		  drain_timeout    = 30
+		}`
+	return tfConfig
+}
+
+func testaccbigipltmPoolattachDescription(ratio int) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_pool" "pa_desc" {
+  name                = "/Common/test_pool_pa_desc"
+  load_balancing_mode = "round-robin"
+}
+resource "bigip_ltm_pool_attachment" "pa_desc" {
+  pool        = bigip_ltm_pool.pa_desc.name
+  node        = "10.10.100.21:80"
+  description = "web-01"
+  ratio       = %d
+}
+`, ratio)
+}
+
+func testaccbigipltmPoolattachSession(state, session string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_pool" "pa_session" {
+  name                = "/Common/test_pool_pa_session"
+  load_balancing_mode = "round-robin"
+}
+resource "bigip_ltm_pool_attachment" "pa_session" {
+  pool    = bigip_ltm_pool.pa_session.name
+  node    = "10.10.100.31:80"
+  state   = "%s"
+  session = "%s"
+}
+`, state, session)
+}
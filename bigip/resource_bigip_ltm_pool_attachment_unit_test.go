@@ -0,0 +1,224 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const poolMemberStatsURI = "/mgmt/tm/ltm/pool/~Common~test-pool/members/~Common~10.10.10.10:80/stats"
+
+func poolMemberStatsHandler(monitorRule, monitorStatus, availability string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"entries":{"https://localhost%s":{"nestedStats":{"entries":{
+"monitorRule":{"description":"%s"},
+"monitorStatus":{"description":"%s"},
+"status.availabilityState":{"description":"%s"},
+"status.statusReason":{"description":"Pool member has been marked down by a monitor"}}}}}}`, poolMemberStatsURI, monitorRule, monitorStatus, availability)
+	}
+}
+
+func TestWaitForPoolMemberUp(t *testing.T) {
+	cases := []struct {
+		name          string
+		monitorRule   string
+		monitorStatus string
+		availability  string
+		severity      *diag.Severity
+		message       string
+	}{
+		{"up", "/Common/http (pool monitor)", "up", "available", nil, ""},
+		{"no monitor", "none", "unchecked", "unknown", severityPtr(diag.Warning), "no health monitor"},
+		{"down", "/Common/http (pool monitor)", "down", "offline", severityPtr(diag.Error), "monitor status: down"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+			mux.HandleFunc(poolMemberStatsURI, poolMemberStatsHandler(c.monitorRule, c.monitorStatus, c.availability))
+			client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+
+			diags := waitForPoolMemberUp(context.Background(), client, "/Common/test-pool", "/Common/10.10.10.10:80", time.Millisecond)
+			if c.severity == nil {
+				assert.Empty(t, diags)
+				return
+			}
+			assert.Len(t, diags, 1)
+			assert.Equal(t, *c.severity, diags[0].Severity)
+			assert.Contains(t, diags[0].Summary+diags[0].Detail, c.message)
+		})
+	}
+}
+
+func severityPtr(s diag.Severity) *diag.Severity {
+	return &s
+}
+
+func TestDrainPoolMember(t *testing.T) {
+	setup()
+	defer teardown()
+	poolMemberPollInterval = time.Millisecond
+	defer func() { poolMemberPollInterval = 5 * time.Second }()
+
+	var body map[string]string
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-pool/members/~Common~10.10.10.10:80", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	conns := []int{2, 1, 0}
+	reads := 0
+	mux.HandleFunc(poolMemberStatsURI, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"entries":{"https://localhost%s":{"nestedStats":{"entries":{"serverside.curConns":{"value":%d}}}}}}`, poolMemberStatsURI, conns[reads])
+		reads++
+	})
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+
+	diags := drainPoolMember(context.Background(), client, "/Common/test-pool", "/Common/10.10.10.10:80", "forced_offline", time.Second)
+	assert.Empty(t, diags)
+	assert.Equal(t, map[string]string{"session": "user-disabled", "state": "user-down"}, body)
+	assert.Equal(t, 3, reads)
+}
+
+func TestDrainPoolMemberTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+	poolMemberPollInterval = time.Millisecond
+	defer func() { poolMemberPollInterval = 5 * time.Second }()
+
+	var body map[string]string
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-pool/members/~Common~10.10.10.10:80", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc(poolMemberStatsURI, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"entries":{"https://localhost%s":{"nestedStats":{"entries":{"serverside.curConns":{"value":4}}}}}}`, poolMemberStatsURI)
+	})
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+
+	diags := drainPoolMember(context.Background(), client, "/Common/test-pool", "/Common/10.10.10.10:80", "disabled", 10*time.Millisecond)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Contains(t, diags[0].Detail, "still has 4 connections")
+	assert.Equal(t, map[string]string{"session": "user-disabled", "state": "user-up"}, body)
+}
+
+func TestSetLtmObjectMeta(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var payloads []map[string]string
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-pool/members/~Common~10.10.10.10:80", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		var payload map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads = append(payloads, payload)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	path := poolMemberURI("/Common/test-pool", "/Common/10.10.10.10:80")
+
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmPoolAttachment().Schema, map[string]interface{}{
+		"pool":        "/Common/test-pool",
+		"node":        "/Common/10.10.10.10:80",
+		"description": "web-01",
+		"app_service": "/Common/web.app/web",
+	})
+	assert.NoError(t, setLtmObjectMeta(d, client, path, "description", "app_service"))
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmPoolAttachment().Schema, map[string]interface{}{
+		"pool": "/Common/test-pool",
+		"node": "/Common/10.10.10.10:80",
+	})
+	assert.NoError(t, setLtmObjectMeta(d, client, path, "description", "app_service"))
+
+	assert.Equal(t, []map[string]string{{"description": "web-01", "appService": "/Common/web.app/web"}}, payloads)
+}
+
+func TestPoolMemberStateSession(t *testing.T) {
+	for _, tc := range []struct {
+		config  map[string]interface{}
+		state   string
+		session string
+	}{
+		{map[string]interface{}{}, "user-up", "user-enabled"},
+		{map[string]interface{}{"state": "disabled"}, "user-up", "user-disabled"},
+		{map[string]interface{}{"state": "forced_offline"}, "user-down", "user-disabled"},
+		{map[string]interface{}{"state": "user-up"}, "user-up", "user-enabled"},
+		{map[string]interface{}{"state": "user-up", "session": "user-disabled"}, "user-up", "user-disabled"},
+		{map[string]interface{}{"state": "user-down", "session": "user-enabled"}, "user-down", "user-enabled"},
+	} {
+		tc.config["pool"] = "/Common/test-pool"
+		tc.config["node"] = "/Common/10.10.10.10:80"
+		d := schema.TestResourceDataRaw(t, resourceBigipLtmPoolAttachment().Schema, tc.config)
+		state, session := poolMemberStateSession(d)
+		assert.Equal(t, tc.state, state, "%v", tc.config)
+		assert.Equal(t, tc.session, session, "%v", tc.config)
+	}
+}
+
+func TestPoolMemberStateIntent(t *testing.T) {
+	assert.Equal(t, "enabled", poolMemberStateIntent("up", "monitor-enabled", "enabled"))
+	assert.Equal(t, "enabled", poolMemberStateIntent("unchecked", "user-enabled", ""))
+	assert.Equal(t, "disabled", poolMemberStateIntent("down", "user-disabled", "enabled"))
+	assert.Equal(t, "forced_offline", poolMemberStateIntent("user-down", "user-disabled", "disabled"))
+	assert.Equal(t, "user-up", poolMemberStateIntent("unchecked", "user-disabled", "user-up"))
+	assert.Equal(t, "user-up", poolMemberStateIntent("down", "monitor-enabled", "user-down"))
+	assert.Equal(t, "user-down", poolMemberStateIntent("user-down", "user-enabled", "user-up"))
+}
+
+func TestResourceBigipLtmPoolAttachmentReadStateSession(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-pool", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"name":"test-pool","fullPath":"/Common/test-pool"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-pool/members", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"10.10.10.10:80","fullPath":"/Common/10.10.10.10:80","state":"unchecked","session":"user-disabled","monitor":"default"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-pool/members/~Common~10.10.10.10:80", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	for configured, want := range map[string]string{"disabled": "disabled", "enabled": "disabled", "user-up": "user-up"} {
+		d := schema.TestResourceDataRaw(t, resourceBigipLtmPoolAttachment().Schema, map[string]interface{}{
+			"pool":  "/Common/test-pool",
+			"node":  "/Common/10.10.10.10:80",
+			"state": configured,
+		})
+		d.SetId("/Common/test-pool-/Common/10.10.10.10:80")
+		diags := resourceBigipLtmPoolAttachmentRead(context.Background(), d, client)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, want, d.Get("state"), configured)
+		assert.Equal(t, "user-disabled", d.Get("session"), configured)
+	}
+}
@@ -75,6 +75,43 @@ func TestAccBigipLtmPool_create(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmPool_updateInPlace(t *testing.T) {
+	var instance testAccInstance
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPoolsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipLtmPoolLoadBalancingConfig("round-robin"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPoolExists(TestPoolName),
+					testCheckResourceNotReplaced("bigip_ltm_pool.test-pool", &instance),
+				),
+			},
+			{
+				Config: testAccBigipLtmPoolLoadBalancingConfig("least-connections-member"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceNotReplaced("bigip_ltm_pool.test-pool", &instance),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "load_balancing_mode", "least-connections-member"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBigipLtmPoolLoadBalancingConfig(mode string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_pool" "test-pool" {
+  name                = "%s"
+  monitors            = ["/Common/http"]
+  load_balancing_mode = "%s"
+}
+`, TestPoolName, mode)
+}
+
 func TestAccBigipLtmPool_import(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -88,10 +125,89 @@ func TestAccBigipLtmPool_import(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testCheckPoolExists(TestPoolName),
 				),
-				ResourceName:      TestPoolResource,
-				ImportState:       false,
+			},
+			{
+				ResourceName:      "bigip_ltm_pool.test-pool",
+				ImportState:       true,
+				ImportStateId:     TestPoolName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccBigipLtmPool_defaults imports a pool created with the defaults of the BIG-IP, a plan of the
+// same configuration must be empty.
+func TestAccBigipLtmPool_defaults(t *testing.T) {
+	config := fmt.Sprintf(`
+resource "bigip_ltm_pool" "test-pool" {
+  name = "%s"
+}
+`, TestPoolName)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPoolsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPoolExists(TestPoolName),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "allow_nat", "yes"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "ignore_persisted_weight", "disabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "ip_tos_to_client", "pass-through"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "link_qos_to_server", "pass-through"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "monitors.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "bigip_ltm_pool.test-pool",
+				ImportState:       true,
+				ImportStateId:     TestPoolName,
 				ImportStateVerify: true,
 			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmPool_trafficClass(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPoolsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "bigip_ltm_pool" "test-pool" {
+  name                    = "%s"
+  allow_nat               = "disabled"
+  allow_snat              = "no"
+  ignore_persisted_weight = "enabled"
+  ip_tos_to_client        = "mimic"
+  ip_tos_to_server        = "16"
+  link_qos_to_client      = "3"
+  link_qos_to_server      = "pass-through"
+}
+`, TestPoolName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPoolExists(TestPoolName),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "allow_nat", "no"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "allow_snat", "no"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "ignore_persisted_weight", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "ip_tos_to_client", "mimic"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "ip_tos_to_server", "16"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "link_qos_to_client", "3"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "link_qos_to_server", "pass-through"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,120 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// defaultPoolJSON is a pool created with the BIG-IP defaults, as returned by a GET.
+const defaultPoolJSON = `{"name":"test-pool","partition":"Common","fullPath":"/Common/test-pool","generation":12,
+	"allowNat":"yes","allowSnat":"yes","ignorePersistedWeight":"disabled","ipTosToClient":"pass-through",
+	"ipTosToServer":"pass-through","linkQosToClient":"pass-through","linkQosToServer":"pass-through",
+	"loadBalancingMode":"round-robin","minActiveMembers":0,"reselectTries":0,"serviceDownAction":"none",
+	"slowRampTime":10}`
+
+func TestResourceBigipLtmPoolReadDefaults(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-pool", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, defaultPoolJSON)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipLtmPool()
+	d := r.TestResourceData()
+	d.SetId("/Common/test-pool")
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	for attr, expected := range map[string]interface{}{
+		"allow_nat":               "yes",
+		"allow_snat":              "yes",
+		"ignore_persisted_weight": "disabled",
+		"ip_tos_to_client":        "pass-through",
+		"ip_tos_to_server":        "pass-through",
+		"link_qos_to_client":      "pass-through",
+		"link_qos_to_server":      "pass-through",
+		"load_balancing_mode":     "round-robin",
+		"service_down_action":     "none",
+		"slow_ramp_time":          10,
+	} {
+		assert.Equal(t, expected, d.Get(attr), attr)
+	}
+	assert.Equal(t, 0, d.Get("monitors").(*schema.Set).Len())
+}
+
+func TestResourceBigipLtmPoolReadNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-pool", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{"code":404,"message":"01020036:3: The requested Pool (/Common/test-pool) was not found."}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipLtmPool()
+	d := r.TestResourceData()
+	d.SetId("/Common/test-pool")
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "", d.Id())
+}
+
+func TestResourceBigipLtmPoolUpdateNormalizes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var put map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-pool", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			b, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(b, &put))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, defaultPoolJSON)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipLtmPool()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":                    "/Common/test-pool",
+		"allow_nat":               "disabled",
+		"allow_snat":              "enabled",
+		"ignore_persisted_weight": "Enabled",
+		"ip_tos_to_client":        "mimic",
+		"link_qos_to_server":      "3",
+	})
+	d.SetId("/Common/test-pool")
+	assert.False(t, r.UpdateContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "no", put["allowNat"])
+	assert.Equal(t, "yes", put["allowSnat"])
+	assert.Equal(t, "enabled", put["ignorePersistedWeight"])
+	assert.Equal(t, "mimic", put["ipTosToClient"])
+	assert.Equal(t, "3", put["linkQosToServer"])
+}
@@ -7,7 +7,9 @@ package bigip
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
 
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,6 +17,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	uriBotDefenseProfile = "security/bot-defense/profile"
+	// botDefenseAllowlistEntry is the whitelist entry of the profile managed through allowlist_address_list.
+	botDefenseAllowlistEntry = "tf-allowlist-address-list"
+)
+
+// addressListPaths are the endpoints an address list may live under: security shared-objects on
+// newer TMOS, which does not need AFM provisioned, and the AFM firewall address lists.
+var addressListPaths = []string{"security/shared-objects/address-list", "security/firewall/address-list"}
+
+// validateCommonAddressList accepts address lists referenced by their full path in /Common.
+var validateCommonAddressList = validation.StringMatch(regexp.MustCompile(`^/Common/[^/]+$`), "must be the full path of an address list in /Common, e.g. /Common/allowlist")
+
 func resourceBigipLtmProfileBotDefense() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceBigipLtmProfileBotDefenseCreate,
@@ -63,6 +78,12 @@ func resourceBigipLtmProfileBotDefense() *schema.Resource {
 					"blocking"}, false),
 				Description: "Select the enforcement mode, possible values are `transparent` and `blocking`.",
 			},
+			"allowlist_address_list": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCommonAddressList,
+				Description:  "Full path of a shared security address list in /Common whose addresses are allowed without bot defense checks",
+			},
 		},
 	}
 }
@@ -81,6 +102,9 @@ func resourceBigipLtmProfileBotDefenseCreate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 	d.SetId(name)
+	if err := setBotDefenseAllowlist(d, client, name); err != nil {
+		return diag.FromErr(err)
+	}
 	return resourceBigipLtmProfileBotDefenseRead(ctx, d, meta)
 }
 
@@ -99,6 +123,14 @@ func resourceBigipLtmProfileBotDefenseRead(ctx context.Context, d *schema.Resour
 	d.Set("description", botProfile.Description)
 	d.Set("template", botProfile.Template)
 	d.Set("enforcement_mode", botProfile.EnforcementMode)
+
+	entry := &struct {
+		SourceAddressList string `json:"sourceAddressList"`
+	}{}
+	if _, err := getRestEntity(client, entry, restObjectPath(uriBotDefenseProfile, name)+"/whitelist/"+botDefenseAllowlistEntry); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("allowlist_address_list", entry.SourceAddressList)
 	return nil
 }
 
@@ -115,6 +147,11 @@ func resourceBigipLtmProfileBotDefenseUpdate(ctx context.Context, d *schema.Reso
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if d.HasChange("allowlist_address_list") {
+		if err := setBotDefenseAllowlist(d, client, name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceBigipLtmProfileBotDefenseRead(ctx, d, meta)
 }
 
@@ -141,3 +178,81 @@ func getProfileBotDefenseConfig(d *schema.ResourceData, config *bigip.BotDefense
 	log.Printf("[INFO][getProfileBotDefenseConfig] config:%+v ", config)
 	return config
 }
+
+// setBotDefenseAllowlist creates, updates or removes the whitelist entry of the profile referencing
+// allowlist_address_list.
+func setBotDefenseAllowlist(d *schema.ResourceData, client *bigip.BigIP, name string) error {
+	addressList := d.Get("allowlist_address_list").(string)
+	entryPath := restObjectPath(uriBotDefenseProfile, name) + "/whitelist/" + botDefenseAllowlistEntry
+	found, err := getRestEntity(client, &struct{}{}, entryPath)
+	if err != nil {
+		return err
+	}
+	if addressList == "" {
+		if !found {
+			return nil
+		}
+		return deleteRestEntity(client, entryPath)
+	}
+	exists, err := securityAddressListExists(client, addressList)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("address list %s referenced by allowlist_address_list not found", addressList)
+	}
+	entry := map[string]interface{}{
+		"sourceAddressList": addressList,
+	}
+	if found {
+		// The entry keeps its place among the other whitelist entries.
+		err = patchRestEntity(client, entry, entryPath)
+	} else {
+		var matchOrder int
+		if matchOrder, err = nextBotDefenseWhitelistMatchOrder(client, name); err != nil {
+			return err
+		}
+		entry["name"] = botDefenseAllowlistEntry
+		entry["matchOrder"] = matchOrder
+		err = postRestEntity(client, entry, restObjectPath(uriBotDefenseProfile, name)+"/whitelist")
+	}
+	if err != nil {
+		return fmt.Errorf("error setting allowlist_address_list on Bot Defense profile (%s): %s", name, err)
+	}
+	return nil
+}
+
+// nextBotDefenseWhitelistMatchOrder returns the first matchOrder after the whitelist entries the
+// profile already has, so the managed entry does not collide with the ones of the template.
+func nextBotDefenseWhitelistMatchOrder(client *bigip.BigIP, name string) (int, error) {
+	whitelist, err := getRestItems[struct {
+		MatchOrder int `json:"matchOrder"`
+	}](client, restObjectPath(uriBotDefenseProfile, name)+"/whitelist")
+	if err != nil {
+		return 0, fmt.Errorf("error reading whitelist of Bot Defense profile (%s): %s", name, err)
+	}
+	next := 1
+	for _, item := range whitelist {
+		if item.MatchOrder >= next {
+			next = item.MatchOrder + 1
+		}
+	}
+	return next, nil
+}
+
+// securityAddressListExists looks the address list up under every endpoint in addressListPaths. An
+// error from an endpoint (e.g. the module is not provisioned) falls through to the next one.
+func securityAddressListExists(client *bigip.BigIP, addressList string) (bool, error) {
+	var lastErr error
+	for _, path := range addressListPaths {
+		found, err := getRestEntity(client, &struct{}{}, restObjectPath(path, addressList))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if found {
+			return true, nil
+		}
+	}
+	return false, lastErr
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityAddressListExists(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/security/shared-objects/address-list/~Common~allowlist", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested address list (/Common/allowlist) was not found."}`)
+	})
+	mux.HandleFunc("/mgmt/tm/security/firewall/address-list/~Common~allowlist", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"allowlist","partition":"Common","fullPath":"/Common/allowlist"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	found, err := securityAddressListExists(client, "/Common/allowlist")
+	assert.NoError(t, err)
+	assert.True(t, found)
+}
+
+func TestValidateCommonAddressList(t *testing.T) {
+	data := map[string]int{
+		"/Common/allowlist": 0,
+		"/Tenant/allowlist": 1,
+		"allowlist":         1,
+		"/Common/a/b":       1,
+	}
+	for v, ec := range data {
+		_, errs := validateCommonAddressList(v, "allowlist_address_list")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", v, ec)
+	}
+}
+
+func TestSetBotDefenseAllowlist(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var entry map[string]interface{}
+	var lastMethod string
+	whitelist := "/mgmt/tm/security/bot-defense/profile/~Common~bd/whitelist"
+	mux.HandleFunc(whitelist, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = fmt.Fprintf(w, `{"items":[{"name":"template-entry","matchOrder":1},{"name":"user-entry","matchOrder":3}]}`)
+		case http.MethodPost:
+			lastMethod = r.Method
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&entry))
+			_, _ = fmt.Fprintf(w, `{}`)
+		}
+	})
+	mux.HandleFunc(whitelist+"/"+botDefenseAllowlistEntry, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if entry == nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested whitelist entry was not found."}`)
+			return
+		}
+		switch r.Method {
+		case http.MethodPatch:
+			lastMethod = r.Method
+			patch := map[string]interface{}{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&patch))
+			assert.NotContains(t, patch, "matchOrder", "update moved the entry")
+			entry["sourceAddressList"] = patch["sourceAddressList"]
+		case http.MethodDelete:
+			lastMethod = r.Method
+			entry = nil
+		}
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	for _, list := range []string{"allowlist", "otherlist"} {
+		mux.HandleFunc("/mgmt/tm/security/shared-objects/address-list/~Common~"+list, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{}`)
+		})
+	}
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	botDefenseData := func(addressList string) *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, resourceBigipLtmProfileBotDefense().Schema, map[string]interface{}{
+			"name":                   "/Common/bd",
+			"allowlist_address_list": addressList,
+		})
+	}
+
+	// create: the entry is appended after the existing ones
+	assert.NoError(t, setBotDefenseAllowlist(botDefenseData("/Common/allowlist"), client, "/Common/bd"))
+	assert.Equal(t, http.MethodPost, lastMethod)
+	assert.Equal(t, botDefenseAllowlistEntry, entry["name"])
+	assert.Equal(t, "/Common/allowlist", entry["sourceAddressList"])
+	assert.Equal(t, float64(4), entry["matchOrder"])
+
+	// update: the existing entry is modified in place
+	assert.NoError(t, setBotDefenseAllowlist(botDefenseData("/Common/otherlist"), client, "/Common/bd"))
+	assert.Equal(t, http.MethodPatch, lastMethod)
+	assert.Equal(t, "/Common/otherlist", entry["sourceAddressList"])
+	assert.Equal(t, float64(4), entry["matchOrder"])
+
+	// remove: clearing the attribute deletes the entry
+	assert.NoError(t, setBotDefenseAllowlist(botDefenseData(""), client, "/Common/bd"))
+	assert.Equal(t, http.MethodDelete, lastMethod)
+	assert.Nil(t, entry)
+}
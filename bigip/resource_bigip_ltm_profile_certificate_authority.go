@@ -0,0 +1,173 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriProfileCertificateAuthority = "ltm/profile/certificate-authority"
+
+// CertificateAuthorityProfile mirrors the ltm profile certificate-authority object.
+type CertificateAuthorityProfile struct {
+	Name              string `json:"name,omitempty"`
+	Partition         string `json:"partition,omitempty"`
+	FullPath          string `json:"fullPath,omitempty"`
+	DefaultsFrom      string `json:"defaultsFrom,omitempty"`
+	Description       string `json:"description,omitempty"`
+	CaFile            string `json:"caFile,omitempty"`
+	CrlFile           string `json:"crlFile,omitempty"`
+	AuthenticateDepth int    `json:"authenticateDepth,omitempty"`
+	UpdateCrl         string `json:"updateCrl,omitempty"`
+}
+
+func resourceBigipLtmProfileCertificateAuthority() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileCertificateAuthorityCreate,
+		ReadContext:   resourceBigipLtmProfileCertificateAuthorityRead,
+		UpdateContext: resourceBigipLtmProfileCertificateAuthorityUpdate,
+		DeleteContext: resourceBigipLtmProfileCertificateAuthorityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the certificate authority profile, in full path format e.g. /Common/my-ca",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the profile that you want to use as the parent profile",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined description",
+			},
+			"ca_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "CA bundle of the certificate authorities trusted to issue client certificates",
+			},
+			"crl_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Certificate revocation list checked against the client certificates",
+			},
+			"authenticate_depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum depth of the client certificate chain",
+			},
+			"update_crl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Specifies whether the CRL is updated automatically",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileCertificateAuthorityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_certificate_authority", name, "create", icontrolURI(uriProfileCertificateAuthority, name))
+
+	config := getCertificateAuthorityProfileConfig(d, &CertificateAuthorityProfile{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriProfileCertificateAuthority)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating certificate authority profile (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileCertificateAuthorityRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileCertificateAuthorityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_certificate_authority", name, "read", icontrolURI(uriProfileCertificateAuthority, name))
+
+	obj := &CertificateAuthorityProfile{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriProfileCertificateAuthority, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "Certificate Authority Profile not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("ca_file", obj.CaFile)
+	_ = d.Set("crl_file", obj.CrlFile)
+	_ = d.Set("authenticate_depth", obj.AuthenticateDepth)
+	_ = d.Set("update_crl", obj.UpdateCrl)
+	return nil
+}
+
+func resourceBigipLtmProfileCertificateAuthorityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_certificate_authority", name, "update", icontrolURI(uriProfileCertificateAuthority, name))
+
+	config := getCertificateAuthorityProfileConfig(d, &CertificateAuthorityProfile{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriProfileCertificateAuthority, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying certificate authority profile (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileCertificateAuthorityRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileCertificateAuthorityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_certificate_authority", name, "delete", icontrolURI(uriProfileCertificateAuthority, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriProfileCertificateAuthority, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getCertificateAuthorityProfileConfig(d *schema.ResourceData, config *CertificateAuthorityProfile) *CertificateAuthorityProfile {
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.CaFile = d.Get("ca_file").(string)
+	config.CrlFile = d.Get("crl_file").(string)
+	config.AuthenticateDepth = d.Get("authenticate_depth").(int)
+	config.UpdateCrl = d.Get("update_crl").(string)
+	return config
+}
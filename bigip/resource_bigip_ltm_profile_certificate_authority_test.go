@@ -0,0 +1,109 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestCertificateAuthorityProfileName = fmt.Sprintf("/%s/test-profile-ca", TestPartition)
+
+func TestAccBigipLtmProfileCertificateAuthority_create(t *testing.T) {
+	resName := "bigip_ltm_profile_certificate_authority.test-ca"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckCertificateAuthorityProfileDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateAuthorityProfileConfig(TestCertificateAuthorityProfileName, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCertificateAuthorityProfileExists(TestCertificateAuthorityProfileName),
+					resource.TestCheckResourceAttr(resName, "name", TestCertificateAuthorityProfileName),
+					resource.TestCheckResourceAttr(resName, "ca_file", "/Common/ca-bundle.crt"),
+					resource.TestCheckResourceAttr(resName, "authenticate_depth", "4"),
+				),
+			},
+			{
+				Config: testAccCertificateAuthorityProfileConfig(TestCertificateAuthorityProfileName, 6),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCertificateAuthorityProfileExists(TestCertificateAuthorityProfileName),
+					resource.TestCheckResourceAttr(resName, "authenticate_depth", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileCertificateAuthority_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckCertificateAuthorityProfileDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateAuthorityProfileConfig(TestCertificateAuthorityProfileName, 4),
+			},
+			{
+				ResourceName:      "bigip_ltm_profile_certificate_authority.test-ca",
+				ImportStateId:     TestCertificateAuthorityProfileName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckCertificateAuthorityProfileExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &CertificateAuthorityProfile{}, restObjectPath(uriProfileCertificateAuthority, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("certificate authority profile %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckCertificateAuthorityProfileDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_certificate_authority" {
+			continue
+		}
+		name := rs.Primary.ID
+		found, err := getRestEntity(client, &CertificateAuthorityProfile{}, restObjectPath(uriProfileCertificateAuthority, name))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("certificate authority profile %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func testAccCertificateAuthorityProfileConfig(name string, depth int) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_certificate_authority" "test-ca" {
+  name               = "%s"
+  ca_file            = "/Common/ca-bundle.crt"
+  authenticate_depth = %d
+}
+`, name, depth)
+}
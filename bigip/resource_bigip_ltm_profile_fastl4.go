@@ -10,6 +10,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
+	"sync"
 
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,6 +19,45 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const uriLtmProfileFastl4 = "ltm/profile/fastl4"
+
+// LtmFastl4 adds to the go-bigip type the synCookieEnable field that replaced hardwareSynCookie
+// in BIG-IP 15.x.
+type LtmFastl4 struct {
+	bigip.Fastl4
+	SynCookieEnable string `json:"synCookieEnable,omitempty"`
+}
+
+// fastl4SynCookieFields maps a configured client to the API field of the SYN cookie setting its
+// device supports, probed once per provider instance.
+var fastl4SynCookieFields sync.Map
+
+// fastl4SynCookieField returns synCookieEnable or hardwareSynCookie, whichever the fastL4 profile
+// of the device has.
+func fastl4SynCookieField(client *bigip.BigIP) (string, error) {
+	if field, ok := fastl4SynCookieFields.Load(client); ok {
+		return field.(string), nil
+	}
+	profile := map[string]interface{}{}
+	if _, err := getRestEntity(client, &profile, restObjectPath(uriLtmProfileFastl4, "/Common/fastL4")); err != nil {
+		return "", fmt.Errorf("error reading the fastL4 profile fields: %v", err)
+	}
+	field := "hardwareSynCookie"
+	if _, ok := profile["synCookieEnable"]; ok {
+		field = "synCookieEnable"
+	}
+	fastl4SynCookieFields.Store(client, field)
+	return field, nil
+}
+
+// validateFastl4IdleTimeout accepts a number of seconds, immediate or indefinite.
+func validateFastl4IdleTimeout(value interface{}, field string) (ws []string, errors []error) {
+	if !regexp.MustCompile(`^([1-9][0-9]*|-1|immediate|indefinite)$`).MatchString(value.(string)) {
+		errors = append(errors, fmt.Errorf("%q must be a number of seconds, immediate or indefinite, got %q", field, value))
+	}
+	return
+}
+
 func resourceBigipLtmProfileFastl4() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceBigipProfileLtmFastl4Create,
@@ -68,17 +109,31 @@ func resourceBigipLtmProfileFastl4() *schema.Resource {
 				Description:  "Specifies whether a qualified late-binding connection requires an explicit iRule command to migrate down to ePVA hardware. The default is disabled",
 			},
 			"hardware_syncookie": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"disabled", "enabled"}, false),
+				ConflictsWith: []string{"syn_cookie_enable"},
+				Deprecated:    "Use syn_cookie_enable instead, which is sent as hardwareSynCookie or synCookieEnable depending on the BIG-IP version",
+				Description:   "Use the parent Fastl4 profile",
+			},
+			"syn_cookie_enable": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"disabled", "enabled"}, false),
+				ConflictsWith: []string{"hardware_syncookie"},
+				Description:   "Enables SYN cookie protection, sent as hardwareSynCookie before BIG-IP 15.x and as synCookieEnable since",
+			},
+			"idle_timeout": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
-				Description:  "Use the parent Fastl4 profile",
-			},
-			"idle_timeout": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Number of seconds (default 300; may not be 0) connection may remain idle before it becomes eligible for deletion. Value -1 (not recommended) means infinite",
+				ValidateFunc: validateFastl4IdleTimeout,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "indefinite" && new == "-1"
+				},
+				Description: "Number of seconds (default 300; may not be 0) connection may remain idle before it becomes eligible for deletion, `immediate` or `indefinite`. Value -1 (not recommended) is the same as indefinite",
 			},
 			"iptos_toclient": {
 				Type:        schema.TypeString,
@@ -134,15 +189,17 @@ func resourceBigipProfileLtmFastl4Create(ctx context.Context, d *schema.Resource
 	name := d.Get("name").(string)
 
 	log.Println("[INFO] Creating Fastl4 profile")
-	configFastl4 := &bigip.Fastl4{
-		Name: name,
-	}
+	configFastl4 := &LtmFastl4{}
+	configFastl4.Name = name
 	if d.Get("explicitflow_migration").(string) == "enabled" && d.Get("late_binding").(string) != "enabled" {
 		return diag.FromErr(fmt.Errorf("explicitflow_migration can be enabled only if late_binding set to enabled"))
 	}
-	fastL4ProfileConfig := getFastL4ProfileConfig(d, configFastl4)
+	fastL4ProfileConfig, err := getFastL4ProfileConfig(d, client, configFastl4)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	err := client.CreateFastl4(fastL4ProfileConfig)
+	err = postRestEntity(client, fastL4ProfileConfig, uriLtmProfileFastl4)
 
 	if err != nil {
 		log.Printf("[ERROR] Unable to Create FastL4  (%s) (%v) ", name, err)
@@ -157,16 +214,18 @@ func resourceBigipLtmProfileFastl4Update(ctx context.Context, d *schema.Resource
 	client := meta.(*bigip.BigIP)
 
 	name := d.Id()
-	configFastl4 := &bigip.Fastl4{
-		Name: name,
-	}
+	configFastl4 := &LtmFastl4{}
+	configFastl4.Name = name
 	if d.Get("explicitflow_migration").(string) == "enabled" && d.Get("late_binding").(string) != "enabled" {
 		return diag.FromErr(fmt.Errorf("explicitflow_migration can be enabled only if late_binding set to enabled"))
 	}
 	log.Println("[INFO] Updating Fastl4 profile")
-	fastL4ProfileConfig := getFastL4ProfileConfig(d, configFastl4)
+	fastL4ProfileConfig, err := getFastL4ProfileConfig(d, client, configFastl4)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	err := client.ModifyFastl4(name, fastL4ProfileConfig)
+	err = patchRestEntity(client, fastL4ProfileConfig, restObjectPath(uriLtmProfileFastl4, name))
 	if err != nil {
 		log.Printf("[ERROR] Unable to Modify FastL4  (%s) (%v) ", name, err)
 		return diag.FromErr(err)
@@ -177,12 +236,13 @@ func resourceBigipLtmProfileFastl4Update(ctx context.Context, d *schema.Resource
 func resourceBigipLtmProfileFastl4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
-	obj, err := client.GetFastl4(name)
+	obj := &LtmFastl4{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriLtmProfileFastl4, name))
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve FastL4  (%s) (%v) ", name, err)
 		return diag.FromErr(err)
 	}
-	if obj == nil {
+	if !found {
 		log.Printf("[WARN] Fastl4 profile  (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -202,8 +262,13 @@ func resourceBigipLtmProfileFastl4Read(ctx context.Context, d *schema.ResourceDa
 	if _, ok := d.GetOk("iptos_toserver"); ok {
 		_ = d.Set("iptos_toserver", obj.IpTosToServer)
 	}
+	synCookie := obj.SynCookieEnable
+	if synCookie == "" {
+		synCookie = obj.HardwareSynCookie
+	}
+	_ = d.Set("syn_cookie_enable", synCookie)
 	if _, ok := d.GetOk("hardware_syncookie"); ok {
-		_ = d.Set("hardware_syncookie", obj.HardwareSynCookie)
+		_ = d.Set("hardware_syncookie", synCookie)
 	}
 	if _, ok := d.GetOk("idle_timeout"); ok {
 		_ = d.Set("idle_timeout", obj.IdleTimeout)
@@ -244,12 +309,11 @@ func resourceBigipLtmProfileFastl4Delete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
-func getFastL4ProfileConfig(d *schema.ResourceData, config *bigip.Fastl4) *bigip.Fastl4 {
+func getFastL4ProfileConfig(d *schema.ResourceData, client *bigip.BigIP, config *LtmFastl4) (*LtmFastl4, error) {
 	config.DefaultsFrom = d.Get("defaults_from").(string)
 	config.ClientTimeout = d.Get("client_timeout").(int)
 	config.LateBinding = d.Get("late_binding").(string)
 	config.ExplicitFlowMigration = d.Get("explicitflow_migration").(string)
-	config.HardwareSynCookie = d.Get("hardware_syncookie").(string)
 	config.IdleTimeout = d.Get("idle_timeout").(string)
 	config.IpTosToClient = d.Get("iptos_toclient").(string)
 	config.IpTosToServer = d.Get("iptos_toserver").(string)
@@ -258,5 +322,22 @@ func getFastL4ProfileConfig(d *schema.ResourceData, config *bigip.Fastl4) *bigip
 	config.LooseInitialization = d.Get("loose_initiation").(string)
 	config.LooseClose = d.Get("loose_close").(string)
 	config.ReceiveWindowSize = d.Get("receive_windowsize").(int)
-	return config
+
+	synCookie := d.Get("syn_cookie_enable").(string)
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && rawConfig.GetAttr("syn_cookie_enable").IsNull() {
+		synCookie = d.Get("hardware_syncookie").(string)
+	}
+	if synCookie == "" {
+		return config, nil
+	}
+	field, err := fastl4SynCookieField(client)
+	if err != nil {
+		return nil, err
+	}
+	if field == "synCookieEnable" {
+		config.SynCookieEnable = synCookie
+	} else {
+		config.HardwareSynCookie = synCookie
+	}
+	return config, nil
 }
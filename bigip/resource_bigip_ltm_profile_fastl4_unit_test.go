@@ -0,0 +1,93 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFastl4IdleTimeout(t *testing.T) {
+	for _, v := range []string{"300", "1", "-1", "immediate", "indefinite"} {
+		_, errs := validateFastl4IdleTimeout(v, "idle_timeout")
+		assert.Empty(t, errs, v)
+	}
+	for _, v := range []string{"0", "", "never", "-2", "30s"} {
+		_, errs := validateFastl4IdleTimeout(v, "idle_timeout")
+		assert.NotEmpty(t, errs, v)
+	}
+}
+
+func TestFastl4SynCookieField(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		defaults string
+		field    string
+	}{
+		{"14.1", `{"name":"fastL4","hardwareSynCookie":"enabled"}`, "hardwareSynCookie"},
+		{"15.1", `{"name":"fastL4","synCookieEnable":"enabled","synCookieWhitelist":"disabled"}`, "synCookieEnable"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			probes := 0
+			mux.HandleFunc("/mgmt/tm/ltm/profile/fastl4/~Common~fastL4", func(w http.ResponseWriter, r *http.Request) {
+				probes++
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprint(w, tc.defaults)
+			})
+			var bodies []map[string]interface{}
+			mux.HandleFunc("/mgmt/tm/ltm/profile/fastl4", func(w http.ResponseWriter, r *http.Request) {
+				body := map[string]interface{}{}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				bodies = append(bodies, body)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{}`)
+			})
+			mux.HandleFunc("/mgmt/tm/ltm/profile/fastl4/~Common~fl4", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "PATCH" {
+					body := map[string]interface{}{}
+					_ = json.NewDecoder(r.Body).Decode(&body)
+					bodies = append(bodies, body)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"name":"fl4","fullPath":"/Common/fl4","idleTimeout":"immediate",%q:"disabled"}`, tc.field)
+			})
+
+			client := bigip.NewSession(&bigip.Config{
+				Address:  server.URL,
+				Username: "xxxx",
+				Password: "xxxx",
+			})
+			r := Provider().ResourcesMap["bigip_ltm_profile_fastl4"]
+			d := r.TestResourceData()
+			_ = d.Set("name", "/Common/fl4")
+			_ = d.Set("syn_cookie_enable", "disabled")
+			_ = d.Set("idle_timeout", "immediate")
+			assert.False(t, r.CreateContext(context.Background(), d, client).HasError())
+			assert.False(t, r.UpdateContext(context.Background(), d, client).HasError())
+
+			assert.Equal(t, 1, probes)
+			if assert.Len(t, bodies, 2) {
+				for _, body := range bodies {
+					assert.Equal(t, "disabled", body[tc.field])
+					assert.Equal(t, "immediate", body["idleTimeout"])
+				}
+			}
+			other := map[string]string{"hardwareSynCookie": "synCookieEnable", "synCookieEnable": "hardwareSynCookie"}[tc.field]
+			assert.NotContains(t, bodies[0], other)
+			assert.Equal(t, "disabled", d.Get("syn_cookie_enable"))
+			assert.Equal(t, "immediate", d.Get("idle_timeout"))
+		})
+	}
+}
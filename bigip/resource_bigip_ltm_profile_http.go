@@ -7,6 +7,7 @@ package bigip
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
 	"strings"
@@ -14,459 +15,669 @@ import (
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/f5devcentral/go-bigip/f5teem"
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const uriProfileHttp = "ltm/profile/http"
+
 func resourceBigipLtmProfileHttp() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceBigipLtmProfileHttpCreate,
-		ReadContext:   resourceBigipLtmProfileHttpRead,
-		UpdateContext: resourceBigipLtmProfileHttpUpdate,
-		DeleteContext: resourceBigipLtmProfileHttpDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				Description:  "Name of the profile",
-				ValidateFunc: validateF5NameWithDirectory,
-			},
-			"proxy_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Computed:    true,
-				Description: "Specifies the proxy mode for this profile: reverse, explicit, or transparent. The default is Reverse.",
-			},
-			"defaults_from": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "Inherit defaults from parent profile",
-				ValidateFunc: validateF5Name,
-			},
-			"app_service": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The application service to which the object belongs.",
-			},
-			"basic_auth_realm": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies a quoted string for the basic authentication realm. The system sends this string to a client whenever authorization fails. The default value is none",
-			},
-			"description": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "User defined description",
-			},
-			"encrypt_cookies": {
-				Type:        schema.TypeSet,
-				Set:         schema.HashString,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Optional:    true,
-				Description: "Encrypts specified cookies that the BIG-IP system sends to a client system",
-			},
-			"encrypt_cookie_secret": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Specifies a passphrase for the cookie encryption. Note: Since it's a sensitive entity idempotency will fail for it in the update call.",
-			},
-			"fallback_host": {
-				Type:     schema.TypeString,
-				Optional: true,
-				// Computed:    true,
-				Description: "Specifies an HTTP fallback host. HTTP redirection allows you to redirect HTTP traffic to another protocol identifier, host name, port number, or URI path.",
-			},
-			"fallback_status_codes": {
-				Type:        schema.TypeSet,
-				Set:         schema.HashString,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Optional:    true,
-				Description: "Specifies one or more three-digit status codes that can be returned by an HTTP server,that should trigger a redirection to the fallback host",
-			},
-			"head_erase": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies the header string that you want to erase from an HTTP request. Default is none",
-			},
-			"head_insert": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies a quoted header string that you want to insert into an HTTP request. Default is none",
-			},
-			"insert_xforwarded_for": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies, when enabled, that the system inserts an X-Forwarded-For header in an HTTP request with the client IP address, to use with connection pooling. The default is Disabled.",
-			},
-			"lws_width": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies the maximum column width for any given line, when inserting an HTTP header in an HTTP request. The default is 80",
-			},
-			"lws_separator": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies the linear white space (LWS) separator that the system inserts when a header exceeds the maximum width you specify in the LWS Maximum Columns setting.",
-			},
-			"accept_xff": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "Enables or disables trusting the client IP address, and statistics from the client IP address, based on the request's XFF (X-forwarded-for) headers, if they exist.",
-			},
-			"oneconnect_transformations": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Enables the system to perform HTTP header transformations for the purpose of keeping server-side connections open. This feature requires configuration of a OneConnect profile.",
-			},
-			"tm_partition": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "Displays the administrative partition within which this profile resides. ",
-			},
-			"redirect_rewrite": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies whether the system rewrites the URIs that are part of HTTP redirect (3XX) responses. The default is None",
-			},
-			"response_headers_permitted": {
-				Type:        schema.TypeSet,
-				Set:         schema.HashString,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies headers that the BIG-IP system allows in an HTTP response.If you are specifying more than one header, separate the headers with a blank space",
-			},
-			"request_chunking": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies how the system handles HTTP content that is chunked by a client. The default is Preserve",
-			},
-			"response_chunking": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies how the system handles HTTP content that is chunked by a server. The default is Selective",
-			},
-			"server_agent_name": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies the value of the Server header in responses that the BIG-IP itself generates. The default is BigIP. If no string is specified, then no Server header will be added to such responses",
-			},
-			"via_host_name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "Specifies the hostname to include into Via header",
-			},
-			"via_request": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies whether to append, remove, or preserve a Via header in an HTTP request",
-			},
-			"via_response": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies whether to append, remove, or preserve a Via header in an HTTP request",
-			},
-			"xff_alternative_names": {
-				Type:        schema.TypeSet,
-				Set:         schema.HashString,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies alternative XFF headers instead of the default X-forwarded-for header",
+	p := &ltmProfile[httpProfile]{
+		resourceType:         "bigip_ltm_profile_http",
+		label:                "HTTP profile",
+		uri:                  uriProfileHttp,
+		additionalAttributes: true,
+		checkReferences:      true,
+		// These are not computed, so a change made on the BIG-IP or an import shows up: their removal
+		// is sent on update and an unset value reads back empty. explicit_proxy only reads back for
+		// an explicit proxy, which cannot go without it.
+		tracked: []string{"via_host_name", "encrypt_cookies", "encrypt_cookie_secret", "fallback_host", "fallback_status_codes", "explicit_proxy"},
+		expand: func(d *schema.ResourceData, name string) (interface{}, error) {
+			return getHttpProfileConfig(d, &httpProfile{HttpProfile: bigip.HttpProfile{Name: name}}), nil
+		},
+		flatten: flattenHttpProfile,
+		beforeUpdate: func(d *schema.ResourceData, client *bigip.BigIP, name string) error {
+			if !d.HasChange("proxy_type") {
+				return nil
+			}
+			return setHttpProfileProxyType(client, name, d.Get("proxy_type").(string))
+		},
+		afterWrite: afterHttpProfileWrite,
+	}
+	r := p.resource(map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Name of the profile",
+			ValidateFunc: validateF5NameWithDirectory,
+		},
+		"proxy_type": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validateEnum([]string{"reverse", "explicit", "transparent"}, nil),
+			Description:      "Specifies the proxy mode for this profile: reverse, explicit, or transparent. The default is Reverse. The mode is changed in place; the BIG-IP may refuse this while virtual servers use the profile.",
+			DiffSuppressFunc: suppressCaseDiff,
+		},
+		"defaults_from": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Inherit defaults from parent profile",
+			ValidateFunc: validateF5Name,
+		},
+		"app_service": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The application service to which the object belongs.",
+		},
+		"basic_auth_realm": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies a quoted string for the basic authentication realm. The system sends this string to a client whenever authorization fails. The default value is none",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "User defined description",
+		},
+		"encrypt_cookies": {
+			Type:        schema.TypeSet,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "Encrypts specified cookies that the BIG-IP system sends to a client system",
+		},
+		"encrypt_cookie_secret": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressObfuscatedSecretDiff,
+			Description:      "Specifies a passphrase for the cookie encryption",
+		},
+		"fallback_host": {
+			Type:     schema.TypeString,
+			Optional: true,
+			// Computed:    true,
+			Description: "Specifies an HTTP fallback host. HTTP redirection allows you to redirect HTTP traffic to another protocol identifier, host name, port number, or URI path.",
+		},
+		"fallback_status_codes": {
+			Type:        schema.TypeSet,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "Specifies one or more three-digit status codes that can be returned by an HTTP server,that should trigger a redirection to the fallback host",
+		},
+		"head_erase": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the header string that you want to erase from an HTTP request. Default is none",
+		},
+		"head_insert": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies a quoted header string that you want to insert into an HTTP request. Default is none",
+		},
+		"insert_xforwarded_for": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validateEnabledDisabledEnum,
+			Description:      "Specifies, when enabled, that the system inserts an X-Forwarded-For header in an HTTP request with the client IP address, to use with connection pooling. The default is Disabled.",
+			DiffSuppressFunc: suppressEnabledDisabledDiff,
+		},
+		"lws_width": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the maximum column width for any given line, when inserting an HTTP header in an HTTP request. The default is 80",
+		},
+		"lws_separator": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the linear white space (LWS) separator that the system inserts when a header exceeds the maximum width you specify in the LWS Maximum Columns setting.",
+		},
+		"accept_xff": {
+			Type:             schema.TypeString,
+			Computed:         true,
+			Optional:         true,
+			ValidateFunc:     validateEnabledDisabledEnum,
+			Description:      "Enables or disables trusting the client IP address, and statistics from the client IP address, based on the request's XFF (X-forwarded-for) headers, if they exist.",
+			DiffSuppressFunc: suppressEnabledDisabledDiff,
+		},
+		"oneconnect_transformations": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validateEnabledDisabledEnum,
+			Description:      "Enables the system to perform HTTP header transformations for the purpose of keeping server-side connections open. This feature requires configuration of a OneConnect profile.",
+			DiffSuppressFunc: suppressEnabledDisabledDiff,
+		},
+		"tm_partition": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Displays the administrative partition within which this profile resides. ",
+		},
+		"redirect_rewrite": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressCaseDiff,
+			ValidateFunc:     validateEnum([]string{"none", "all", "matching", "nodes"}, nil),
+			Description:      "Specifies whether the system rewrites the URIs that are part of HTTP redirect (3XX) responses. The default is None",
+		},
+		"response_headers_permitted": {
+			Type:        schema.TypeSet,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies headers that the BIG-IP system allows in an HTTP response.If you are specifying more than one header, separate the headers with a blank space",
+		},
+		"request_chunking": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressHttpChunkingDiff,
+			ValidateFunc:     validateHttpChunking,
+			Description:      "Specifies how the system handles HTTP content that is chunked by a client. The default is Preserve",
+		},
+		"response_chunking": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressHttpChunkingDiff,
+			ValidateFunc:     validateHttpChunking,
+			Description:      "Specifies how the system handles HTTP content that is chunked by a server. The default is Selective",
+		},
+		"server_agent_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the value of the Server header in responses that the BIG-IP itself generates. The default is BigIP. If no string is specified, then no Server header will be added to such responses",
+		},
+		"via_host_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies the hostname to include into Via header",
+		},
+		"via_request": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressCaseDiff,
+			ValidateFunc:     validateHttpVia,
+			Description:      "Specifies whether to append, remove, or preserve a Via header in an HTTP request",
+		},
+		"via_response": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressCaseDiff,
+			ValidateFunc:     validateHttpVia,
+			Description:      "Specifies whether to append, remove, or preserve a Via header in an HTTP request",
+		},
+		"xff_alternative_names": {
+			Type:        schema.TypeSet,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies alternative XFF headers instead of the default X-forwarded-for header",
+		},
+		"http_strict_transport_security": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"include_subdomains": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validateEnabledDisabledEnum,
+						Description:  "Specifies whether to include the includeSubdomains directive in the HSTS header.",
+					},
+					"maximum_age": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "Specifies the maximum age to assume the connection should remain secure.",
+					},
+					"mode": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validateEnabledDisabledEnum,
+						Description:  "Specifies whether to include the HSTS response header.",
+					},
+					"preload": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validateEnabledDisabledEnum,
+						Description:  "Specifies whether to include the preload directive in the HSTS header.",
+					},
+				},
 			},
-			"http_strict_transport_security": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"include_subdomains": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							Description: "Specifies whether to include the includeSubdomains directive in the HSTS header.",
-						},
-						"maximum_age": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Computed:    true,
-							Description: "Specifies the maximum age to assume the connection should remain secure.",
-						},
-						"mode": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							Description: "Specifies whether to include the HSTS response header.",
-						},
-						"preload": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							Description: "Specifies whether to include the preload directive in the HSTS header.",
-						},
+		},
+		"enforcement": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"known_methods": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Optional:    true,
+						Description: "Specifies which HTTP methods count as being known. Removing RFC-defined methods from this list will cause the HTTP filter to not recognize them.",
+					},
+					"max_header_count": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "Specifies the maximum number of headers allowed in HTTP request/response.",
+					},
+					"max_header_size": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "Specifies the maximum header size.",
+					},
+					"unknown_method": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validateEnum([]string{"allow", "reject", "pass-through"}, nil),
+						Description:  "Specifies whether to allow, reject or switch to pass-through mode when an unknown HTTP method is parsed.",
+					},
+					"pipeline": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"allow", "reject", "pass-through"}, false),
+						Description:  "Specifies whether to allow, reject or switch to pass-through mode when pipelined requests are received.",
+					},
+					"truncated_redirects": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Specifies whether redirect responses without a trailing CRLF are passed through.",
+					},
+					"excess_client_headers": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"reject", "pass-through"}, false),
+						Description:  "Specifies whether to reject or switch to pass-through mode when the number of request headers exceeds max_header_count.",
+					},
+					"excess_server_headers": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"reject", "pass-through"}, false),
+						Description:  "Specifies whether to reject or switch to pass-through mode when the number of response headers exceeds max_header_count.",
+					},
+					"oversize_client_headers": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"reject", "pass-through"}, false),
+						Description:  "Specifies whether to reject or switch to pass-through mode when the request headers exceed max_header_size.",
+					},
+					"oversize_server_headers": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"reject", "pass-through"}, false),
+						Description:  "Specifies whether to reject or switch to pass-through mode when the response headers exceed max_header_size.",
+					},
+					"max_requests": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "Specifies the maximum number of requests allowed on a client connection, 0 allows any number.",
+					},
+					"rfc_compliance": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+						Description:  "Specifies whether the requests and responses that do not comply with the HTTP RFCs are rejected.",
 					},
 				},
 			},
-			"enforcement": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"known_methods": {
-							Type:        schema.TypeList,
-							Computed:    true,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-							Optional:    true,
-							Description: "Specifies which HTTP methods count as being known. Removing RFC-defined methods from this list will cause the HTTP filter to not recognize them.",
-						},
-						"max_header_count": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Computed:    true,
-							Description: "Specifies the maximum number of headers allowed in HTTP request/response.",
-						},
-						"max_header_size": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Computed:    true,
-							Description: "Specifies the maximum header size.",
-						},
-						"unknown_method": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							Description: "Specifies whether to allow, reject or switch to pass-through mode when an unknown HTTP method is parsed.",
-						},
+		},
+		"explicit_proxy": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Settings of the explicit forward proxy, only valid with proxy_type explicit",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"dns_resolver": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Specifies the DNS resolver used to resolve the host names of the requests, e.g. /Common/resolver. The BIG-IP requires one for an explicit proxy.",
+					},
+					"tunnel_name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Specifies the tunnel used for the CONNECT requests. The default is /Common/http-tunnel",
+					},
+					"default_connect_handling": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+						Description:  "Specifies whether the CONNECT requests that match no virtual server are allowed or denied. The default is deny",
+					},
+					"host_names": {
+						Type:        schema.TypeSet,
+						Set:         schema.HashString,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Optional:    true,
+						Computed:    true,
+						Description: "Specifies the host names of the proxy itself, whose requests are handled by the BIG-IP instead of being forwarded",
+					},
+					"route_domain": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Specifies the route domain used to resolve and connect to the requested hosts",
+					},
+					"bad_request_message": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Specifies the message sent to the client when its request cannot be parsed",
+					},
+					"bad_response_message": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Specifies the message sent to the client when the response of the server cannot be parsed",
+					},
+					"connect_error_message": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Specifies the message sent to the client when the connection to the requested host fails",
+					},
+					"dns_error_message": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Specifies the message sent to the client when the requested host name cannot be resolved",
 					},
 				},
 			},
 		},
-	}
+	})
+	r.CustomizeDiff = validateHttpProfileExplicitProxy
+	return r
 }
 
-func resourceBigipLtmProfileHttpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*bigip.BigIP)
+// httpProfile is the HTTP profile with the explicit proxy settings, which bigip.HttpProfile does not
+// model.
+type httpProfile struct {
+	bigip.HttpProfile
+	// Enforcement replaces the one of bigip.HttpProfile, whose fields are partly untagged and so
+	// sent under the wrong names.
+	Enforcement   httpEnforcement    `json:"enforcement,omitempty"`
+	ExplicitProxy *httpExplicitProxy `json:"explicitProxy,omitempty"`
+}
 
-	name := d.Get("name").(string)
-	log.Printf("[INFO] Creating HTTP Profile:%+v ", name)
+type httpEnforcement struct {
+	KnownMethods          []string `json:"knownMethods,omitempty"`
+	ExcessClientHeaders   string   `json:"excessClientHeaders,omitempty"`
+	ExcessServerHeaders   string   `json:"excessServerHeaders,omitempty"`
+	MaxHeaderCount        int      `json:"maxHeaderCount,omitempty"`
+	MaxHeaderSize         int      `json:"maxHeaderSize,omitempty"`
+	MaxRequests           *int     `json:"maxRequests,omitempty"`
+	OversizeClientHeaders string   `json:"oversizeClientHeaders,omitempty"`
+	OversizeServerHeaders string   `json:"oversizeServerHeaders,omitempty"`
+	Pipeline              string   `json:"pipeline,omitempty"`
+	RfcCompliance         string   `json:"rfcCompliance,omitempty"`
+	TruncatedRedirects    string   `json:"truncatedRedirects,omitempty"`
+	UnknownMethod         string   `json:"unknownMethod,omitempty"`
+}
 
-	pss := &bigip.HttpProfile{
-		Name: name,
-	}
-	config := getHttpProfileConfig(d, pss)
+type httpExplicitProxy struct {
+	DnsResolver            string   `json:"dnsResolver,omitempty"`
+	TunnelName             string   `json:"tunnelName,omitempty"`
+	DefaultConnectHandling string   `json:"defaultConnectHandling,omitempty"`
+	HostNames              []string `json:"hostNames,omitempty"`
+	RouteDomain            string   `json:"routeDomain,omitempty"`
+	BadRequestMessage      string   `json:"badRequestMessage,omitempty"`
+	BadResponseMessage     string   `json:"badResponseMessage,omitempty"`
+	ConnectErrorMessage    string   `json:"connectErrorMessage,omitempty"`
+	DnsErrorMessage        string   `json:"dnsErrorMessage,omitempty"`
+}
 
-	err := client.AddHttpProfile(config)
-	if err != nil {
-		return diag.FromErr(err)
+// validateHttpProfileExplicitProxy rejects explicit_proxy unless proxy_type is explicit, the BIG-IP
+// ignores the settings of the other proxy types. An unset proxy_type is the reverse default.
+func validateHttpProfileExplicitProxy(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if len(d.Get("explicit_proxy").([]interface{})) == 0 || !d.NewValueKnown("proxy_type") {
+		return nil
 	}
-	d.SetId(name)
-
-	if !client.Teem {
-		id := uuid.New()
-		uniqueID := id.String()
-		assetInfo := f5teem.AssetInfo{
-			Name:    "Terraform-provider-bigip",
-			Version: client.UserAgent,
-			Id:      uniqueID,
-		}
-		apiKey := os.Getenv("TEEM_API_KEY")
-		teemDevice := f5teem.AnonymousClient(assetInfo, apiKey)
-		f := map[string]interface{}{
-			"Terraform Version": client.UserAgent,
-		}
-		tsVer := strings.Split(client.UserAgent, "/")
-		err = teemDevice.Report(f, "bigip_ltm_profile_http", tsVer[3])
-		if err != nil {
-			log.Printf("[ERROR]Sending Telemetry data failed:%v", err)
+	if proxyType := d.Get("proxy_type").(string); !strings.EqualFold(proxyType, "explicit") {
+		if proxyType == "" {
+			proxyType = "reverse"
 		}
+		return fmt.Errorf("explicit_proxy can only be set with proxy_type explicit, not %s", proxyType)
 	}
-	return resourceBigipLtmProfileHttpRead(ctx, d, meta)
+	return nil
 }
 
-func resourceBigipLtmProfileHttpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*bigip.BigIP)
-
-	name := d.Id()
-
-	log.Println("[INFO] Fetching HTTP  Profile " + name)
-
-	pp, err := client.GetHttpProfile(name)
-	if err != nil {
-		log.Printf("[ERROR] Unable to retrieve HTTP Profile  (%s) ", err)
-		return diag.FromErr(err)
-	}
-	if pp == nil {
-		log.Printf("[WARN] HTTP  Profile (%s) not found, removing from state", name)
-		d.SetId("")
+// afterHttpProfileWrite clears the values an update cannot send in the payload and reports the
+// creation of a profile to TEEM.
+func afterHttpProfileWrite(_ context.Context, d *schema.ResourceData, client *bigip.BigIP, name string, create bool) error {
+	if create {
+		if !client.Teem {
+			id := uuid.New()
+			uniqueID := id.String()
+			assetInfo := f5teem.AssetInfo{
+				Name:    "Terraform-provider-bigip",
+				Version: client.UserAgent,
+				Id:      uniqueID,
+			}
+			apiKey := os.Getenv("TEEM_API_KEY")
+			teemDevice := f5teem.AnonymousClient(assetInfo, apiKey)
+			f := map[string]interface{}{
+				"Terraform Version": client.UserAgent,
+			}
+			tsVer := strings.Split(client.UserAgent, "/")
+			if err := teemDevice.Report(f, "bigip_ltm_profile_http", tsVer[3]); err != nil {
+				log.Printf("[ERROR]Sending Telemetry data failed:%v", err)
+			}
+		}
 		return nil
 	}
-	_ = d.Set("name", name)
-	_ = d.Set("defaults_from", pp.DefaultsFrom)
-	_ = d.Set("proxy_type", pp.ProxyType)
-
-	if _, ok := d.GetOk("accept_xff"); ok {
-		_ = d.Set("accept_xff", pp.AcceptXff)
-	}
-	if _, ok := d.GetOk("basic_auth_realm"); ok {
-		_ = d.Set("basic_auth_realm", pp.BasicAuthRealm)
-	}
-	if _, ok := d.GetOk("description"); ok {
-		_ = d.Set("description", pp.Description)
-	}
-	if _, ok := d.GetOk("encrypt_cookie_secret"); ok {
-		_ = d.Set("encrypt_cookie_secret", pp.EncryptCookieSecret)
-	}
-	if _, ok := d.GetOk("encrypt_cookies"); ok {
-		_ = d.Set("encrypt_cookies", pp.EncryptCookies)
-	}
-	if _, ok := d.GetOk("fallback_host"); ok {
-		_ = d.Set("fallback_host", pp.FallbackHost)
-	}
-	if _, ok := d.GetOk("fallback_status_codes"); ok {
-		_ = d.Set("fallback_status_codes", pp.FallbackStatusCodes)
-	}
-	if _, ok := d.GetOk("head_erase"); ok {
-		_ = d.Set("head_erase", pp.HeaderErase)
-	}
-	if _, ok := d.GetOk("head_insert"); ok {
-		_ = d.Set("head_insert", pp.HeaderInsert)
-	}
-	if _, ok := d.GetOk("insert_xforwarded_for"); ok {
-		_ = d.Set("insert_xforwarded_for", pp.InsertXforwardedFor)
-	}
-	if _, ok := d.GetOk("lws_separator"); ok {
-		_ = d.Set("lws_separator", pp.LwsSeparator)
-	}
-	if _, ok := d.GetOk("oneconnect_transformations"); ok {
-		_ = d.Set("oneconnect_transformations", pp.OneconnectTransformations)
-	}
-	if _, ok := d.GetOk("tm_partition"); ok {
-		_ = d.Set("tm_partition", pp.TmPartition)
-	}
-	if _, ok := d.GetOk("redirect_rewrite"); ok {
-		_ = d.Set("redirect_rewrite", pp.RedirectRewrite)
-	}
-	if _, ok := d.GetOk("request_chunking"); ok {
-		_ = d.Set("request_chunking", pp.RequestChunking)
-	}
-	if _, ok := d.GetOk("response_chunking"); ok {
-		_ = d.Set("response_chunking", pp.ResponseChunking)
-	}
-	_ = d.Set("response_headers_permitted", pp.ResponseHeadersPermitted)
-
-	if _, ok := d.GetOk("server_agent_name"); ok {
-		_ = d.Set("server_agent_name", pp.ServerAgentName)
-	}
-	if _, ok := d.GetOk("via_host_name"); ok {
-		_ = d.Set("via_host_name", pp.ViaHostName)
-	}
-	if _, ok := d.GetOk("via_request"); ok {
-		_ = d.Set("via_request", pp.ViaRequest)
-	}
-	if _, ok := d.GetOk("via_response"); ok {
-		_ = d.Set("via_response", pp.ViaResponse)
+	if d.HasChange("via_host_name") && d.Get("via_host_name").(string) == "" {
+		// An empty viaHostName is omitted from the payload, so it is cleared explicitly.
+		if err := patchRestEntity(client, map[string]string{"viaHostName": "none"}, restObjectPath(uriProfileHttp, name)); err != nil {
+			return err
+		}
 	}
-	_ = d.Set("xff_alternative_names", pp.XffAlternativeNames)
-
-	var enforcementList []interface{}
-	enforcement := make(map[string]interface{})
-	enforcement["max_header_count"] = pp.Enforcement.MaxHeaderCount
-	enforcement["max_header_size"] = pp.Enforcement.MaxHeaderSize
-	enforcement["unknown_method"] = pp.Enforcement.UnknownMethod
-
-	if p, ok := d.GetOk("enforcement"); ok {
-		for _, r := range p.(*schema.Set).List() {
-			if len(r.(map[string]interface{})["known_methods"].([]interface{})) != 0 {
-				enforcement["known_methods"] = pp.Enforcement.KnownMethods
-			}
+	// An empty list is omitted from the payload as well, which would keep the old values.
+	cleared := make(map[string][]string)
+	for attr, field := range map[string]string{
+		"response_headers_permitted": "responseHeadersPermitted",
+		"encrypt_cookies":            "encryptCookies",
+		"fallback_status_codes":      "fallbackStatusCodes",
+	} {
+		if d.HasChange(attr) && d.Get(attr).(*schema.Set).Len() == 0 {
+			cleared[field] = []string{}
 		}
 	}
-
-	enforcementList = append(enforcementList, enforcement)
-
-	if _, ok := d.GetOk("enforcement"); ok {
-		_ = d.Set("enforcement", enforcementList)
-	}
-
-	var hstsList []interface{}
-	hsts := make(map[string]interface{})
-	hsts["include_subdomains"] = pp.Hsts.IncludeSubdomains
-	hsts["maximum_age"] = pp.Hsts.MaximumAge
-	hsts["mode"] = pp.Hsts.Mode
-	hsts["preload"] = pp.Hsts.Preload
-
-	hstsList = append(hstsList, hsts)
-	if _, ok := d.GetOk("http_strict_transport_security"); ok {
-		_ = d.Set("http_strict_transport_security", hstsList)
+	if len(cleared) > 0 {
+		return patchRestEntity(client, cleared, restObjectPath(uriProfileHttp, name))
 	}
 	return nil
 }
 
-func resourceBigipLtmProfileHttpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*bigip.BigIP)
-	name := d.Id()
-	log.Printf("[INFO] Updating HTTP Profile Profile:%+v ", name)
-
-	pss := &bigip.HttpProfile{
-		Name: name,
+func flattenHttpProfile(d *schema.ResourceData, pp *httpProfile) map[string]interface{} {
+	// The obfuscated form of the secret never matches the configuration, see setSecret.
+	secret := pp.EncryptCookieSecret
+	if isObfuscatedSecret(secret) {
+		secret = d.Get("encrypt_cookie_secret").(string)
+	}
+	// The BIG-IP reports an unset Via host name and fallback host as none.
+	if pp.ViaHostName == "none" {
+		pp.ViaHostName = ""
+	}
+	if pp.FallbackHost == "none" {
+		pp.FallbackHost = ""
+	}
+
+	maxRequests := 0
+	if pp.Enforcement.MaxRequests != nil {
+		maxRequests = *pp.Enforcement.MaxRequests
+	}
+	enforcement := map[string]interface{}{
+		"known_methods":           pp.Enforcement.KnownMethods,
+		"max_header_count":        pp.Enforcement.MaxHeaderCount,
+		"max_header_size":         pp.Enforcement.MaxHeaderSize,
+		"unknown_method":          pp.Enforcement.UnknownMethod,
+		"pipeline":                pp.Enforcement.Pipeline,
+		"truncated_redirects":     pp.Enforcement.TruncatedRedirects == "enabled" || pp.Enforcement.TruncatedRedirects == "true",
+		"excess_client_headers":   pp.Enforcement.ExcessClientHeaders,
+		"excess_server_headers":   pp.Enforcement.ExcessServerHeaders,
+		"oversize_client_headers": pp.Enforcement.OversizeClientHeaders,
+		"oversize_server_headers": pp.Enforcement.OversizeServerHeaders,
+		"max_requests":            maxRequests,
+		"rfc_compliance":          pp.Enforcement.RfcCompliance,
+	}
+
+	hsts := map[string]interface{}{
+		"include_subdomains": pp.Hsts.IncludeSubdomains,
+		"maximum_age":        pp.Hsts.MaximumAge,
+		"mode":               pp.Hsts.Mode,
+		"preload":            pp.Hsts.Preload,
+	}
+
+	// Every profile reads back explicit proxy defaults, they only mean something to an explicit proxy.
+	explicitProxy := []interface{}{}
+	if ep := pp.ExplicitProxy; ep != nil && strings.EqualFold(pp.ProxyType, "explicit") {
+		explicitProxy = append(explicitProxy, map[string]interface{}{
+			"dns_resolver":             ep.DnsResolver,
+			"tunnel_name":              ep.TunnelName,
+			"default_connect_handling": ep.DefaultConnectHandling,
+			"host_names":               ep.HostNames,
+			"route_domain":             ep.RouteDomain,
+			"bad_request_message":      ep.BadRequestMessage,
+			"bad_response_message":     ep.BadResponseMessage,
+			"connect_error_message":    ep.ConnectErrorMessage,
+			"dns_error_message":        ep.DnsErrorMessage,
+		})
+	}
+
+	return map[string]interface{}{
+		"defaults_from":                  pp.DefaultsFrom,
+		"app_service":                    pp.AppService,
+		"proxy_type":                     pp.ProxyType,
+		"accept_xff":                     pp.AcceptXff,
+		"basic_auth_realm":               pp.BasicAuthRealm,
+		"description":                    pp.Description,
+		"encrypt_cookie_secret":          secret,
+		"encrypt_cookies":                pp.EncryptCookies,
+		"fallback_host":                  pp.FallbackHost,
+		"fallback_status_codes":          pp.FallbackStatusCodes,
+		"head_erase":                     pp.HeaderErase,
+		"head_insert":                    pp.HeaderInsert,
+		"insert_xforwarded_for":          pp.InsertXforwardedFor,
+		"lws_separator":                  pp.LwsSeparator,
+		"lws_width":                      pp.LwsWidth,
+		"oneconnect_transformations":     pp.OneconnectTransformations,
+		"tm_partition":                   pp.TmPartition,
+		"redirect_rewrite":               pp.RedirectRewrite,
+		"request_chunking":               pp.RequestChunking,
+		"response_chunking":              pp.ResponseChunking,
+		"response_headers_permitted":     unquoteHeaderNames(pp.ResponseHeadersPermitted),
+		"server_agent_name":              pp.ServerAgentName,
+		"via_host_name":                  pp.ViaHostName,
+		"via_request":                    pp.ViaRequest,
+		"via_response":                   pp.ViaResponse,
+		"xff_alternative_names":          pp.XffAlternativeNames,
+		"enforcement":                    []interface{}{enforcement},
+		"http_strict_transport_security": []interface{}{hsts},
+		"explicit_proxy":                 explicitProxy,
 	}
-	config := getHttpProfileConfig(d, pss)
-
-	err := client.ModifyHttpProfile(name, config)
+}
 
-	if err != nil {
-		log.Printf("[ERROR] Unable to Modify HTTP Profile  (%s) (%v)", name, err)
-		return diag.FromErr(err)
+// unquoteHeaderNames strips the double quotes the BIG-IP adds around a header name when the list
+// holds a single entry, so that ["Server"] and ["\"Server\""] are the same value.
+func unquoteHeaderNames(headers []interface{}) []interface{} {
+	names := make([]interface{}, 0, len(headers))
+	for _, h := range headers {
+		names = append(names, strings.Trim(h.(string), `"`))
 	}
+	return names
+}
 
-	return resourceBigipLtmProfileHttpRead(ctx, d, meta)
+// setHttpProfileProxyType changes proxyType on its own, ahead of the other attributes, so a
+// refusal from the BIG-IP can be reported together with the virtual servers that cause it.
+func setHttpProfileProxyType(client *bigip.BigIP, name, proxyType string) error {
+	err := patchRestEntity(client, map[string]string{"proxyType": proxyType}, restObjectPath(uriProfileHttp, name))
+	if err == nil {
+		return nil
+	}
+	virtuals, lookupErr := virtualServersUsingProfile(client, name)
+	if lookupErr != nil || len(virtuals) == 0 {
+		return fmt.Errorf("error changing proxy_type of %s to %s: %v", name, proxyType, err)
+	}
+	return fmt.Errorf("error changing proxy_type of %s to %s: %v; the profile is used by virtual servers %s, "+
+		"remove it from them before changing proxy_type", name, proxyType, err, strings.Join(virtuals, ", "))
 }
 
-func resourceBigipLtmProfileHttpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*bigip.BigIP)
+type virtualServerProfileReferences struct {
+	FullPath          string `json:"fullPath"`
+	ProfilesReference struct {
+		Items []bigip.Profile `json:"items"`
+	} `json:"profilesReference"`
+}
 
-	name := d.Id()
-	log.Println("[INFO] Deleting HTTPProfile " + name)
-	err := client.DeleteHttpProfile(name)
+// virtualServersUsingProfile returns the full paths of the virtual servers that have the profile attached.
+func virtualServersUsingProfile(client *bigip.BigIP, profile string) ([]string, error) {
+	virtuals, err := getRestItems[virtualServerProfileReferences](client, "ltm/virtual?expandSubcollections=true&$select=fullPath,profilesReference")
 	if err != nil {
-		log.Printf("[ERROR] Unable to Delete HTTPProfile  (%s) (%v) ", name, err)
-		return diag.FromErr(err)
+		return nil, err
+	}
+	var names []string
+	for _, vs := range virtuals {
+		for _, p := range vs.ProfilesReference.Items {
+			if p.FullPath == profile {
+				names = append(names, vs.FullPath)
+				break
+			}
+		}
 	}
-	d.SetId("")
-	return nil
+	return names, nil
 }
 
-func getHttpProfileConfig(d *schema.ResourceData, config *bigip.HttpProfile) *bigip.HttpProfile {
+func getHttpProfileConfig(d *schema.ResourceData, config *httpProfile) *httpProfile {
 	config.AppService = d.Get("app_service").(string)
 	config.DefaultsFrom = d.Get("defaults_from").(string)
 	config.AcceptXff = d.Get("accept_xff").(string)
 	config.BasicAuthRealm = d.Get("basic_auth_realm").(string)
 	config.Description = d.Get("description").(string)
-	config.EncryptCookieSecret = d.Get("encrypt_cookie_secret").(string)
+	// The BIG-IP re-encrypts the secret each time it is sent, so it is only sent when it changed.
+	if d.HasChange("encrypt_cookie_secret") {
+		config.EncryptCookieSecret = d.Get("encrypt_cookie_secret").(string)
+	}
 	config.EncryptCookies = setToStringSlice(d.Get("encrypt_cookies").(*schema.Set))
 	if _, ok := d.GetOk("fallback_host"); ok {
 		config.FallbackHost = d.Get("fallback_host").(string)
@@ -485,7 +696,7 @@ func getHttpProfileConfig(d *schema.ResourceData, config *bigip.HttpProfile) *bi
 	config.RedirectRewrite = d.Get("redirect_rewrite").(string)
 	config.RequestChunking = d.Get("request_chunking").(string)
 	config.ResponseChunking = d.Get("response_chunking").(string)
-	config.ResponseHeadersPermitted = setToInterfaceSlice(d.Get("response_headers_permitted").(*schema.Set))
+	config.ResponseHeadersPermitted = unquoteHeaderNames(setToInterfaceSlice(d.Get("response_headers_permitted").(*schema.Set)))
 	config.ServerAgentName = d.Get("server_agent_name").(string)
 	config.ViaHostName = d.Get("via_host_name").(string)
 	config.ViaRequest = d.Get("via_request").(string)
@@ -512,6 +723,37 @@ func getHttpProfileConfig(d *schema.ResourceData, config *bigip.HttpProfile) *bi
 		config.Enforcement.UnknownMethod = r.(map[string]interface{})["unknown_method"].(string)
 		config.Enforcement.MaxHeaderCount = r.(map[string]interface{})["max_header_count"].(int)
 		config.Enforcement.MaxHeaderSize = r.(map[string]interface{})["max_header_size"].(int)
+		config.Enforcement.Pipeline = r.(map[string]interface{})["pipeline"].(string)
+		config.Enforcement.TruncatedRedirects = "disabled"
+		if r.(map[string]interface{})["truncated_redirects"].(bool) {
+			config.Enforcement.TruncatedRedirects = "enabled"
+		}
+		config.Enforcement.ExcessClientHeaders = r.(map[string]interface{})["excess_client_headers"].(string)
+		config.Enforcement.ExcessServerHeaders = r.(map[string]interface{})["excess_server_headers"].(string)
+		config.Enforcement.OversizeClientHeaders = r.(map[string]interface{})["oversize_client_headers"].(string)
+		config.Enforcement.OversizeServerHeaders = r.(map[string]interface{})["oversize_server_headers"].(string)
+		config.Enforcement.RfcCompliance = r.(map[string]interface{})["rfc_compliance"].(string)
+		// 0 allows any number of requests, so the value is sent with the block like truncated_redirects.
+		maxRequests := r.(map[string]interface{})["max_requests"].(int)
+		config.Enforcement.MaxRequests = &maxRequests
+	}
+
+	for _, r := range d.Get("explicit_proxy").([]interface{}) {
+		ep, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		config.ExplicitProxy = &httpExplicitProxy{
+			DnsResolver:            ep["dns_resolver"].(string),
+			TunnelName:             ep["tunnel_name"].(string),
+			DefaultConnectHandling: ep["default_connect_handling"].(string),
+			HostNames:              setToStringSlice(ep["host_names"].(*schema.Set)),
+			RouteDomain:            ep["route_domain"].(string),
+			BadRequestMessage:      ep["bad_request_message"].(string),
+			BadResponseMessage:     ep["bad_response_message"].(string),
+			ConnectErrorMessage:    ep["connect_error_message"].(string),
+			DnsErrorMessage:        ep["dns_error_message"].(string),
+		}
 	}
 
 	return config
@@ -190,38 +190,20 @@ func resourceBigipLtmProfileHttp2Read(ctx context.Context, d *schema.ResourceDat
 		d.SetId("")
 		return nil
 	}
+	// All the settings are read, whether configured or not, so that an imported profile has them.
 	_ = d.Set("name", name)
 	_ = d.Set("defaults_from", obj.DefaultsFrom)
-	if _, ok := d.GetOk("concurrent_streams_per_connection"); ok {
-		_ = d.Set("concurrent_streams_per_connection", obj.ConcurrentStreamsPerConnection)
-	}
-	if _, ok := d.GetOk("connection_idle_timeout"); ok {
-		_ = d.Set("connection_idle_timeout", obj.ConnectionIdleTimeout)
-	}
-	if _, ok := d.GetOk("header_table_size"); ok {
-		_ = d.Set("header_table_size", obj.HeaderTableSize)
-	}
-	if _, ok := d.GetOk("enforce_tls_requirements"); ok {
-		_ = d.Set("enforce_tls_requirements", obj.EnforceTLSRequirements)
-	}
-	if _, ok := d.GetOk("frame_size"); ok {
-		_ = d.Set("frame_size", obj.FrameSize)
-	}
-	if _, ok := d.GetOk("receive_window"); ok {
-		_ = d.Set("receive_window", obj.ReceiveWindow)
-	}
-	if _, ok := d.GetOk("write_size"); ok {
-		_ = d.Set("write_size", obj.WriteSize)
-	}
-	if _, ok := d.GetOk("insert_header"); ok {
-		_ = d.Set("insert_header", obj.InsertHeader)
-	}
-	if _, ok := d.GetOk("insert_header_name"); ok {
-		_ = d.Set("insert_header_name", obj.InsertHeaderName)
-	}
-	if _, ok := d.GetOk("connection_idle_timeout"); ok {
-		_ = d.Set("activation_modes", obj.ActivationModes)
-	}
+	_ = d.Set("concurrent_streams_per_connection", obj.ConcurrentStreamsPerConnection)
+	_ = d.Set("connection_idle_timeout", obj.ConnectionIdleTimeout)
+	_ = d.Set("header_table_size", obj.HeaderTableSize)
+	_ = d.Set("enforce_tls_requirements", obj.EnforceTLSRequirements)
+	_ = d.Set("frame_size", obj.FrameSize)
+	_ = d.Set("receive_window", obj.ReceiveWindow)
+	_ = d.Set("write_size", obj.WriteSize)
+	_ = d.Set("insert_header", obj.InsertHeader)
+	_ = d.Set("insert_header_name", obj.InsertHeaderName)
+	_ = d.Set("include_content_length", obj.IncludeContentLength)
+	_ = d.Set("activation_modes", obj.ActivationModes)
 	return nil
 }
 
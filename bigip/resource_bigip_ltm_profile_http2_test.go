@@ -456,8 +456,11 @@ func TestAccBigipLtmProfileHttp2Import(t *testing.T) {
 					resource.TestCheckResourceAttr(resFullName, "name", TestHttp2Name),
 					resource.TestCheckResourceAttr(resFullName, "defaults_from", "/Common/http2"),
 				),
-				ResourceName:      TestHttp2Name,
-				ImportState:       false,
+			},
+			{
+				ResourceName:      resFullName,
+				ImportStateId:     TestHttp2Name,
+				ImportState:       true,
 				ImportStateVerify: true,
 			},
 		},
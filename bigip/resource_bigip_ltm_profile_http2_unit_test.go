@@ -0,0 +1,49 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestResourceBigipLtmProfileHttp2Read checks that the settings left out of the configuration, as on
+// import, are read too.
+func TestResourceBigipLtmProfileHttp2Read(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http2/~Common~test-http2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"name":"test-http2","partition":"Common","fullPath":"/Common/test-http2","defaultsFrom":"/Common/http2",
+			"activationModes":["alpn"],"concurrentStreamsPerConnection":20,"connectionIdleTimeout":300,"enforceTlsRequirements":"enabled",
+			"frameSize":2048,"headerTableSize":4096,"includeContentLength":"enabled","insertHeader":"disabled","insertHeaderName":"X-HTTP2",
+			"receiveWindow":32,"writeSize":16384}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	d := resourceBigipLtmProfileHttp2().TestResourceData()
+	d.SetId("/Common/test-http2")
+	assert.False(t, resourceBigipLtmProfileHttp2Read(context.Background(), d, client).HasError())
+	assert.Equal(t, "/Common/test-http2", d.Get("name"))
+	assert.Equal(t, "/Common/http2", d.Get("defaults_from"))
+	assert.Equal(t, 20, d.Get("concurrent_streams_per_connection"))
+	assert.Equal(t, 300, d.Get("connection_idle_timeout"))
+	assert.Equal(t, 2048, d.Get("frame_size"))
+	assert.Equal(t, 32, d.Get("receive_window"))
+	assert.Equal(t, "enabled", d.Get("include_content_length"))
+	assert.Equal(t, "X-HTTP2", d.Get("insert_header_name"))
+	assert.Equal(t, []interface{}{"alpn"}, d.Get("activation_modes").(*schema.Set).List())
+}
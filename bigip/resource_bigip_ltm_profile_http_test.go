@@ -195,6 +195,40 @@ func TestAccBigipLtmProfileHttpUpdateBasicAuthRealm(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmProfileHttpUpdateViaHostName(t *testing.T) {
+	t.Parallel()
+	var instName = "test-http-Update-viaHostName"
+	var instFullName = fmt.Sprintf("/%s/%s", TestPartition, instName)
+	resFullName := fmt.Sprintf("%s.%s", resHttpName, instName)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckHttpsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmprofilehttpUpdateParam(instName, "via_host_name"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckhttpExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "via_host_name", "titanic"),
+				),
+			},
+			{
+				Config:   testaccbigipltmprofilehttpUpdateParam(instName, "via_host_name"),
+				PlanOnly: true,
+			},
+			{
+				Config: testaccbigipltmprofilehttpUpdateParam(instName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckhttpExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "via_host_name", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmProfileHttpUpdateHeaderErase(t *testing.T) {
 	t.Parallel()
 	var instName = "test-http-Update-headerErase"
@@ -261,6 +295,76 @@ func TestAccBigipLtmProfileHttpUpdateDescription(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmProfileHttpUpdateProxyType(t *testing.T) {
+	t.Parallel()
+	var instName = "test-http-Update-proxytype"
+	var instFullName = fmt.Sprintf("/%s/%s", TestPartition, instName)
+	resFullName := fmt.Sprintf("%s.%s", resHttpName, instName)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckHttpsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmprofilehttpUpdateParam(instName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckhttpExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "proxy_type", "reverse"),
+				),
+			},
+			{
+				Config: testaccbigipltmprofilehttpUpdateParam(instName, "proxy_type"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckhttpExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "proxy_type", "transparent"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileHttpUpdateResponseHeadersPermitted(t *testing.T) {
+	t.Parallel()
+	var instName = "test-http-Update-respheaders"
+	var instFullName = fmt.Sprintf("/%s/%s", TestPartition, instName)
+	resFullName := fmt.Sprintf("%s.%s", resHttpName, instName)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckHttpsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmprofilehttpUpdateParam(instName, "response_headers_permitted_one"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckhttpExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "response_headers_permitted.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resFullName, "response_headers_permitted.*", "Server"),
+				),
+			},
+			{
+				Config: testaccbigipltmprofilehttpUpdateParam(instName, "response_headers_permitted_many"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckhttpExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "response_headers_permitted.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resFullName, "response_headers_permitted.*", "Server"),
+					resource.TestCheckTypeSetElemAttr(resFullName, "response_headers_permitted.*", "X-Frame-Options"),
+				),
+			},
+			{
+				Config: testaccbigipltmprofilehttpUpdateParam(instName, "response_headers_permitted_clear"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckhttpExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "response_headers_permitted.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmProfileHttpUpdateFallbackStatusCodes(t *testing.T) {
 	t.Parallel()
 	var instName = "test-http-Update-fallbackStatusCodes"
@@ -361,6 +465,46 @@ func TestAccBigipLtmProfileHttpUpdateEncryptCookies(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmProfileHttpEncryptCookieSecretNoDiff(t *testing.T) {
+	t.Parallel()
+	var instName = "test-http-encryptCookieSecret"
+	var instFullName = fmt.Sprintf("/%s/%s", TestPartition, instName)
+	resFullName := fmt.Sprintf("%s.%s", resHttpName, instName)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckHttpsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmprofilehttpUpdateParam(instName, "encrypt_cookie_secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckhttpExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "encrypt_cookie_secret", "f5-secret"),
+				),
+			},
+			{
+				// the secret is echoed back obfuscated and must not show up as a change
+				Config:   testaccbigipltmprofilehttpUpdateParam(instName, "encrypt_cookie_secret"),
+				PlanOnly: true,
+			},
+			{
+				// an update of another attribute does not send the secret again
+				Config: testaccbigipltmprofilehttpUpdateParam(instName, "encrypt_cookie_secret_description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resFullName, "description", "my-http-profile"),
+					resource.TestCheckResourceAttr(resFullName, "encrypt_cookie_secret", "f5-secret"),
+				),
+			},
+			{
+				Config:   testaccbigipltmprofilehttpUpdateParam(instName, "encrypt_cookie_secret_description"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmProfileHttpUpdateEnforcement(t *testing.T) {
 	t.Parallel()
 	var instName = "test-http-Update-enforcement"
@@ -427,6 +571,38 @@ func TestAccBigipLtmProfileHttpUpdateEnforcement(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmProfileHttpUpdateEnforcementPipeline(t *testing.T) {
+	t.Parallel()
+	var instName = "test-http-Update-enforcement-pipeline"
+	var instFullName = fmt.Sprintf("/%s/%s", TestPartition, instName)
+	resFullName := fmt.Sprintf("%s.%s", resHttpName, instName)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckHttpsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmprofilehttpUpdateParam(instName, "enforcement_pipeline"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckhttpExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "enforcement.0.pipeline", "reject"),
+					resource.TestCheckResourceAttr(resFullName, "enforcement.0.truncated_redirects", "true"),
+					resource.TestCheckResourceAttr(resFullName, "enforcement.0.excess_client_headers", "reject"),
+					resource.TestCheckResourceAttr(resFullName, "enforcement.0.oversize_server_headers", "pass-through"),
+					resource.TestCheckResourceAttr(resFullName, "enforcement.0.max_requests", "100"),
+					resource.TestCheckResourceAttr(resFullName, "enforcement.0.rfc_compliance", "enabled"),
+				),
+			},
+			{
+				Config:   testaccbigipltmprofilehttpUpdateParam(instName, "enforcement_pipeline"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmProfileHttpUpdateHSTS(t *testing.T) {
 	t.Parallel()
 	var instName = "test-http-Update-hsts"
@@ -496,6 +672,33 @@ func TestAccBigipLtmProfileHttpImport(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmProfileHttpImportConfigured(t *testing.T) {
+	resFullName := "bigip_ltm_profile_http.test-http-import-configured"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckHttpsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccBigipLtmHttpProfileImportConfiguredConfig(),
+			},
+			{
+				ResourceName:      resFullName,
+				ImportStateId:     "/Common/test-http-import-configured",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the state refreshed from the BIG-IP matches the configuration
+				Config:   testaccBigipLtmHttpProfileImportConfiguredConfig(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testCheckhttpExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
@@ -572,6 +775,25 @@ resource "bigip_ltm_profile_http" "test-http" {
 `, "/Common/test-http")
 }
 
+func testaccBigipLtmHttpProfileImportConfiguredConfig() string {
+	return `
+resource "bigip_ltm_profile_http" "test-http-import-configured" {
+  name            = "/Common/test-http-import-configured"
+  defaults_from   = "/Common/http"
+  head_insert     = "X-Forwarded-Proto: https"
+  encrypt_cookies = ["session", "cart"]
+  enforcement {
+    known_methods       = ["GET", "POST", "PUT"]
+    max_header_count    = 40
+    max_header_size     = 16384
+    unknown_method      = "reject"
+    pipeline            = "allow"
+    truncated_redirects = true
+  }
+}
+`
+}
+
 func testaccbigipltmprofilehttpUpdateParam(instName, updateParam string) string {
 	resPrefix := fmt.Sprintf(`
 		resource "%[1]s" "%[2]s" {
@@ -588,6 +810,15 @@ func testaccbigipltmprofilehttpUpdateParam(instName, updateParam string) string
 	case "encrypt_cookies":
 		resPrefix = fmt.Sprintf(`%s
 			  encrypt_cookies = ["peanutButter"]`, resPrefix)
+	case "encrypt_cookie_secret":
+		resPrefix = fmt.Sprintf(`%s
+			  encrypt_cookies = ["peanutButter"]
+			  encrypt_cookie_secret = "f5-secret"`, resPrefix)
+	case "encrypt_cookie_secret_description":
+		resPrefix = fmt.Sprintf(`%s
+			  description = "my-http-profile"
+			  encrypt_cookies = ["peanutButter"]
+			  encrypt_cookie_secret = "f5-secret"`, resPrefix)
 	case "head_erase":
 		resPrefix = fmt.Sprintf(`%s
 			  head_erase = "titanic"`, resPrefix)
@@ -599,19 +830,32 @@ func testaccbigipltmprofilehttpUpdateParam(instName, updateParam string) string
 			  head_insert = "X-Forwarded-IP: [expr { [IP::client_addr] }]"`, resPrefix)
 	case "insert_xforwarded_for":
 		resPrefix = fmt.Sprintf(`%s
-			  insert_xforwarded_for = 262100`, resPrefix)
+			  insert_xforwarded_for = "enabled"`, resPrefix)
 	case "lws_separator":
 		resPrefix = fmt.Sprintf(`%s
 			  lws_separator = 2400`, resPrefix)
 	case "oneconnect_transformations":
 		resPrefix = fmt.Sprintf(`%s
-			  oneconnect_transformations = 40`, resPrefix)
+			  oneconnect_transformations = "enabled"`, resPrefix)
 	case "proxy_type":
 		resPrefix = fmt.Sprintf(`%s
-			  proxy_type = 40`, resPrefix)
+			  proxy_type = "transparent"`, resPrefix)
+	case "response_headers_permitted_one":
+		resPrefix = fmt.Sprintf(`%s
+			  response_headers_permitted = ["Server"]`, resPrefix)
+	case "response_headers_permitted_many":
+		resPrefix = fmt.Sprintf(`%s
+			  response_headers_permitted = ["Server", "X-Frame-Options"]`, resPrefix)
+	case "response_headers_permitted_clear":
+		resPrefix = fmt.Sprintf(`%s
+			  response_headers_permitted = []`, resPrefix)
 	case "redirect_rewrite":
 		resPrefix = fmt.Sprintf(`%s
-			  redirect_rewrite = "AES"`, resPrefix)
+			  redirect_rewrite = "matching"`, resPrefix)
+	case "via_host_name":
+		resPrefix = fmt.Sprintf(`%s
+			  via_host_name = "titanic"
+			  via_request = "Append"`, resPrefix)
 	case "basic_auth_realm":
 		resPrefix = fmt.Sprintf(`%s
 			  basic_auth_realm = "titanic"`, resPrefix)
@@ -623,6 +867,22 @@ func testaccbigipltmprofilehttpUpdateParam(instName, updateParam string) string
 				max_header_count = 40
 				max_header_size = 80
 			}`, resPrefix)
+	case "enforcement_pipeline":
+		resPrefix = fmt.Sprintf(`%s
+			enforcement {
+				known_methods = ["CONNECT","DELETE","GET","HEAD","LOCK","OPTIONS","POST","PROPFIND","PUT","TRACE","UNLOCK"]
+				unknown_method = "allow"
+				max_header_count = 64
+				max_header_size = 32768
+				pipeline = "reject"
+				truncated_redirects = true
+				excess_client_headers = "reject"
+				excess_server_headers = "reject"
+				oversize_client_headers = "reject"
+				oversize_server_headers = "pass-through"
+				max_requests = 100
+				rfc_compliance = "enabled"
+			}`, resPrefix)
 	case "hsts":
 		resPrefix = fmt.Sprintf(`%s
 				http_strict_transport_security {
@@ -0,0 +1,377 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetHttpProfileProxyType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http/~Common~test-http", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"test-http","proxyType":"transparent"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	assert.NoError(t, setHttpProfileProxyType(client, "/Common/test-http", "transparent"))
+	assert.JSONEq(t, `{"proxyType":"transparent"}`, body)
+}
+
+func TestSetHttpProfileProxyTypeReferenced(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http/~Common~test-http", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintf(w, `{"code":400,"message":"01070734:3: Configuration error: the proxy type of a profile in use cannot be changed"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("expandSubcollections"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[
+			{"fullPath":"/Common/vs1","profilesReference":{"items":[{"fullPath":"/Common/tcp"},{"fullPath":"/Common/test-http"}]}},
+			{"fullPath":"/Common/vs2","profilesReference":{"items":[{"fullPath":"/Common/http"}]}},
+			{"fullPath":"/Common/vs3","profilesReference":{"items":[{"fullPath":"/Common/test-http"}]}}]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	err := setHttpProfileProxyType(client, "/Common/test-http", "transparent")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "used by virtual servers /Common/vs1, /Common/vs3")
+}
+
+func TestUnquoteHeaderNames(t *testing.T) {
+	assert.Equal(t, []interface{}{"Server"}, unquoteHeaderNames([]interface{}{`"Server"`}))
+	assert.Equal(t, []interface{}{"Server", "X-Frame-Options"}, unquoteHeaderNames([]interface{}{"Server", "X-Frame-Options"}))
+	assert.Equal(t, []interface{}{}, unquoteHeaderNames(nil))
+}
+
+func TestGetHttpProfileConfigEnforcement(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileHttp().Schema, map[string]interface{}{
+		"name": "/Common/test-http",
+		"enforcement": []interface{}{map[string]interface{}{
+			"pipeline":            "reject",
+			"truncated_redirects": true,
+		}},
+	})
+	config := getHttpProfileConfig(d, &httpProfile{HttpProfile: bigip.HttpProfile{Name: "/Common/test-http"}})
+	assert.Equal(t, "reject", config.Enforcement.Pipeline)
+	assert.Equal(t, "enabled", config.Enforcement.TruncatedRedirects)
+}
+
+func TestResourceBigipLtmProfileHttpReadEnforcement(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http/~Common~test-http", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"test-http","fullPath":"/Common/test-http","defaultsFrom":"/Common/http",
+			"enforcement":{"maxHeaderCount":64,"maxHeaderSize":32768,"unknownMethod":"allow","pipeline":"reject","truncatedRedirects":"false"}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileHttp().Schema, map[string]interface{}{
+		"name":        "/Common/test-http",
+		"enforcement": []interface{}{map[string]interface{}{"pipeline": "reject"}},
+	})
+	d.SetId("/Common/test-http")
+	assert.False(t, resourceBigipLtmProfileHttp().ReadContext(context.Background(), d, client).HasError())
+
+	enforcement := d.Get("enforcement").(*schema.Set).List()
+	if assert.Len(t, enforcement, 1) {
+		assert.Equal(t, "reject", enforcement[0].(map[string]interface{})["pipeline"])
+		assert.Equal(t, false, enforcement[0].(map[string]interface{})["truncated_redirects"])
+	}
+}
+
+func TestResourceBigipLtmProfileHttpImport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http/~Common~test-http", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"test-http","fullPath":"/Common/test-http","defaultsFrom":"/Common/http","proxyType":"reverse",
+			"headerInsert":"X-Forwarded-Proto: https","insertXforwardedFor":"enabled","serverAgentName":"web",
+			"encryptCookies":["session","cart"],"fallbackHost":"none","fallbackStatusCodes":["500","503"],"viaHostName":"none",
+			"enforcement":{"knownMethods":["GET","POST"],"maxHeaderCount":64,"maxHeaderSize":32768,"unknownMethod":"reject","pipeline":"allow","truncatedRedirects":"true"}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipLtmProfileHttp()
+	d := r.TestResourceData()
+	d.SetId("test-http")
+	states, err := r.Importer.StateContext(context.Background(), d, client)
+	assert.NoError(t, err)
+	if assert.Len(t, states, 1) {
+		d = states[0]
+	}
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+
+	assert.Equal(t, "X-Forwarded-Proto: https", d.Get("head_insert"))
+	assert.Equal(t, "enabled", d.Get("insert_xforwarded_for"))
+	assert.Equal(t, "web", d.Get("server_agent_name"))
+	assert.ElementsMatch(t, []interface{}{"session", "cart"}, d.Get("encrypt_cookies").(*schema.Set).List())
+	assert.ElementsMatch(t, []interface{}{"500", "503"}, d.Get("fallback_status_codes").(*schema.Set).List())
+	assert.Equal(t, "", d.Get("fallback_host"))
+	assert.Equal(t, "", d.Get("via_host_name"))
+	enforcement := d.Get("enforcement").(*schema.Set).List()
+	if assert.Len(t, enforcement, 1) {
+		e := enforcement[0].(map[string]interface{})
+		assert.Equal(t, []interface{}{"GET", "POST"}, e["known_methods"])
+		assert.Equal(t, "reject", e["unknown_method"])
+		assert.Equal(t, "allow", e["pipeline"])
+		assert.Equal(t, true, e["truncated_redirects"])
+	}
+}
+
+// TestResourceBigipLtmProfileHttpImportVerify checks the attributes that are not computed are
+// imported, so that planning the configuration of the imported profile changes nothing.
+func TestResourceBigipLtmProfileHttpImportVerify(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http/~Common~test-http", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"test-http","fullPath":"/Common/test-http","defaultsFrom":"/Common/http-explicit",
+			"appService":"/Common/web.app/web","tmPartition":"Common","proxyType":"explicit","serverAgentName":"web",
+			"explicitProxy":{"dnsResolver":"/Common/resolver","tunnelName":"/Common/http-tunnel","defaultConnectHandling":"deny",
+			"routeDomain":"0"}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipLtmProfileHttp()
+	d := r.TestResourceData()
+	d.SetId("/Common/test-http")
+	states, err := r.Importer.StateContext(context.Background(), d, client)
+	assert.NoError(t, err)
+	if assert.Len(t, states, 1) {
+		d = states[0]
+	}
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	assert.Equal(t, "/Common/web.app/web", d.Get("app_service"))
+	assert.Equal(t, "Common", d.Get("tm_partition"))
+	assert.Equal(t, "/Common/resolver", d.Get("explicit_proxy.0.dns_resolver"))
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "/Common/test-http",
+		"proxy_type":     "explicit",
+		"explicit_proxy": []interface{}{map[string]interface{}{"dns_resolver": "/Common/resolver"}},
+	}), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "unexpected diff: %v", diff)
+
+	// An explicit proxy missing from the configuration shows as a change.
+	diff, err = r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":       "/Common/test-http",
+		"proxy_type": "explicit",
+	}), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.Contains(t, diff.Attributes, "explicit_proxy.#")
+	}
+
+	// The additional attributes are read back, the fields the BIG-IP does not return are dropped.
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":                  "/Common/test-http",
+		"additional_attributes": map[string]interface{}{"serverAgentName": "other", "removedField": "x"},
+	})
+	d.SetId("/Common/test-http")
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	assert.Equal(t, map[string]interface{}{"serverAgentName": "web"}, d.Get("additional_attributes"))
+	assert.Equal(t, map[string]interface{}{"serverAgentName": "web"}, d.Get("raw_attributes"))
+}
+
+func TestValidateHttpProfileExplicitProxy(t *testing.T) {
+	r := resourceBigipLtmProfileHttp()
+	explicitProxy := []interface{}{map[string]interface{}{"dns_resolver": "/Common/resolver"}}
+	for _, tc := range []struct {
+		config map[string]interface{}
+		err    string
+	}{
+		{map[string]interface{}{"proxy_type": "explicit", "explicit_proxy": explicitProxy}, ""},
+		{map[string]interface{}{"proxy_type": "Explicit", "explicit_proxy": explicitProxy}, ""},
+		{map[string]interface{}{"proxy_type": "transparent"}, ""},
+		{map[string]interface{}{"proxy_type": "transparent", "explicit_proxy": explicitProxy}, "explicit_proxy can only be set with proxy_type explicit, not transparent"},
+		{map[string]interface{}{"explicit_proxy": explicitProxy}, "explicit_proxy can only be set with proxy_type explicit, not reverse"},
+	} {
+		tc.config["name"] = "/Common/test-http"
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+		if tc.err == "" {
+			assert.NoError(t, err, "%v", tc.config)
+		} else {
+			assert.EqualError(t, err, tc.err)
+		}
+	}
+}
+
+func TestGetHttpProfileConfigExplicitProxy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileHttp().Schema, map[string]interface{}{
+		"name":       "/Common/test-http",
+		"proxy_type": "explicit",
+		"explicit_proxy": []interface{}{map[string]interface{}{
+			"dns_resolver":             "/Common/resolver",
+			"default_connect_handling": "allow",
+			"host_names":               []interface{}{"proxy.example.com"},
+		}},
+	})
+	config := getHttpProfileConfig(d, &httpProfile{HttpProfile: bigip.HttpProfile{Name: "/Common/test-http"}})
+	payload, err := restMarshal(config)
+	assert.NoError(t, err)
+	assert.Contains(t, payload, `"explicitProxy":{"dnsResolver":"/Common/resolver","defaultConnectHandling":"allow","hostNames":["proxy.example.com"]}`)
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmProfileHttp().Schema, map[string]interface{}{
+		"name": "/Common/test-http",
+	})
+	config = getHttpProfileConfig(d, &httpProfile{HttpProfile: bigip.HttpProfile{Name: "/Common/test-http"}})
+	assert.Nil(t, config.ExplicitProxy)
+}
+
+func TestResourceBigipLtmProfileHttpReadExplicitProxy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http/~Common~test-http", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"test-http","fullPath":"/Common/test-http","defaultsFrom":"/Common/http-explicit","proxyType":"explicit",
+			"explicitProxy":{"dnsResolver":"/Common/resolver","tunnelName":"/Common/http-tunnel","defaultConnectHandling":"deny",
+			"hostNames":["proxy.example.com"],"routeDomain":"0","badRequestMessage":"bad request","dnsErrorMessage":"no such host"}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipLtmProfileHttp()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":           "/Common/test-http",
+		"proxy_type":     "explicit",
+		"explicit_proxy": []interface{}{map[string]interface{}{"dns_resolver": "/Common/resolver"}},
+	})
+	d.SetId("/Common/test-http")
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+
+	explicitProxy := d.Get("explicit_proxy").([]interface{})
+	if assert.Len(t, explicitProxy, 1) {
+		ep := explicitProxy[0].(map[string]interface{})
+		assert.Equal(t, "/Common/resolver", ep["dns_resolver"])
+		assert.Equal(t, "/Common/http-tunnel", ep["tunnel_name"])
+		assert.Equal(t, "deny", ep["default_connect_handling"])
+		assert.Equal(t, []interface{}{"proxy.example.com"}, ep["host_names"].(*schema.Set).List())
+		assert.Equal(t, "no such host", ep["dns_error_message"])
+	}
+}
+
+func TestHttpProfileEnforcementPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileHttp().Schema, map[string]interface{}{
+		"name": "/Common/test-http",
+		"enforcement": []interface{}{map[string]interface{}{
+			"pipeline":                "reject",
+			"truncated_redirects":     true,
+			"excess_client_headers":   "reject",
+			"oversize_server_headers": "pass-through",
+			"rfc_compliance":          "enabled",
+		}},
+	})
+	payload, err := restMarshal(getHttpProfileConfig(d, &httpProfile{HttpProfile: bigip.HttpProfile{Name: "/Common/test-http"}}))
+	assert.NoError(t, err)
+	assert.Contains(t, payload, `"enforcement":{"excessClientHeaders":"reject","maxRequests":0,"oversizeServerHeaders":"pass-through",`+
+		`"pipeline":"reject","rfcCompliance":"enabled","truncatedRedirects":"enabled"}`)
+
+	// without the block the inherited enforcement settings are left alone
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmProfileHttp().Schema, map[string]interface{}{
+		"name": "/Common/test-http",
+	})
+	payload, err = restMarshal(getHttpProfileConfig(d, &httpProfile{HttpProfile: bigip.HttpProfile{Name: "/Common/test-http"}}))
+	assert.NoError(t, err)
+	assert.Contains(t, payload, `"enforcement":{}`)
+}
+
+func TestFlattenHttpProfileEnforcement(t *testing.T) {
+	d := resourceBigipLtmProfileHttp().TestResourceData()
+	maxRequests := 100
+	values := flattenHttpProfile(d, &httpProfile{Enforcement: httpEnforcement{
+		ExcessClientHeaders:   "pass-through",
+		ExcessServerHeaders:   "reject",
+		OversizeClientHeaders: "reject",
+		OversizeServerHeaders: "pass-through",
+		MaxRequests:           &maxRequests,
+		RfcCompliance:         "disabled",
+		TruncatedRedirects:    "enabled",
+	}})
+	enforcement := values["enforcement"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "pass-through", enforcement["excess_client_headers"])
+	assert.Equal(t, "reject", enforcement["excess_server_headers"])
+	assert.Equal(t, "reject", enforcement["oversize_client_headers"])
+	assert.Equal(t, "pass-through", enforcement["oversize_server_headers"])
+	assert.Equal(t, 100, enforcement["max_requests"])
+	assert.Equal(t, "disabled", enforcement["rfc_compliance"])
+	assert.Equal(t, true, enforcement["truncated_redirects"])
+}
+
+func TestGetHttpProfileConfigEncryptCookieSecret(t *testing.T) {
+	r := resourceBigipLtmProfileHttp()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":                  "/Common/test-http",
+		"encrypt_cookie_secret": "f5-secret",
+	})
+	config := getHttpProfileConfig(d, &httpProfile{HttpProfile: bigip.HttpProfile{Name: "/Common/test-http"}})
+	assert.Equal(t, "f5-secret", config.EncryptCookieSecret)
+
+	// an update leaving the secret as it is does not send it again
+	d = r.Data(&terraform.InstanceState{
+		ID: "/Common/test-http",
+		Attributes: map[string]string{
+			"id":                    "/Common/test-http",
+			"name":                  "/Common/test-http",
+			"encrypt_cookie_secret": "f5-secret",
+		},
+	})
+	config = getHttpProfileConfig(d, &httpProfile{HttpProfile: bigip.HttpProfile{Name: "/Common/test-http"}})
+	assert.Equal(t, "", config.EncryptCookieSecret)
+	payload, err := restMarshal(config)
+	assert.NoError(t, err)
+	assert.NotContains(t, payload, "encryptCookieSecret")
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriProfileHttpRouter = "ltm/profile/httprouter"
+
+// HttpRouterProfile mirrors the ltm profile httprouter object.
+type HttpRouterProfile struct {
+	Name         string `json:"name,omitempty"`
+	Partition    string `json:"partition,omitempty"`
+	FullPath     string `json:"fullPath,omitempty"`
+	DefaultsFrom string `json:"defaultsFrom,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+func resourceBigipLtmProfileHttpRouter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileHttpRouterCreate,
+		ReadContext:   resourceBigipLtmProfileHttpRouterRead,
+		UpdateContext: resourceBigipLtmProfileHttpRouterUpdate,
+		DeleteContext: resourceBigipLtmProfileHttpRouterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the HTTP router profile, in full path format e.g. /Common/my-httprouter",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the profile that you want to use as the parent profile",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined description",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileHttpRouterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_httprouter", name, "create", icontrolURI(uriProfileHttpRouter, name))
+
+	config := getHttpRouterProfileConfig(d, &HttpRouterProfile{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriProfileHttpRouter)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating HTTP router profile (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileHttpRouterRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileHttpRouterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_httprouter", name, "read", icontrolURI(uriProfileHttpRouter, name))
+
+	obj := &HttpRouterProfile{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriProfileHttpRouter, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "HTTP Router Profile not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	return nil
+}
+
+func resourceBigipLtmProfileHttpRouterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_httprouter", name, "update", icontrolURI(uriProfileHttpRouter, name))
+
+	config := getHttpRouterProfileConfig(d, &HttpRouterProfile{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriProfileHttpRouter, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying HTTP router profile (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileHttpRouterRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileHttpRouterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_httprouter", name, "delete", icontrolURI(uriProfileHttpRouter, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriProfileHttpRouter, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getHttpRouterProfileConfig(d *schema.ResourceData, config *HttpRouterProfile) *HttpRouterProfile {
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	return config
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestHttpRouterProfileName = fmt.Sprintf("/%s/test-profile-httprouter", TestPartition)
+
+func TestAccBigipLtmProfileHttpRouter_create(t *testing.T) {
+	resName := "bigip_ltm_profile_httprouter.test-httprouter"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckHttpRouterProfileDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHttpRouterProfileConfig(TestHttpRouterProfileName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckHttpRouterProfileExists(TestHttpRouterProfileName),
+					resource.TestCheckResourceAttr(resName, "name", TestHttpRouterProfileName),
+					resource.TestCheckResourceAttr(resName, "defaults_from", "/Common/httprouter"),
+					resource.TestCheckResourceAttr(resName, "description", "h2 full proxy"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportStateId:     TestHttpRouterProfileName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileHttpRouter_http2FullProxy(t *testing.T) {
+	vsName := "bigip_ltm_virtual_server.test-h2-vs"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckHttpRouterProfileDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHttpRouterProfileConfig(TestHttpRouterProfileName) + testAccHttp2FullProxyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("/Common/test-h2-vs"),
+					resource.TestCheckResourceAttr(vsName, "profiles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(vsName, "profiles.*", TestHttpRouterProfileName),
+					resource.TestCheckResourceAttr(vsName, "client_profiles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(vsName, "client_profiles.*", "/Common/test-h2-http2"),
+					resource.TestCheckResourceAttr(vsName, "server_profiles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(vsName, "server_profiles.*", "/Common/test-h2-http2"),
+				),
+			},
+			{
+				Config:   testAccHttpRouterProfileConfig(TestHttpRouterProfileName) + testAccHttp2FullProxyConfig(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testCheckHttpRouterProfileExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &HttpRouterProfile{}, restObjectPath(uriProfileHttpRouter, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("HTTP router profile %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckHttpRouterProfileDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_httprouter" {
+			continue
+		}
+		name := rs.Primary.ID
+		found, err := getRestEntity(client, &HttpRouterProfile{}, restObjectPath(uriProfileHttpRouter, name))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("HTTP router profile %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func testAccHttpRouterProfileConfig(name string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_httprouter" "test-httprouter" {
+  name          = "%s"
+  defaults_from = "/Common/httprouter"
+  description   = "h2 full proxy"
+}
+`, name)
+}
+
+func testAccHttp2FullProxyConfig() string {
+	return `
+resource "bigip_ltm_profile_http2" "test-h2-http2" {
+  name          = "/Common/test-h2-http2"
+  defaults_from = "/Common/http2"
+}
+
+resource "bigip_ltm_virtual_server" "test-h2-vs" {
+  name            = "/Common/test-h2-vs"
+  destination     = "10.255.255.60"
+  port            = 443
+  profiles        = ["/Common/http", bigip_ltm_profile_httprouter.test-httprouter.name]
+  client_profiles = [bigip_ltm_profile_http2.test-h2-http2.name, "/Common/clientssl"]
+  server_profiles = [bigip_ltm_profile_http2.test-h2-http2.name, "/Common/serverssl"]
+}
+`
+}
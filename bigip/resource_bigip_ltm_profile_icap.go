@@ -0,0 +1,206 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriProfileIcap = "ltm/profile/icap"
+
+// IcapProfile mirrors the ltm profile icap object.
+type IcapProfile struct {
+	Name          string `json:"name,omitempty"`
+	Partition     string `json:"partition,omitempty"`
+	FullPath      string `json:"fullPath,omitempty"`
+	DefaultsFrom  string `json:"defaultsFrom,omitempty"`
+	Description   string `json:"description,omitempty"`
+	Uri           string `json:"uri,omitempty"`
+	HeaderFrom    string `json:"headerFrom,omitempty"`
+	Host          string `json:"host,omitempty"`
+	Referer       string `json:"referer,omitempty"`
+	UserAgent     string `json:"userAgent,omitempty"`
+	PreviewLength *int   `json:"previewLength,omitempty"`
+}
+
+func resourceBigipLtmProfileIcap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileIcapCreate,
+		ReadContext:   resourceBigipLtmProfileIcapRead,
+		UpdateContext: resourceBigipLtmProfileIcapUpdate,
+		DeleteContext: resourceBigipLtmProfileIcapDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the ICAP profile, in full path format e.g. /Common/my-icap",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the profile that you want to use as the parent profile",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined description",
+			},
+			"uri": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "URI of the ICAP service, e.g. icap://${SERVER_IP}:${SERVER_PORT}/avscan",
+			},
+			"header_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Value of the From header sent to the ICAP server",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Value of the Host header sent to the ICAP server",
+			},
+			"referer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Value of the Referer header sent to the ICAP server",
+			},
+			"user_agent": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Value of the User-Agent header sent to the ICAP server",
+			},
+			"preview_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 51200),
+				Description:  "Number of bytes of the HTTP payload sent to the ICAP server as a preview, 0 disables the preview",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileIcapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_icap", name, "create", icontrolURI(uriProfileIcap, name))
+
+	config := getIcapProfileConfig(d, &IcapProfile{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriProfileIcap)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating ICAP profile (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileIcapRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileIcapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_icap", name, "read", icontrolURI(uriProfileIcap, name))
+
+	obj := &IcapProfile{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriProfileIcap, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "ICAP Profile not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("uri", obj.Uri)
+	_ = d.Set("header_from", obj.HeaderFrom)
+	_ = d.Set("host", obj.Host)
+	_ = d.Set("referer", obj.Referer)
+	_ = d.Set("user_agent", obj.UserAgent)
+	previewLength := 0
+	if obj.PreviewLength != nil {
+		previewLength = *obj.PreviewLength
+	}
+	_ = d.Set("preview_length", previewLength)
+	return nil
+}
+
+func resourceBigipLtmProfileIcapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_icap", name, "update", icontrolURI(uriProfileIcap, name))
+
+	config := getIcapProfileConfig(d, &IcapProfile{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriProfileIcap, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying ICAP profile (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileIcapRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileIcapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_icap", name, "delete", icontrolURI(uriProfileIcap, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriProfileIcap, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getIcapProfileConfig(d *schema.ResourceData, config *IcapProfile) *IcapProfile {
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.Uri = d.Get("uri").(string)
+	config.HeaderFrom = d.Get("header_from").(string)
+	config.Host = d.Get("host").(string)
+	config.Referer = d.Get("referer").(string)
+	config.UserAgent = d.Get("user_agent").(string)
+	config.PreviewLength = configuredIntPtr(d, "preview_length")
+	return config
+}
+
+// configuredIntPtr returns the value of the int attribute when it is configured (or known from a
+// previous read) so that an explicit 0 is sent, and nil otherwise so that the BIG-IP keeps the
+// value inherited from the parent profile.
+func configuredIntPtr(d *schema.ResourceData, attr string) *int {
+	rawConfig := d.GetRawConfig()
+	if _, ok := d.GetOk(attr); !ok && (rawConfig.IsNull() || rawConfig.GetAttr(attr).IsNull()) {
+		return nil
+	}
+	value := d.Get(attr).(int)
+	return &value
+}
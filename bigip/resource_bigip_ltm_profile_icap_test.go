@@ -0,0 +1,117 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBigipLtmProfileIcapChain(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckRestEntityDestroyed("bigip_ltm_profile_request_adapt", uriProfileRequestAdapt),
+			testCheckRestEntityDestroyed("bigip_ltm_profile_icap", uriProfileIcap),
+			testCheckVSsDestroyed,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipLtmProfileIcapChainConfig("2048"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_icap.test", "uri", "icap://${SERVER_IP}:${SERVER_PORT}/avscan"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_icap.test", "preview_length", "2048"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_icap.test", "header_from", "admin@example.com"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.icap", "type", "internal"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.icap", "destination", ""),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_adapt.test", "internal_virtual", "/Common/test-icap-vs"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_adapt.test", "enabled", "yes"),
+					resource.TestCheckTypeSetElemAttr("bigip_ltm_virtual_server.app", "profiles.*", "/Common/test-reqadapt"),
+				),
+			},
+			{
+				Config: testAccBigipLtmProfileIcapChainConfig("0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_icap.test", "preview_length", "0"),
+				),
+			},
+			{
+				ResourceName:      "bigip_ltm_profile_icap.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "bigip_ltm_virtual_server.icap",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testCheckRestEntityDestroyed checks that the objects of resourceType are gone from collection.
+func testCheckRestEntityDestroyed(resourceType, collection string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			found, err := getRestEntity(client, &struct{}{}, restObjectPath(collection, rs.Primary.ID))
+			if err != nil {
+				return err
+			}
+			if found {
+				return fmt.Errorf("%s %s not destroyed", resourceType, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccBigipLtmProfileIcapChainConfig(previewLength string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_pool" "icap" {
+  name = "/Common/test-icap-pool"
+}
+
+resource "bigip_ltm_profile_icap" "test" {
+  name           = "/Common/test-icap"
+  defaults_from  = "/Common/icap"
+  uri            = "icap://$${SERVER_IP}:$${SERVER_PORT}/avscan"
+  header_from    = "admin@example.com"
+  preview_length = %s
+}
+
+resource "bigip_ltm_virtual_server" "icap" {
+  name     = "/Common/test-icap-vs"
+  type     = "internal"
+  pool     = bigip_ltm_pool.icap.name
+  profiles = ["/Common/tcp", bigip_ltm_profile_icap.test.name]
+}
+
+resource "bigip_ltm_profile_request_adapt" "test" {
+  name             = "/Common/test-reqadapt"
+  defaults_from    = "/Common/requestadapt"
+  enabled          = "yes"
+  internal_virtual = bigip_ltm_virtual_server.icap.name
+}
+
+resource "bigip_ltm_virtual_server" "app" {
+  name        = "/Common/test-icap-app-vs"
+  destination = "10.12.12.12"
+  port        = 80
+  profiles    = ["/Common/tcp", "/Common/http", bigip_ltm_profile_request_adapt.test.name]
+}
+`, previewLength)
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIcapProfileConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileIcap().Schema, map[string]interface{}{
+		"name":           "/Common/test-icap",
+		"uri":            "icap://${SERVER_IP}:${SERVER_PORT}/avscan",
+		"preview_length": 1024,
+	})
+	config := getIcapProfileConfig(d, &IcapProfile{Name: "/Common/test-icap"})
+	assert.Equal(t, "icap://${SERVER_IP}:${SERVER_PORT}/avscan", config.Uri)
+	if assert.NotNil(t, config.PreviewLength) {
+		assert.Equal(t, 1024, *config.PreviewLength)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmProfileIcap().Schema, map[string]interface{}{
+		"name": "/Common/test-icap",
+	})
+	config = getIcapProfileConfig(d, &IcapProfile{Name: "/Common/test-icap"})
+	assert.Nil(t, config.PreviewLength)
+}
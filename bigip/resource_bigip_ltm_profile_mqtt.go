@@ -0,0 +1,134 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriProfileMqtt = "ltm/profile/mqtt"
+
+// MqttProfile mirrors the ltm profile mqtt object.
+type MqttProfile struct {
+	Name         string `json:"name,omitempty"`
+	Partition    string `json:"partition,omitempty"`
+	FullPath     string `json:"fullPath,omitempty"`
+	DefaultsFrom string `json:"defaultsFrom,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+func resourceBigipLtmProfileMqtt() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileMqttCreate,
+		ReadContext:   resourceBigipLtmProfileMqttRead,
+		UpdateContext: resourceBigipLtmProfileMqttUpdate,
+		DeleteContext: resourceBigipLtmProfileMqttDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the MQTT profile, in full path format e.g. /Common/my-mqtt",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the profile that you want to use as the parent profile",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined description",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileMqttCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_mqtt", name, "create", icontrolURI(uriProfileMqtt, name))
+
+	config := getMqttProfileConfig(d, &MqttProfile{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriProfileMqtt)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating MQTT profile (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileMqttRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileMqttRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_mqtt", name, "read", icontrolURI(uriProfileMqtt, name))
+
+	obj := &MqttProfile{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriProfileMqtt, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "MQTT Profile not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	return nil
+}
+
+func resourceBigipLtmProfileMqttUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_mqtt", name, "update", icontrolURI(uriProfileMqtt, name))
+
+	config := getMqttProfileConfig(d, &MqttProfile{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriProfileMqtt, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying MQTT profile (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileMqttRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileMqttDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_mqtt", name, "delete", icontrolURI(uriProfileMqtt, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriProfileMqtt, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getMqttProfileConfig(d *schema.ResourceData, config *MqttProfile) *MqttProfile {
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	return config
+}
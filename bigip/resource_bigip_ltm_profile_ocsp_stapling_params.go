@@ -0,0 +1,256 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriOcspStaplingParams = "ltm/profile/ocsp-stapling-params"
+
+// OcspStaplingParams mirrors the ltm profile ocsp-stapling-params object.
+type OcspStaplingParams struct {
+	Name                string `json:"name,omitempty"`
+	Partition           string `json:"partition,omitempty"`
+	FullPath            string `json:"fullPath,omitempty"`
+	DefaultsFrom        string `json:"defaultsFrom,omitempty"`
+	Description         string `json:"description,omitempty"`
+	DnsResolver         string `json:"dnsResolver,omitempty"`
+	ProxyServerPool     string `json:"proxyServerPool,omitempty"`
+	UseProxyServer      string `json:"useProxyServer,omitempty"`
+	TrustedCa           string `json:"trustedCa,omitempty"`
+	TrustedResponders   string `json:"trustedResponders,omitempty"`
+	ResponderUrl        string `json:"responderUrl,omitempty"`
+	CacheTimeout        string `json:"cacheTimeout,omitempty"`
+	CacheErrorTimeout   int    `json:"cacheErrorTimeout"`
+	ClockSkew           int    `json:"clockSkew"`
+	StatusAge           int    `json:"statusAge"`
+	Timeout             int    `json:"timeout"`
+	StrictRespCertCheck string `json:"strictRespCertCheck,omitempty"`
+	SignHash            string `json:"signHash,omitempty"`
+}
+
+func resourceBigipLtmProfileOcspStaplingParams() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileOcspStaplingParamsCreate,
+		ReadContext:   resourceBigipLtmProfileOcspStaplingParamsRead,
+		UpdateContext: resourceBigipLtmProfileOcspStaplingParamsUpdate,
+		DeleteContext: resourceBigipLtmProfileOcspStaplingParamsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the OCSP stapling parameters profile, in full path format e.g. /Common/my-ocsp-params",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the profile that you want to use as the parent profile",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined description",
+			},
+			"dns_resolver": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"proxy_server_pool"},
+				Description:   "Specifies the internal DNS resolver the BIG-IP system uses to fetch the OCSP response",
+			},
+			"proxy_server_pool": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"dns_resolver"},
+				Description:   "Specifies the proxy server pool the BIG-IP system uses to fetch the OCSP response",
+			},
+			"use_proxy_server": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Specifies whether the system uses a proxy server pool to fetch the OCSP response",
+			},
+			"trusted_ca": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Specifies the certificate-authority that signs the responder's certificate, e.g. /Common/ca-bundle.crt",
+			},
+			"trusted_responders": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the certificates used for validating the OCSP response",
+			},
+			"responder_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies an OCSP responder URL to use instead of the one in the certificate's AIA extension",
+			},
+			"cache_timeout": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the lifetime of the OCSP response in the cache, in seconds or `indefinite`",
+			},
+			"cache_error_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3600,
+				Description: "Specifies the lifetime of an error response in the cache, in seconds",
+			},
+			"clock_skew": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     300,
+				Description: "Specifies the tolerable absolute difference in the clocks of the responder and the BIG-IP system, in seconds",
+			},
+			"status_age": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     86400,
+				Description: "Specifies the maximum allowed lag time for the 'thisUpdate' time in the OCSP response, in seconds, 0 disables the check",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     8,
+				Description: "Specifies the time the system waits for a response from the OCSP responder, in seconds",
+			},
+			"strict_resp_cert_check": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Specifies whether the responder's certificate is checked for an OCSP signing extension",
+			},
+			"sign_hash": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"sha1", "sha256"}, false),
+				Description:  "Specifies the hash algorithm used to sign the OCSP request",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileOcspStaplingParamsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_ocsp_stapling_params", name, "create", icontrolURI(uriOcspStaplingParams, name))
+
+	config := getOcspStaplingParamsConfig(d, &OcspStaplingParams{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriOcspStaplingParams)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating OCSP stapling params profile (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileOcspStaplingParamsRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileOcspStaplingParamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_ocsp_stapling_params", name, "read", icontrolURI(uriOcspStaplingParams, name))
+
+	obj := &OcspStaplingParams{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriOcspStaplingParams, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "OCSP Stapling Params Profile not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("dns_resolver", obj.DnsResolver)
+	_ = d.Set("proxy_server_pool", obj.ProxyServerPool)
+	_ = d.Set("use_proxy_server", obj.UseProxyServer)
+	_ = d.Set("trusted_ca", obj.TrustedCa)
+	_ = d.Set("trusted_responders", obj.TrustedResponders)
+	_ = d.Set("responder_url", obj.ResponderUrl)
+	_ = d.Set("cache_timeout", obj.CacheTimeout)
+	_ = d.Set("cache_error_timeout", obj.CacheErrorTimeout)
+	_ = d.Set("clock_skew", obj.ClockSkew)
+	_ = d.Set("status_age", obj.StatusAge)
+	_ = d.Set("timeout", obj.Timeout)
+	_ = d.Set("strict_resp_cert_check", obj.StrictRespCertCheck)
+	_ = d.Set("sign_hash", obj.SignHash)
+	return nil
+}
+
+func resourceBigipLtmProfileOcspStaplingParamsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_ocsp_stapling_params", name, "update", icontrolURI(uriOcspStaplingParams, name))
+
+	config := getOcspStaplingParamsConfig(d, &OcspStaplingParams{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriOcspStaplingParams, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying OCSP stapling params profile (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileOcspStaplingParamsRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileOcspStaplingParamsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_ocsp_stapling_params", name, "delete", icontrolURI(uriOcspStaplingParams, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriOcspStaplingParams, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getOcspStaplingParamsConfig(d *schema.ResourceData, config *OcspStaplingParams) *OcspStaplingParams {
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.DnsResolver = d.Get("dns_resolver").(string)
+	config.ProxyServerPool = d.Get("proxy_server_pool").(string)
+	config.UseProxyServer = d.Get("use_proxy_server").(string)
+	config.TrustedCa = d.Get("trusted_ca").(string)
+	config.TrustedResponders = d.Get("trusted_responders").(string)
+	config.ResponderUrl = d.Get("responder_url").(string)
+	config.CacheTimeout = d.Get("cache_timeout").(string)
+	config.CacheErrorTimeout = d.Get("cache_error_timeout").(int)
+	config.ClockSkew = d.Get("clock_skew").(int)
+	config.StatusAge = d.Get("status_age").(int)
+	config.Timeout = d.Get("timeout").(int)
+	config.StrictRespCertCheck = d.Get("strict_resp_cert_check").(string)
+	config.SignHash = d.Get("sign_hash").(string)
+	return config
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+var TestOcspStaplingParamsName = fmt.Sprintf("/%s/test-ocsp-stapling-params", TestPartition)
+
+var TestOcspStaplingParamsResource = `
+resource "bigip_ltm_profile_ocsp_stapling_params" "test-ocsp-params" {
+  name          = "/Common/test-ocsp-stapling-params"
+  dns_resolver  = "/Common/f5-aws-dns"
+  trusted_ca    = "/Common/ca-bundle.crt"
+  cache_timeout = "indefinite"
+  sign_hash     = "sha256"
+}
+`
+
+func TestOcspStaplingParamsConfigIntegers(t *testing.T) {
+	// status_age = 0 disables the check and must reach the BIG-IP
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileOcspStaplingParams().Schema, map[string]interface{}{
+		"name":       TestOcspStaplingParamsName,
+		"trusted_ca": "/Common/ca-bundle.crt",
+		"status_age": 0,
+	})
+	body, err := json.Marshal(getOcspStaplingParamsConfig(d, &OcspStaplingParams{}))
+	assert.NoError(t, err)
+	payload := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, float64(0), payload["statusAge"])
+	// the unset ones are sent with their TMOS defaults, so removing them from the config resets them
+	assert.Equal(t, float64(3600), payload["cacheErrorTimeout"])
+	assert.Equal(t, float64(300), payload["clockSkew"])
+	assert.Equal(t, float64(8), payload["timeout"])
+}
+
+func TestAccBigipLtmProfileOcspStaplingParamsCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckOcspStaplingParamsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestOcspStaplingParamsResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOcspStaplingParamsExists(TestOcspStaplingParamsName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_ocsp_stapling_params.test-ocsp-params", "name", TestOcspStaplingParamsName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_ocsp_stapling_params.test-ocsp-params", "dns_resolver", "/Common/f5-aws-dns"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_ocsp_stapling_params.test-ocsp-params", "trusted_ca", "/Common/ca-bundle.crt"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_ocsp_stapling_params.test-ocsp-params", "sign_hash", "sha256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileOcspStaplingParamsImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckOcspStaplingParamsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestOcspStaplingParamsResource,
+			},
+			{
+				ResourceName:      "bigip_ltm_profile_ocsp_stapling_params.test-ocsp-params",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var TestClientSslOcspName = fmt.Sprintf("/%s/test-clientssl-ocsp", TestPartition)
+
+func TestAccBigipLtmProfileClientSslOcspStaplingDisabled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			// the misconfiguration must be rejected before the client-ssl profile is created
+			client := testAccProvider.Meta().(*bigip.BigIP)
+			p, err := client.GetClientSSLProfile(TestClientSslOcspName)
+			if err != nil {
+				return err
+			}
+			if p != nil {
+				return fmt.Errorf("client-ssl profile %s was left on the device", TestClientSslOcspName)
+			}
+			return testCheckOcspStaplingParamsDestroyed(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClientSslOcspConfig("disabled"),
+				ExpectError: regexp.MustCompile("ocsp_stapling must be enabled"),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileClientSslOcspStaplingDrift(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckOcspStaplingParamsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientSslOcspConfig("enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckClientSslExists(TestClientSslOcspName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_client_ssl.test-clientssl-ocsp", "cert_key_chain.0.ocsp_stapling_params", TestOcspStaplingParamsName),
+				),
+			},
+			{
+				// detach the OCSP stapling params outside of Terraform, the plan must pick it up
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*bigip.BigIP)
+					body := map[string]interface{}{
+						"certKeyChain": []map[string]interface{}{
+							{
+								"name":               "default",
+								"cert":               "/Common/default.crt",
+								"key":                "/Common/default.key",
+								"ocspStaplingParams": "none",
+							},
+						},
+					}
+					if err := patchRestEntity(client, body, restObjectPath("ltm/profile/client-ssl", TestClientSslOcspName)); err != nil {
+						t.Fatalf("error detaching OCSP stapling params: %v", err)
+					}
+				},
+				Config:             testAccClientSslOcspConfig("enabled"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckOcspStaplingParamsExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &OcspStaplingParams{}, restObjectPath(uriOcspStaplingParams, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("OCSP stapling params profile %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckOcspStaplingParamsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_ocsp_stapling_params" {
+			continue
+		}
+		found, err := getRestEntity(client, &OcspStaplingParams{}, restObjectPath(uriOcspStaplingParams, rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("OCSP stapling params profile %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccClientSslOcspConfig(ocspStapling string) string {
+	return TestOcspStaplingParamsResource + fmt.Sprintf(`
+resource "bigip_ltm_profile_client_ssl" "test-clientssl-ocsp" {
+  name          = "%s"
+  defaults_from = "/Common/clientssl"
+  ocsp_stapling = "%s"
+  cert_key_chain {
+    name                 = "default"
+    cert                 = "/Common/default.crt"
+    key                  = "/Common/default.key"
+    ocsp_stapling_params = bigip_ltm_profile_ocsp_stapling_params.test-ocsp-params.name
+  }
+}
+`, TestClientSslOcspName, ocspStapling)
+}
@@ -57,6 +57,50 @@ func TestAccBigipLtmProfileoneconnect_create(t *testing.T) {
 	})
 }
 
+var TEST_ONECONNECT_UNLIMITED_RESOURCE = `
+resource "bigip_ltm_profile_oneconnect" "test-oneconnect" {
+            name = "/Common/test-oneconnect"
+            defaults_from = "/Common/oneconnect"
+            max_age = 0
+            max_reuse = 0
+            share_pools = "enabled"
+            limit_type = "strict"
+        }
+`
+
+func TestAccBigipLtmProfileoneconnect_zeroValues(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckoneconnectsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_ONECONNECT_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckoneconnectExists(TEST_ONECONNECT_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_oneconnect.test-oneconnect", "max_age", "3600"),
+				),
+			},
+			{
+				Config: TEST_ONECONNECT_UNLIMITED_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckoneconnectValues(TEST_ONECONNECT_NAME, 0, 0),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_oneconnect.test-oneconnect", "max_age", "0"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_oneconnect.test-oneconnect", "max_reuse", "0"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_oneconnect.test-oneconnect", "share_pools", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_oneconnect.test-oneconnect", "limit_type", "strict"),
+				),
+			},
+			{
+				Config:   TEST_ONECONNECT_UNLIMITED_RESOURCE,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmProfileoneconnect_import(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -96,6 +140,23 @@ func testCheckoneconnectExists(name string, exists bool) resource.TestCheckFunc
 	}
 }
 
+func testCheckoneconnectValues(name string, maxAge, maxReuse int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		p, err := client.GetOneconnect(name)
+		if err != nil {
+			return err
+		}
+		if p == nil {
+			return fmt.Errorf("oneconnect %s not found.", name)
+		}
+		if p.MaxAge != maxAge || p.MaxReuse != maxReuse {
+			return fmt.Errorf("oneconnect %s has max_age %d and max_reuse %d, expected %d and %d", name, p.MaxAge, p.MaxReuse, maxAge, maxReuse)
+		}
+		return nil
+	}
+}
+
 func testCheckoneconnectsDestroyed(s *terraform.State) error {
 	client := testAccProvider.Meta().(*bigip.BigIP)
 
@@ -0,0 +1,188 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriProfileRequestAdapt = "ltm/profile/request-adapt"
+
+// RequestAdaptProfile mirrors the ltm profile request-adapt object.
+type RequestAdaptProfile struct {
+	Name              string `json:"name,omitempty"`
+	Partition         string `json:"partition,omitempty"`
+	FullPath          string `json:"fullPath,omitempty"`
+	DefaultsFrom      string `json:"defaultsFrom,omitempty"`
+	Description       string `json:"description,omitempty"`
+	Enabled           string `json:"enabled,omitempty"`
+	InternalVirtual   string `json:"internalVirtual,omitempty"`
+	PreviewSize       *int   `json:"previewSize,omitempty"`
+	ServiceDownAction string `json:"serviceDownAction,omitempty"`
+	Timeout           *int   `json:"timeout,omitempty"`
+}
+
+func resourceBigipLtmProfileRequestAdapt() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileRequestAdaptCreate,
+		ReadContext:   resourceBigipLtmProfileRequestAdaptRead,
+		UpdateContext: resourceBigipLtmProfileRequestAdaptUpdate,
+		DeleteContext: resourceBigipLtmProfileRequestAdaptDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the request adapt profile, in full path format e.g. /Common/my-reqadapt",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the profile that you want to use as the parent profile",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined description",
+			},
+			"enabled": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+				Description:  "Specifies whether HTTP requests are sent to the internal virtual server for adaptation",
+			},
+			"internal_virtual": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Internal virtual server the requests are sent to, e.g. one with an ICAP profile",
+			},
+			"preview_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 51200),
+				Description:  "Maximum number of bytes of the payload sent as a preview",
+			},
+			"service_down_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ignore", "drop", "reset"}, false),
+				Description:  "Action taken when the internal virtual server is unavailable",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Milliseconds to wait for the internal virtual server to respond, 0 waits forever",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileRequestAdaptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_request_adapt", name, "create", icontrolURI(uriProfileRequestAdapt, name))
+
+	config := getRequestAdaptProfileConfig(d, &RequestAdaptProfile{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriProfileRequestAdapt)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating request adapt profile (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileRequestAdaptRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileRequestAdaptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_request_adapt", name, "read", icontrolURI(uriProfileRequestAdapt, name))
+
+	obj := &RequestAdaptProfile{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriProfileRequestAdapt, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "Request Adapt Profile not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("enabled", obj.Enabled)
+	_ = d.Set("internal_virtual", obj.InternalVirtual)
+	_ = d.Set("service_down_action", obj.ServiceDownAction)
+	if obj.PreviewSize != nil {
+		_ = d.Set("preview_size", *obj.PreviewSize)
+	}
+	if obj.Timeout != nil {
+		_ = d.Set("timeout", *obj.Timeout)
+	}
+	return nil
+}
+
+func resourceBigipLtmProfileRequestAdaptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_request_adapt", name, "update", icontrolURI(uriProfileRequestAdapt, name))
+
+	config := getRequestAdaptProfileConfig(d, &RequestAdaptProfile{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriProfileRequestAdapt, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying request adapt profile (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileRequestAdaptRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileRequestAdaptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_request_adapt", name, "delete", icontrolURI(uriProfileRequestAdapt, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriProfileRequestAdapt, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getRequestAdaptProfileConfig(d *schema.ResourceData, config *RequestAdaptProfile) *RequestAdaptProfile {
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.Enabled = d.Get("enabled").(string)
+	config.InternalVirtual = d.Get("internal_virtual").(string)
+	config.ServiceDownAction = d.Get("service_down_action").(string)
+	config.PreviewSize = configuredIntPtr(d, "preview_size")
+	config.Timeout = configuredIntPtr(d, "timeout")
+	return config
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriProfileSctp = "ltm/profile/sctp"
+
+// SctpProfile mirrors the ltm profile sctp object.
+type SctpProfile struct {
+	Name              string `json:"name,omitempty"`
+	Partition         string `json:"partition,omitempty"`
+	FullPath          string `json:"fullPath,omitempty"`
+	DefaultsFrom      string `json:"defaultsFrom,omitempty"`
+	Description       string `json:"description,omitempty"`
+	IdleTimeout       *int   `json:"idleTimeout,omitempty"`
+	HeartbeatInterval *int   `json:"heartbeatInterval,omitempty"`
+	HeartbeatMaxBurst *int   `json:"heartbeatMaxBurst,omitempty"`
+	InStreams         *int   `json:"inStreams,omitempty"`
+	OutStreams        *int   `json:"outStreams,omitempty"`
+	Secret            string `json:"secret,omitempty"`
+}
+
+func resourceBigipLtmProfileSctp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileSctpCreate,
+		ReadContext:   resourceBigipLtmProfileSctpRead,
+		UpdateContext: resourceBigipLtmProfileSctpUpdate,
+		DeleteContext: resourceBigipLtmProfileSctpDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the SCTP profile, in full path format e.g. /Common/my-sctp",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the profile that you want to use as the parent profile",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined description",
+			},
+			"idle_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of seconds without traffic before an association is closed",
+			},
+			"heartbeat_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of seconds between the heartbeats sent to an idle peer",
+			},
+			"heartbeat_max_burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of heartbeats sent at once",
+			},
+			"in_streams": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+				Description:  "Number of inbound streams of an association",
+			},
+			"out_streams": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+				Description:  "Number of outbound streams of an association",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Internal secret used to compute the cookies of the association setup, write only",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileSctpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_sctp", name, "create", icontrolURI(uriProfileSctp, name))
+
+	config := getSctpProfileConfig(d, &SctpProfile{Name: name})
+	err := postRestEntity(client, config, uriProfileSctp)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating SCTP profile (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileSctpRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileSctpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_sctp", name, "read", icontrolURI(uriProfileSctp, name))
+
+	obj := &SctpProfile{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriProfileSctp, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "SCTP Profile not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	for attr, value := range map[string]*int{
+		"idle_timeout":        obj.IdleTimeout,
+		"heartbeat_interval":  obj.HeartbeatInterval,
+		"heartbeat_max_burst": obj.HeartbeatMaxBurst,
+		"in_streams":          obj.InStreams,
+		"out_streams":         obj.OutStreams,
+	} {
+		if value != nil {
+			_ = d.Set(attr, *value)
+		}
+	}
+	// the BIG-IP only returns the secret encrypted, the configured value is kept in state
+	return nil
+}
+
+func resourceBigipLtmProfileSctpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_sctp", name, "update", icontrolURI(uriProfileSctp, name))
+
+	config := getSctpProfileConfig(d, &SctpProfile{})
+	err := patchRestEntity(client, config, restObjectPath(uriProfileSctp, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying SCTP profile (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileSctpRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileSctpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_sctp", name, "delete", icontrolURI(uriProfileSctp, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriProfileSctp, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSctpProfileConfig(d *schema.ResourceData, config *SctpProfile) *SctpProfile {
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.IdleTimeout = configuredIntPtr(d, "idle_timeout")
+	config.HeartbeatInterval = configuredIntPtr(d, "heartbeat_interval")
+	config.HeartbeatMaxBurst = configuredIntPtr(d, "heartbeat_max_burst")
+	config.InStreams = configuredIntPtr(d, "in_streams")
+	config.OutStreams = configuredIntPtr(d, "out_streams")
+	config.Secret = d.Get("secret").(string)
+	return config
+}
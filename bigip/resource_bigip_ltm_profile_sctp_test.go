@@ -0,0 +1,119 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBigipLtmProfileSctp_create(t *testing.T) {
+	var instance testAccInstance
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckRestEntityDestroyed("bigip_ltm_profile_sctp", uriProfileSctp),
+			testCheckVSsDestroyed,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipLtmProfileSctpConfig(300, 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sctp.test", "defaults_from", "/Common/sctp"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sctp.test", "idle_timeout", "300"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sctp.test", "in_streams", "4"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sctp.test", "out_streams", "4"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.sctp", "ip_protocol", "sctp"),
+					resource.TestCheckTypeSetElemAttr("bigip_ltm_virtual_server.sctp", "profiles.*", "/Common/test-sctp"),
+					testCheckResourceNotReplaced("bigip_ltm_profile_sctp.test", &instance),
+				),
+			},
+			{
+				Config: testAccBigipLtmProfileSctpConfig(600, 8),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sctp.test", "idle_timeout", "600"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sctp.test", "in_streams", "8"),
+					testCheckResourceNotReplaced("bigip_ltm_profile_sctp.test", &instance),
+				),
+			},
+			{
+				ResourceName:            "bigip_ltm_profile_sctp.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileMqtt_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckRestEntityDestroyed("bigip_ltm_profile_mqtt", uriProfileMqtt),
+			testCheckVSsDestroyed,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipLtmProfileMqttConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_mqtt.test", "defaults_from", "/Common/mqtt"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_mqtt.test", "description", "iot brokers"),
+					resource.TestCheckTypeSetElemAttr("bigip_ltm_virtual_server.mqtt", "profiles.*", "/Common/test-mqtt"),
+				),
+			},
+			{
+				ResourceName:      "bigip_ltm_profile_mqtt.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBigipLtmProfileSctpConfig(idleTimeout, streams int) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_sctp" "test" {
+  name          = "/Common/test-sctp"
+  defaults_from = "/Common/sctp"
+  idle_timeout  = %d
+  in_streams    = %d
+  out_streams   = %d
+  secret        = "acceptance-secret"
+}
+
+resource "bigip_ltm_virtual_server" "sctp" {
+  name        = "/Common/test-sctp-vs"
+  destination = "10.13.13.13"
+  port        = 3868
+  ip_protocol = "sctp"
+  profiles    = [bigip_ltm_profile_sctp.test.name]
+}
+`, idleTimeout, streams, streams)
+}
+
+const testAccBigipLtmProfileMqttConfig = `
+resource "bigip_ltm_profile_mqtt" "test" {
+  name          = "/Common/test-mqtt"
+  defaults_from = "/Common/mqtt"
+  description   = "iot brokers"
+}
+
+resource "bigip_ltm_virtual_server" "mqtt" {
+  name        = "/Common/test-mqtt-vs"
+  destination = "10.14.14.14"
+  port        = 1883
+  profiles    = ["/Common/tcp", bigip_ltm_profile_mqtt.test.name]
+}
+`
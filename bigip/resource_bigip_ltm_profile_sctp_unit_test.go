@@ -0,0 +1,62 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSctpProfileConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileSctp().Schema, map[string]interface{}{
+		"name":         "/Common/test-sctp",
+		"idle_timeout": 120,
+		"in_streams":   8,
+		"secret":       "s3cr3t",
+	})
+	config := getSctpProfileConfig(d, &SctpProfile{Name: "/Common/test-sctp"})
+	if assert.NotNil(t, config.IdleTimeout) {
+		assert.Equal(t, 120, *config.IdleTimeout)
+	}
+	if assert.NotNil(t, config.InStreams) {
+		assert.Equal(t, 8, *config.InStreams)
+	}
+	assert.Nil(t, config.OutStreams)
+	assert.Nil(t, config.HeartbeatInterval)
+	assert.Equal(t, "s3cr3t", config.Secret)
+}
+
+func TestResourceBigipLtmProfileSctpRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/profile/sctp/~Common~test-sctp", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"name":"test-sctp","partition":"Common","fullPath":"/Common/test-sctp","defaultsFrom":"/Common/sctp",
+			"idleTimeout":300,"heartbeatInterval":30,"heartbeatMaxBurst":1,"inStreams":2,"outStreams":2,"secret":"$M$encrypted"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipLtmProfileSctp()
+	d := r.TestResourceData()
+	d.SetId("/Common/test-sctp")
+	_ = d.Set("secret", "s3cr3t")
+	assert.False(t, resourceBigipLtmProfileSctpRead(context.Background(), d, client).HasError())
+	assert.Equal(t, "/Common/sctp", d.Get("defaults_from"))
+	assert.Equal(t, 300, d.Get("idle_timeout"))
+	assert.Equal(t, 30, d.Get("heartbeat_interval"))
+	assert.Equal(t, 2, d.Get("out_streams"))
+	assert.Equal(t, "s3cr3t", d.Get("secret"))
+}
@@ -21,14 +21,15 @@ import (
 )
 
 func resourceBigipLtmProfileClientSsl() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceBigipLtmProfileClientSSLCreate,
 		UpdateContext: resourceBigipLtmProfileClientSSLUpdate,
 		ReadContext:   resourceBigipLtmProfileClientSSLRead,
 		DeleteContext: resourceBigipLtmProfileClientSSLDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importProfileFullPath,
 		},
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -103,10 +104,11 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 			},
 
 			"c3d_drop_unknown_ocsp_status": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Unknown OCSP Response Control. Default Drop.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Unknown OCSP Response Control. Default Drop.",
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 			"c3d_ocsp": {
 				Type:        schema.TypeString,
@@ -157,11 +159,12 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 				Description: "Client certificate chain name.",
 			},
 			"passphrase": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "Client Certificate Constrained Delegation CA passphrase",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressObfuscatedSecretDiff,
+				Description:      "Client Certificate Constrained Delegation CA passphrase",
 			},
 			"cert_key_chain": {
 				Type:       schema.TypeList,
@@ -230,6 +233,12 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 							Sensitive:   true,
 							Description: "Key passphrase",
 						},
+						"ocsp_stapling_params": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Name of the OCSP stapling parameters profile (bigip_ltm_profile_ocsp_stapling_params) used for this certificate",
+							ValidateFunc: validateF5NameWithDirectory,
+						},
 					},
 				},
 			},
@@ -251,10 +260,11 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 			},
 
 			"cert_lookup_by_ipaddr_port": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Cert lookup by ip address and port enabled / disabled",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Cert lookup by ip address and port enabled / disabled",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"ciphers": {
@@ -278,6 +288,12 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 				Computed:    true,
 				Description: "(Advertised Certificate Authorities)Specifies that the CAs that the system advertises to clients is being trusted by the profile. The default is `None`",
 			},
+			"advertised_cert_authorities": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_cert_ca"},
+				Description:   "CA bundle whose certificate authorities are advertised to clients requesting a client certificate, same setting as client_cert_ca",
+			},
 			"crl_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -291,17 +307,19 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 				Description: "allow_expired_crl option to be `enabled` / `disabled`.  Default is `disabled`.",
 			},
 			"forward_proxy_bypass_default_action": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Forward proxy bypass default action. (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Forward proxy bypass default action. (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"generic_alert": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Generic alerts enabled / disabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Generic alerts enabled / disabled.",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"handshake_timeout": {
@@ -319,17 +337,19 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 			},
 
 			"mod_ssl_methods": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "ModSSL Methods enabled / disabled.  Default is disabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "ModSSL Methods enabled / disabled.  Default is disabled.",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"mode": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "ModSSL Methods enabled / disabled.  Default is disabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "ModSSL Methods enabled / disabled.  Default is disabled.",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"ocsp_stapling": {
@@ -341,12 +361,20 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 
 			"tm_options": {
 				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: warnUnknownSslValue(sslTmOptions)},
 				Set:      schema.HashString,
 				Optional: true,
 				Computed: true,
 			},
 
+			"data_0rtt": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: warnUnknownSslValue(sslData0rttValues),
+				Description:  "TLS 1.3 early data (0-RTT) setting, e.g. disabled or enabled-with-anti-replay",
+			},
+
 			"peer_cert_mode": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -369,24 +397,28 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 			},
 
 			"proxy_ca_passphrase": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Proxy CA Passphrase",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressObfuscatedSecretDiff,
+				Description:      "Proxy CA Passphrase",
 			},
 
 			"proxy_ssl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Proxy SSL enabled / disabled.  Default is disabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Proxy SSL enabled / disabled.  Default is disabled.",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"proxy_ssl_passthrough": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Proxy SSL passthrough enabled / disabled.  Default is disabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Proxy SSL passthrough enabled / disabled.  Default is disabled.",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"renegotiate_period": {
@@ -404,10 +436,11 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 			},
 
 			"renegotiation": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Renegotiation (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Renegotiation (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"retain_certificate": {
@@ -418,10 +451,11 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 			},
 
 			"secure_renegotiation": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Secure reneogotiaton (request / require / require-strict).",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Secure reneogotiaton (request / require / require-strict).",
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 
 			"server_name": {
@@ -432,52 +466,59 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 			},
 
 			"session_mirroring": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Session Mirroring (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Session Mirroring (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"session_ticket": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Session Ticket (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Session Ticket (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"sni_default": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "SNI Default (true / false)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "SNI Default (true / false)",
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 
 			"sni_require": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "SNI Require (true / false)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "SNI Require (true / false)",
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 
 			"ssl_c3d": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Client Certificate Constrained Delegation enabled / disabled.  Default is disabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Client Certificate Constrained Delegation enabled / disabled.  Default is disabled.",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"ssl_forward_proxy": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "SSL forward Proxy (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "SSL forward Proxy (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"ssl_forward_proxy_bypass": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "SSL forward Proxy Bypass (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "SSL forward Proxy Bypass (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"ssl_sign_hash": {
@@ -488,20 +529,24 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 			},
 
 			"strict_resume": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Strict Resume (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Strict Resume (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"unclean_shutdown": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Unclean Shutdown (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Unclean Shutdown (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 		},
 	}
+	r.StateUpgraders = profileFullPathStateUpgraders(r.Schema)
+	return r
 }
 
 func resourceBigipLtmProfileClientSSLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -509,6 +554,9 @@ func resourceBigipLtmProfileClientSSLCreate(ctx context.Context, d *schema.Resou
 	name := d.Get("name").(string)
 	log.Printf("[INFO] Creating Client Ssl Profile:%+v ", name)
 
+	if err := validateClientSslOcspStapling(d, name); err != nil {
+		return diag.FromErr(err)
+	}
 	pss := &bigip.ClientSSLProfile{
 		Name: name,
 	}
@@ -519,6 +567,9 @@ func resourceBigipLtmProfileClientSSLCreate(ctx context.Context, d *schema.Resou
 		log.Printf("[ERROR] Unable to Create Client Ssl Profile (%s) (%v)", name, err)
 		return diag.FromErr(err)
 	}
+	if err := setClientSslOcspStaplingParams(d, client, name); err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId(name)
 
@@ -542,7 +593,10 @@ func resourceBigipLtmProfileClientSSLCreate(ctx context.Context, d *schema.Resou
 		}
 	}
 
-	return resourceBigipLtmProfileClientSSLRead(ctx, d, meta)
+	if err := setSslProfileData0rtt(d, client, "ltm/profile/client-ssl", name); err != nil {
+		return diag.FromErr(err)
+	}
+	return append(sslTls13CipherGroupWarning(d, name), resourceBigipLtmProfileClientSSLRead(ctx, d, meta)...)
 }
 
 func resourceBigipLtmProfileClientSSLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -551,6 +605,9 @@ func resourceBigipLtmProfileClientSSLUpdate(ctx context.Context, d *schema.Resou
 
 	log.Printf("[INFO] Updating Clientssl Profile : %v", name)
 
+	if err := validateClientSslOcspStapling(d, name); err != nil {
+		return diag.FromErr(err)
+	}
 	pss := &bigip.ClientSSLProfile{
 		Name: name,
 	}
@@ -559,7 +616,16 @@ func resourceBigipLtmProfileClientSSLUpdate(ctx context.Context, d *schema.Resou
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error create profile Ssl (%s): %s", name, err))
 	}
-	return resourceBigipLtmProfileClientSSLRead(ctx, d, meta)
+	if err := setClientSslPeerCertAuth(d, client, name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setClientSslOcspStaplingParams(d, client, name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setSslProfileData0rtt(d, client, "ltm/profile/client-ssl", name); err != nil {
+		return diag.FromErr(err)
+	}
+	return append(sslTls13CipherGroupWarning(d, name), resourceBigipLtmProfileClientSSLRead(ctx, d, meta)...)
 }
 
 func resourceBigipLtmProfileClientSSLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -580,8 +646,13 @@ func resourceBigipLtmProfileClientSSLRead(ctx context.Context, d *schema.Resourc
 		return nil
 	}
 
+	if obj.FullPath != "" && obj.FullPath != name {
+		d.SetId(obj.FullPath)
+		name = obj.FullPath
+	}
 	_ = d.Set("name", name)
 	_ = d.Set("partition", obj.Partition)
+	_ = d.Set("full_path", obj.FullPath)
 	_ = d.Set("defaults_from", obj.DefaultsFrom)
 	if _, ok := d.GetOk("alert_timeout"); ok {
 		_ = d.Set("alert_timeout", obj.AlertTimeout)
@@ -628,6 +699,12 @@ func resourceBigipLtmProfileClientSSLRead(ctx context.Context, d *schema.Resourc
 		certMapList = append(certMapList, certMap)
 	}
 	log.Printf("certMapList:%+v", certMapList)
+	if err := readClientSslOcspStaplingParams(d, client, name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := readSslProfileData0rtt(d, client, "ltm/profile/client-ssl", name); err != nil {
+		return diag.FromErr(err)
+	}
 
 	if _, ok := d.GetOk("cert_extension_includes"); ok {
 		_ = d.Set("cert_extension_includes", obj.CertExtensionIncludes)
@@ -644,15 +721,14 @@ func resourceBigipLtmProfileClientSSLRead(ctx context.Context, d *schema.Resourc
 	if _, ok := d.GetOk("key"); ok {
 		_ = d.Set("key", obj.Key)
 	}
-	if _, ok := d.GetOk("ciphers"); ok {
-		_ = d.Set("ciphers", obj.Ciphers)
-	}
-	if _, ok := d.GetOk("cipher_group"); ok {
-		_ = d.Set("cipher_group", obj.CipherGroup)
-	}
+	_ = d.Set("ciphers", obj.Ciphers)
+	_ = d.Set("cipher_group", obj.CipherGroup)
 	if _, ok := d.GetOk("client_cert_ca"); ok {
 		_ = d.Set("client_cert_ca", obj.ClientCertCa)
 	}
+	if _, ok := d.GetOk("advertised_cert_authorities"); ok {
+		_ = d.Set("advertised_cert_authorities", obj.ClientCertCa)
+	}
 
 	if _, ok := d.GetOk("crl_file"); ok {
 		_ = d.Set("crl_file", obj.CrlFile)
@@ -717,7 +793,7 @@ func resourceBigipLtmProfileClientSSLRead(ctx context.Context, d *schema.Resourc
 	}
 
 	if _, ok := d.GetOk("proxy_ca_passphrase"); ok {
-		_ = d.Set("proxy_ca_passphrase", obj.ProxyCaPassphrase)
+		setSecret(d, "proxy_ca_passphrase", obj.ProxyCaPassphrase)
 	}
 
 	if _, ok := d.GetOk("proxy_ssl"); ok {
@@ -798,9 +874,16 @@ func resourceBigipLtmProfileClientSSLDelete(ctx context.Context, d *schema.Resou
 	client := meta.(*bigip.BigIP)
 
 	name := d.Id()
+	referrers, err := profileReferrers(client, "ltm/profile/client-ssl", name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(referrers) > 0 {
+		return referencedObjectError("client SSL profile", name, referrers, false)
+	}
 	log.Println("[INFO] Deleting Ssl Client Profile " + name)
 
-	err := client.DeleteClientSSLProfile(name)
+	err = client.DeleteClientSSLProfile(name)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Delete Ssl Profile (%s) (%v)", name, err)
 		return diag.FromErr(err)
@@ -897,6 +980,9 @@ func getClientSslConfig(d *schema.ResourceData, config *bigip.ClientSSLProfile)
 		config.Ciphers = "none"
 	}
 	config.ClientCertCa = d.Get("client_cert_ca").(string)
+	if advertised, ok := d.GetOk("advertised_cert_authorities"); ok {
+		config.ClientCertCa = advertised.(string)
+	}
 	config.CrlFile = d.Get("crl_file").(string)
 	config.AllowExpiredCrl = d.Get("allow_expired_crl").(string)
 	config.ForwardProxyBypassDefaultAction = d.Get("forward_proxy_bypass_default_action").(string)
@@ -937,3 +1023,117 @@ func getClientSslConfig(d *schema.ResourceData, config *bigip.ClientSSLProfile)
 	}
 	return config
 }
+
+// setClientSslOcspStaplingParams attaches OCSP stapling parameter profiles to the cert-key-chain entries,
+// which the go-bigip ClientSSLProfile model cannot carry.
+func setClientSslOcspStaplingParams(d *schema.ResourceData, client *bigip.BigIP, name string) error {
+	type ocspCertKeyChain struct {
+		Name               string `json:"name,omitempty"`
+		Cert               string `json:"cert,omitempty"`
+		Chain              string `json:"chain,omitempty"`
+		Key                string `json:"key,omitempty"`
+		Passphrase         string `json:"passphrase,omitempty"`
+		OcspStaplingParams string `json:"ocspStaplingParams,omitempty"`
+	}
+	var chains []ocspCertKeyChain
+	hasOcsp := false
+	for i := 0; i < d.Get("cert_key_chain.#").(int); i++ {
+		prefix := fmt.Sprintf("cert_key_chain.%d", i)
+		chain := ocspCertKeyChain{
+			Name:               d.Get(prefix + ".name").(string),
+			Cert:               d.Get(prefix + ".cert").(string),
+			Chain:              d.Get(prefix + ".chain").(string),
+			Key:                d.Get(prefix + ".key").(string),
+			Passphrase:         d.Get(prefix + ".passphrase").(string),
+			OcspStaplingParams: d.Get(prefix + ".ocsp_stapling_params").(string),
+		}
+		if chain.OcspStaplingParams != "" {
+			hasOcsp = true
+		} else if !d.IsNewResource() && d.HasChange(prefix+".ocsp_stapling_params") {
+			// detach a previously configured profile
+			chain.OcspStaplingParams = "none"
+			hasOcsp = true
+		}
+		chains = append(chains, chain)
+	}
+	if !hasOcsp {
+		return nil
+	}
+	body := map[string]interface{}{
+		"certKeyChain": chains,
+	}
+	if err := patchRestEntity(client, body, restObjectPath("ltm/profile/client-ssl", name)); err != nil {
+		return fmt.Errorf("error attaching OCSP stapling params to client-ssl profile (%s): %s", name, err)
+	}
+	return nil
+}
+
+// setClientSslPeerCertAuth sends peer_cert_mode, ca_file and authenticate_depth together whenever one
+// of them changes. The device validates them as a whole, and a partial update of peerCertMode does not
+// take effect without the caFile it relies on.
+func setClientSslPeerCertAuth(d *schema.ResourceData, client *bigip.BigIP, name string) error {
+	if !d.HasChanges("peer_cert_mode", "ca_file", "authenticate_depth") {
+		return nil
+	}
+	caFile := d.Get("ca_file").(string)
+	if caFile == "" {
+		caFile = "none"
+	}
+	body := map[string]interface{}{
+		"peerCertMode":      d.Get("peer_cert_mode").(string),
+		"caFile":            caFile,
+		"authenticateDepth": d.Get("authenticate_depth").(int),
+	}
+	if err := patchRestEntity(client, body, restObjectPath("ltm/profile/client-ssl", name)); err != nil {
+		return fmt.Errorf("error updating client certificate authentication of client-ssl profile (%s): %s", name, err)
+	}
+	return nil
+}
+
+// validateClientSslOcspStapling rejects ocsp_stapling_params without ocsp_stapling enabled before
+// anything is sent to the device, so a misconfiguration cannot leave an untracked profile behind.
+func validateClientSslOcspStapling(d *schema.ResourceData, name string) error {
+	for i := 0; i < d.Get("cert_key_chain.#").(int); i++ {
+		if d.Get(fmt.Sprintf("cert_key_chain.%d.ocsp_stapling_params", i)).(string) == "" {
+			continue
+		}
+		if d.Get("ocsp_stapling").(string) != "enabled" {
+			return fmt.Errorf("ocsp_stapling must be enabled on client-ssl profile (%s) to use ocsp_stapling_params", name)
+		}
+	}
+	return nil
+}
+
+// readClientSslOcspStaplingParams refreshes ocsp_stapling_params of the configured cert_key_chain
+// entries from the device. The other cert_key_chain attributes are left as they are in state.
+func readClientSslOcspStaplingParams(d *schema.ResourceData, client *bigip.BigIP, name string) error {
+	chains, ok := d.Get("cert_key_chain").([]interface{})
+	if !ok || len(chains) == 0 {
+		return nil
+	}
+	profile := &struct {
+		CertKeyChain []struct {
+			Name               string `json:"name"`
+			OcspStaplingParams string `json:"ocspStaplingParams"`
+		} `json:"certKeyChain"`
+	}{}
+	if _, err := getRestEntity(client, profile, restObjectPath("ltm/profile/client-ssl", name)); err != nil {
+		return fmt.Errorf("error reading cert-key-chain of client-ssl profile (%s): %s", name, err)
+	}
+	for i, c := range chains {
+		chain, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		chain["ocsp_stapling_params"] = ""
+		for j, remote := range profile.CertKeyChain {
+			if remote.Name == chain["name"].(string) || (chain["name"].(string) == "" && i == j) {
+				if remote.OcspStaplingParams != "none" {
+					chain["ocsp_stapling_params"] = remote.OcspStaplingParams
+				}
+				break
+			}
+		}
+	}
+	return d.Set("cert_key_chain", chains)
+}
@@ -160,6 +160,39 @@ func TestAccBigipLtmProfileClientSsl_UpdateAuthenticateDepth(t *testing.T) {
 }
 
 // This TC is added based on ref: https://github.com/F5Networks/terraform-provider-bigip/issues/213
+func TestAccBigipLtmProfileClientSsl_UpdatePeerCertMode(t *testing.T) {
+	t.Parallel()
+	var instName = "test-ClientSsl-UpdatePeerCertMode"
+	var instFullName = fmt.Sprintf("/%s/%s", TestPartition, instName)
+	resFullName := fmt.Sprintf("%s.%s", resName, instName)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckClientSslDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmprofileclientsslUpdateparam(instName, "peer_cert_mode_ignore"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckClientSslExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "peer_cert_mode", "ignore"),
+				),
+			},
+			{
+				Config: testaccbigipltmprofileclientsslUpdateparam(instName, "peer_cert_mode_require"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckClientSslExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "peer_cert_mode", "require"),
+					resource.TestCheckResourceAttr(resFullName, "ca_file", "/Common/ca-bundle.crt"),
+					resource.TestCheckResourceAttr(resFullName, "authenticate_depth", "5"),
+					resource.TestCheckResourceAttr(resFullName, "advertised_cert_authorities", "/Common/ca-bundle.crt"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmProfileClientSsl_UpdateTmoptions(t *testing.T) {
 	t.Parallel()
 	var instName = "test-ClientSsl-UpdateTmoptions"
@@ -464,6 +497,73 @@ func TestAccBigipLtmProfileClientSsl_import(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmProfileClientSsl_PassphrasesNoDiff(t *testing.T) {
+	var instName = "test-ClientSsl-passphrases"
+	var instFullName = fmt.Sprintf("/%s/%s", TestPartition, instName)
+	resFullName := fmt.Sprintf("%s.%s", resName, instName)
+	config := fmt.Sprintf(`
+resource "%[1]s" "%[2]s" {
+  name                = "/Common/%[2]s"
+  defaults_from       = "/Common/clientssl"
+  cert                = "/Common/default.crt"
+  key                 = "/Common/default.key"
+  passphrase          = "test123"
+  ssl_forward_proxy   = "enabled"
+  proxy_ca_cert       = "/Common/default.crt"
+  proxy_ca_key        = "/Common/default.key"
+  proxy_ca_passphrase = "test123"
+}
+`, resName, instName)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckClientSslDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckClientSslExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "passphrase", "test123"),
+					resource.TestCheckResourceAttr(resFullName, "proxy_ca_passphrase", "test123"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileClientSsl_Tls13Only(t *testing.T) {
+	var instName = "test-ClientSsl-Tls13Only"
+	var instFullName = fmt.Sprintf("/%s/%s", TestPartition, instName)
+	resFullName := fmt.Sprintf("%s.%s", resName, instName)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckClientSslDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccbigipltmprofileclientsslTls13Only(instName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckClientSslExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "cipher_group", "/Common/test-tls13-group"),
+					resource.TestCheckResourceAttr(resFullName, "ciphers", "none"),
+					resource.TestCheckResourceAttr(resFullName, "data_0rtt", "enabled-with-anti-replay"),
+					resource.TestCheckResourceAttr(resFullName, "tm_options.#", "4"),
+					resource.TestCheckTypeSetElemAttr(resFullName, "tm_options.*", "no-tlsv1.2"),
+					resource.TestCheckResourceAttr("bigip_ltm_cipher_rule.tls13", "signature_algorithms", "DEFAULT"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckClientSslExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
@@ -557,6 +657,15 @@ func testaccbigipltmprofileclientsslUpdateparam(instName, updateParam string) st
 	case "authenticate_depth":
 		resPrefix = fmt.Sprintf(`%s
 			  authenticate_depth = 8`, resPrefix)
+	case "peer_cert_mode_ignore":
+		resPrefix = fmt.Sprintf(`%s
+			  peer_cert_mode = "ignore"`, resPrefix)
+	case "peer_cert_mode_require":
+		resPrefix = fmt.Sprintf(`%s
+			  peer_cert_mode = "require"
+			  ca_file = "/Common/ca-bundle.crt"
+			  authenticate_depth = 5
+			  advertised_cert_authorities = "/Common/ca-bundle.crt"`, resPrefix)
 	case "cache_size":
 		resPrefix = fmt.Sprintf(`%s
 			  cache_size = 262100`, resPrefix)
@@ -611,3 +720,25 @@ resource "%[2]s" "%[3]s" {
 }
 	`, partition, resName, instName)
 }
+
+func testaccbigipltmprofileclientsslTls13Only(instName string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_cipher_rule" "tls13" {
+  name                 = "/Common/test-tls13-rule"
+  cipher               = "TLS13-AES128-GCM-SHA256:TLS13-AES256-GCM-SHA384"
+  dh_groups            = "P256:P384"
+  signature_algorithms = "DEFAULT"
+}
+resource "bigip_ltm_cipher_group" "tls13" {
+  name  = "/Common/test-tls13-group"
+  allow = [bigip_ltm_cipher_rule.tls13.name]
+}
+resource "%[1]s" "%[2]s" {
+  name          = "/Common/%[2]s"
+  defaults_from = "/Common/clientssl"
+  cipher_group  = bigip_ltm_cipher_group.tls13.name
+  tm_options    = ["dont-insert-empty-fragments", "no-tlsv1", "no-tlsv1.1", "no-tlsv1.2"]
+  data_0rtt     = "enabled-with-anti-replay"
+}
+`, resName, instName)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateClientSslOcspStapling(t *testing.T) {
+	chain := []interface{}{
+		map[string]interface{}{
+			"name":                 "default",
+			"cert":                 "/Common/default.crt",
+			"key":                  "/Common/default.key",
+			"ocsp_stapling_params": "/Common/ocsp-params",
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileClientSsl().Schema, map[string]interface{}{
+		"name":           "/Common/test-clientssl",
+		"ocsp_stapling":  "disabled",
+		"cert_key_chain": chain,
+	})
+	assert.ErrorContains(t, validateClientSslOcspStapling(d, "/Common/test-clientssl"), "ocsp_stapling must be enabled")
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmProfileClientSsl().Schema, map[string]interface{}{
+		"name":           "/Common/test-clientssl",
+		"ocsp_stapling":  "enabled",
+		"cert_key_chain": chain,
+	})
+	assert.NoError(t, validateClientSslOcspStapling(d, "/Common/test-clientssl"))
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmProfileClientSsl().Schema, map[string]interface{}{
+		"name":          "/Common/test-clientssl",
+		"ocsp_stapling": "disabled",
+	})
+	assert.NoError(t, validateClientSslOcspStapling(d, "/Common/test-clientssl"))
+}
+
+func TestSetClientSslPeerCertAuth(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/ltm/profile/client-ssl/~Common~test-clientssl", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	// only peer_cert_mode is configured, caFile and authenticateDepth are still sent with it
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileClientSsl().Schema, map[string]interface{}{
+		"name":           "/Common/test-clientssl",
+		"peer_cert_mode": "require",
+	})
+	assert.NoError(t, setClientSslPeerCertAuth(d, client, "/Common/test-clientssl"))
+	assert.Equal(t, map[string]interface{}{"peerCertMode": "require", "caFile": "none", "authenticateDepth": float64(0)}, body)
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmProfileClientSsl().Schema, map[string]interface{}{
+		"name":               "/Common/test-clientssl",
+		"peer_cert_mode":     "require",
+		"ca_file":            "/Common/ca-bundle.crt",
+		"authenticate_depth": 3,
+	})
+	assert.NoError(t, setClientSslPeerCertAuth(d, client, "/Common/test-clientssl"))
+	assert.Equal(t, map[string]interface{}{"peerCertMode": "require", "caFile": "/Common/ca-bundle.crt", "authenticateDepth": float64(3)}, body)
+}
+
+func TestClientSslAdvertisedCertAuthorities(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileClientSsl().Schema, map[string]interface{}{
+		"name":                        "/Common/test-clientssl",
+		"advertised_cert_authorities": "/Common/ca-bundle.crt",
+	})
+	config := getClientSslConfig(d, &bigip.ClientSSLProfile{Name: "/Common/test-clientssl"})
+	assert.Equal(t, "/Common/ca-bundle.crt", config.ClientCertCa)
+}
+
+func TestSslProfileTls13Settings(t *testing.T) {
+	warnings, errs := warnUnknownSslValue(sslTmOptions)("no-tlsv1.3", "tm_options")
+	assert.Empty(t, warnings)
+	assert.Empty(t, errs)
+	warnings, errs = warnUnknownSslValue(sslTmOptions)("no-tlsv1.4", "tm_options")
+	assert.Len(t, warnings, 1)
+	assert.Empty(t, errs)
+
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileClientSsl().Schema, map[string]interface{}{
+		"name":       "/Common/test-clientssl",
+		"tm_options": []interface{}{"dont-insert-empty-fragments", "no-tlsv1.1"},
+		"ciphers":    "DEFAULT",
+	})
+	diags := sslTls13CipherGroupWarning(d, "/Common/test-clientssl")
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmProfileClientSsl().Schema, map[string]interface{}{
+		"name":         "/Common/test-clientssl",
+		"tm_options":   []interface{}{"no-tlsv1.1"},
+		"cipher_group": "/Common/f5-default",
+	})
+	assert.Empty(t, sslTls13CipherGroupWarning(d, "/Common/test-clientssl"))
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmProfileServerSsl().Schema, map[string]interface{}{
+		"name":       "/Common/test-serverssl",
+		"tm_options": []interface{}{"no-tlsv1.3"},
+		"ciphers":    "DEFAULT",
+	})
+	assert.Empty(t, sslTls13CipherGroupWarning(d, "/Common/test-serverssl"))
+}
+
+func TestSslProfileData0rtt(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/ltm/profile/client-ssl/~Common~test-clientssl", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_, _ = fmt.Fprintf(w, `{}`)
+			return
+		}
+		assert.Equal(t, "data_0rtt", r.URL.Query().Get("$select"))
+		_, _ = fmt.Fprintf(w, `{"data_0rtt":"enabled-with-anti-replay"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileClientSsl().Schema, map[string]interface{}{
+		"name":      "/Common/test-clientssl",
+		"data_0rtt": "enabled-with-anti-replay",
+	})
+	d.MarkNewResource()
+	assert.NoError(t, setSslProfileData0rtt(d, client, "ltm/profile/client-ssl", "/Common/test-clientssl"))
+	assert.Equal(t, map[string]interface{}{"data_0rtt": "enabled-with-anti-replay"}, body)
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmProfileClientSsl().Schema, map[string]interface{}{
+		"name": "/Common/test-clientssl",
+	})
+	assert.NoError(t, readSslProfileData0rtt(d, client, "ltm/profile/client-ssl", "/Common/test-clientssl"))
+	assert.Equal(t, "enabled-with-anti-replay", d.Get("data_0rtt"))
+}
+
+// TestClientSslImportPartition imports a profile of a partition with a profile of the same name in
+// /Common: the one of the partition must be read.
+func TestClientSslImportPartition(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, partition := range []string{"Common", "Uat"} {
+		partition := partition
+		mux.HandleFunc("/mgmt/tm/ltm/profile/client-ssl/~"+partition+"~web-ssl", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"name":"web-ssl","partition":"%[1]s","fullPath":"/%[1]s/web-ssl","defaultsFrom":"/Common/clientssl-%[1]s","tmOptions":"none"}`, partition)
+		})
+	}
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	for id, partition := range map[string]string{"/Uat/web-ssl": "Uat", "web-ssl": "Common"} {
+		r := resourceBigipLtmProfileClientSsl()
+		d := r.TestResourceData()
+		d.SetId(id)
+		states, err := r.Importer.StateContext(context.Background(), d, client)
+		assert.NoError(t, err)
+		if assert.Len(t, states, 1) {
+			d = states[0]
+		}
+		assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+		assert.Equal(t, "/"+partition+"/web-ssl", d.Id(), id)
+		assert.Equal(t, "/"+partition+"/web-ssl", d.Get("name"), id)
+		assert.Equal(t, partition, d.Get("partition"), id)
+		assert.Equal(t, "/Common/clientssl-"+partition, d.Get("defaults_from"), id)
+	}
+}
@@ -21,14 +21,15 @@ import (
 )
 
 func resourceBigipLtmProfileServerSsl() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceBigipLtmProfileServerSslCreate,
 		UpdateContext: resourceBigipLtmProfileServerSslUpdate,
 		ReadContext:   resourceBigipLtmProfileServerSslRead,
 		DeleteContext: resourceBigipLtmProfileServerSslDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importProfileFullPath,
 		},
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -102,10 +103,12 @@ func resourceBigipLtmProfileServerSsl() *schema.Resource {
 			},
 
 			"c3d_ca_passphrase": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "CA Passphrase. Default",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressObfuscatedSecretDiff,
+				Description:      "CA Passphrase. Default",
 			},
 
 			"c3d_certificate_extensions": {
@@ -182,11 +185,12 @@ func resourceBigipLtmProfileServerSsl() *schema.Resource {
 				Description: "Server certificate chain name.",
 			},
 			"passphrase": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "Client Certificate Constrained Delegation CA passphrase",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressObfuscatedSecretDiff,
+				Description:      "Client Certificate Constrained Delegation CA passphrase",
 			},
 
 			"ciphers": {
@@ -205,17 +209,19 @@ func resourceBigipLtmProfileServerSsl() *schema.Resource {
 			},
 
 			"expire_cert_response_control": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Response if the cert is expired (drop / ignore). ",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Response if the cert is expired (drop / ignore). ",
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 
 			"generic_alert": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Generic alerts enabled / disabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Generic alerts enabled / disabled.",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"handshake_timeout": {
@@ -226,27 +232,37 @@ func resourceBigipLtmProfileServerSsl() *schema.Resource {
 			},
 
 			"mod_ssl_methods": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "ModSSL Methods enabled / disabled.  Default is disabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "ModSSL Methods enabled / disabled.  Default is disabled.",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"mode": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "ModSSL Methods enabled / disabled.  Default is disabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "ModSSL Methods enabled / disabled.  Default is disabled.",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"tm_options": {
 				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: warnUnknownSslValue(sslTmOptions)},
 				Set:      schema.HashString,
 				Computed: true,
 				Optional: true,
 			},
 
+			"data_0rtt": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: warnUnknownSslValue(sslData0rttValues),
+				Description:  "TLS 1.3 early data (0-RTT) setting, e.g. disabled or enabled-with-anti-replay",
+			},
+
 			"proxy_ca_cert": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -268,10 +284,11 @@ func resourceBigipLtmProfileServerSsl() *schema.Resource {
 			},
 
 			"proxy_ssl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Proxy SSL enabled / disabled.  Default is disabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Proxy SSL enabled / disabled.  Default is disabled.",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"renegotiate_period": {
@@ -289,10 +306,11 @@ func resourceBigipLtmProfileServerSsl() *schema.Resource {
 			},
 
 			"renegotiation": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Renegotiation (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Renegotiation (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"retain_certificate": {
@@ -303,10 +321,11 @@ func resourceBigipLtmProfileServerSsl() *schema.Resource {
 			},
 
 			"secure_renegotiation": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Secure reneogotiaton (request / require / require-strict).",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Secure reneogotiaton (request / require / require-strict).",
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 
 			"server_name": {
@@ -317,52 +336,59 @@ func resourceBigipLtmProfileServerSsl() *schema.Resource {
 			},
 
 			"session_mirroring": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Session Mirroring (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Session Mirroring (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"session_ticket": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Session Ticket (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Session Ticket (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"sni_default": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "SNI Default (true / false)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "SNI Default (true / false)",
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 
 			"sni_require": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "SNI Require (true / false)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "SNI Require (true / false)",
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 
 			"ssl_c3d": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "disabled",
-				Description: "Client Certificate Constrained Delegation. Default disabled",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "disabled",
+				Description:      "Client Certificate Constrained Delegation. Default disabled",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"ssl_forward_proxy": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "SSL forward Proxy (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "SSL forward Proxy (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"ssl_forward_proxy_bypass": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "SSL forward Proxy Bypass (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "SSL forward Proxy Bypass (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"ssl_sign_hash": {
@@ -373,27 +399,32 @@ func resourceBigipLtmProfileServerSsl() *schema.Resource {
 			},
 
 			"strict_resume": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Strict Resume (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Strict Resume (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"unclean_shutdown": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Unclean Shutdown (enabled / disabled)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Unclean Shutdown (enabled / disabled)",
+				DiffSuppressFunc: suppressEnabledDisabledDiff,
 			},
 
 			"untrusted_cert_response_control": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Unclean Shutdown (drop / ignore)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Unclean Shutdown (drop / ignore)",
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 		},
 	}
+	r.StateUpgraders = profileFullPathStateUpgraders(r.Schema)
+	return r
 }
 
 func resourceBigipLtmProfileServerSslCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -437,7 +468,10 @@ func resourceBigipLtmProfileServerSslCreate(ctx context.Context, d *schema.Resou
 		}
 	}
 
-	return resourceBigipLtmProfileServerSslRead(ctx, d, meta)
+	if err := setSslProfileData0rtt(d, client, "ltm/profile/server-ssl", name); err != nil {
+		return diag.FromErr(err)
+	}
+	return append(sslTls13CipherGroupWarning(d, name), resourceBigipLtmProfileServerSslRead(ctx, d, meta)...)
 }
 
 func resourceBigipLtmProfileServerSslUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -456,7 +490,10 @@ func resourceBigipLtmProfileServerSslUpdate(ctx context.Context, d *schema.Resou
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error create profile Ssl (%s): %s", name, err))
 	}
-	return resourceBigipLtmProfileServerSslRead(ctx, d, meta)
+	if err := setSslProfileData0rtt(d, client, "ltm/profile/server-ssl", name); err != nil {
+		return diag.FromErr(err)
+	}
+	return append(sslTls13CipherGroupWarning(d, name), resourceBigipLtmProfileServerSslRead(ctx, d, meta)...)
 }
 
 func resourceBigipLtmProfileServerSslRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -477,8 +514,13 @@ func resourceBigipLtmProfileServerSslRead(ctx context.Context, d *schema.Resourc
 		return nil
 	}
 
+	if obj.FullPath != "" && obj.FullPath != name {
+		d.SetId(obj.FullPath)
+		name = obj.FullPath
+	}
 	_ = d.Set("name", name)
 	_ = d.Set("partition", obj.Partition)
+	_ = d.Set("full_path", obj.FullPath)
 
 	_ = d.Set("defaults_from", obj.DefaultsFrom)
 	_ = d.Set("alert_timeout", obj.AlertTimeout)
@@ -486,19 +528,15 @@ func resourceBigipLtmProfileServerSslRead(ctx context.Context, d *schema.Resourc
 	_ = d.Set("authenticate_depth", obj.AuthenticateDepth)
 	_ = d.Set("c3d_ca_cert", obj.C3dCaCert)
 	_ = d.Set("c3d_ca_key", obj.C3dCaKey)
-	_ = d.Set("c3d_ca_passphrase", obj.C3dCaPassphrase)
+	setSecret(d, "c3d_ca_passphrase", obj.C3dCaPassphrase)
 	_ = d.Set("c3d_cert_extension_custom_oids", obj.C3dCertExtensionCustomOids)
 	_ = d.Set("c3d_cert_extension_includes", obj.C3dCertExtensionIncludes)
 	_ = d.Set("c3d_cert_lifespan", obj.C3dCertLifespan)
 	_ = d.Set("ca_file", obj.CaFile)
 	_ = d.Set("cert", obj.Cert)
 	_ = d.Set("chain", obj.Chain)
-	if _, ok := d.GetOk("ciphers"); ok {
-		_ = d.Set("ciphers", obj.Ciphers)
-	}
-	if _, ok := d.GetOk("cipher_group"); ok {
-		_ = d.Set("cipher_group", obj.CipherGroup)
-	}
+	_ = d.Set("ciphers", obj.Ciphers)
+	_ = d.Set("cipher_group", obj.CipherGroup)
 	_ = d.Set("expire_cert_response_control", obj.ExpireCertResponseControl)
 	_ = d.Set("cache_size", obj.CacheSize)
 	_ = d.Set("handshake_timeout", obj.HandshakeTimeout)
@@ -531,7 +569,10 @@ func resourceBigipLtmProfileServerSslRead(ctx context.Context, d *schema.Resourc
 		_ = d.Set("tm_options", tmOptions)
 	}
 
-	_ = d.Set("passphrase", obj.Passphrase)
+	setSecret(d, "passphrase", obj.Passphrase)
+	if err := readSslProfileData0rtt(d, client, "ltm/profile/server-ssl", name); err != nil {
+		return diag.FromErr(err)
+	}
 	_ = d.Set("proxy_ssl", obj.ProxySsl)
 	_ = d.Set("peer_cert_mode", obj.PeerCertMode)
 	_ = d.Set("renegotiate_period", obj.RenegotiatePeriod)
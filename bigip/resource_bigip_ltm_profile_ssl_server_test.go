@@ -209,6 +209,46 @@ func TestAccBigipLtmProfileServerSsl_import(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmProfileServerSsl_PassphrasesNoDiff(t *testing.T) {
+	var instName = "test-ServerSsl-passphrases"
+	var instFullName = fmt.Sprintf("/%s/%s", TestPartition, instName)
+	resFullName := fmt.Sprintf("%s.%s", resNameserver, instName)
+	config := fmt.Sprintf(`
+resource "%[1]s" "%[2]s" {
+  name              = "/Common/%[2]s"
+  defaults_from     = "/Common/serverssl"
+  cert              = "/Common/default.crt"
+  key               = "/Common/default.key"
+  passphrase        = "test123"
+  ssl_c3d           = "enabled"
+  c3d_ca_cert       = "/Common/default.crt"
+  c3d_ca_key        = "/Common/default.key"
+  c3d_ca_passphrase = "test123"
+}
+`, resNameserver, instName)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckServerSslDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckServerSslExists(instFullName),
+					resource.TestCheckResourceAttr(resFullName, "passphrase", "test123"),
+					resource.TestCheckResourceAttr(resFullName, "c3d_ca_passphrase", "test123"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testCheckServerSslExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
@@ -7,292 +7,198 @@ If a copy of the MPL was not distributed with this file,You can obtain one at ht
 package bigip
 
 import (
-	"context"
+	"encoding/json"
 	"fmt"
-	"log"
+	"strconv"
 
 	bigip "github.com/f5devcentral/go-bigip"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func resourceBigipLtmProfileTcp() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceBigipLtmProfileTcpCreate,
-		UpdateContext: resourceBigipLtmProfileTcpUpdate,
-		ReadContext:   resourceBigipLtmProfileTcpRead,
-		DeleteContext: resourceBigipLtmProfileTcpDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validateF5NameWithDirectory,
-				Description:  "Name of the TCP Profile",
-			},
-			"partition": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "name of partition",
-			},
-			"defaults_from": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateF5Name,
-				Description:  "Use the parent tcp profile",
-			},
-			"idle_timeout": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Number of seconds (default 300; may not be 0) connection may remain idle before it becomes eligible for deletion. Value -1 (not recommended) means infinite",
-			},
-			"close_wait_timeout": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Number of seconds (default 5) connection will remain in LAST-ACK state before exiting. Value -1 means indefinite, limited by maximum retransmission timeout",
-			},
-			"finwait_2timeout": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Number of seconds (default 300) connection will remain in LAST-ACK state before closing. Value -1 means indefinite, limited by maximum retransmission timeout",
-			},
-			"finwait_timeout": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Number of seconds (default 5) connection will remain in FIN-WAIT-1 or closing state before exiting. Value -1 means indefinite, limited by maximum retransmission timeout",
-			},
-			"keepalive_interval": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Number of seconds (default 1800) between keep-alive probes",
-			},
-			"congestion_control": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Specifies the algorithm to use to share network resources among competing users to reduce congestion. The default is High Speed.",
-				ValidateFunc: validation.StringInSlice([]string{"none", "high-speed", "bbr", "cdg"}, false),
-			},
-			"initial_congestion_windowsize": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Specifies the initial congestion window size for connections to this destination. Actual window size is this value multiplied by the MSS (Maximum Segment Size) for the same connection. The default is 10. Valid values range from 0 to 64",
-			},
-			"delayed_acks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Specifies, when checked (enabled), that the system can send fewer than one ACK (acknowledgment) segment per data segment received. By default, this setting is enabled",
-				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
-			},
-			"nagle": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Specifies whether the system applies Nagle's algorithm to reduce the number of short segments on the network.If you select Auto, the system determines whether to use Nagle's algorithm based on network conditions. By default, this setting is disabled.",
-				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled", "auto"}, false),
-			},
-			"early_retransmit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Enabling this setting allows TCP to assume a packet is lost after fewer than the standard number of duplicate ACKs, if there is no way to send new data and generate more duplicate ACKs",
-				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
-			},
-			"tailloss_probe": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Enabling this setting allows TCP to send a probe segment to trigger fast recovery instead of recovering a loss via a retransmission timeout,By default, this setting is enabled",
-				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
-			},
-			"timewait_recycle": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Using this setting enabled, the system can recycle a wait-state connection immediately upon receipt of a new connection request instead of having to wait until the connection times out of the wait state. By default, this setting is enabled",
-				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
-			},
-			"proxybuffer_high": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Specifies the proxy buffer level, in bytes, at which the receive window is closed.",
-			},
-			"receive_windowsize": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Specifies the maximum advertised RECEIVE window size. This value represents the maximum number of bytes to which the RECEIVE window can scale. The default is 65535 bytes",
-			},
-			"send_buffersize": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Specifies the SEND window size. The default is 131072 bytes",
-			},
-			"zerowindow_timeout": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Specifies the timeout in milliseconds for terminating a connection with an effective zero length TCP transmit window",
-			},
-			"deferred_accept": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "If enabled, ADC will defer allocating resources to a connection until some payload data has arrived from the client (default false). This may help minimize the impact of certain DoS attacks but adds undesirable latency under normal conditions. Note: ‘deferredAccept’ is incompatible with server-speaks-first application protocols,Default : disabled",
-			},
-			"fast_open": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
-				Description:  "If enabled (default), the system can use the TCP Fast Open protocol extension to reduce latency by sending payload data with initial SYN",
-			},
-			"verified_accept": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Specifies, when checked (enabled), that the system can actually communicate with the server before establishing a client connection. To determine this, the system sends the server a SYN packet before responding to the client's SYN with a SYN-ACK. When unchecked, the system accepts the client connection before selecting a server to talk to. By default, this setting is disabled",
-				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
-			},
-		},
-	}
-}
-
-func resourceBigipLtmProfileTcpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*bigip.BigIP)
-	name := d.Get("name").(string)
-	tcpConfig := &bigip.Tcp{
-		Name: name,
-	}
-	tcpProfileConfig := getTCPProfileConfig(d, tcpConfig)
-	log.Println("[INFO] Creating TCP profile")
-	err := client.CreateTcp(tcpProfileConfig)
-	if err != nil {
-		log.Printf("[ERROR] Unable to Create tcp Profile  (%s) (%v)", name, err)
-		return diag.FromErr(err)
-	}
-	d.SetId(name)
-	return resourceBigipLtmProfileTcpRead(ctx, d, meta)
-}
+const uriProfileTcp = "ltm/profile/tcp"
 
-func resourceBigipLtmProfileTcpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*bigip.BigIP)
-	name := d.Id()
-	log.Println("[INFO] Updating TCP Profile " + name)
-	tcpConfig := &bigip.Tcp{
-		Name: name,
-	}
-	tcpProfileConfig := getTCPProfileConfig(d, tcpConfig)
-	err := client.ModifyTcp(name, tcpProfileConfig)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error create profile tcp (%s): %s", name, err))
-	}
-	return resourceBigipLtmProfileTcpRead(ctx, d, meta)
-}
-
-func resourceBigipLtmProfileTcpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*bigip.BigIP)
-	name := d.Id()
-	log.Println("[INFO] Reading TCP Profile  " + name)
-	obj, err := client.GetTcp(name)
-	if err != nil {
-		log.Printf("[ERROR] Unable to retrieve tcp Profile  (%s) (%v)", name, err)
-		return diag.FromErr(err)
-	}
-	log.Printf("[INFO] Reading TCP Object:%+v ", obj)
-	if obj == nil {
-		log.Printf("[WARN] tcp  Profile (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
-	_ = d.Set("name", name)
-	if _, ok := d.GetOk("defaults_from"); ok {
-		_ = d.Set("defaults_from", obj.DefaultsFrom)
-	}
-	if _, ok := d.GetOk("idle_timeout"); ok {
-		_ = d.Set("idle_timeout", obj.IdleTimeout)
-	}
-	if _, ok := d.GetOk("close_wait_timeout"); ok {
-		_ = d.Set("close_wait_timeout", obj.CloseWaitTimeout)
-	}
-	if _, ok := d.GetOk("finwait_2timeout"); ok {
-		_ = d.Set("finwait_2timeout", obj.FinWait_2Timeout)
-	}
-	if _, ok := d.GetOk("finwait_timeout"); ok {
-		_ = d.Set("finwait_timeout", obj.FinWaitTimeout)
-	}
-	if _, ok := d.GetOk("congestion_control"); ok {
-		_ = d.Set("congestion_control", obj.CongestionControl)
-	}
-	if _, ok := d.GetOk("delayed_acks"); ok {
-		_ = d.Set("delayed_acks", obj.DelayedAcks)
-	}
-	if _, ok := d.GetOk("nagle"); ok {
-		_ = d.Set("nagle", obj.Nagle)
-	}
-	if _, ok := d.GetOk("early_retransmit"); ok {
-		_ = d.Set("early_retransmit", obj.EarlyRetransmit)
-	}
-	if _, ok := d.GetOk("tailloss_probe"); ok {
-		_ = d.Set("tailloss_probe", obj.TailLossProbe)
-	}
-	if _, ok := d.GetOk("initial_congestion_windowsize"); ok {
-		_ = d.Set("initial_congestion_windowsize", obj.InitCwnd)
-	}
-	if _, ok := d.GetOk("zerowindow_timeout"); ok {
-		_ = d.Set("zerowindow_timeout", obj.ZeroWindowTimeout)
-	}
-	if _, ok := d.GetOk("send_buffersize"); ok {
-		_ = d.Set("send_buffersize", obj.SendBufferSize)
-	}
-	if _, ok := d.GetOk("receive_windowsize"); ok {
-		_ = d.Set("receive_windowsize", obj.ReceiveWindowSize)
-	}
-	if _, ok := d.GetOk("proxybuffer_high"); ok {
-		_ = d.Set("proxybuffer_high", obj.ProxyBufferHigh)
-	}
-	if _, ok := d.GetOk("timewait_recycle"); ok {
-		_ = d.Set("timewait_recycle", obj.TimeWaitRecycle)
-	}
-	if _, ok := d.GetOk("verified_accept"); ok {
-		_ = d.Set("verified_accept", obj.VerifiedAccept)
-	}
-	if _, ok := d.GetOk("keepalive_interval"); ok {
-		_ = d.Set("keepalive_interval", obj.KeepAliveInterval)
-	}
-	if _, ok := d.GetOk("deferred_accept"); ok {
-		_ = d.Set("deferred_accept", obj.DeferredAccept)
-	}
-	if _, ok := d.GetOk("fast_open"); ok {
-		_ = d.Set("fast_open", obj.FastOpen)
-	}
-	return nil
+// tcpTimeouts maps the timeout attributes, which accept keywords and so are not modelled by
+// bigip.Tcp, to their JSON keys. The first key is the one sent; the others are names used by
+// some TMOS versions and are also checked on read.
+var tcpTimeouts = map[string][]string{
+	"idle_timeout":       {"idleTimeout"},
+	"close_wait_timeout": {"closeWaitTimeout", "closeWait"},
+	"finwait_timeout":    {"finWaitTimeout", "finWait"},
+	"finwait_2timeout":   {"finWait_2Timeout", "finWait2Timeout", "finWait_2"},
 }
 
-func resourceBigipLtmProfileTcpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*bigip.BigIP)
-
-	name := d.Id()
-	log.Println("[INFO] Deleting Tcp Profile " + name)
-
-	err := client.DeleteTcp(name)
-	if err != nil {
-		log.Printf("[ERROR] Unable to Delete tcp Profile (%s) (%v)", name, err)
-		return diag.FromErr(err)
+func resourceBigipLtmProfileTcp() *schema.Resource {
+	p := &ltmProfile[tcpProfile]{
+		resourceType:         "bigip_ltm_profile_tcp",
+		label:                "TCP profile",
+		uri:                  uriProfileTcp,
+		additionalAttributes: true,
+		expand: func(d *schema.ResourceData, name string) (interface{}, error) {
+			return getTCPProfilePayload(d, getTCPProfileConfig(d, &bigip.Tcp{Name: name}))
+		},
+		flatten: flattenTCPProfile,
 	}
-	d.SetId("")
-	return nil
+	return p.resource(map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateF5NameWithDirectory,
+			Description:  "Name of the TCP Profile",
+		},
+		"partition": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "name of partition",
+		},
+		"defaults_from": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateF5Name,
+			Description:  "Use the parent tcp profile",
+		},
+		"idle_timeout": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Number of seconds (default 300; may not be 0) connection may remain idle before it becomes eligible for deletion, or one of the keywords immediate and indefinite (not recommended)",
+			ValidateFunc: validateTcpTimeout,
+		},
+		"close_wait_timeout": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Number of seconds (default 5) connection will remain in LAST-ACK state before exiting, or one of the keywords immediate and indefinite. Indefinite is limited by maximum retransmission timeout",
+			ValidateFunc: validateTcpTimeout,
+		},
+		"finwait_2timeout": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Number of seconds (default 300) connection will remain in FIN-WAIT-2 state before closing, or one of the keywords immediate and indefinite. Indefinite is limited by maximum retransmission timeout",
+			ValidateFunc: validateTcpTimeout,
+		},
+		"finwait_timeout": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Number of seconds (default 5) connection will remain in FIN-WAIT-1 or closing state before exiting, or one of the keywords immediate and indefinite. Indefinite is limited by maximum retransmission timeout",
+			ValidateFunc: validateTcpTimeout,
+		},
+		"keepalive_interval": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Number of seconds (default 1800) between keep-alive probes",
+		},
+		"congestion_control": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Specifies the algorithm to use to share network resources among competing users to reduce congestion. The default is High Speed.",
+			ValidateFunc: validation.StringInSlice([]string{"none", "high-speed", "bbr", "cdg"}, false),
+		},
+		"initial_congestion_windowsize": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the initial congestion window size for connections to this destination. Actual window size is this value multiplied by the MSS (Maximum Segment Size) for the same connection. The default is 10. Valid values range from 0 to 64",
+		},
+		"delayed_acks": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "Specifies, when checked (enabled), that the system can send fewer than one ACK (acknowledgment) segment per data segment received. By default, this setting is enabled",
+			ValidateFunc:     validation.StringInSlice([]string{"disabled", "enabled"}, false),
+			DiffSuppressFunc: suppressEnabledDisabledDiff,
+		},
+		"nagle": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "Specifies whether the system applies Nagle's algorithm to reduce the number of short segments on the network.If you select Auto, the system determines whether to use Nagle's algorithm based on network conditions. By default, this setting is disabled.",
+			ValidateFunc:     validation.StringInSlice([]string{"disabled", "enabled", "auto"}, false),
+			DiffSuppressFunc: suppressEnabledDisabledDiff,
+		},
+		"early_retransmit": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "Enabling this setting allows TCP to assume a packet is lost after fewer than the standard number of duplicate ACKs, if there is no way to send new data and generate more duplicate ACKs",
+			ValidateFunc:     validation.StringInSlice([]string{"disabled", "enabled"}, false),
+			DiffSuppressFunc: suppressEnabledDisabledDiff,
+		},
+		"tailloss_probe": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "Enabling this setting allows TCP to send a probe segment to trigger fast recovery instead of recovering a loss via a retransmission timeout,By default, this setting is enabled",
+			ValidateFunc:     validation.StringInSlice([]string{"disabled", "enabled"}, false),
+			DiffSuppressFunc: suppressEnabledDisabledDiff,
+		},
+		"timewait_recycle": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "Using this setting enabled, the system can recycle a wait-state connection immediately upon receipt of a new connection request instead of having to wait until the connection times out of the wait state. By default, this setting is enabled",
+			ValidateFunc:     validation.StringInSlice([]string{"disabled", "enabled"}, false),
+			DiffSuppressFunc: suppressEnabledDisabledDiff,
+		},
+		"proxybuffer_high": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the proxy buffer level, in bytes, at which the receive window is closed.",
+		},
+		"receive_windowsize": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the maximum advertised RECEIVE window size. This value represents the maximum number of bytes to which the RECEIVE window can scale. The default is 65535 bytes",
+		},
+		"send_buffersize": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the SEND window size. The default is 131072 bytes",
+		},
+		"zerowindow_timeout": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the timeout in milliseconds for terminating a connection with an effective zero length TCP transmit window",
+		},
+		"deferred_accept": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "If enabled, ADC will defer allocating resources to a connection until some payload data has arrived from the client (default false). This may help minimize the impact of certain DoS attacks but adds undesirable latency under normal conditions. Note: ‘deferredAccept’ is incompatible with server-speaks-first application protocols,Default : disabled",
+		},
+		"fast_open": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringInSlice([]string{"disabled", "enabled"}, false),
+			Description:      "If enabled (default), the system can use the TCP Fast Open protocol extension to reduce latency by sending payload data with initial SYN",
+			DiffSuppressFunc: suppressEnabledDisabledDiff,
+		},
+		"verified_accept": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "Specifies, when checked (enabled), that the system can actually communicate with the server before establishing a client connection. To determine this, the system sends the server a SYN packet before responding to the client's SYN with a SYN-ACK. When unchecked, the system accepts the client connection before selecting a server to talk to. By default, this setting is disabled",
+			ValidateFunc:     validation.StringInSlice([]string{"disabled", "enabled"}, false),
+			DiffSuppressFunc: suppressEnabledDisabledDiff,
+		},
+	})
 }
 
 func getTCPProfileConfig(d *schema.ResourceData, config *bigip.Tcp) *bigip.Tcp {
 	config.Partition = d.Get("partition").(string)
 	config.DefaultsFrom = d.Get("defaults_from").(string)
-	config.IdleTimeout = d.Get("idle_timeout").(int)
-	config.CloseWaitTimeout = d.Get("close_wait_timeout").(int)
-	config.FinWait_2Timeout = d.Get("finwait_2timeout").(int)
-	config.FinWaitTimeout = d.Get("finwait_timeout").(int)
 	config.SendBufferSize = d.Get("send_buffersize").(int)
 	config.ReceiveWindowSize = d.Get("receive_windowsize").(int)
 	config.ProxyBufferHigh = d.Get("proxybuffer_high").(int)
@@ -310,3 +216,110 @@ func getTCPProfileConfig(d *schema.ResourceData, config *bigip.Tcp) *bigip.Tcp {
 	config.FastOpen = d.Get("fast_open").(string)
 	return config
 }
+
+// getTCPProfilePayload adds the configured timeouts to the payload of config. Numeric values are
+// sent as integers and keywords as strings.
+func getTCPProfilePayload(d *schema.ResourceData, config *bigip.Tcp) (map[string]interface{}, error) {
+	body, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	for attr, keys := range tcpTimeouts {
+		value := d.Get(attr).(string)
+		if value == "" {
+			continue
+		}
+		if n, err := strconv.Atoi(value); err == nil {
+			payload[keys[0]] = n
+		} else {
+			payload[keys[0]] = value
+		}
+	}
+	return payload, nil
+}
+
+// tcpProfile is the TCP profile as read. The timeouts, which the device may return as a keyword,
+// are kept apart from bigip.Tcp, normalized to strings and keyed by attribute.
+type tcpProfile struct {
+	bigip.Tcp
+	timeouts map[string]string
+}
+
+func (p *tcpProfile) UnmarshalJSON(b []byte) error {
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	p.timeouts = make(map[string]string)
+	for attr, keys := range tcpTimeouts {
+		for _, key := range keys {
+			if value, ok := raw[key]; ok {
+				p.timeouts[attr] = normalizeTcpTimeout(value)
+				break
+			}
+		}
+		for _, key := range keys {
+			delete(raw, key)
+		}
+	}
+	body, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, &p.Tcp)
+}
+
+func flattenTCPProfile(_ *schema.ResourceData, obj *tcpProfile) map[string]interface{} {
+	values := map[string]interface{}{
+		"partition":                     obj.Partition,
+		"defaults_from":                 obj.DefaultsFrom,
+		"congestion_control":            obj.CongestionControl,
+		"delayed_acks":                  obj.DelayedAcks,
+		"nagle":                         obj.Nagle,
+		"early_retransmit":              obj.EarlyRetransmit,
+		"tailloss_probe":                obj.TailLossProbe,
+		"initial_congestion_windowsize": obj.InitCwnd,
+		"zerowindow_timeout":            obj.ZeroWindowTimeout,
+		"send_buffersize":               obj.SendBufferSize,
+		"receive_windowsize":            obj.ReceiveWindowSize,
+		"proxybuffer_high":              obj.ProxyBufferHigh,
+		"timewait_recycle":              obj.TimeWaitRecycle,
+		"verified_accept":               obj.VerifiedAccept,
+		"keepalive_interval":            obj.KeepAliveInterval,
+		"deferred_accept":               obj.DeferredAccept,
+		"fast_open":                     obj.FastOpen,
+	}
+	for attr, value := range obj.timeouts {
+		values[attr] = value
+	}
+	return values
+}
+
+// normalizeTcpTimeout returns the string form of a timeout returned either as a number or a keyword.
+func normalizeTcpTimeout(value json.RawMessage) string {
+	var s string
+	if json.Unmarshal(value, &s) == nil {
+		return s
+	}
+	var n json.Number
+	if json.Unmarshal(value, &n) == nil {
+		return n.String()
+	}
+	return string(value)
+}
+
+// validateTcpTimeout accepts a number of seconds or one of the timeout keywords.
+func validateTcpTimeout(value interface{}, field string) (ws []string, errors []error) {
+	v := value.(string)
+	if v == "immediate" || v == "indefinite" {
+		return
+	}
+	if _, err := strconv.Atoi(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a number of seconds, immediate or indefinite, got %q", field, v))
+	}
+	return
+}
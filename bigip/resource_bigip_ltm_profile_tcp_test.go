@@ -180,6 +180,38 @@ func TestAccBigipLtmProfileTcp_import(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmProfileTcp_importBuiltin(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "bigip_ltm_profile_tcp" "lan" {
+  name = "/Common/tcp-lan-optimized"
+}
+`,
+				ResourceName:  "bigip_ltm_profile_tcp.lan",
+				ImportState:   true,
+				ImportStateId: "/Common/tcp-lan-optimized",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported profile, got %d", len(states))
+					}
+					for _, attr := range []string{"idle_timeout", "close_wait_timeout", "finwait_timeout", "finwait_2timeout"} {
+						if _, errs := validateTcpTimeout(states[0].Attributes[attr], attr); len(errs) > 0 {
+							return fmt.Errorf("imported %s: %v", attr, errs)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func testCheckTcpExists(name string, exists bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
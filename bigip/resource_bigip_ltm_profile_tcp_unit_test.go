@@ -0,0 +1,110 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTCPProfileTimeouts(t *testing.T) {
+	obj := &tcpProfile{}
+	err := json.Unmarshal([]byte(`{"name":"tcp-lan-optimized","defaultsFrom":"/Common/tcp","idleTimeout":"indefinite","closeWaitTimeout":5,"finWait":"immediate","finWait_2Timeout":300,"keepAliveInterval":1800}`), obj)
+	assert.NoError(t, err)
+	assert.Equal(t, "/Common/tcp", obj.DefaultsFrom)
+	assert.Equal(t, 1800, obj.KeepAliveInterval)
+	assert.Equal(t, map[string]string{
+		"idle_timeout":       "indefinite",
+		"close_wait_timeout": "5",
+		"finwait_timeout":    "immediate",
+		"finwait_2timeout":   "300",
+	}, obj.timeouts)
+}
+
+func TestGetTCPProfilePayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileTcp().Schema, map[string]interface{}{
+		"name":               "/Common/test-tcp",
+		"defaults_from":      "/Common/tcp",
+		"idle_timeout":       "indefinite",
+		"close_wait_timeout": "5",
+	})
+	payload, err := getTCPProfilePayload(d, getTCPProfileConfig(d, &bigip.Tcp{Name: "/Common/test-tcp"}))
+	assert.NoError(t, err)
+	assert.Equal(t, "indefinite", payload["idleTimeout"])
+	assert.Equal(t, 5, payload["closeWaitTimeout"])
+	assert.NotContains(t, payload, "finWaitTimeout")
+	assert.Equal(t, "/Common/tcp", payload["defaultsFrom"])
+}
+
+func TestValidateTcpTimeout(t *testing.T) {
+	data := map[string]int{
+		"300":        0,
+		"-1":         0,
+		"indefinite": 0,
+		"immediate":  0,
+		"forever":    1,
+		"5s":         1,
+	}
+	for v, ec := range data {
+		_, errs := validateTcpTimeout(v, "idle_timeout")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", v, ec)
+	}
+}
+
+// TestTCPProfileImportPlan checks an imported profile has every setting in state, and that leaving
+// them out of the configuration plans no change.
+func TestTCPProfileImportPlan(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/ltm/profile/tcp/~Common~test-tcp", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"test-tcp","partition":"Common","fullPath":"/Common/test-tcp","defaultsFrom":"/Common/tcp",
+			"congestionControl":"high-speed","initCwnd":10,"delayedAcks":"enabled","nagle":"disabled","earlyRetransmit":"enabled",
+			"tailLossProbe":"enabled","timeWaitRecycle":"enabled","proxyBufferHigh":49152,"receiveWindowSize":65535,
+			"sendBufferSize":131072,"zeroWindowTimeout":20000,"verifiedAccept":"disabled","idleTimeout":300}`)
+	})
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	r := resourceBigipLtmProfileTcp()
+	d := r.TestResourceData()
+	d.SetId("/Common/test-tcp")
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	for attr, value := range map[string]interface{}{
+		"partition":                     "Common",
+		"congestion_control":            "high-speed",
+		"initial_congestion_windowsize": 10,
+		"delayed_acks":                  "enabled",
+		"nagle":                         "disabled",
+		"early_retransmit":              "enabled",
+		"tailloss_probe":                "enabled",
+		"timewait_recycle":              "enabled",
+		"proxybuffer_high":              49152,
+		"receive_windowsize":            65535,
+		"send_buffersize":               131072,
+		"zerowindow_timeout":            20000,
+		"verified_accept":               "disabled",
+	} {
+		assert.Equal(t, value, d.Get(attr), attr)
+	}
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "/Common/test-tcp",
+	}), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "unexpected diff: %v", diff)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriProfileXml = "ltm/profile/xml"
+
+// XmlProfile mirrors the ltm profile xml object.
+type XmlProfile struct {
+	Name                 string                `json:"name,omitempty"`
+	Partition            string                `json:"partition,omitempty"`
+	FullPath             string                `json:"fullPath,omitempty"`
+	DefaultsFrom         string                `json:"defaultsFrom,omitempty"`
+	Description          string                `json:"description,omitempty"`
+	MultipleQueryMatches string                `json:"multipleQueryMatches,omitempty"`
+	NamespaceMappings    []XmlNamespaceMapping `json:"namespaceMappings"`
+	XpathQueries         []string              `json:"xpathQueries"`
+}
+
+// XmlNamespaceMapping maps a prefix used in the xpath queries to an XML namespace.
+type XmlNamespaceMapping struct {
+	Prefix    string `json:"mappingPrefix"`
+	Namespace string `json:"mappingNamespace"`
+}
+
+func resourceBigipLtmProfileXml() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileXmlCreate,
+		ReadContext:   resourceBigipLtmProfileXmlRead,
+		UpdateContext: resourceBigipLtmProfileXmlUpdate,
+		DeleteContext: resourceBigipLtmProfileXmlDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the XML profile, in full path format e.g. /Common/my-xml",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the profile that you want to use as the parent profile",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined description",
+			},
+			"namespace_mappings": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Namespace prefixes used in the xpath queries",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Prefix used in the xpath queries",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "XML namespace the prefix maps to, e.g. http://schemas.xmlsoap.org/soap/envelope/",
+						},
+					},
+				},
+			},
+			"xpath_queries": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "XPath queries evaluated against the XML content, referenced from LTM policy xml_content conditions through tm_name",
+			},
+			"multiple_query_matches": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Specifies whether a query keeps matching after its first match",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileXmlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_xml", name, "create", icontrolURI(uriProfileXml, name))
+
+	config := getXmlProfileConfig(d, &XmlProfile{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriProfileXml)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating XML profile (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileXmlRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileXmlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_xml", name, "read", icontrolURI(uriProfileXml, name))
+
+	obj := &XmlProfile{}
+	found, err := getRestEntity(client, obj, restObjectPath(uriProfileXml, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "XML Profile not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("multiple_query_matches", obj.MultipleQueryMatches)
+	_ = d.Set("xpath_queries", obj.XpathQueries)
+
+	var mappings []interface{}
+	for _, m := range obj.NamespaceMappings {
+		mappings = append(mappings, map[string]interface{}{
+			"prefix":    m.Prefix,
+			"namespace": m.Namespace,
+		})
+	}
+	_ = d.Set("namespace_mappings", mappings)
+	return nil
+}
+
+func resourceBigipLtmProfileXmlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_xml", name, "update", icontrolURI(uriProfileXml, name))
+
+	config := getXmlProfileConfig(d, &XmlProfile{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriProfileXml, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying XML profile (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileXmlRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileXmlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_profile_xml", name, "delete", icontrolURI(uriProfileXml, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriProfileXml, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getXmlProfileConfig(d *schema.ResourceData, config *XmlProfile) *XmlProfile {
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.MultipleQueryMatches = d.Get("multiple_query_matches").(string)
+	config.XpathQueries = listToStringSlice(d.Get("xpath_queries").([]interface{}))
+	config.NamespaceMappings = []XmlNamespaceMapping{}
+	for _, m := range d.Get("namespace_mappings").([]interface{}) {
+		mapping := m.(map[string]interface{})
+		config.NamespaceMappings = append(config.NamespaceMappings, XmlNamespaceMapping{
+			Prefix:    mapping["prefix"].(string),
+			Namespace: mapping["namespace"].(string),
+		})
+	}
+	return config
+}
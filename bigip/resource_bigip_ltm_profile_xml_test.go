@@ -0,0 +1,142 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestXmlProfileName = fmt.Sprintf("/%s/test-profile-xml", TestPartition)
+
+func TestAccBigipLtmProfileXml_create(t *testing.T) {
+	resName := "bigip_ltm_profile_xml.test-xml"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckXmlProfileDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccXmlProfileConfig(TestXmlProfileName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckXmlProfileExists(TestXmlProfileName),
+					resource.TestCheckResourceAttr(resName, "name", TestXmlProfileName),
+					resource.TestCheckResourceAttr(resName, "namespace_mappings.#", "2"),
+					resource.TestCheckResourceAttr(resName, "namespace_mappings.0.prefix", "soap"),
+					resource.TestCheckResourceAttr(resName, "namespace_mappings.1.namespace", "http://example.com/orders"),
+					resource.TestCheckResourceAttr(resName, "xpath_queries.#", "2"),
+					resource.TestCheckResourceAttr(resName, "xpath_queries.0", "/soap:Envelope/soap:Body/ord:Order"),
+					resource.TestCheckResourceAttr("bigip_ltm_policy.test-xml-policy", "rule.0.condition.0.xml_content", "true"),
+					resource.TestCheckResourceAttr("bigip_ltm_policy.test-xml-policy", "rule.0.condition.0.xpath_query", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileXml_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckXmlProfileDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccXmlProfileConfig(TestXmlProfileName),
+			},
+			{
+				ResourceName:      "bigip_ltm_profile_xml.test-xml",
+				ImportStateId:     TestXmlProfileName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckXmlProfileExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &XmlProfile{}, restObjectPath(uriProfileXml, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("XML profile %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckXmlProfileDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_xml" {
+			continue
+		}
+		name := rs.Primary.ID
+		found, err := getRestEntity(client, &XmlProfile{}, restObjectPath(uriProfileXml, name))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("XML profile %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func testAccXmlProfileConfig(name string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_xml" "test-xml" {
+  name = "%s"
+  namespace_mappings {
+    prefix    = "soap"
+    namespace = "http://schemas.xmlsoap.org/soap/envelope/"
+  }
+  namespace_mappings {
+    prefix    = "ord"
+    namespace = "http://example.com/orders"
+  }
+  xpath_queries = ["/soap:Envelope/soap:Body/ord:Order", "/soap:Envelope/soap:Header"]
+}
+
+resource "bigip_ltm_pool" "test-xml-pool" {
+  name                = "/Common/test-xml-pool"
+  load_balancing_mode = "round-robin"
+}
+
+resource "bigip_ltm_policy" "test-xml-policy" {
+  name     = "/Common/test-xml-policy"
+  strategy = "first-match"
+  requires = ["http"]
+  controls = ["forwarding"]
+  rule {
+    name = "orders"
+    condition {
+      xml_content = true
+      xpath_query = true
+      tm_name     = "/soap:Envelope/soap:Body/ord:Order"
+      exists      = true
+      request     = true
+    }
+    action {
+      forward    = true
+      connection = false
+      pool       = bigip_ltm_pool.test-xml-pool.name
+    }
+  }
+  depends_on = [bigip_ltm_profile_xml.test-xml]
+}
+`, name)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriTrafficClass = "ltm/traffic-class"
+
+// TrafficClass mirrors the ltm traffic-class object.
+type TrafficClass struct {
+	Name               string `json:"name,omitempty"`
+	Partition          string `json:"partition,omitempty"`
+	FullPath           string `json:"fullPath,omitempty"`
+	Classification     string `json:"classification,omitempty"`
+	Description        string `json:"description"`
+	SourceAddress      string `json:"sourceAddress,omitempty"`
+	SourceMask         string `json:"sourceMask,omitempty"`
+	SourcePort         int    `json:"sourcePort"`
+	DestinationAddress string `json:"destinationAddress,omitempty"`
+	DestinationMask    string `json:"destinationMask,omitempty"`
+	DestinationPort    int    `json:"destinationPort"`
+	Protocol           string `json:"protocol,omitempty"`
+}
+
+func resourceBigipLtmTrafficClass() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmTrafficClassCreate,
+		ReadContext:   resourceBigipLtmTrafficClassRead,
+		UpdateContext: resourceBigipLtmTrafficClassUpdate,
+		DeleteContext: resourceBigipLtmTrafficClassDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the traffic class, in full path format e.g. /Common/voip",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"classification": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Classification string the matching traffic is tagged with, used by iRules and policy rules",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"source_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Source IP address the traffic must match",
+			},
+			"source_mask": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Netmask applied to source_address",
+			},
+			"source_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "Source port the traffic must match, 0 matches any port",
+			},
+			"destination_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Destination IP address the traffic must match",
+			},
+			"destination_mask": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Netmask applied to destination_address",
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "Destination port the traffic must match, 0 matches any port",
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "IP protocol the traffic must match, e.g. tcp, udp or any",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmTrafficClassCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_traffic_class", name, "create", icontrolURI(uriTrafficClass, name))
+
+	config := getTrafficClassConfig(d, &TrafficClass{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriTrafficClass)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating traffic class (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmTrafficClassRead(ctx, d, meta)
+}
+
+func resourceBigipLtmTrafficClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_traffic_class", name, "read", icontrolURI(uriTrafficClass, name))
+
+	tc := &TrafficClass{}
+	found, err := getRestEntity(client, tc, restObjectPath(uriTrafficClass, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "Traffic Class not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("classification", tc.Classification)
+	_ = d.Set("description", tc.Description)
+	_ = d.Set("source_address", tc.SourceAddress)
+	_ = d.Set("source_mask", tc.SourceMask)
+	_ = d.Set("source_port", tc.SourcePort)
+	_ = d.Set("destination_address", tc.DestinationAddress)
+	_ = d.Set("destination_mask", tc.DestinationMask)
+	_ = d.Set("destination_port", tc.DestinationPort)
+	_ = d.Set("protocol", tc.Protocol)
+	return nil
+}
+
+func resourceBigipLtmTrafficClassUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_traffic_class", name, "update", icontrolURI(uriTrafficClass, name))
+
+	config := getTrafficClassConfig(d, &TrafficClass{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriTrafficClass, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying traffic class (%s): %s", name, err))
+	}
+	return resourceBigipLtmTrafficClassRead(ctx, d, meta)
+}
+
+func resourceBigipLtmTrafficClassDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_traffic_class", name, "delete", icontrolURI(uriTrafficClass, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriTrafficClass, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getTrafficClassConfig(d *schema.ResourceData, config *TrafficClass) *TrafficClass {
+	config.Classification = d.Get("classification").(string)
+	config.Description = d.Get("description").(string)
+	config.SourceAddress = d.Get("source_address").(string)
+	config.SourceMask = d.Get("source_mask").(string)
+	config.SourcePort = d.Get("source_port").(int)
+	config.DestinationAddress = d.Get("destination_address").(string)
+	config.DestinationMask = d.Get("destination_mask").(string)
+	config.DestinationPort = d.Get("destination_port").(int)
+	config.Protocol = d.Get("protocol").(string)
+	return config
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestTrafficClassName = fmt.Sprintf("/%s/test-traffic-class", TestPartition)
+
+func TestAccBigipLtmTrafficClass_create(t *testing.T) {
+	resName := "bigip_ltm_traffic_class.test-tc"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTrafficClassDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficClassConfig(TestTrafficClassName, "voip"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTrafficClassExists(TestTrafficClassName),
+					resource.TestCheckResourceAttr(resName, "name", TestTrafficClassName),
+					resource.TestCheckResourceAttr(resName, "classification", "voip"),
+					resource.TestCheckResourceAttr(resName, "destination_address", "10.10.10.0"),
+					resource.TestCheckResourceAttr(resName, "destination_mask", "255.255.255.0"),
+					resource.TestCheckResourceAttr(resName, "destination_port", "5060"),
+					resource.TestCheckResourceAttr(resName, "protocol", "udp"),
+				),
+			},
+			{
+				Config: testAccTrafficClassConfig(TestTrafficClassName, "sip"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTrafficClassExists(TestTrafficClassName),
+					resource.TestCheckResourceAttr(resName, "classification", "sip"),
+				),
+			},
+			{
+				Config: testAccTrafficClassNoDescriptionConfig(TestTrafficClassName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTrafficClassExists(TestTrafficClassName),
+					resource.TestCheckResourceAttr(resName, "description", ""),
+				),
+			},
+			{
+				Config:   testAccTrafficClassNoDescriptionConfig(TestTrafficClassName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmTrafficClass_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTrafficClassDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficClassConfig(TestTrafficClassName, "voip"),
+			},
+			{
+				ResourceName:      "bigip_ltm_traffic_class.test-tc",
+				ImportStateId:     TestTrafficClassName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckTrafficClassExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &TrafficClass{}, restObjectPath(uriTrafficClass, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("traffic class %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckTrafficClassDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_traffic_class" {
+			continue
+		}
+		name := rs.Primary.ID
+		found, err := getRestEntity(client, &TrafficClass{}, restObjectPath(uriTrafficClass, name))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("traffic class %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func testAccTrafficClassConfig(name, classification string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_traffic_class" "test-tc" {
+  name                = "%s"
+  classification      = "%s"
+  description         = "voice traffic"
+  destination_address = "10.10.10.0"
+  destination_mask    = "255.255.255.0"
+  destination_port    = 5060
+  protocol            = "udp"
+}
+`, name, classification)
+}
+
+func testAccTrafficClassNoDescriptionConfig(name string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_traffic_class" "test-tc" {
+  name                = "%s"
+  classification      = "sip"
+  destination_address = "10.10.10.0"
+  destination_mask    = "255.255.255.0"
+  destination_port    = 5060
+  protocol            = "udp"
+}
+`, name)
+}
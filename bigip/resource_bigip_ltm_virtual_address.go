@@ -112,7 +112,7 @@ func resourceBigipLtmVirtualAddressRead(ctx context.Context, d *schema.ResourceD
 	log.Println("[INFO] Fetching virtual address " + name)
 
 	var va bigip.VirtualAddress
-	vas, err := client.VirtualAddresses()
+	vas, err := getRestItems[bigip.VirtualAddress](client, "ltm/virtual-address")
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve Virtual Address (%s) (%v) ", name, err)
 		return diag.FromErr(err)
@@ -122,7 +122,7 @@ func resourceBigipLtmVirtualAddressRead(ctx context.Context, d *schema.ResourceD
 		d.SetId("")
 		return nil
 	}
-	for _, va = range vas.VirtualAddresses {
+	for _, va = range vas {
 		if va.FullPath == name {
 			break
 		}
@@ -155,12 +155,12 @@ func resourceBigipLtmVirtualAddressExists(d *schema.ResourceData, meta interface
 	log.Println("[INFO] Fetching virtual address " + name)
 
 	var va *bigip.VirtualAddress
-	vas, err := client.VirtualAddresses()
+	vas, err := getRestItems[bigip.VirtualAddress](client, "ltm/virtual-address")
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve Virtual Address  (%s) (%v) ", name, err)
 		return false, err
 	}
-	for _, cand := range vas.VirtualAddresses {
+	for _, cand := range vas {
 		if cand.FullPath == name {
 			va = &cand
 			break
@@ -8,16 +8,19 @@ package bigip
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/f5devcentral/go-bigip/f5teem"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -68,6 +71,7 @@ func resourceBigipLtmVirtualServer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateVirtualServerPersistenceOverride,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -94,6 +98,12 @@ func resourceBigipLtmVirtualServer() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"app_service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The application service (iApp) the object belongs to. Read from the BIG-IP when not configured, so adopted iApp objects keep their owner",
+			},
 			"state": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -101,6 +111,14 @@ func resourceBigipLtmVirtualServer() *schema.Resource {
 				ValidateFunc: validateEnabledDisabled,
 				Description:  "Specifies whether the virtual server and its resources are available for load balancing. The default is Enabled",
 			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "standard",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"standard", "internal"}, false),
+				Description:  "Type of the virtual server, standard or internal. An internal virtual server has no destination and is reached through a request-adapt or response-adapt profile",
+			},
 			"destination": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -162,15 +180,92 @@ func resourceBigipLtmVirtualServer() *schema.Resource {
 				//Computed: true,
 			},
 			"default_persistence_profile": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"persistence_profiles"},
 			},
 			"fallback_persistence_profile": {
-				Type:        schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Fallback persistence profile",
+				RequiredWith: []string{"persistence_profiles"},
+			},
+			"persistence_override": {
+				Type:        schema.TypeList,
 				Optional:    true,
+				MaxItems:    1,
+				Description: "Per-virtual server settings of the default persistence profile. The BIG-IP does not support them, setting the block fails at plan time with the way to get the same result",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeout": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Persistence timeout for this virtual server only",
+						},
+						"mirror": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateEnabledDisabled,
+							Description:  "Persistence mirroring for this virtual server only",
+						},
+					},
+				},
+			},
+			"persistence": {
+				Type:        schema.TypeList,
 				Computed:    true,
-				Description: "Fallback persistence profile",
+				Description: "Effective configuration of the persistence profiles of the virtual server, inherited settings included",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Full path of the persistence profile",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Persistence type of the profile, e.g. cookie or source-addr",
+						},
+						"default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the profile is the default persistence profile of the virtual server",
+						},
+						"defaults_from": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Parent profile the profile inherits its settings from",
+						},
+						"timeout": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Persistence timeout of the profile",
+						},
+						"mirror": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the persistence records are mirrored to the peer device",
+						},
+						"match_across_pools": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the persistence records are shared across the pools",
+						},
+						"match_across_services": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the persistence records are shared across the services of the virtual address",
+						},
+						"match_across_virtuals": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the persistence records are shared across the virtual servers",
+						},
+					},
+				},
 			},
 			"irules": {
 				Type:     schema.TypeList,
@@ -199,10 +294,11 @@ func resourceBigipLtmVirtualServer() *schema.Resource {
 				Description: "none, automap, snat",
 			},
 			"snatpool": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Name of the snatpool to use. Requires source_address_translation to be set to 'snat'.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Name of the snatpool to use. Requires source_address_translation to be set to 'snat'.",
+				RequiredWith: []string{"source_address_translation"},
 			},
 			"ip_protocol": {
 				Type:        schema.TypeString,
@@ -226,16 +322,16 @@ func resourceBigipLtmVirtualServer() *schema.Resource {
 			"translate_address": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      "enabled",
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
-				Description:  "Specifies, when checked (enabled), that the system translates the address of the virtual server. When cleared (disabled), specifies that the system uses the address without translation. This option is useful when the system is load balancing devices that have the same IP address. The default is enabled",
+				Description:  "Specifies, when checked (enabled), that the system translates the address of the virtual server. When cleared (disabled), specifies that the system uses the address without translation. This option is useful when the system is load balancing devices that have the same IP address. When not set, the value chosen by the BIG-IP is kept",
 			},
 			"translate_port": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      "enabled",
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
-				Description:  "Specifies, when checked (enabled), that the system translates the port of the virtual server. When cleared (disabled), specifies that the system uses the port without translation. Turning off port translation for a virtual server is useful if you want to use the virtual server to load balance connections to any service. The default is enabled.",
+				Description:  "Specifies, when checked (enabled), that the system translates the port of the virtual server. When cleared (disabled), specifies that the system uses the port without translation. Turning off port translation for a virtual server is useful if you want to use the virtual server to load balance connections to any service. When not set, the value chosen by the BIG-IP is kept.",
 			},
 			"vlans_enabled": {
 				Type:        schema.TypeBool,
@@ -249,6 +345,96 @@ func resourceBigipLtmVirtualServer() *schema.Resource {
 				Computed:    true,
 				Description: "Applies the specified AFM policy to the virtual in an enforcing way,when creating a new virtual, if this parameter is not specified, the enforced is disabled.this should be in full path ex: `/Common/afm-test-policy`",
 			},
+			"bwc_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the bandwidth controller policy attached to the virtual server, in full path format e.g. `/Common/bwc-10m`",
+			},
+			"rate_class": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the rate class attached to the virtual server, in full path format e.g. `/Common/rate-1m`",
+			},
+			"auto_lasthop": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"default", "enabled", "disabled"}, false),
+				Description:  "Specifies whether return traffic is sent to the MAC address of the last hop the request came from, default uses the global setting",
+			},
+			"last_hop_pool": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Specifies the last hop pool the return traffic is sent through, in full path format e.g. `/Common/lasthop-routers`",
+			},
+			"cmp_enabled": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringInSlice([]string{"yes", "no", "enabled", "disabled"}, false),
+				DiffSuppressFunc: suppressYesNoDiff,
+				Description:      "Specifies whether the virtual server uses Clustered Multiprocessing (CMP), yes or no. enabled and disabled are accepted as yes and no",
+			},
+			"gtm_score": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Specifies the score of the virtual server GTM (DNS) can use to load balance traffic in a proportional manner",
+			},
+			"eviction_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Specifies the flow eviction policy of the virtual server, in full path format e.g. `/Common/default-eviction-policy`",
+			},
+			"asm_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Full path of the ASM (WAF) policy enforced on the virtual server, attached through an LTM policy managed by the provider",
+			},
+			"ip_intelligence_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Specifies the IP Intelligence policy attached to the virtual server, in full path format e.g. `/Common/ip-intelligence`",
+			},
+			"security_nat_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Specifies the AFM NAT policy attached to the virtual server, in full path format e.g. `/Common/outbound-nat`",
+			},
+			"connectivity_profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Specifies the APM connectivity profile attached to the virtual server, in full path format e.g. `/Common/connectivity`. Requires APM to be provisioned",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Metadata entries of the virtual server, saved with persist set so they survive a config save",
+			},
+			"fetch_status": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, refresh reads the availability of the virtual server from its stats into status",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Availability of the virtual server (available, offline, unknown...), only read when fetch_status is set",
+			},
+			"generation": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Generation number of the virtual server, increased by the BIG-IP on each change",
+			},
 		},
 	}
 }
@@ -258,7 +444,9 @@ func ltmVirtualServerAttrDefaults(d *schema.ResourceData) {
 	_, hasSource := d.GetOk("source")
 
 	// Set default mask if nil
-	if !hasMask {
+	if !hasMask && d.Get("type").(string) == "internal" {
+		_ = d.Set("mask", "0.0.0.0")
+	} else if !hasMask {
 		// looks like IPv6, lets set to /128
 		if strings.Contains(d.Get("destination").(string), ":") {
 			_ = d.Set("mask", "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff")
@@ -285,13 +473,36 @@ func resourceBigipLtmVirtualServerCreate(ctx context.Context, d *schema.Resource
 	pss := &bigip.VirtualServer{
 		Name: name,
 	}
+	if err := checkVirtualServerConnectivityProfile(d, client); err != nil {
+		return diag.FromErr(err)
+	}
+	if asmPolicy := d.Get("asm_policy").(string); asmPolicy != "" {
+		if err := setVirtualServerAsmPolicy(client, virtualServerAsmPolicyName(name), asmPolicy); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	config := getVirtualServerConfig(d, pss)
-	err := client.CreateVirtualServer(config)
+	err := createVirtualServer(d, client, config)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Create Virtual Server  (%s) (%v)", name, err)
+		if d.Get("asm_policy").(string) != "" {
+			// Do not leave the helper policy behind for a virtual server that was never created.
+			if delErr := deleteVirtualServerAsmPolicy(client, virtualServerAsmPolicyName(name)); delErr != nil {
+				tflog.Error(ctx, delErr.Error())
+			}
+		}
 		return diag.FromErr(err)
 	}
 	d.SetId(name)
+	if err := setVirtualServerAttachments(ctx, d, client, name, false); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setVirtualServerMetadata(d, client, name, false); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setLtmObjectMeta(d, client, restObjectPath("ltm/virtual", name), "description", "app_service"); err != nil {
+		return diag.FromErr(err)
+	}
 	if !client.Teem {
 		id := uuid.New()
 		uniqueID := id.String()
@@ -319,19 +530,25 @@ func resourceBigipLtmVirtualServerRead(ctx context.Context, d *schema.ResourceDa
 	name := d.Id()
 	log.Println("[INFO] Fetching virtual server " + name)
 
-	vs, err := client.GetVirtualServer(name)
+	vs := &virtualServerExpanded{}
+	found, err := getRestEntity(client, vs, restObjectPath("ltm/virtual", name)+"?expandSubcollections=true")
 	log.Printf("[DEBUG]virtual Server Details:%+v", vs)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve Virtual Server  (%s) (%v)", name, err)
 		d.SetId("")
 		return diag.FromErr(err)
 	}
-	if vs == nil {
+	if !found {
 		log.Printf("[WARN] VirtualServer (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
+	attachments := &vs.virtualServerAttachments
 	vsDest := vs.Destination
+	if attachments.Internal {
+		// an internal virtual server has no destination to parse
+		vsDest = ":0"
+	}
 	log.Printf("[DEBUG]vsDest :%+v", vsDest)
 	if vsDest != ":0" && strings.Count(vsDest, ":") >= 2 {
 		log.Printf("[DEBUG] Matched one:%+v", vsDest)
@@ -362,6 +579,7 @@ func resourceBigipLtmVirtualServerRead(ctx context.Context, d *schema.ResourceDa
 	_ = d.Set("source", vs.Source)
 	_ = d.Set("ip_protocol", vs.IPProtocol)
 	_ = d.Set("name", name)
+	_ = d.Set("generation", vs.Generation)
 	_ = d.Set("pool", vs.Pool)
 	if vs.Mask != "any" {
 		_ = d.Set("mask", vs.Mask)
@@ -382,7 +600,7 @@ func resourceBigipLtmVirtualServerRead(ctx context.Context, d *schema.ResourceDa
 
 	if strings.Count(vsDest, ":") < 2 {
 		regex := regexp.MustCompile(`:(\d+)`)
-		port := regex.FindStringSubmatch(vs.Destination)
+		port := regex.FindStringSubmatch(vsDest)
 		log.Printf("[DEBUG] Matched for port-1:%+v", port)
 		if len(port) < 2 {
 			return diag.FromErr(fmt.Errorf("Unable to extract service port from virtual server destination: %s ", vs.Destination))
@@ -409,54 +627,81 @@ func resourceBigipLtmVirtualServerRead(ctx context.Context, d *schema.ResourceDa
 	_ = d.Set("security_log_profiles", makeStringList(&vs.SecurityLogProfiles))
 	_ = d.Set("per_flow_request_access_policy", vs.PerFlowRequestAccessPolicy)
 	_ = d.Set("description", vs.Description)
-	if vs.Enabled {
-		_ = d.Set("state", "enabled")
-	} else {
+	// The BIG-IP only returns the flag that is set, either enabled or disabled.
+	if vs.Disabled {
 		_ = d.Set("state", "disabled")
+	} else {
+		_ = d.Set("state", "enabled")
 	}
 	_ = d.Set("source_address_translation", vs.SourceAddressTranslation.Type)
 
 	_ = d.Set("snatpool", vs.SourceAddressTranslation.Pool)
-	_ = d.Set("policies", vs.Policies)
+	asmHelper := virtualServerAsmPolicyName(name)
+	var policies []string
+	asmPolicy := ""
+	for _, p := range vs.PoliciesReference.Items {
+		if p.FullPath != asmHelper {
+			policies = append(policies, p.FullPath)
+			continue
+		}
+		if asmPolicy, err = getVirtualServerAsmPolicy(client, asmHelper); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	_ = d.Set("policies", policies)
+	_ = d.Set("asm_policy", asmPolicy)
 	_ = d.Set("vlans", vs.Vlans)
 	_ = d.Set("translate_address", vs.TranslateAddress)
 	_ = d.Set("translate_port", vs.TranslatePort)
 	_ = d.Set("firewall_enforced_policy", vs.FwEnforcedPolicy)
+	_ = d.Set("auto_lasthop", vs.AutoLastHop)
+	_ = d.Set("cmp_enabled", vs.CMPEnabled)
+	_ = d.Set("gtm_score", vs.GTMScore)
 
-	if len(vs.PersistenceProfiles) > 0 {
-		default_persistence := fmt.Sprintf("/%s/%s", vs.PersistenceProfiles[0].Partition, vs.PersistenceProfiles[0].Name)
-		_ = d.Set("default_persistence_profile", default_persistence)
+	_ = d.Set("bwc_policy", attachments.BwcPolicy)
+	_ = d.Set("rate_class", attachments.RateClass)
+	_ = d.Set("ip_intelligence_policy", attachments.IpIntelligencePolicy)
+	_ = d.Set("last_hop_pool", attachments.LastHopPool)
+	_ = d.Set("eviction_policy", attachments.EvictionPolicy)
+	_ = d.Set("security_nat_policy", attachments.SecurityNatPolicy.Policy)
+	_ = d.Set("app_service", attachments.AppService)
+	if attachments.Internal {
+		_ = d.Set("type", "internal")
+	} else {
+		_ = d.Set("type", "standard")
 	}
-	profileNames := schema.NewSet(schema.HashString, make([]interface{}, 0, len(vs.PersistenceProfiles)))
-	for _, profile := range vs.PersistenceProfiles {
+	metadata := make(map[string]interface{}, len(attachments.Metadata))
+	for _, m := range attachments.Metadata {
+		metadata[m.Name] = m.Value
+	}
+	_ = d.Set("metadata", metadata)
+
+	// The default persistence profile is flagged by tmDefault, it is not always the first one listed.
+	defaultPersistence := ""
+	profileNames := schema.NewSet(schema.HashString, make([]interface{}, 0, len(vs.Persist)))
+	for _, profile := range vs.Persist {
 		FullProfileName := "/" + profile.Partition + "/" + profile.Name
 		profileNames.Add(FullProfileName)
+		if defaultPersistence == "" || profile.TmDefault == "yes" {
+			defaultPersistence = FullProfileName
+		}
 	}
-	if profileNames.Len() > 0 {
-		_ = d.Set("persistence_profiles", profileNames)
+	_ = d.Set("default_persistence_profile", defaultPersistence)
+	_ = d.Set("persistence_profiles", profileNames)
+	persistence, err := getVirtualServerPersistence(client, vs.Persist)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading the persistence profiles of virtual server %s: %v", name, err))
 	}
+	_ = d.Set("persistence", persistence)
 
 	_ = d.Set("fallback_persistence_profile", vs.FallbackPersistenceProfile)
 	_ = d.Set("source_port", vs.SourcePort)
 	_ = d.Set("vlans_enabled", vs.VlansEnabled)
-	profiles, err := client.VirtualServerProfiles(name)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	if profiles != nil && len(profiles.Profiles) > 0 {
-		profileNames := schema.NewSet(schema.HashString, make([]interface{}, 0, len(profiles.Profiles)))
-		clientProfileNames := schema.NewSet(schema.HashString, make([]interface{}, 0, len(profiles.Profiles)))
-		serverProfileNames := schema.NewSet(schema.HashString, make([]interface{}, 0, len(profiles.Profiles)))
-		for _, profile := range profiles.Profiles {
-			switch profile.Context {
-			case bigip.CONTEXT_CLIENT:
-				clientProfileNames.Add(profile.FullPath)
-			case bigip.CONTEXT_SERVER:
-				serverProfileNames.Add(profile.FullPath)
-			default:
-				profileNames.Add(profile.FullPath)
-			}
-		}
+	profiles := &vs.ProfilesReference
+	connectivityProfile := ""
+	if len(profiles.Items) > 0 {
+		profileNames, clientProfileNames, serverProfileNames, connectivity := splitVirtualServerProfiles(d, profiles)
+		connectivityProfile = connectivity
 		if profileNames.Len() > 0 {
 			_ = d.Set("profiles", profileNames)
 		}
@@ -467,9 +712,71 @@ func resourceBigipLtmVirtualServerRead(ctx context.Context, d *schema.ResourceDa
 			_ = d.Set("server_profiles", serverProfileNames)
 		}
 	}
+	_ = d.Set("connectivity_profile", connectivityProfile)
+
+	status := ""
+	if d.Get("fetch_status").(bool) {
+		if status, err = getVirtualServerStatus(client, name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	_ = d.Set("status", status)
 	return nil
 }
 
+type virtualServerStats struct {
+	Entries map[string]struct {
+		NestedStats struct {
+			Entries map[string]struct {
+				Description string `json:"description"`
+			} `json:"entries"`
+		} `json:"nestedStats"`
+	} `json:"entries"`
+}
+
+// getVirtualServerStatus returns the availability state of the virtual server from its stats.
+func getVirtualServerStatus(client *bigip.BigIP, name string) (string, error) {
+	stats := &virtualServerStats{}
+	if _, err := getRestEntity(client, stats, restObjectPath("ltm/virtual", name)+"/stats"); err != nil {
+		return "", fmt.Errorf("error retrieving stats of virtual server %s: %v", name, err)
+	}
+	for _, entry := range stats.Entries {
+		if state, ok := entry.NestedStats.Entries["status.availabilityState"]; ok {
+			return state.Description, nil
+		}
+	}
+	return "unknown", nil
+}
+
+// splitVirtualServerProfiles sorts the profiles of a virtual server into profiles, client_profiles and
+// server_profiles by context, and returns the connectivity profile. A profile attached on both sides,
+// like http2 in an HTTP/2 full proxy, has the context all on the BIG-IP: it goes back to
+// client_profiles and server_profiles when it is configured in both.
+func splitVirtualServerProfiles(d *schema.ResourceData, profiles *virtualServerProfiles) (*schema.Set, *schema.Set, *schema.Set, string) {
+	profileNames := schema.NewSet(schema.HashString, make([]interface{}, 0, len(profiles.Items)))
+	clientProfileNames := schema.NewSet(schema.HashString, make([]interface{}, 0, len(profiles.Items)))
+	serverProfileNames := schema.NewSet(schema.HashString, make([]interface{}, 0, len(profiles.Items)))
+	configuredClient := d.Get("client_profiles").(*schema.Set)
+	configuredServer := d.Get("server_profiles").(*schema.Set)
+	connectivityProfile := ""
+	for _, profile := range profiles.Items {
+		switch {
+		case strings.Contains(profile.NameReference.Link, "/apm/profile/connectivity/"):
+			connectivityProfile = profile.FullPath
+		case profile.Context == bigip.CONTEXT_CLIENT:
+			clientProfileNames.Add(profile.FullPath)
+		case profile.Context == bigip.CONTEXT_SERVER:
+			serverProfileNames.Add(profile.FullPath)
+		case configuredClient.Contains(profile.FullPath) && configuredServer.Contains(profile.FullPath):
+			clientProfileNames.Add(profile.FullPath)
+			serverProfileNames.Add(profile.FullPath)
+		default:
+			profileNames.Add(profile.FullPath)
+		}
+	}
+	return profileNames, clientProfileNames, serverProfileNames, connectivityProfile
+}
+
 func resourceBigipLtmVirtualServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
 
@@ -478,11 +785,36 @@ func resourceBigipLtmVirtualServerUpdate(ctx context.Context, d *schema.Resource
 		Name: name,
 	}
 	log.Println("[INFO] Updating virtual server " + name)
+	if d.HasChange("connectivity_profile") {
+		if err := checkVirtualServerConnectivityProfile(d, client); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	asmPolicy := d.Get("asm_policy").(string)
+	if d.HasChange("asm_policy") && asmPolicy != "" {
+		if err := setVirtualServerAsmPolicy(client, virtualServerAsmPolicyName(name), asmPolicy); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	config := getVirtualServerConfig(d, pss)
 	err := client.ModifyVirtualServer(name, config)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if d.HasChange("asm_policy") && asmPolicy == "" {
+		if err := deleteVirtualServerAsmPolicy(client, virtualServerAsmPolicyName(name)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := setVirtualServerAttachments(ctx, d, client, name, true); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setVirtualServerMetadata(d, client, name, true); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setLtmObjectMeta(d, client, restObjectPath("ltm/virtual", name), "description", "app_service"); err != nil {
+		return diag.FromErr(err)
+	}
 	return resourceBigipLtmVirtualServerRead(ctx, d, meta)
 }
 
@@ -497,6 +829,9 @@ func resourceBigipLtmVirtualServerDelete(ctx context.Context, d *schema.Resource
 		log.Printf("[ERROR] Unable to Delete Virtual Server  (%s) (%v)", name, err)
 		return diag.FromErr(err)
 	}
+	if err := deleteVirtualServerAsmPolicy(client, virtualServerAsmPolicyName(name)); err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId("")
 	return nil
 }
@@ -509,9 +844,16 @@ func getVirtualServerConfig(d *schema.ResourceData, config *bigip.VirtualServer)
 
 	config.Name = d.Get("name").(string)
 	config.Pool = d.Get("pool").(string)
-	config.TranslatePort = d.Get("translate_port").(string)
-	config.TranslateAddress = d.Get("translate_address").(string)
+	// translation is only sent when configured (or known from a previous read), an empty value
+	// is omitted so the BIG-IP keeps its own default, e.g. disabled for forwarding virtuals
+	if v, ok := d.GetOk("translate_port"); ok {
+		config.TranslatePort = v.(string)
+	}
+	if v, ok := d.GetOk("translate_address"); ok {
+		config.TranslateAddress = v.(string)
+	}
 	config.SourcePort = d.Get("source_port").(string)
+	config.CMPEnabled = normalizeEnum(d.Get("cmp_enabled").(string), yesNoSynonyms)
 	config.FwEnforcedPolicy = d.Get("firewall_enforced_policy").(string)
 	config.Source = d.Get("source").(string)
 	if strings.Contains(destination, ":") {
@@ -529,22 +871,7 @@ func getVirtualServerConfig(d *schema.ResourceData, config *bigip.VirtualServer)
 		config.Mask = subnetMask
 	}
 
-	var profiles []bigip.Profile
-	if p, ok := d.GetOk("profiles"); ok {
-		for _, profile := range p.(*schema.Set).List() {
-			profiles = append(profiles, bigip.Profile{Name: profile.(string), Context: bigip.CONTEXT_ALL})
-		}
-	}
-	if p, ok := d.GetOk("client_profiles"); ok {
-		for _, profile := range p.(*schema.Set).List() {
-			profiles = append(profiles, bigip.Profile{Name: profile.(string), Context: bigip.CONTEXT_CLIENT})
-		}
-	}
-	if p, ok := d.GetOk("server_profiles"); ok {
-		for _, profile := range p.(*schema.Set).List() {
-			profiles = append(profiles, bigip.Profile{Name: profile.(string), Context: bigip.CONTEXT_SERVER})
-		}
-	}
+	profiles := getVirtualServerProfiles(d)
 	var persistenceProfiles []bigip.Profile
 	if p, ok := d.GetOk("persistence_profiles"); ok {
 		for _, profile := range p.(*schema.Set).List() {
@@ -560,6 +887,9 @@ func getVirtualServerConfig(d *schema.ResourceData, config *bigip.VirtualServer)
 	if p, ok := d.GetOk("policies"); ok {
 		policies = setToStringSlice(p.(*schema.Set))
 	}
+	if d.Get("asm_policy").(string) != "" {
+		policies = append(policies, virtualServerAsmPolicyName(config.Name))
+	}
 	var vlans []string
 	if v, ok := d.GetOk("vlans"); ok {
 		vlans = setToStringSlice(v.(*schema.Set))
@@ -588,6 +918,7 @@ func getVirtualServerConfig(d *schema.ResourceData, config *bigip.VirtualServer)
 	config.Vlans = vlans
 	config.IPProtocol = d.Get("ip_protocol").(string)
 	config.TrafficMatchingCriteria = d.Get("trafficmatching_criteria").(string)
+	config.AutoLastHop = d.Get("auto_lasthop").(string)
 	srcAddrsTrans := struct {
 		Type string `json:"type,omitempty"`
 		Pool string `json:"pool,omitempty"`
@@ -610,3 +941,329 @@ func getVirtualServerConfig(d *schema.ResourceData, config *bigip.VirtualServer)
 	}
 	return config
 }
+
+// createVirtualServer POSTs config, adding the internal flag go-bigip does not model for internal
+// virtual servers.
+func createVirtualServer(d *schema.ResourceData, client *bigip.BigIP, config *bigip.VirtualServer) error {
+	if d.Get("type").(string) != "internal" {
+		return client.CreateVirtualServer(config)
+	}
+	if d.Get("destination").(string) != "" || d.Get("trafficmatching_criteria").(string) != "" {
+		return fmt.Errorf("destination and trafficmatching_criteria cannot be set on internal virtual server %s", config.Name)
+	}
+	payload, err := restMarshal(config)
+	if err != nil {
+		return err
+	}
+	body := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(payload), &body); err != nil {
+		return err
+	}
+	body["internal"] = true
+	return postRestEntity(client, body, "ltm/virtual")
+}
+
+// virtualServerAttachments holds the bandwidth controller, rate class, IP Intelligence, NAT and flow
+// eviction policy attachments, the metadata, the application service and the internal flag of a
+// virtual server, which go-bigip does not model.
+type virtualServerAttachments struct {
+	AppService           string                  `json:"appService,omitempty"`
+	Internal             bool                    `json:"internal,omitempty"`
+	BwcPolicy            string                  `json:"bwcPolicy,omitempty"`
+	RateClass            string                  `json:"rateClass,omitempty"`
+	IpIntelligencePolicy string                  `json:"ipIntelligencePolicy,omitempty"`
+	LastHopPool          string                  `json:"lastHopPool,omitempty"`
+	EvictionPolicy       string                  `json:"evictionPolicy,omitempty"`
+	SecurityNatPolicy    virtualServerNatPolicy  `json:"securityNatPolicy,omitempty"`
+	Metadata             []virtualServerMetadata `json:"metadata,omitempty"`
+}
+
+// virtualServerNatPolicy is the AFM NAT policy attachment of a virtual server.
+type virtualServerNatPolicy struct {
+	Policy string `json:"policy,omitempty"`
+}
+
+// virtualServerMetadata is one metadata entry of a virtual server.
+type virtualServerMetadata struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Persist string `json:"persist,omitempty"`
+}
+
+// virtualServerAttachmentKeys maps the attachment attributes to their field of the virtual server.
+var virtualServerAttachmentKeys = map[string]string{
+	"bwc_policy":             "bwcPolicy",
+	"rate_class":             "rateClass",
+	"ip_intelligence_policy": "ipIntelligencePolicy",
+	"last_hop_pool":          "lastHopPool",
+	"eviction_policy":        "evictionPolicy",
+}
+
+// setVirtualServerAttachments PATCHes bwc_policy, rate_class, ip_intelligence_policy, last_hop_pool,
+// eviction_policy and security_nat_policy onto the virtual server. An empty value is sent as "none" on
+// update so that detaching clears the field on the device. gtm_score is sent here as well, since the
+// go-bigip virtual server omits a score of 0.
+func setVirtualServerAttachments(ctx context.Context, d *schema.ResourceData, client *bigip.BigIP, name string, update bool) error {
+	body := make(map[string]interface{})
+	for attr, key := range virtualServerAttachmentKeys {
+		value := d.Get(attr).(string)
+		if (update && !d.HasChange(attr)) || (!update && value == "") {
+			continue
+		}
+		if value == "" {
+			value = "none"
+		}
+		body[key] = value
+	}
+	// the NAT policy is a nested object of the virtual server rather than a plain reference
+	if natPolicy := d.Get("security_nat_policy").(string); (update && d.HasChange("security_nat_policy")) || (!update && natPolicy != "") {
+		if natPolicy == "" {
+			natPolicy = "none"
+		}
+		body["securityNatPolicy"] = map[string]string{"policy": natPolicy}
+	}
+	if d.HasChange("gtm_score") {
+		body["gtmScore"] = d.Get("gtm_score").(int)
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	tflog.Debug(ctx, "Setting attachments of virtual server "+name, body)
+	if err := patchRestEntity(client, body, restObjectPath("ltm/virtual", name)); err != nil {
+		return fmt.Errorf("error setting bwc_policy/rate_class/ip_intelligence_policy/last_hop_pool/eviction_policy/security_nat_policy/gtm_score on virtual server (%s): %s", name, err)
+	}
+	return nil
+}
+
+// setVirtualServerMetadata PATCHes the metadata entries of the virtual server, all with persist set
+// so that they are kept by a config save. On update it only runs when metadata changed, sending an
+// empty list once every entry is removed.
+func setVirtualServerMetadata(d *schema.ResourceData, client *bigip.BigIP, name string, update bool) error {
+	metadata := d.Get("metadata").(map[string]interface{})
+	if (update && !d.HasChange("metadata")) || (!update && len(metadata) == 0) {
+		return nil
+	}
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	entries := make([]virtualServerMetadata, 0, len(keys))
+	for _, k := range keys {
+		entries = append(entries, virtualServerMetadata{Name: k, Value: metadata[k].(string), Persist: "true"})
+	}
+	body := map[string]interface{}{
+		"metadata": entries,
+	}
+	if err := patchRestEntity(client, body, restObjectPath("ltm/virtual", name)); err != nil {
+		return fmt.Errorf("error setting metadata on virtual server (%s): %s", name, err)
+	}
+	return nil
+}
+
+// getVirtualServerProfiles returns the profiles of the virtual server with their context. A profile in
+// both client_profiles and server_profiles is sent once with the context all, as the BIG-IP does not
+// accept the same profile twice.
+func getVirtualServerProfiles(d *schema.ResourceData) []bigip.Profile {
+	var profiles []bigip.Profile
+	if p, ok := d.GetOk("profiles"); ok {
+		for _, profile := range p.(*schema.Set).List() {
+			profiles = append(profiles, bigip.Profile{Name: profile.(string), Context: bigip.CONTEXT_ALL})
+		}
+	}
+	clientProfiles := d.Get("client_profiles").(*schema.Set)
+	serverProfiles := d.Get("server_profiles").(*schema.Set)
+	for _, profile := range clientProfiles.List() {
+		profileContext := bigip.CONTEXT_CLIENT
+		if serverProfiles.Contains(profile) {
+			profileContext = bigip.CONTEXT_ALL
+		}
+		profiles = append(profiles, bigip.Profile{Name: profile.(string), Context: profileContext})
+	}
+	for _, profile := range serverProfiles.List() {
+		if !clientProfiles.Contains(profile) {
+			profiles = append(profiles, bigip.Profile{Name: profile.(string), Context: bigip.CONTEXT_SERVER})
+		}
+	}
+	if p, ok := d.GetOk("connectivity_profile"); ok {
+		profiles = append(profiles, bigip.Profile{Name: p.(string), Context: bigip.CONTEXT_ALL})
+	}
+	return profiles
+}
+
+// virtualServerProfiles is the profiles subcollection of a virtual server. The name reference
+// tells which kind of profile each item is, which go-bigip does not expose.
+// virtualServerExpanded is a virtual server read with expandSubcollections, which returns its profiles,
+// policies and attachments along with its settings in a single GET.
+type virtualServerExpanded struct {
+	bigip.VirtualServer
+	virtualServerAttachments
+	Persist           []virtualServerPersist `json:"persist"`
+	ProfilesReference virtualServerProfiles  `json:"profilesReference"`
+	PoliciesReference struct {
+		Items []struct {
+			FullPath string `json:"fullPath"`
+		} `json:"items"`
+	} `json:"policiesReference"`
+}
+
+type virtualServerProfiles struct {
+	Items []struct {
+		FullPath      string `json:"fullPath"`
+		Context       string `json:"context"`
+		NameReference struct {
+			Link string `json:"link"`
+		} `json:"nameReference"`
+	} `json:"items"`
+}
+
+// virtualServerPersist is a persistence profile of a virtual server. Like for the profiles, the name
+// reference tells the persistence type, which the persist field of go-bigip does not keep.
+type virtualServerPersist struct {
+	Name          string `json:"name"`
+	Partition     string `json:"partition"`
+	TmDefault     string `json:"tmDefault"`
+	NameReference struct {
+		Link string `json:"link"`
+	} `json:"nameReference"`
+}
+
+// getVirtualServerPersistence reads the effective settings of the persistence profiles of a virtual
+// server from the profiles themselves, the BIG-IP resolving the inherited ones.
+func getVirtualServerPersistence(client *bigip.BigIP, persist []virtualServerPersist) ([]map[string]interface{}, error) {
+	var persistence []map[string]interface{}
+	for _, p := range persist {
+		profile := &bigip.PersistenceProfile{}
+		path, err := restNextLinkPath(p.NameReference.Link)
+		if err != nil {
+			return nil, err
+		}
+		// e.g. mgmt/tm/ltm/persistence/cookie/~Common~cookie?ver=16.1.0
+		path = strings.TrimPrefix(strings.SplitN(path, "?", 2)[0], "mgmt/tm/")
+		profileType := ""
+		if parts := strings.Split(path, "/"); len(parts) == 4 && parts[1] == "persistence" {
+			profileType = parts[2]
+			if _, err := getRestEntity(client, profile, path+"?$select=defaultsFrom,timeout,mirror,matchAcrossPools,matchAcrossServices,matchAcrossVirtuals"); err != nil {
+				return nil, err
+			}
+		}
+		persistence = append(persistence, map[string]interface{}{
+			"name":                  "/" + p.Partition + "/" + p.Name,
+			"type":                  profileType,
+			"default":               p.TmDefault == "yes",
+			"defaults_from":         profile.DefaultsFrom,
+			"timeout":               profile.Timeout,
+			"mirror":                profile.Mirror,
+			"match_across_pools":    profile.MatchAcrossPools,
+			"match_across_services": profile.MatchAcrossServices,
+			"match_across_virtuals": profile.MatchAcrossVirtuals,
+		})
+	}
+	return persistence, nil
+}
+
+// validateVirtualServerPersistenceOverride rejects persistence_override: a virtual server only
+// references its persistence profiles, whose settings apply to every virtual server using them.
+func validateVirtualServerPersistenceOverride(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if len(d.Get("persistence_override").([]interface{})) == 0 {
+		return nil
+	}
+	profile := d.Get("default_persistence_profile").(string)
+	if profile == "" {
+		profile = "the default persistence profile"
+	}
+	return fmt.Errorf("persistence_override is not supported by the BIG-IP: the timeout and mirror settings of %s apply to "+
+		"every virtual server using it. Create a persistence profile inheriting from it with these settings, e.g. a "+
+		"bigip_ltm_persistence_profile_cookie with defaults_from, and use it as default_persistence_profile instead", profile)
+}
+
+// checkVirtualServerConnectivityProfile fails with a clear message when connectivity_profile is set
+// but APM is not provisioned, instead of the 400 the BIG-IP returns for the virtual server.
+func checkVirtualServerConnectivityProfile(d *schema.ResourceData, client *bigip.BigIP) error {
+	profile := d.Get("connectivity_profile").(string)
+	if profile == "" {
+		return nil
+	}
+	p, err := client.Provisions("apm")
+	if err != nil {
+		return fmt.Errorf("unable to retrieve APM provisioning: %v", err)
+	}
+	if p.Level == "" || p.Level == "none" {
+		return fmt.Errorf("connectivity_profile %s requires APM to be provisioned on the BIG-IP", profile)
+	}
+	return nil
+}
+
+// virtualServerAsmPolicyName is the LTM policy the provider manages to enforce asm_policy on a virtual server.
+func virtualServerAsmPolicyName(vsName string) string {
+	return vsName + "_asm"
+}
+
+// setVirtualServerAsmPolicy creates or updates the LTM policy enabling asmPolicy, then publishes it.
+func setVirtualServerAsmPolicy(client *bigip.BigIP, helper, asmPolicy string) error {
+	partition, name := helper[:strings.LastIndex(helper, "/")], helper[strings.LastIndex(helper, "/")+1:]
+	rules := []map[string]interface{}{
+		{
+			"name":    "enable-asm",
+			"ordinal": 0,
+			"actions": []map[string]interface{}{
+				{"name": "0", "asm": true, "enable": true, "request": true, "policy": asmPolicy},
+			},
+		},
+	}
+	found, err := getRestEntity(client, &struct{}{}, restObjectPath("ltm/policy", helper))
+	if err != nil {
+		return err
+	}
+	if !found {
+		err = postRestEntity(client, map[string]interface{}{
+			"name":      name,
+			"partition": strings.Trim(partition, "/"),
+			"subPath":   "Drafts",
+			"strategy":  "/Common/first-match",
+			"requires":  []string{"http"},
+			"controls":  []string{"asm"},
+			"rules":     rules,
+		}, "ltm/policy")
+	} else {
+		err = patchRestEntity(client, struct{}{}, restObjectPath("ltm/policy", helper)+"?options=create-draft")
+		if err == nil {
+			err = patchRestEntity(client, map[string]interface{}{"rules": rules}, restObjectPath("ltm/policy", partition+"/Drafts/"+name))
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("error setting asm_policy LTM policy (%s): %s", helper, err)
+	}
+	if err := client.PublishPolicy(name, partition+"/Drafts/"+name); err != nil {
+		return fmt.Errorf("error publishing asm_policy LTM policy (%s): %s", helper, err)
+	}
+	return nil
+}
+
+// getVirtualServerAsmPolicy returns the ASM policy enabled by the LTM policy helper.
+func getVirtualServerAsmPolicy(client *bigip.BigIP, helper string) (string, error) {
+	actions, err := getRestItems[struct {
+		Policy string `json:"policy"`
+	}](client, restObjectPath("ltm/policy", helper)+"/rules/enable-asm/actions")
+	if err != nil {
+		return "", err
+	}
+	for _, a := range actions {
+		if a.Policy != "" {
+			return a.Policy, nil
+		}
+	}
+	return "", nil
+}
+
+// deleteVirtualServerAsmPolicy removes the LTM policy helper once it is no longer attached, if it exists.
+func deleteVirtualServerAsmPolicy(client *bigip.BigIP, helper string) error {
+	found, err := getRestEntity(client, &struct{}{}, restObjectPath("ltm/policy", helper))
+	if err != nil || !found {
+		return err
+	}
+	if err := deleteRestEntity(client, restObjectPath("ltm/policy", helper)); err != nil {
+		return fmt.Errorf("error deleting asm_policy LTM policy (%s): %s", helper, err)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ package bigip
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	bigip "github.com/f5devcentral/go-bigip"
@@ -181,6 +182,275 @@ func TestAccBigipLtmVirtualServercreate_Defaultstate(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmVirtualServerForwardingTranslationDisabled(t *testing.T) {
+	resName := "bigip_ltm_virtual_server.test-vs"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckVSsDestroyed,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testVSCreateForwarding("test-vs-forward", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("test-vs-forward"),
+					resource.TestCheckResourceAttr(resName, "translate_address", "disabled"),
+					resource.TestCheckResourceAttr(resName, "translate_port", "disabled"),
+				),
+			},
+			{
+				// once translation is no longer configured the device values are kept
+				Config: testVSCreateForwarding("test-vs-forward", false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("test-vs-forward"),
+					resource.TestCheckResourceAttr(resName, "translate_address", "disabled"),
+					resource.TestCheckResourceAttr(resName, "translate_port", "disabled"),
+				),
+			},
+			{
+				Config:   testVSCreateForwarding("test-vs-forward", false),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmVirtualServerAsmPolicy_attachDetach(t *testing.T) {
+	resName := "bigip_ltm_virtual_server.test-vs"
+	helper := "/Common/test-vs-asm_asm"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckVSsDestroyed,
+			testCheckAsmHelperPolicy(helper, false),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testVSCreateAsmPolicy("test-vs-asm", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("test-vs-asm"),
+					testCheckAsmHelperPolicy(helper, true),
+					resource.TestCheckResourceAttr(resName, "asm_policy", "/Common/test-vs-asm-waf"),
+					resource.TestCheckResourceAttr(resName, "policies.#", "0"),
+				),
+			},
+			{
+				Config: testVSCreateAsmPolicy("test-vs-asm", false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("test-vs-asm"),
+					testCheckAsmHelperPolicy(helper, false),
+					resource.TestCheckResourceAttr(resName, "asm_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmVirtualServerAsmPolicy_createFailure(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckVSsDestroyed,
+			testCheckAsmHelperPolicy("/Common/test-vs-asm-fail_asm", false),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config:      testVSCreateAsmPolicyMissingPool("test-vs-asm-fail"),
+				ExpectError: regexp.MustCompile("no-such-pool"),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmVirtualServerIpIntelligencePolicy_attachDetach(t *testing.T) {
+	resName := "bigip_ltm_virtual_server.test-vs"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVSsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testVSCreateIpIntelligencePolicy("test-vs-ipi", "/Common/ip-intelligence"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("test-vs-ipi"),
+					resource.TestCheckResourceAttr(resName, "ip_intelligence_policy", "/Common/ip-intelligence"),
+					resource.TestCheckResourceAttr(resName, "connectivity_profile", ""),
+				),
+			},
+			{
+				Config: testVSCreateIpIntelligencePolicy("test-vs-ipi", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("test-vs-ipi"),
+					resource.TestCheckResourceAttr(resName, "ip_intelligence_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmVirtualServerLastHop(t *testing.T) {
+	resName := "bigip_ltm_virtual_server.test-vs"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVSsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testVSCreateLastHop("test-vs-lasthop", "disabled", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("test-vs-lasthop"),
+					resource.TestCheckResourceAttr(resName, "auto_lasthop", "disabled"),
+					resource.TestCheckResourceAttr(resName, "last_hop_pool", "/Common/test-lasthop-pool"),
+				),
+			},
+			{
+				Config: testVSCreateLastHop("test-vs-lasthop", "enabled", false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("test-vs-lasthop"),
+					resource.TestCheckResourceAttr(resName, "auto_lasthop", "enabled"),
+					resource.TestCheckResourceAttr(resName, "last_hop_pool", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmVirtualServerCmpGtmScoreEviction(t *testing.T) {
+	resName := "bigip_ltm_virtual_server.test-vs"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVSsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testVSCreateCmpGtmScoreEviction("test-vs-cmp", "disabled", 20, "/Common/default-eviction-policy"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("test-vs-cmp"),
+					resource.TestCheckResourceAttr(resName, "cmp_enabled", "no"),
+					resource.TestCheckResourceAttr(resName, "gtm_score", "20"),
+					resource.TestCheckResourceAttr(resName, "eviction_policy", "/Common/default-eviction-policy"),
+				),
+			},
+			{
+				Config:   testVSCreateCmpGtmScoreEviction("test-vs-cmp", "disabled", 20, "/Common/default-eviction-policy"),
+				PlanOnly: true,
+			},
+			{
+				Config: testVSCreateCmpGtmScoreEviction("test-vs-cmp", "yes", 0, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("test-vs-cmp"),
+					resource.TestCheckResourceAttr(resName, "cmp_enabled", "yes"),
+					resource.TestCheckResourceAttr(resName, "gtm_score", "0"),
+					resource.TestCheckResourceAttr(resName, "eviction_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmVirtualServerFetchStatus(t *testing.T) {
+	resName := "bigip_ltm_virtual_server.test-vs-status"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVSsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testVSCreateFetchStatus(false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("/Common/test-vs-status"),
+					resource.TestCheckResourceAttr(resName, "status", ""),
+					resource.TestCheckResourceAttrSet(resName, "generation"),
+				),
+			},
+			{
+				Config: testVSCreateFetchStatus(true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("/Common/test-vs-status"),
+					resource.TestCheckResourceAttrSet(resName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testVSCreateFetchStatus(fetch bool) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_virtual_server" "test-vs-status" {
+  name         = "/Common/test-vs-status"
+  destination  = "10.255.255.61"
+  port         = 80
+  fetch_status = %t
+}
+`, fetch)
+}
+
+func TestAccBigipLtmVirtualServerMetadata(t *testing.T) {
+	resName := "bigip_ltm_virtual_server.test-vs-metadata"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVSsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testVSCreateMetadata(`{ owner = "team-a", app-id = "1234" }`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("/Common/test-vs-metadata"),
+					resource.TestCheckResourceAttr(resName, "description", "tagged virtual"),
+					resource.TestCheckResourceAttr(resName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resName, "metadata.owner", "team-a"),
+				),
+			},
+			{
+				Config: testVSCreateMetadata(`{ owner = "team-b" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resName, "metadata.owner", "team-b"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportStateId:           "/Common/test-vs-metadata",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fetch_status"},
+			},
+		},
+	})
+}
+
+func testVSCreateMetadata(metadata string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_virtual_server" "test-vs-metadata" {
+  name        = "/Common/test-vs-metadata"
+  destination = "10.255.255.62"
+  port        = 80
+  description = "tagged virtual"
+  state       = "disabled"
+  metadata    = %s
+}
+`, metadata)
+}
+
 func TestAccBigipLtmVirtualServerModify_stateDisabledtoEnabled(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -515,6 +785,126 @@ func TestAccBigipLtmVirtualServerimport(t *testing.T) {
 	})
 }
 
+// TestAccBigipLtmVirtualServerDeepImport imports a virtual server created outside of terraform with
+// its irules, policies, persistence, snat and profiles attached, and expects no change to be planned.
+func TestAccBigipLtmVirtualServerDeepImport(t *testing.T) {
+	vsName := "/Common/test-vs-deep-import"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVSsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testVSDeepImportConfig(vsName, false),
+			},
+			{
+				PreConfig:          func() { testCreateVSDeepImportFixture(t, vsName) },
+				Config:             testVSDeepImportConfig(vsName, true),
+				ResourceName:       "bigip_ltm_virtual_server.deep_import",
+				ImportStateId:      vsName,
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config:   testVSDeepImportConfig(vsName, true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testCreateVSDeepImportFixture creates the virtual server through the API, as found on a brownfield device.
+func testCreateVSDeepImportFixture(t *testing.T, vsName string) {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	err := postRestEntity(client, map[string]interface{}{
+		"name":        vsName,
+		"destination": "/Common/192.168.12.12:443",
+		"mask":        "255.255.255.255",
+		"ipProtocol":  "tcp",
+		"description": "brownfield virtual server",
+		"pool":        "/Common/test-pool-deep-import",
+		"profiles": []map[string]string{
+			{"name": "/Common/tcp", "context": "all"},
+			{"name": "/Common/http", "context": "all"},
+			{"name": "/Common/clientssl", "context": "clientside"},
+		},
+		"persist":                  []map[string]string{{"name": "/Common/cookie", "tmDefault": "yes"}},
+		"fallbackPersistence":      "/Common/source_addr",
+		"rules":                    []string{"/Common/test-rule-deep-import"},
+		"policies":                 []map[string]string{{"name": "/Common/test-policy-deep-import"}},
+		"sourceAddressTranslation": map[string]string{"type": "snat", "pool": "/Common/test-snatpool-deep-import"},
+		"translateAddress":         "enabled",
+		"translatePort":            "enabled",
+	}, "ltm/virtual")
+	if err != nil {
+		t.Fatalf("creating virtual server %s: %v", vsName, err)
+	}
+}
+
+func testVSDeepImportConfig(vsName string, imported bool) string {
+	config := `
+resource "bigip_ltm_pool" "deep_import" {
+  name                = "/Common/test-pool-deep-import"
+  load_balancing_mode = "round-robin"
+}
+
+resource "bigip_ltm_irule" "deep_import" {
+  name  = "/Common/test-rule-deep-import"
+  irule = <<EOF
+when CLIENT_ACCEPTED {
+     log local0. "test"
+}
+EOF
+}
+
+resource "bigip_ltm_snatpool" "deep_import" {
+  name    = "/Common/test-snatpool-deep-import"
+  members = ["/Common/191.1.1.10"]
+}
+
+resource "bigip_ltm_policy" "deep_import" {
+  name     = "/Common/test-policy-deep-import"
+  strategy = "first-match"
+  requires = ["http"]
+  controls = ["forwarding"]
+  rule {
+    name = "rule1"
+    action {
+      forward    = true
+      connection = false
+      pool       = bigip_ltm_pool.deep_import.name
+    }
+  }
+}
+`
+	if !imported {
+		return config
+	}
+	return config + fmt.Sprintf(`
+resource "bigip_ltm_virtual_server" "deep_import" {
+  name                         = "%s"
+  destination                  = "192.168.12.12"
+  port                         = 443
+  ip_protocol                  = "tcp"
+  description                  = "brownfield virtual server"
+  pool                         = bigip_ltm_pool.deep_import.name
+  profiles                     = ["/Common/tcp", "/Common/http"]
+  client_profiles              = ["/Common/clientssl"]
+  persistence_profiles         = ["/Common/cookie"]
+  default_persistence_profile  = "/Common/cookie"
+  fallback_persistence_profile = "/Common/source_addr"
+  irules                       = [bigip_ltm_irule.deep_import.name]
+  policies                     = [bigip_ltm_policy.deep_import.name]
+  source_address_translation   = "snat"
+  snatpool                     = bigip_ltm_snatpool.deep_import.name
+  translate_address            = "enabled"
+  translate_port               = "enabled"
+}
+`, vsName)
+}
+
 func testaccBigipLtmVSImportConfig() string {
 	return fmt.Sprintf(`
 resource "bigip_ltm_virtual_server" "test_vs_import" {
@@ -586,6 +976,136 @@ resource "bigip_ltm_virtual_server" "test-vs" {
 `, vsName)
 }
 
+func testVSCreateForwarding(vsName string, translation bool) string {
+	translate := ""
+	if translation {
+		translate = `
+  translate_address = "disabled"
+  translate_port    = "disabled"`
+	}
+	return fmt.Sprintf(`
+resource "bigip_ltm_virtual_server" "test-vs" {
+  name        = "/Common/%s"
+  destination = "0.0.0.0"
+  port        = 0
+  mask        = "0.0.0.0"
+  ip_protocol = "any"
+  profiles    = ["/Common/fastL4"]%s
+}
+`, vsName, translate)
+}
+
+func testVSCreateAsmPolicy(vsName string, attach bool) string {
+	asmPolicy := ""
+	if attach {
+		asmPolicy = `
+  asm_policy  = "/Common/${bigip_waf_policy.test-waf.name}"`
+	}
+	return fmt.Sprintf(`
+resource "bigip_waf_policy" "test-waf" {
+  name                 = "%[1]s-waf"
+  partition            = "Common"
+  template_name        = "POLICY_TEMPLATE_RAPID_DEPLOYMENT"
+  application_language = "utf-8"
+  enforcement_mode     = "blocking"
+}
+
+resource "bigip_ltm_virtual_server" "test-vs" {
+  name        = "/Common/%[1]s"
+  destination = "192.168.50.20"
+  port        = 80
+  profiles    = ["/Common/http"]%[2]s
+}
+`, vsName, asmPolicy)
+}
+
+func testVSCreateAsmPolicyMissingPool(vsName string) string {
+	return fmt.Sprintf(`
+resource "bigip_waf_policy" "test-waf" {
+  name                 = "%[1]s-waf"
+  partition            = "Common"
+  template_name        = "POLICY_TEMPLATE_RAPID_DEPLOYMENT"
+  application_language = "utf-8"
+  enforcement_mode     = "blocking"
+}
+
+resource "bigip_ltm_virtual_server" "test-vs" {
+  name        = "/Common/%[1]s"
+  destination = "192.168.50.21"
+  port        = 80
+  pool        = "/Common/no-such-pool"
+  profiles    = ["/Common/http"]
+  asm_policy  = "/Common/${bigip_waf_policy.test-waf.name}"
+}
+`, vsName)
+}
+
+func testVSCreateIpIntelligencePolicy(vsName, policy string) string {
+	attach := ""
+	if policy != "" {
+		attach = fmt.Sprintf(`
+  ip_intelligence_policy = "%s"`, policy)
+	}
+	return fmt.Sprintf(`
+resource "bigip_ltm_virtual_server" "test-vs" {
+  name        = "/Common/%[1]s"
+  destination = "192.168.50.22"
+  port        = 80
+  profiles    = ["/Common/http"]%[2]s
+}
+`, vsName, attach)
+}
+
+func testVSCreateCmpGtmScoreEviction(vsName, cmpEnabled string, gtmScore int, evictionPolicy string) string {
+	eviction := ""
+	if evictionPolicy != "" {
+		eviction = fmt.Sprintf(`
+  eviction_policy = "%s"`, evictionPolicy)
+	}
+	return fmt.Sprintf(`
+resource "bigip_ltm_virtual_server" "test-vs" {
+  name        = "/Common/%[1]s"
+  destination = "192.168.50.24"
+  port        = 80
+  cmp_enabled = "%[2]s"
+  gtm_score   = %[3]d%[4]s
+}
+`, vsName, cmpEnabled, gtmScore, eviction)
+}
+
+func testVSCreateLastHop(vsName, autoLasthop string, lastHopPool bool) string {
+	pool := ""
+	if lastHopPool {
+		pool = `
+  last_hop_pool = bigip_ltm_pool.lasthop.name`
+	}
+	return fmt.Sprintf(`
+resource "bigip_ltm_pool" "lasthop" {
+  name = "/Common/test-lasthop-pool"
+}
+resource "bigip_ltm_virtual_server" "test-vs" {
+  name         = "/Common/%[1]s"
+  destination  = "192.168.50.23"
+  port         = 80
+  auto_lasthop = "%[2]s"%[3]s
+}
+`, vsName, autoLasthop, pool)
+}
+
+func testCheckAsmHelperPolicy(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &struct{}{}, restObjectPath("ltm/policy", name))
+		if err != nil {
+			return err
+		}
+		if found != exists {
+			return fmt.Errorf("asm_policy LTM policy %s: expected exists=%v, got %v", name, exists, found)
+		}
+		return nil
+	}
+}
+
 func testVSCreatePolicyAttach(vsName string) string {
 	return fmt.Sprintf(`
 resource "bigip_ltm_pool" "mypool" {
@@ -0,0 +1,402 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckVirtualServerConnectivityProfile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	level := "none"
+	mux.HandleFunc("/mgmt/tm/sys/provision/apm", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"apm","level":"%s"}`, level)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmVirtualServer().Schema, map[string]interface{}{
+		"name":                 "/Common/test-vs",
+		"destination":          "192.168.50.22",
+		"port":                 443,
+		"connectivity_profile": "/Common/connectivity",
+	})
+
+	err := checkVirtualServerConnectivityProfile(d, client)
+	assert.EqualError(t, err, "connectivity_profile /Common/connectivity requires APM to be provisioned on the BIG-IP")
+
+	level = "nominal"
+	assert.NoError(t, checkVirtualServerConnectivityProfile(d, client))
+}
+
+func testHttp2FullProxyVirtualServer(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceBigipLtmVirtualServer().Schema, map[string]interface{}{
+		"name":            "/Common/test-vs",
+		"destination":     "192.168.50.22",
+		"port":            443,
+		"profiles":        []interface{}{"/Common/http", "/Common/httprouter"},
+		"client_profiles": []interface{}{"/Common/http2", "/Common/clientssl"},
+		"server_profiles": []interface{}{"/Common/http2", "/Common/serverssl"},
+	})
+}
+
+func TestGetVirtualServerProfilesHttp2FullProxy(t *testing.T) {
+	contexts := make(map[string]string)
+	for _, p := range getVirtualServerProfiles(testHttp2FullProxyVirtualServer(t)) {
+		_, seen := contexts[p.Name]
+		assert.False(t, seen, "profile %s sent twice", p.Name)
+		contexts[p.Name] = p.Context
+	}
+	assert.Equal(t, map[string]string{
+		"/Common/http":       bigip.CONTEXT_ALL,
+		"/Common/httprouter": bigip.CONTEXT_ALL,
+		"/Common/http2":      bigip.CONTEXT_ALL,
+		"/Common/clientssl":  bigip.CONTEXT_CLIENT,
+		"/Common/serverssl":  bigip.CONTEXT_SERVER,
+	}, contexts)
+}
+
+func TestSplitVirtualServerProfilesHttp2FullProxy(t *testing.T) {
+	profiles := &virtualServerProfiles{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"items":[
+		{"fullPath":"/Common/http","context":"all"},
+		{"fullPath":"/Common/httprouter","context":"all"},
+		{"fullPath":"/Common/http2","context":"all"},
+		{"fullPath":"/Common/clientssl","context":"clientside"},
+		{"fullPath":"/Common/serverssl","context":"serverside"}]}`), profiles))
+
+	all, client, server, connectivity := splitVirtualServerProfiles(testHttp2FullProxyVirtualServer(t), profiles)
+	assert.ElementsMatch(t, []interface{}{"/Common/http", "/Common/httprouter"}, all.List())
+	assert.ElementsMatch(t, []interface{}{"/Common/http2", "/Common/clientssl"}, client.List())
+	assert.ElementsMatch(t, []interface{}{"/Common/http2", "/Common/serverssl"}, server.List())
+	assert.Equal(t, "", connectivity)
+}
+
+func TestGetVirtualServerStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/ltm/virtual/~Common~test-vs/stats":{"nestedStats":{"entries":{
+			"clientside.curConns":{"value":0},
+			"status.availabilityState":{"description":"offline"},
+			"status.statusReason":{"description":"The children pool member(s) are down"}}}}}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	status, err := getVirtualServerStatus(client, "/Common/test-vs")
+	assert.NoError(t, err)
+	assert.Equal(t, "offline", status)
+}
+
+func TestSetVirtualServerMetadata(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string][]virtualServerMetadata
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmVirtualServer().Schema, map[string]interface{}{
+		"name":        "/Common/test-vs",
+		"destination": "192.168.50.22",
+		"port":        443,
+		"metadata":    map[string]interface{}{"owner": "team-a", "app-id": "1234"},
+	})
+	assert.NoError(t, setVirtualServerMetadata(d, client, "/Common/test-vs", false))
+	assert.Equal(t, []virtualServerMetadata{
+		{Name: "app-id", Value: "1234", Persist: "true"},
+		{Name: "owner", Value: "team-a", Persist: "true"},
+	}, body["metadata"])
+}
+
+func TestCreateVirtualServerInternal(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/ltm/virtual", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmVirtualServer().Schema, map[string]interface{}{
+		"name":     "/Common/test-icap-vs",
+		"type":     "internal",
+		"pool":     "/Common/icap-pool",
+		"profiles": []interface{}{"/Common/tcp", "/Common/test-icap"},
+	})
+	config := getVirtualServerConfig(d, &bigip.VirtualServer{Name: "/Common/test-icap-vs"})
+	assert.NoError(t, createVirtualServer(d, client, config))
+	assert.Equal(t, true, body["internal"])
+	assert.Equal(t, ":0", body["destination"])
+	assert.Equal(t, "0.0.0.0", body["mask"])
+	assert.Equal(t, "/Common/icap-pool", body["pool"])
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmVirtualServer().Schema, map[string]interface{}{
+		"name":        "/Common/test-icap-vs",
+		"type":        "internal",
+		"destination": "10.1.1.1",
+		"port":        1344,
+	})
+	config = getVirtualServerConfig(d, &bigip.VirtualServer{Name: "/Common/test-icap-vs"})
+	assert.EqualError(t, createVirtualServer(d, client, config), "destination and trafficmatching_criteria cannot be set on internal virtual server /Common/test-icap-vs")
+}
+
+func TestSetVirtualServerAttachmentsLastHopPool(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]string
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		body = nil
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmVirtualServer().Schema, map[string]interface{}{
+		"name":          "/Common/test-vs",
+		"destination":   "192.168.50.22",
+		"port":          443,
+		"last_hop_pool": "/Common/lasthop-routers",
+	})
+	assert.NoError(t, setVirtualServerAttachments(context.Background(), d, client, "/Common/test-vs", false))
+	assert.Equal(t, map[string]string{"lastHopPool": "/Common/lasthop-routers"}, body)
+
+	// clearing the pool on update detaches it on the device
+	r := resourceBigipLtmVirtualServer()
+	state := &terraform.InstanceState{
+		ID:         "/Common/test-vs",
+		Attributes: map[string]string{"name": "/Common/test-vs", "last_hop_pool": "/Common/lasthop-routers"},
+	}
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "/Common/test-vs",
+	}), nil, nil, true)
+	assert.NoError(t, err)
+	d, err = schema.InternalMap(r.Schema).Data(state, diff)
+	assert.NoError(t, err)
+	assert.NoError(t, setVirtualServerAttachments(context.Background(), d, client, "/Common/test-vs", true))
+	assert.Equal(t, map[string]string{"lastHopPool": "none"}, body)
+}
+
+func TestSetVirtualServerAttachmentsSecurityNatPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		body = nil
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmVirtualServer().Schema, map[string]interface{}{
+		"name":                "/Common/test-vs",
+		"destination":         "192.168.50.22",
+		"port":                443,
+		"security_nat_policy": "/Common/outbound-nat",
+	})
+	assert.NoError(t, setVirtualServerAttachments(context.Background(), d, client, "/Common/test-vs", false))
+	assert.Equal(t, map[string]interface{}{"securityNatPolicy": map[string]interface{}{"policy": "/Common/outbound-nat"}}, body)
+
+	// removing the attribute on update detaches the policy on the device
+	r := resourceBigipLtmVirtualServer()
+	state := &terraform.InstanceState{
+		ID:         "/Common/test-vs",
+		Attributes: map[string]string{"name": "/Common/test-vs", "security_nat_policy": "/Common/outbound-nat"},
+	}
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "/Common/test-vs",
+	}), nil, nil, true)
+	assert.NoError(t, err)
+	d, err = schema.InternalMap(r.Schema).Data(state, diff)
+	assert.NoError(t, err)
+	assert.NoError(t, setVirtualServerAttachments(context.Background(), d, client, "/Common/test-vs", true))
+	assert.Equal(t, map[string]interface{}{"securityNatPolicy": map[string]interface{}{"policy": "none"}}, body)
+}
+
+func TestSetVirtualServerAttachmentsEvictionPolicyGtmScore(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		body = nil
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmVirtualServer().Schema, map[string]interface{}{
+		"name":            "/Common/test-vs",
+		"destination":     "192.168.50.22",
+		"port":            443,
+		"cmp_enabled":     "disabled",
+		"gtm_score":       20,
+		"eviction_policy": "/Common/default-eviction-policy",
+	})
+	assert.NoError(t, setVirtualServerAttachments(context.Background(), d, client, "/Common/test-vs", false))
+	assert.Equal(t, map[string]interface{}{"evictionPolicy": "/Common/default-eviction-policy", "gtmScore": float64(20)}, body)
+	// cmp_enabled is sent with the virtual server, in the yes/no form of the API
+	assert.Equal(t, "no", getVirtualServerConfig(d, &bigip.VirtualServer{}).CMPEnabled)
+	assert.True(t, suppressYesNoDiff("cmp_enabled", "no", "disabled", nil))
+
+	// removing the eviction policy detaches it and a score of 0 is sent, which go-bigip would omit
+	r := resourceBigipLtmVirtualServer()
+	state := &terraform.InstanceState{
+		ID:         "/Common/test-vs",
+		Attributes: map[string]string{"name": "/Common/test-vs", "eviction_policy": "/Common/default-eviction-policy", "gtm_score": "20"},
+	}
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":      "/Common/test-vs",
+		"gtm_score": 0,
+	}), nil, nil, true)
+	assert.NoError(t, err)
+	d, err = schema.InternalMap(r.Schema).Data(state, diff)
+	assert.NoError(t, err)
+	assert.NoError(t, setVirtualServerAttachments(context.Background(), d, client, "/Common/test-vs", true))
+	assert.Equal(t, map[string]interface{}{"evictionPolicy": "none", "gtmScore": float64(0)}, body)
+}
+
+func TestResourceBigipLtmVirtualServerReadExpanded(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("expandSubcollections"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"test-vs","partition":"Common","fullPath":"/Common/test-vs",
+			"destination":"/Common/192.168.50.22:443","mask":"255.255.255.255","ipProtocol":"tcp","source":"0.0.0.0/0",
+			"pool":"/Common/web-pool","enabled":true,"translateAddress":"enabled","translatePort":"enabled",
+			"rules":["/Common/rule-b","/Common/rule-a"],
+			"vlans":["/Common/external"],"vlansEnabled":true,
+			"sourceAddressTranslation":{"type":"snat","pool":"/Common/snatpool1"},
+			"persist":[{"name":"source_addr","partition":"Common","tmDefault":"no"},
+				{"name":"cookie","partition":"Common","tmDefault":"yes","nameReference":{"link":"https://localhost/mgmt/tm/ltm/persistence/cookie/~Common~cookie?ver=16.1.0"}}],
+			"fallbackPersistence":"/Common/dest_addr",
+			"lastHopPool":"/Common/lasthop-routers",
+			"cmpEnabled":"no","gtmScore":20,"evictionPolicy":"/Common/default-eviction-policy",
+			"policiesReference":{"items":[{"name":"policy1","fullPath":"/Common/policy1"}]},
+			"profilesReference":{"items":[
+				{"name":"tcp","fullPath":"/Common/tcp","context":"all"},
+				{"name":"http","fullPath":"/Common/http","context":"all"},
+				{"name":"clientssl","fullPath":"/Common/clientssl","context":"clientside"}]}}`)
+	})
+
+	mux.HandleFunc("/mgmt/tm/ltm/persistence/cookie/~Common~cookie", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "defaultsFrom,timeout,mirror,matchAcrossPools,matchAcrossServices,matchAcrossVirtuals", r.URL.Query().Get("$select"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"defaultsFrom":"none","timeout":"180","mirror":"enabled","matchAcrossPools":"disabled",
+			"matchAcrossServices":"disabled","matchAcrossVirtuals":"enabled"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	d := resourceBigipLtmVirtualServer().TestResourceData()
+	d.SetId("/Common/test-vs")
+	assert.False(t, resourceBigipLtmVirtualServerRead(context.Background(), d, client).HasError())
+
+	assert.Equal(t, "192.168.50.22", d.Get("destination"))
+	assert.Equal(t, 443, d.Get("port"))
+	assert.Equal(t, []interface{}{"/Common/rule-b", "/Common/rule-a"}, d.Get("irules"))
+	assert.ElementsMatch(t, []interface{}{"/Common/policy1"}, d.Get("policies").(*schema.Set).List())
+	assert.ElementsMatch(t, []interface{}{"/Common/external"}, d.Get("vlans").(*schema.Set).List())
+	assert.Equal(t, "snat", d.Get("source_address_translation"))
+	assert.Equal(t, "/Common/snatpool1", d.Get("snatpool"))
+	assert.ElementsMatch(t, []interface{}{"/Common/source_addr", "/Common/cookie"}, d.Get("persistence_profiles").(*schema.Set).List())
+	assert.Equal(t, "/Common/cookie", d.Get("default_persistence_profile"))
+	assert.Equal(t, "/Common/dest_addr", d.Get("fallback_persistence_profile"))
+	assert.Equal(t, 2, d.Get("persistence.#"))
+	assert.Equal(t, "/Common/source_addr", d.Get("persistence.0.name"))
+	assert.Equal(t, "", d.Get("persistence.0.type"))
+	assert.Equal(t, "/Common/cookie", d.Get("persistence.1.name"))
+	assert.Equal(t, "cookie", d.Get("persistence.1.type"))
+	assert.Equal(t, true, d.Get("persistence.1.default"))
+	assert.Equal(t, "180", d.Get("persistence.1.timeout"))
+	assert.Equal(t, "enabled", d.Get("persistence.1.mirror"))
+	assert.Equal(t, "enabled", d.Get("persistence.1.match_across_virtuals"))
+	assert.ElementsMatch(t, []interface{}{"/Common/tcp", "/Common/http"}, d.Get("profiles").(*schema.Set).List())
+	assert.ElementsMatch(t, []interface{}{"/Common/clientssl"}, d.Get("client_profiles").(*schema.Set).List())
+	assert.Equal(t, "/Common/lasthop-routers", d.Get("last_hop_pool"))
+	assert.Equal(t, "no", d.Get("cmp_enabled"))
+	assert.Equal(t, 20, d.Get("gtm_score"))
+	assert.Equal(t, "/Common/default-eviction-policy", d.Get("eviction_policy"))
+}
+
+func TestValidateVirtualServerPersistenceOverride(t *testing.T) {
+	r := resourceBigipLtmVirtualServer()
+	config := map[string]interface{}{
+		"name":                        "/Common/test-vs",
+		"destination":                 "10.0.0.1",
+		"port":                        443,
+		"persistence_profiles":        []interface{}{"/Common/cookie"},
+		"default_persistence_profile": "/Common/cookie",
+	}
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+
+	config["persistence_override"] = []interface{}{map[string]interface{}{"timeout": "60", "mirror": "enabled"}}
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "persistence_override is not supported by the BIG-IP")
+		assert.Contains(t, err.Error(), "/Common/cookie")
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriLtmVirtual = "ltm/virtual"
+
+// virtualServerState holds the enabled and disabled flags of a virtual server, exactly one of which
+// is set by the BIG-IP.
+type virtualServerState struct {
+	Enabled  bool `json:"enabled,omitempty"`
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// bigip_ltm_virtual_toggle sets the state of a virtual server it does not own for the time the
+// resource exists, e.g. to disable it during a change window, and puts back the state it found
+// when it is destroyed.
+func resourceBigipLtmVirtualToggle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmVirtualToggleCreate,
+		ReadContext:   resourceBigipLtmVirtualToggleRead,
+		UpdateContext: resourceBigipLtmVirtualToggleUpdate,
+		DeleteContext: resourceBigipLtmVirtualToggleDelete,
+		Schema: map[string]*schema.Schema{
+			"virtual_server": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Full path of the virtual server to toggle",
+			},
+			"desired_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "State the virtual server is set to for as long as the resource exists",
+			},
+			"restore_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Specifies whether destroying the resource restores the state the virtual server had before it was created",
+			},
+			"prior_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the virtual server before the resource was created",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmVirtualToggleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("virtual_server").(string)
+
+	prior, err := getVirtualServerState(client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if prior == "" {
+		return diag.Errorf("virtual server %s not found", name)
+	}
+	_ = d.Set("prior_state", prior)
+
+	if err := setVirtualServerState(ctx, client, name, d.Get("desired_state").(string), "create"); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipLtmVirtualToggleRead(ctx, d, meta)
+}
+
+func resourceBigipLtmVirtualToggleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+
+	state, err := getVirtualServerState(client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if state == "" {
+		tflog.Warn(ctx, fmt.Sprintf("virtual server %s not found, removing the toggle from state", name))
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("virtual_server", name)
+	// A virtual server re-enabled outside Terraform during the window shows as a change to apply.
+	_ = d.Set("desired_state", state)
+	return nil
+}
+
+func resourceBigipLtmVirtualToggleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	if d.HasChange("desired_state") {
+		if err := setVirtualServerState(ctx, client, d.Id(), d.Get("desired_state").(string), "update"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceBigipLtmVirtualToggleRead(ctx, d, meta)
+}
+
+func resourceBigipLtmVirtualToggleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	prior := d.Get("prior_state").(string)
+
+	if !d.Get("restore_on_destroy").(bool) || prior == "" {
+		d.SetId("")
+		return nil
+	}
+	current, err := getVirtualServerState(client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if current == "" {
+		tflog.Warn(ctx, fmt.Sprintf("virtual server %s not found, nothing to restore", name))
+		d.SetId("")
+		return nil
+	}
+	var diags diag.Diagnostics
+	if desired := d.Get("desired_state").(string); current != prior && current != desired {
+		// Someone else changed the virtual server during the window, their change is undone.
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("virtual server %s was %s outside Terraform", name, current),
+			Detail:   fmt.Sprintf("It is set back to %s, the state it had before the toggle was created.", prior),
+		})
+	}
+	if current != prior {
+		if err := setVirtualServerState(ctx, client, name, prior, "delete"); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+	d.SetId("")
+	return diags
+}
+
+// getVirtualServerState returns enabled or disabled, or "" when the virtual server name does not exist.
+func getVirtualServerState(client *bigip.BigIP, name string) (string, error) {
+	vs := &virtualServerState{}
+	found, err := getRestEntity(client, vs, restObjectPath(uriLtmVirtual, name)+"?$select=enabled,disabled")
+	if err != nil {
+		return "", fmt.Errorf("error reading virtual server %s: %v", name, err)
+	}
+	if !found {
+		return "", nil
+	}
+	if vs.Disabled {
+		return "disabled", nil
+	}
+	return "enabled", nil
+}
+
+func setVirtualServerState(ctx context.Context, client *bigip.BigIP, name, state, action string) error {
+	config := &virtualServerState{Enabled: state == "enabled", Disabled: state == "disabled"}
+	apiLog := newAPICallLogger(ctx, "bigip_ltm_virtual_toggle", name, action, icontrolURI(uriLtmVirtual, name))
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriLtmVirtual, name))
+	apiLog.done(err)
+	if err != nil {
+		return fmt.Errorf("error setting virtual server %s %s: %v", name, state, err)
+	}
+	return nil
+}
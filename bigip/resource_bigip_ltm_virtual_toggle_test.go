@@ -0,0 +1,68 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TEST_VIRTUAL_TOGGLE_VS = fmt.Sprintf("/%s/test-toggle-vs", TestPartition)
+
+var TEST_VIRTUAL_TOGGLE_VS_RESOURCE = `
+resource "bigip_ltm_virtual_server" "test-toggle-vs" {
+  name        = "` + TEST_VIRTUAL_TOGGLE_VS + `"
+  destination = "10.255.255.253"
+  port        = 80
+}
+`
+
+var TEST_VIRTUAL_TOGGLE_RESOURCE = TEST_VIRTUAL_TOGGLE_VS_RESOURCE + `
+resource "bigip_ltm_virtual_toggle" "test-toggle" {
+  virtual_server = bigip_ltm_virtual_server.test-toggle-vs.name
+}
+`
+
+func TestAccBigipLtmVirtualToggle_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_VIRTUAL_TOGGLE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_toggle.test-toggle", "prior_state", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_toggle.test-toggle", "desired_state", "disabled"),
+					testCheckVirtualServerState(TEST_VIRTUAL_TOGGLE_VS, "disabled"),
+				),
+			},
+			{
+				Config: TEST_VIRTUAL_TOGGLE_VS_RESOURCE,
+				Check:  testCheckVirtualServerState(TEST_VIRTUAL_TOGGLE_VS, "enabled"),
+			},
+		},
+	})
+}
+
+func testCheckVirtualServerState(name, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		state, err := getVirtualServerState(client, name)
+		if err != nil {
+			return err
+		}
+		if state != expected {
+			return fmt.Errorf("virtual server %s is %s, expected %s", name, state, expected)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockVirtualServerState serves the state of /Common/vs1, starting as initial, and records the PATCH
+// bodies.
+func mockVirtualServerState(initial string) *[]map[string]interface{} {
+	disabled := initial == "disabled"
+	var patches []map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~vs1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			body := map[string]interface{}{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			patches = append(patches, body)
+			disabled = body["disabled"] == true
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if disabled {
+			_, _ = fmt.Fprintf(w, `{"disabled":true}`)
+		} else {
+			_, _ = fmt.Fprintf(w, `{"enabled":true}`)
+		}
+	})
+	return &patches
+}
+
+func TestLtmVirtualToggle(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		initial  string
+		restore  bool
+		expected []map[string]interface{}
+	}{
+		{"restores enabled", "enabled", true, []map[string]interface{}{{"disabled": true}, {"enabled": true}}},
+		{"keeps disabled", "enabled", false, []map[string]interface{}{{"disabled": true}}},
+		{"restores disabled", "disabled", true, []map[string]interface{}{{"disabled": true}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+			patches := mockVirtualServerState(tc.initial)
+
+			client := bigip.NewSession(&bigip.Config{
+				Address:  server.URL,
+				Username: "xxxx",
+				Password: "xxxx",
+			})
+			r := Provider().ResourcesMap["bigip_ltm_virtual_toggle"]
+			d := r.TestResourceData()
+			_ = d.Set("virtual_server", "/Common/vs1")
+			_ = d.Set("desired_state", "disabled")
+			_ = d.Set("restore_on_destroy", tc.restore)
+			assert.False(t, r.CreateContext(context.Background(), d, client).HasError())
+			assert.Equal(t, "/Common/vs1", d.Id())
+			assert.Equal(t, tc.initial, d.Get("prior_state"))
+			assert.Equal(t, "disabled", d.Get("desired_state"))
+
+			assert.False(t, r.DeleteContext(context.Background(), d, client).HasError())
+			assert.Equal(t, "", d.Id())
+			assert.Equal(t, tc.expected, *patches)
+		})
+	}
+}
+
+func TestLtmVirtualToggleEnabledDuringWindow(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		refresh bool
+		warning bool
+	}{
+		// the refresh records the enabled state, destroy restores the prior one without a warning
+		{"refreshed", true, false},
+		// destroy finds a state neither the toggle nor the virtual server had: it warns and restores
+		{"not refreshed", false, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+			patches := mockVirtualServerState("disabled")
+
+			client := bigip.NewSession(&bigip.Config{
+				Address:  server.URL,
+				Username: "xxxx",
+				Password: "xxxx",
+			})
+			r := Provider().ResourcesMap["bigip_ltm_virtual_toggle"]
+			d := r.TestResourceData()
+			_ = d.Set("virtual_server", "/Common/vs1")
+			_ = d.Set("desired_state", "disabled")
+			_ = d.Set("restore_on_destroy", true)
+			assert.False(t, r.CreateContext(context.Background(), d, client).HasError())
+
+			// enabled by hand during the window
+			assert.NoError(t, setVirtualServerState(context.Background(), client, "/Common/vs1", "enabled", "update"))
+			if tc.refresh {
+				assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+				assert.Equal(t, "enabled", d.Get("desired_state"))
+			}
+			diags := r.DeleteContext(context.Background(), d, client)
+			assert.False(t, diags.HasError())
+			if tc.warning {
+				if assert.Len(t, diags, 1) {
+					assert.Equal(t, diag.Warning, diags[0].Severity)
+					assert.Equal(t, "virtual server /Common/vs1 was enabled outside Terraform", diags[0].Summary)
+				}
+			} else {
+				assert.Empty(t, diags)
+			}
+			assert.Equal(t, []map[string]interface{}{{"disabled": true}, {"enabled": true}, {"disabled": true}}, *patches)
+		})
+	}
+}
+
+func TestLtmVirtualToggleNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~vs1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested Virtual Server (/Common/vs1) was not found."}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := Provider().ResourcesMap["bigip_ltm_virtual_toggle"]
+	d := r.TestResourceData()
+	_ = d.Set("virtual_server", "/Common/vs1")
+	diags := r.CreateContext(context.Background(), d, client)
+	if assert.True(t, diags.HasError()) {
+		assert.Equal(t, "virtual server /Common/vs1 not found", diags[0].Summary)
+	}
+}
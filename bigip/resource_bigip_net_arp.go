@@ -0,0 +1,19 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBigipNetArp() *schema.Resource {
+	n := &netNeighbor{
+		resourceType: "bigip_net_arp",
+		label:        "ARP entry",
+		uri:          "net/arp",
+	}
+	return n.resource()
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBigipNetArp_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckNetNeighborsDestroyed("bigip_net_arp", "net/arp"),
+		Steps: []resource.TestStep{
+			{
+				Config: testNetNeighborConfig("bigip_net_arp", "test-arp", "10.10.10.50", "00:50:56:a1:0c:2b"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckNetNeighborExists("net/arp", "/Common/test-arp"),
+					resource.TestCheckResourceAttr("bigip_net_arp.test", "name", "test-arp"),
+					resource.TestCheckResourceAttr("bigip_net_arp.test", "partition", "Common"),
+					resource.TestCheckResourceAttr("bigip_net_arp.test", "ip_address", "10.10.10.50"),
+				),
+			},
+			{
+				Config: testNetNeighborConfig("bigip_net_arp", "test-arp", "10.10.10.50", "00:50:56:a1:0c:2c"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckNetNeighborExists("net/arp", "/Common/test-arp"),
+				),
+			},
+			{
+				ResourceName:      "bigip_net_arp.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipNetArp_invalidAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testNetNeighborConfig("bigip_net_arp", "test-arp", "2001:db8::50", "00:50:56:a1:0c:2b"),
+				ExpectError: regexp.MustCompile("is not an IPv4 address"),
+			},
+			{
+				Config:      testNetNeighborConfig("bigip_net_arp", "test-arp", "10.10.10.50", "00:50:56:a1:0c"),
+				ExpectError: regexp.MustCompile("is not a MAC address"),
+			},
+		},
+	})
+}
+
+func testNetNeighborConfig(resourceType, name, ip, mac string) string {
+	return fmt.Sprintf(`
+resource "%s" "test" {
+  name        = "%s"
+  ip_address  = "%s"
+  mac_address = "%s"
+}
+`, resourceType, name, ip, mac)
+}
+
+func testCheckNetNeighborExists(uri, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &NetNeighbor{}, restObjectPath(uri, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("%s %s was not created", uri, name)
+		}
+		return nil
+	}
+}
+
+func testCheckNetNeighborsDestroyed(resourceType, uri string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			found, err := getRestEntity(client, &NetNeighbor{}, restObjectPath(uri, rs.Primary.ID))
+			if err != nil {
+				return err
+			}
+			if found {
+				return fmt.Errorf("%s %s not destroyed", uri, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriBwcPolicy = "net/bwc/policy"
+
+// BwcPolicy mirrors the net bwc policy (bandwidth controller) object.
+type BwcPolicy struct {
+	Name                string              `json:"name,omitempty"`
+	Partition           string              `json:"partition,omitempty"`
+	FullPath            string              `json:"fullPath,omitempty"`
+	Description         string              `json:"description,omitempty"`
+	Dynamic             string              `json:"dynamic,omitempty"`
+	MaxRate             int                 `json:"maxRate,omitempty"`
+	MaxUserRate         int                 `json:"maxUserRate,omitempty"`
+	Categories          []BwcPolicyCategory `json:"categories"`
+	CategoriesReference *struct {
+		Items []BwcPolicyCategory `json:"items,omitempty"`
+	} `json:"categoriesReference,omitempty"`
+}
+
+// BwcPolicyCategory is a traffic category of a bandwidth controller policy.
+type BwcPolicyCategory struct {
+	Name                 string `json:"name"`
+	MaxCatRate           int    `json:"maxCatRate,omitempty"`
+	MaxCatRatePercentage int    `json:"maxCatRatePercentage,omitempty"`
+}
+
+func resourceBigipNetBwcPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipNetBwcPolicyCreate,
+		ReadContext:   resourceBigipNetBwcPolicyRead,
+		UpdateContext: resourceBigipNetBwcPolicyUpdate,
+		DeleteContext: resourceBigipNetBwcPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the bandwidth controller policy, in full path format e.g. /Common/bwc-10m",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"max_rate": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Specifies the maximum rate of the policy, in bits per second",
+			},
+			"dynamic": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Specifies whether the policy is dynamic, i.e. creates a policy instance per user",
+			},
+			"max_user_rate": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the maximum rate per user of a dynamic policy, in bits per second",
+			},
+			"categories": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Traffic categories of the policy, each with its own maximum rate",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the category",
+						},
+						"max_rate": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Specifies the maximum rate of the category, in bits per second",
+						},
+						"max_rate_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+							Description:  "Specifies the maximum rate of the category as a percentage of the policy max_rate",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipNetBwcPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_net_bwc_policy", name, "create", icontrolURI(uriBwcPolicy, name))
+
+	config := getBwcPolicyConfig(d, &BwcPolicy{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriBwcPolicy)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating BWC policy (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipNetBwcPolicyRead(ctx, d, meta)
+}
+
+func resourceBigipNetBwcPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_net_bwc_policy", name, "read", icontrolURI(uriBwcPolicy, name))
+
+	policy := &BwcPolicy{}
+	found, err := getRestEntity(client, policy, restObjectPath(uriBwcPolicy, name)+"?expandSubcollections=true")
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "BWC Policy not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("description", policy.Description)
+	_ = d.Set("max_rate", policy.MaxRate)
+	_ = d.Set("dynamic", policy.Dynamic)
+	_ = d.Set("max_user_rate", policy.MaxUserRate)
+
+	var categories []interface{}
+	if policy.CategoriesReference != nil {
+		for _, c := range policy.CategoriesReference.Items {
+			categories = append(categories, map[string]interface{}{
+				"name":                c.Name,
+				"max_rate":            c.MaxCatRate,
+				"max_rate_percentage": c.MaxCatRatePercentage,
+			})
+		}
+	}
+	_ = d.Set("categories", categories)
+	return nil
+}
+
+func resourceBigipNetBwcPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_net_bwc_policy", name, "update", icontrolURI(uriBwcPolicy, name))
+
+	config := getBwcPolicyConfig(d, &BwcPolicy{})
+	apiLog.payload(config)
+	err := putRestEntity(client, config, restObjectPath(uriBwcPolicy, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying BWC policy (%s): %s", name, err))
+	}
+	return resourceBigipNetBwcPolicyRead(ctx, d, meta)
+}
+
+func resourceBigipNetBwcPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_net_bwc_policy", name, "delete", icontrolURI(uriBwcPolicy, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriBwcPolicy, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getBwcPolicyConfig(d *schema.ResourceData, config *BwcPolicy) *BwcPolicy {
+	config.Description = d.Get("description").(string)
+	config.MaxRate = d.Get("max_rate").(int)
+	config.Dynamic = d.Get("dynamic").(string)
+	if config.Dynamic == "enabled" {
+		config.MaxUserRate = d.Get("max_user_rate").(int)
+	}
+	config.Categories = []BwcPolicyCategory{}
+	for _, c := range d.Get("categories").([]interface{}) {
+		category := c.(map[string]interface{})
+		config.Categories = append(config.Categories, BwcPolicyCategory{
+			Name:                 category["name"].(string),
+			MaxCatRate:           category["max_rate"].(int),
+			MaxCatRatePercentage: category["max_rate_percentage"].(int),
+		})
+	}
+	return config
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestBwcPolicyName = fmt.Sprintf("/%s/test-bwc-policy", TestPartition)
+
+func TestAccBigipNetBwcPolicy_create(t *testing.T) {
+	resName := "bigip_net_bwc_policy.test-bwc"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBwcPolicyDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBwcPolicyConfig(TestBwcPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBwcPolicyExists(TestBwcPolicyName),
+					resource.TestCheckResourceAttr(resName, "name", TestBwcPolicyName),
+					resource.TestCheckResourceAttr(resName, "max_rate", "10000000"),
+					resource.TestCheckResourceAttr(resName, "dynamic", "enabled"),
+					resource.TestCheckResourceAttr(resName, "max_user_rate", "1000000"),
+					resource.TestCheckResourceAttr(resName, "categories.#", "2"),
+					resource.TestCheckResourceAttr(resName, "categories.0.name", "video"),
+					resource.TestCheckResourceAttr(resName, "categories.1.max_rate_percentage", "30"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs-bwc", "bwc_policy", TestBwcPolicyName),
+				),
+			},
+			{
+				Config: testAccBwcPolicyDetachConfig(TestBwcPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBwcPolicyExists(TestBwcPolicyName),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs-bwc", "bwc_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetBwcPolicy_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBwcPolicyDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBwcPolicyConfig(TestBwcPolicyName),
+			},
+			{
+				ResourceName:      "bigip_net_bwc_policy.test-bwc",
+				ImportStateId:     TestBwcPolicyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBwcPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &BwcPolicy{}, restObjectPath(uriBwcPolicy, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("BWC policy %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBwcPolicyDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_bwc_policy" {
+			continue
+		}
+		name := rs.Primary.ID
+		found, err := getRestEntity(client, &BwcPolicy{}, restObjectPath(uriBwcPolicy, name))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("BWC policy %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func testAccBwcPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "bigip_net_bwc_policy" "test-bwc" {
+  name          = "%s"
+  max_rate      = 10000000
+  dynamic       = "enabled"
+  max_user_rate = 1000000
+  categories {
+    name     = "video"
+    max_rate = 5000000
+  }
+  categories {
+    name                = "web"
+    max_rate_percentage = 30
+  }
+}
+
+resource "bigip_ltm_virtual_server" "test-vs-bwc" {
+  name        = "/Common/test-vs-bwc"
+  destination = "192.168.50.10"
+  port        = 80
+  bwc_policy  = bigip_net_bwc_policy.test-bwc.name
+}
+`, name)
+}
+
+func testAccBwcPolicyDetachConfig(name string) string {
+	return fmt.Sprintf(`
+resource "bigip_net_bwc_policy" "test-bwc" {
+  name          = "%s"
+  max_rate      = 10000000
+  dynamic       = "enabled"
+  max_user_rate = 1000000
+  categories {
+    name     = "video"
+    max_rate = 5000000
+  }
+  categories {
+    name                = "web"
+    max_rate_percentage = 30
+  }
+}
+
+resource "bigip_ltm_virtual_server" "test-vs-bwc" {
+  name        = "/Common/test-vs-bwc"
+  destination = "192.168.50.10"
+  port        = 80
+}
+`, name)
+}
@@ -82,10 +82,12 @@ func resourceBigipNetIkePeer() *schema.Resource {
 				Description: "Specifies the name of the certificate key file object",
 			},
 			"my_cert_key_passphrase": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specifies the passphrase of the key used for my-cert-key-file",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressObfuscatedSecretDiff,
+				Description:      "Specifies the passphrase of the key used for my-cert-key-file",
 			},
 			"my_id_type": {
 				Type:        schema.TypeString,
@@ -160,16 +162,19 @@ func resourceBigipNetIkePeer() *schema.Resource {
 				Description: "Defines the Diffie-Hellman group for key exchange to provide perfect forward secrecy",
 			},
 			"preshared_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
 				//Computed:    true,
 				Description: "Specifies the preshared key for ISAKMP SAs",
 			},
 			"preshared_key_encrypted": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Display the encrypted preshared-key for the IKE remote node",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressObfuscatedSecretDiff,
+				Description:      "Display the encrypted preshared-key for the IKE remote node",
 			},
 			"prf": {
 				Type:        schema.TypeString,
@@ -279,10 +284,10 @@ func resourceBigipNetIkePeerRead(ctx context.Context, d *schema.ResourceData, me
 
 	_ = d.Set("my_cert_key_file", ikepeer.MyCertKeyFile)
 
-	_ = d.Set("my_cert_key_passphrase", ikepeer.MyCertKeyPassphrase)
+	setSecret(d, "my_cert_key_passphrase", ikepeer.MyCertKeyPassphrase)
 
 	if ikepeer.PresharedKey != "" && d.Get("preshared_key").(string) != "" {
-		_ = d.Set("preshared_key", ikepeer.PresharedKey)
+		setSecret(d, "preshared_key", ikepeer.PresharedKey)
 	}
 	_ = d.Set("preshared_key_encrypted", ikepeer.PresharedKeyEncrypted)
 
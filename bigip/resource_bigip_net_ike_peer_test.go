@@ -104,6 +104,44 @@ func TestAccBigipNetIkePeerImport(t *testing.T) {
 	})
 }
 
+func TestAccBigipNetIkePeer_SecretsNoDiff(t *testing.T) {
+	config := `
+resource "bigip_net_ike_peer" "test_ike_peer_secrets" {
+  name                     = "/Common/testpeer-secrets"
+  my_cert_file             = "/Common/default.crt"
+  my_cert_key_file         = "/Common/default.key"
+  my_cert_key_passphrase   = "test123"
+  phase1_auth_method       = "pre-shared-key"
+  phase1_encrypt_algorithm = "aes256"
+  phase1_hash_algorithm    = "sha256"
+  preshared_key            = "s3cr3tk3y"
+  remote_address           = "1.5.3.5"
+  version                  = ["v2"]
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetIkePeerDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testBigipNetIkePeerExists("/Common/testpeer-secrets", true),
+					resource.TestCheckResourceAttr("bigip_net_ike_peer.test_ike_peer_secrets", "preshared_key", "s3cr3tk3y"),
+					resource.TestCheckResourceAttr("bigip_net_ike_peer.test_ike_peer_secrets", "my_cert_key_passphrase", "test123"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testBigipNetIkePeerExists(name string, exists bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
@@ -0,0 +1,53 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBigipNetNdp_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckNetNeighborsDestroyed("bigip_net_ndp", "net/ndp"),
+		Steps: []resource.TestStep{
+			{
+				Config: testNetNeighborConfig("bigip_net_ndp", "test-ndp", "2001:db8::50", "00:50:56:a1:0c:2b"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckNetNeighborExists("net/ndp", "/Common/test-ndp"),
+					resource.TestCheckResourceAttr("bigip_net_ndp.test", "name", "test-ndp"),
+					resource.TestCheckResourceAttr("bigip_net_ndp.test", "ip_address", "2001:db8::50"),
+				),
+			},
+			{
+				ResourceName:      "bigip_net_ndp.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipNetNdp_invalidAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testNetNeighborConfig("bigip_net_ndp", "test-ndp", "10.10.10.50", "00:50:56:a1:0c:2b"),
+				ExpectError: regexp.MustCompile("is not an IPv6 address"),
+			},
+		},
+	})
+}
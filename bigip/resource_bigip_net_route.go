@@ -38,20 +38,23 @@ func resourceBigipNetRoute() *schema.Resource {
 				Description: "Destination network",
 			},
 			"gw": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Gateway address",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Gateway address",
+				ExactlyOneOf: []string{"gw", "tunnel_ref", "reject"},
 			},
 			"tunnel_ref": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validateF5Name,
 				Description:  "tunnel_ref to route traffic",
+				ExactlyOneOf: []string{"gw", "tunnel_ref", "reject"},
 			},
 			"reject": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Description: "reject route",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Description:  "reject route",
+				ExactlyOneOf: []string{"gw", "tunnel_ref", "reject"},
 			},
 		},
 	}
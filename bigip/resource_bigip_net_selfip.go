@@ -16,6 +16,7 @@ import (
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceBigipNetSelfIP() *schema.Resource {
@@ -28,6 +29,7 @@ func resourceBigipNetSelfIP() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateNetSelfIPPortLockdown,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -67,8 +69,20 @@ func resourceBigipNetSelfIP() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Optional:    true,
-				Description: "port lockdown",
+				Optional:      true,
+				ConflictsWith: []string{"allow_default_plus"},
+				Description:   "port lockdown",
+			},
+
+			"allow_default_plus": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringNotInSlice(netSelfIPPortLockdownPresets, false),
+				},
+				Optional:      true,
+				ConflictsWith: []string{"port_lockdown"},
+				Description:   "Services allowed in addition to the default port lockdown services, as protocol:port",
 			},
 		},
 	}
@@ -117,18 +131,38 @@ func resourceBigipNetSelfIPRead(ctx context.Context, d *schema.ResourceData, met
 	_ = d.Set("vlan", selfIP.Vlan)
 	_ = d.Set("ip", selfIP.Address)
 
-	// Extract Traffic Group name from the full path (ignoring /Common/ prefix)
+	// Extract Traffic Group name from the full path (ignoring /Common/ prefix), a traffic group
+	// configured with its full path is kept as configured.
 	regex := regexp.MustCompile(`\/Common\/(.+)`)
-	_ = d.Set("traffic_group", selfIP.TrafficGroup)
-	trafficGroup := regex.FindStringSubmatch(selfIP.TrafficGroup)
-	if len(trafficGroup) > 0 {
-		_ = d.Set("traffic_group", trafficGroup[1])
+	trafficGroup := selfIP.TrafficGroup
+	if match := regex.FindStringSubmatch(selfIP.TrafficGroup); len(match) > 0 {
+		trafficGroup = match[1]
+	}
+	if configured := d.Get("traffic_group").(string); strings.TrimPrefix(configured, "/Common/") == trafficGroup {
+		trafficGroup = configured
+	}
+	_ = d.Set("traffic_group", trafficGroup)
+
+	services := selfIPPortLockdown(selfIP.AllowService)
+	if len(d.Get("allow_default_plus").([]interface{})) > 0 {
+		plus := make([]string, 0, len(services))
+		for _, service := range services {
+			if service != "default" {
+				plus = append(plus, service)
+			}
+		}
+		if len(plus) < len(services) {
+			_ = d.Set("allow_default_plus", plus)
+			_ = d.Set("port_lockdown", nil)
+			return nil
+		}
 	}
-	if selfIP.AllowService == nil {
-		_ = d.Set("port_lockdown", []string{"none"})
-	} else {
-		_ = d.Set("port_lockdown", selfIP.AllowService)
+	_ = d.Set("allow_default_plus", nil)
+	if selfIP.AllowService == nil && len(d.Get("port_lockdown").([]interface{})) == 0 {
+		// none is the default when port_lockdown is not configured
+		services = nil
 	}
+	_ = d.Set("port_lockdown", services)
 	return nil
 }
 
@@ -168,9 +202,30 @@ func resourceBigipNetSelfIPDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// netSelfIPPortLockdownPresets are the port_lockdown values standing for a set of services, all and
+// none cannot be combined with other services, default can.
+var netSelfIPPortLockdownPresets = []string{"all", "none", "default"}
+
+// validateNetSelfIPPortLockdown rejects port_lockdown lists combining all or none with other services.
+func validateNetSelfIPPortLockdown(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	p := d.Get("port_lockdown").([]interface{})
+	if len(p) < 2 {
+		return nil
+	}
+	for _, v := range p {
+		if v == "all" || v == "none" {
+			return fmt.Errorf("port_lockdown %q cannot be combined with other services", v)
+		}
+	}
+	return nil
+}
+
 func getNetSelfIPConfig(d *schema.ResourceData, config *bigip.SelfIP) *bigip.SelfIP {
 	var portLockdown interface{}
 	p := d.Get("port_lockdown").([]interface{})
+	if plus := d.Get("allow_default_plus").([]interface{}); len(plus) > 0 {
+		p = append([]interface{}{"default"}, plus...)
+	}
 
 	if len(p) > 0 {
 		switch p[0] {
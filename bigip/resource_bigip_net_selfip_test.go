@@ -171,6 +171,36 @@ func TestAccBigipNetselfipPortlockdown(t *testing.T) {
 	})
 }
 
+func TestAccBigipNetselfipAllowDefaultPlus(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckselfipsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccselfipAllowDefaultPlus(),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckselfipExists(TEST_SELFIP_NAME),
+					testCheckselfipExists(TEST_FLOAT_SELFIP_NAME),
+					testCheckselfipExists("/Common/test-custom-selfip"),
+					resource.TestCheckResourceAttr("bigip_net_selfip.test-selfip", "traffic_group", "traffic-group-local-only"),
+					resource.TestCheckResourceAttr("bigip_net_selfip.test-selfip", "allow_default_plus.#", "1"),
+					resource.TestCheckResourceAttr("bigip_net_selfip.test-selfip", "allow_default_plus.0", "tcp:8443"),
+					resource.TestCheckResourceAttr("bigip_net_selfip.test-float-selfip", "traffic_group", "/Common/traffic-group-1"),
+					resource.TestCheckResourceAttr("bigip_net_selfip.test-float-selfip", "allow_default_plus.0", "tcp:8443"),
+					resource.TestCheckResourceAttr("bigip_net_selfip.test-custom-selfip", "port_lockdown.#", "2"),
+				),
+			},
+			{
+				Config:   testaccselfipAllowDefaultPlus(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccBigipNetselfipRouteDomain(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -223,6 +253,41 @@ func testaccselfipRouteDomain(ip string) string {
 	return fmt.Sprintf(resPrefix, ip)
 }
 
+func testaccselfipAllowDefaultPlus() string {
+	return `
+resource "bigip_net_vlan" "test-vlan" {
+  name = "` + TestVlanName + `"
+  tag  = 101
+  interfaces {
+    vlanport = 1.1
+    tagged   = true
+  }
+}
+resource "bigip_net_selfip" "test-selfip" {
+  name               = "` + TEST_SELFIP_NAME + `"
+  ip                 = "11.1.1.1/24"
+  vlan               = "/Common/test-vlan"
+  allow_default_plus = ["tcp:8443"]
+  depends_on         = ["bigip_net_vlan.test-vlan"]
+}
+resource "bigip_net_selfip" "test-float-selfip" {
+  name               = "` + TEST_FLOAT_SELFIP_NAME + `"
+  ip                 = "11.1.1.2/24"
+  traffic_group      = "/Common/traffic-group-1"
+  vlan               = "/Common/test-vlan"
+  allow_default_plus = ["tcp:8443"]
+  depends_on         = ["bigip_net_selfip.test-selfip"]
+}
+resource "bigip_net_selfip" "test-custom-selfip" {
+  name          = "/Common/test-custom-selfip"
+  ip            = "11.1.1.3/24"
+  vlan          = "/Common/test-vlan"
+  port_lockdown = ["tcp:4040", "udp:4040"]
+  depends_on    = ["bigip_net_selfip.test-float-selfip"]
+}
+`
+}
+
 func testaccselfipPortLockdownParam(portLockdown string) string {
 	resPrefix := `
 	resource "bigip_net_vlan" "test-vlan" {
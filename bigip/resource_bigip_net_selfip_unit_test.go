@@ -0,0 +1,111 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNetSelfIPConfigPortLockdown(t *testing.T) {
+	for _, tc := range []struct {
+		config   map[string]interface{}
+		expected interface{}
+	}{
+		{map[string]interface{}{"port_lockdown": []interface{}{"all"}}, "all"},
+		{map[string]interface{}{"port_lockdown": []interface{}{"none"}}, nil},
+		{map[string]interface{}{"port_lockdown": []interface{}{"default", "tcp:8443"}}, []interface{}{"default", "tcp:8443"}},
+		{map[string]interface{}{"allow_default_plus": []interface{}{"tcp:8443", "udp:53"}}, []interface{}{"default", "tcp:8443", "udp:53"}},
+		{map[string]interface{}{}, nil},
+	} {
+		tc.config["name"] = "/Common/test-selfip"
+		tc.config["ip"] = "11.1.1.1/24"
+		tc.config["vlan"] = "/Common/test-vlan"
+		d := schema.TestResourceDataRaw(t, resourceBigipNetSelfIP().Schema, tc.config)
+		config := getNetSelfIPConfig(d, &bigip.SelfIP{Name: "/Common/test-selfip"})
+		assert.Equal(t, tc.expected, config.AllowService, "%v", tc.config)
+	}
+}
+
+func TestValidateNetSelfIPPortLockdown(t *testing.T) {
+	r := resourceBigipNetSelfIP()
+	for _, tc := range []struct {
+		portLockdown []interface{}
+		err          string
+	}{
+		{[]interface{}{"all"}, ""},
+		{[]interface{}{"default", "tcp:8443"}, ""},
+		{[]interface{}{"all", "tcp:8443"}, `port_lockdown "all" cannot be combined with other services`},
+		{[]interface{}{"tcp:8443", "none"}, `port_lockdown "none" cannot be combined with other services`},
+	} {
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":          "/Common/test-selfip",
+			"ip":            "11.1.1.1/24",
+			"vlan":          "/Common/test-vlan",
+			"port_lockdown": tc.portLockdown,
+		}), nil)
+		if tc.err == "" {
+			assert.NoError(t, err, "%v", tc.portLockdown)
+		} else {
+			assert.EqualError(t, err, tc.err)
+		}
+	}
+}
+
+func TestResourceBigipNetSelfIPRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	allowService := `["default","tcp:8443"]`
+	mux.HandleFunc("/mgmt/tm/net/self/~Common~test-selfip", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"test-selfip","partition":"Common","fullPath":"/Common/test-selfip","address":"11.1.1.2%%1/24",
+			"floating":"enabled","trafficGroup":"/Common/traffic-group-1","vlan":"/Common/test-vlan","allowService":%s}`, allowService)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	read := func(config map[string]interface{}) *schema.ResourceData {
+		config["name"] = "/Common/test-selfip"
+		config["ip"] = "11.1.1.2%1/24"
+		config["vlan"] = "/Common/test-vlan"
+		d := schema.TestResourceDataRaw(t, resourceBigipNetSelfIP().Schema, config)
+		d.SetId("/Common/test-selfip")
+		assert.False(t, resourceBigipNetSelfIPRead(context.Background(), d, client).HasError())
+		return d
+	}
+
+	// the traffic group of a floating self IP is kept as configured
+	d := read(map[string]interface{}{"traffic_group": "/Common/traffic-group-1", "allow_default_plus": []interface{}{"tcp:8443"}})
+	assert.Equal(t, "/Common/traffic-group-1", d.Get("traffic_group"))
+	assert.Equal(t, []interface{}{"tcp:8443"}, d.Get("allow_default_plus"))
+	assert.Empty(t, d.Get("port_lockdown"))
+
+	d = read(map[string]interface{}{"traffic_group": "traffic-group-1", "port_lockdown": []interface{}{"default", "tcp:8443"}})
+	assert.Equal(t, "traffic-group-1", d.Get("traffic_group"))
+	assert.Equal(t, []interface{}{"default", "tcp:8443"}, d.Get("port_lockdown"))
+	assert.Empty(t, d.Get("allow_default_plus"))
+
+	allowService = `"all"`
+	d = read(map[string]interface{}{"port_lockdown": []interface{}{"all"}})
+	assert.Equal(t, []interface{}{"all"}, d.Get("port_lockdown"))
+
+	allowService = `null`
+	d = read(map[string]interface{}{"port_lockdown": []interface{}{"none"}})
+	assert.Equal(t, []interface{}{"none"}, d.Get("port_lockdown"))
+	d = read(map[string]interface{}{})
+	assert.Empty(t, d.Get("port_lockdown"))
+}
@@ -147,14 +147,14 @@ func resourceBigipNetVlanRead(ctx context.Context, d *schema.ResourceData, meta
 
 	log.Printf("[DEBUG] Reading VLAN %s Interfaces", name)
 
-	vlanInterfaces, err := client.GetVlanInterfaces(name)
+	vlanInterfaces, err := getRestItems[bigip.VlanInterface](client, restObjectPath("net/vlan", name)+"/interfaces")
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error retrieving VLAN %s Interfaces: %v", name, err))
 	}
 
 	var interfaces []map[string]interface{}
 	var ifaceTagged bool
-	for _, iface := range vlanInterfaces.VlanInterfaces {
+	for _, iface := range vlanInterfaces {
 		if iface.Tagged {
 			ifaceTagged = true
 		} else {
@@ -0,0 +1,255 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriSecurityNatPolicy = "security/nat/policy"
+
+// SecurityNatPolicy mirrors the security nat policy object of AFM. The rules are evaluated in the
+// order of the list.
+type SecurityNatPolicy struct {
+	Name           string            `json:"name,omitempty"`
+	Partition      string            `json:"partition,omitempty"`
+	FullPath       string            `json:"fullPath,omitempty"`
+	Description    string            `json:"description,omitempty"`
+	Rules          []SecurityNatRule `json:"rules"`
+	RulesReference *struct {
+		Items []SecurityNatRule `json:"items,omitempty"`
+	} `json:"rulesReference,omitempty"`
+}
+
+// SecurityNatRule is a rule of a NAT policy, translating the traffic matching its source and
+// destination with the referenced source and destination translations.
+type SecurityNatRule struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Source      securityNatRuleMatch   `json:"source"`
+	Destination securityNatRuleMatch   `json:"destination"`
+	Translation securityNatTranslation `json:"translation"`
+	LogProfile  string                 `json:"logProfile,omitempty"`
+}
+
+// securityNatRuleMatch is the source or destination a NAT rule matches on.
+type securityNatRuleMatch struct {
+	Addresses    []securityNatMember `json:"addresses,omitempty"`
+	AddressLists []string            `json:"addressLists,omitempty"`
+}
+
+// securityNatTranslation references the translation objects applied by a NAT rule.
+type securityNatTranslation struct {
+	Source      string `json:"source,omitempty"`
+	Destination string `json:"destination,omitempty"`
+}
+
+func resourceBigipSecurityNatPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSecurityNatPolicyCreate,
+		ReadContext:   resourceBigipSecurityNatPolicyRead,
+		UpdateContext: resourceBigipSecurityNatPolicyUpdate,
+		DeleteContext: resourceBigipSecurityNatPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the NAT policy, in full path format e.g. /Common/outbound-nat",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules of the policy, evaluated in the order of the list",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the rule",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "User defined description of the rule",
+						},
+						"source_addresses": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Source addresses, prefixes or address ranges the rule matches",
+						},
+						"source_address_lists": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Address lists of the source the rule matches, e.g. /Common/internal-nets",
+						},
+						"destination_addresses": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Destination addresses, prefixes or address ranges the rule matches",
+						},
+						"destination_address_lists": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Address lists of the destination the rule matches",
+						},
+						"source_translation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateF5NameWithDirectory,
+							Description:  "Source translation applied to the matching traffic, e.g. /Common/outbound-pat",
+						},
+						"destination_translation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateF5NameWithDirectory,
+							Description:  "Destination translation applied to the matching traffic",
+						},
+						"log_profile": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateF5NameWithDirectory,
+							Description:  "Security logging profile the translations of the rule are logged with",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipSecurityNatPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_security_nat_policy", name, "create", icontrolURI(uriSecurityNatPolicy, name))
+
+	config := getSecurityNatPolicyConfig(d, &SecurityNatPolicy{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriSecurityNatPolicy)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating NAT policy (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipSecurityNatPolicyRead(ctx, d, meta)
+}
+
+func resourceBigipSecurityNatPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_security_nat_policy", name, "read", icontrolURI(uriSecurityNatPolicy, name))
+
+	policy := &SecurityNatPolicy{}
+	found, err := getRestEntity(client, policy, restObjectPath(uriSecurityNatPolicy, name)+"?expandSubcollections=true")
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "NAT policy not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("description", policy.Description)
+
+	var rules []interface{}
+	if policy.RulesReference != nil {
+		for _, r := range policy.RulesReference.Items {
+			rules = append(rules, map[string]interface{}{
+				"name":                      r.Name,
+				"description":               r.Description,
+				"source_addresses":          securityNatMemberNames(r.Source.Addresses),
+				"source_address_lists":      r.Source.AddressLists,
+				"destination_addresses":     securityNatMemberNames(r.Destination.Addresses),
+				"destination_address_lists": r.Destination.AddressLists,
+				"source_translation":        r.Translation.Source,
+				"destination_translation":   r.Translation.Destination,
+				"log_profile":               r.LogProfile,
+			})
+		}
+	}
+	_ = d.Set("rule", rules)
+	return nil
+}
+
+func resourceBigipSecurityNatPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_security_nat_policy", name, "update", icontrolURI(uriSecurityNatPolicy, name))
+
+	config := getSecurityNatPolicyConfig(d, &SecurityNatPolicy{})
+	apiLog.payload(config)
+	err := putRestEntity(client, config, restObjectPath(uriSecurityNatPolicy, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying NAT policy (%s): %s", name, err))
+	}
+	return resourceBigipSecurityNatPolicyRead(ctx, d, meta)
+}
+
+func resourceBigipSecurityNatPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_security_nat_policy", name, "delete", icontrolURI(uriSecurityNatPolicy, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriSecurityNatPolicy, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSecurityNatPolicyConfig(d *schema.ResourceData, config *SecurityNatPolicy) *SecurityNatPolicy {
+	config.Description = d.Get("description").(string)
+	config.Rules = []SecurityNatRule{}
+	for _, r := range d.Get("rule").([]interface{}) {
+		rule := r.(map[string]interface{})
+		config.Rules = append(config.Rules, SecurityNatRule{
+			Name:        rule["name"].(string),
+			Description: rule["description"].(string),
+			Source: securityNatRuleMatch{
+				Addresses:    securityNatMembers(rule["source_addresses"].(*schema.Set).List()),
+				AddressLists: setToStringSlice(rule["source_address_lists"].(*schema.Set)),
+			},
+			Destination: securityNatRuleMatch{
+				Addresses:    securityNatMembers(rule["destination_addresses"].(*schema.Set).List()),
+				AddressLists: setToStringSlice(rule["destination_address_lists"].(*schema.Set)),
+			},
+			Translation: securityNatTranslation{
+				Source:      rule["source_translation"].(string),
+				Destination: rule["destination_translation"].(string),
+			},
+			LogProfile: rule["log_profile"].(string),
+		})
+	}
+	return config
+}
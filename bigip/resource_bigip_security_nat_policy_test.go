@@ -0,0 +1,145 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestSecurityNatSourceTranslationName = fmt.Sprintf("/%s/test-outbound-pat", TestPartition)
+var TestSecurityNatPolicyName = fmt.Sprintf("/%s/test-outbound-nat", TestPartition)
+
+func TestAccBigipSecurityNatPolicy_create(t *testing.T) {
+	var instance testAccInstance
+	resName := "bigip_security_nat_policy.test-nat"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSecurityNatDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityNatPolicyConfig("10.10.0.0/16", "10.20.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSecurityNatExists(uriSecurityNatSourceTranslation, TestSecurityNatSourceTranslationName),
+					testCheckSecurityNatExists(uriSecurityNatPolicy, TestSecurityNatPolicyName),
+					resource.TestCheckResourceAttr("bigip_security_nat_source_translation.test-pat", "type", "dynamic-pat"),
+					resource.TestCheckResourceAttr("bigip_security_nat_source_translation.test-pat", "pat_mode", "napt"),
+					resource.TestCheckResourceAttr(resName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resName, "rule.0.name", "first"),
+					resource.TestCheckResourceAttr(resName, "rule.0.source_translation", TestSecurityNatSourceTranslationName),
+					resource.TestCheckResourceAttr(resName, "rule.1.name", "second"),
+					testCheckResourceNotReplaced(resName, &instance),
+				),
+			},
+			{
+				Config: testAccSecurityNatPolicyConfig("10.30.0.0/16", "10.10.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "rule.0.source_addresses.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resName, "rule.0.source_addresses.*", "10.30.0.0/16"),
+					testCheckResourceNotReplaced(resName, &instance),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSecurityNatPolicy_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSecurityNatDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityNatPolicyConfig("10.10.0.0/16", "10.20.0.0/16"),
+			},
+			{
+				ResourceName:      "bigip_security_nat_policy.test-nat",
+				ImportStateId:     TestSecurityNatPolicyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "bigip_security_nat_source_translation.test-pat",
+				ImportStateId:     TestSecurityNatSourceTranslationName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckSecurityNatExists(uri, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &struct{}{}, restObjectPath(uri, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("%s %s was not created ", uri, name)
+		}
+		return nil
+	}
+}
+
+func testCheckSecurityNatDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	uris := map[string]string{
+		"bigip_security_nat_policy":             uriSecurityNatPolicy,
+		"bigip_security_nat_source_translation": uriSecurityNatSourceTranslation,
+	}
+	for _, rs := range s.RootModule().Resources {
+		uri, ok := uris[rs.Type]
+		if !ok {
+			continue
+		}
+		name := rs.Primary.ID
+		found, err := getRestEntity(client, &struct{}{}, restObjectPath(uri, name))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("%s %s not destroyed ", uri, name)
+		}
+	}
+	return nil
+}
+
+func testAccSecurityNatPolicyConfig(firstSource, secondSource string) string {
+	return fmt.Sprintf(`
+resource "bigip_security_nat_source_translation" "test-pat" {
+  name      = "%s"
+  type      = "dynamic-pat"
+  addresses = ["192.0.2.10", "192.0.2.11"]
+  ports     = ["1024-65535"]
+  pat_mode  = "napt"
+}
+
+resource "bigip_security_nat_policy" "test-nat" {
+  name        = "%s"
+  description = "terraform acceptance test"
+  rule {
+    name               = "first"
+    source_addresses   = ["%s"]
+    source_translation = bigip_security_nat_source_translation.test-pat.name
+  }
+  rule {
+    name               = "second"
+    source_addresses   = ["%s"]
+    source_translation = bigip_security_nat_source_translation.test-pat.name
+  }
+}
+`, TestSecurityNatSourceTranslationName, TestSecurityNatPolicyName, firstSource, secondSource)
+}
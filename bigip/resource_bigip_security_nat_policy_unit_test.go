@@ -0,0 +1,81 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSecurityNatPolicyConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipSecurityNatPolicy().Schema, map[string]interface{}{
+		"name": "/Common/outbound-nat",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"name":                 "web",
+				"source_addresses":     []interface{}{"10.10.0.0/16"},
+				"source_translation":   "/Common/outbound-pat",
+				"log_profile":          "/Common/nat-log",
+				"source_address_lists": []interface{}{"/Common/internal-nets"},
+			},
+			map[string]interface{}{
+				"name":                  "any",
+				"destination_addresses": []interface{}{"0.0.0.0/0"},
+			},
+		},
+	})
+	config := getSecurityNatPolicyConfig(d, &SecurityNatPolicy{Name: "/Common/outbound-nat"})
+	assert.Equal(t, []SecurityNatRule{
+		{
+			Name:        "web",
+			Source:      securityNatRuleMatch{Addresses: []securityNatMember{{Name: "10.10.0.0/16"}}, AddressLists: []string{"/Common/internal-nets"}},
+			Destination: securityNatRuleMatch{Addresses: []securityNatMember{}, AddressLists: []string{}},
+			Translation: securityNatTranslation{Source: "/Common/outbound-pat"},
+			LogProfile:  "/Common/nat-log",
+		},
+		{
+			Name:        "any",
+			Source:      securityNatRuleMatch{Addresses: []securityNatMember{}, AddressLists: []string{}},
+			Destination: securityNatRuleMatch{Addresses: []securityNatMember{{Name: "0.0.0.0/0"}}, AddressLists: []string{}},
+		},
+	}, config.Rules)
+}
+
+func TestResourceBigipSecurityNatPolicyRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/security/nat/policy/~Common~outbound-nat", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("expandSubcollections"))
+		_, _ = fmt.Fprintf(w, `{"name":"outbound-nat","partition":"Common","fullPath":"/Common/outbound-nat","rulesReference":{"items":[
+			{"name":"web","source":{"addresses":[{"name":"10.10.0.0/16"}]},"translation":{"source":"/Common/outbound-pat"}},
+			{"name":"any","destination":{"addressLists":["/Common/public"]},"logProfile":"/Common/nat-log"}
+		]}}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipSecurityNatPolicy()
+	d := r.TestResourceData()
+	d.SetId("/Common/outbound-nat")
+	assert.False(t, resourceBigipSecurityNatPolicyRead(context.Background(), d, client).HasError())
+	assert.Equal(t, 2, d.Get("rule.#"))
+	assert.Equal(t, "web", d.Get("rule.0.name"))
+	assert.Equal(t, "/Common/outbound-pat", d.Get("rule.0.source_translation"))
+	assert.Equal(t, []interface{}{"10.10.0.0/16"}, d.Get("rule.0.source_addresses").(*schema.Set).List())
+	assert.Equal(t, "any", d.Get("rule.1.name"))
+	assert.Equal(t, []interface{}{"/Common/public"}, d.Get("rule.1.destination_address_lists").(*schema.Set).List())
+	assert.Equal(t, "/Common/nat-log", d.Get("rule.1.log_profile"))
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriSecurityNatSourceTranslation = "security/nat/source-translation"
+
+// SecurityNatSourceTranslation mirrors the security nat source-translation object of AFM.
+type SecurityNatSourceTranslation struct {
+	Name        string              `json:"name,omitempty"`
+	Partition   string              `json:"partition,omitempty"`
+	FullPath    string              `json:"fullPath,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Type        string              `json:"type,omitempty"`
+	PatMode     string              `json:"patMode,omitempty"`
+	Addresses   []securityNatMember `json:"addresses"`
+	Ports       []securityNatMember `json:"ports"`
+}
+
+// securityNatMember is an entry of the address and port lists of the AFM NAT objects, an address,
+// prefix or range, or a port or port range.
+type securityNatMember struct {
+	Name string `json:"name"`
+}
+
+func resourceBigipSecurityNatSourceTranslation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSecurityNatSourceTranslationCreate,
+		ReadContext:   resourceBigipSecurityNatSourceTranslationRead,
+		UpdateContext: resourceBigipSecurityNatSourceTranslationUpdate,
+		DeleteContext: resourceBigipSecurityNatSourceTranslationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the source translation, in full path format e.g. /Common/outbound-pat",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"dynamic-pat", "static-nat", "static-pat"}, false),
+				Description:  "Type of the translation: dynamic-pat, static-nat or static-pat",
+			},
+			"addresses": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Addresses, prefixes or address ranges the source is translated to",
+			},
+			"ports": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Ports or port ranges the source port is translated to, e.g. 1024-65535",
+			},
+			"pat_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"napt", "deterministic", "pba"}, false),
+				Description:  "Port address translation mode of a dynamic-pat translation: napt, deterministic or pba",
+			},
+		},
+	}
+}
+
+func resourceBigipSecurityNatSourceTranslationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_security_nat_source_translation", name, "create", icontrolURI(uriSecurityNatSourceTranslation, name))
+
+	config := getSecurityNatSourceTranslationConfig(d, &SecurityNatSourceTranslation{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriSecurityNatSourceTranslation)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating NAT source translation (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipSecurityNatSourceTranslationRead(ctx, d, meta)
+}
+
+func resourceBigipSecurityNatSourceTranslationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_security_nat_source_translation", name, "read", icontrolURI(uriSecurityNatSourceTranslation, name))
+
+	translation := &SecurityNatSourceTranslation{}
+	found, err := getRestEntity(client, translation, restObjectPath(uriSecurityNatSourceTranslation, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "NAT source translation not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("description", translation.Description)
+	_ = d.Set("type", translation.Type)
+	_ = d.Set("pat_mode", translation.PatMode)
+	_ = d.Set("addresses", securityNatMemberNames(translation.Addresses))
+	_ = d.Set("ports", securityNatMemberNames(translation.Ports))
+	return nil
+}
+
+func resourceBigipSecurityNatSourceTranslationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_security_nat_source_translation", name, "update", icontrolURI(uriSecurityNatSourceTranslation, name))
+
+	config := getSecurityNatSourceTranslationConfig(d, &SecurityNatSourceTranslation{})
+	apiLog.payload(config)
+	err := putRestEntity(client, config, restObjectPath(uriSecurityNatSourceTranslation, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying NAT source translation (%s): %s", name, err))
+	}
+	return resourceBigipSecurityNatSourceTranslationRead(ctx, d, meta)
+}
+
+func resourceBigipSecurityNatSourceTranslationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_security_nat_source_translation", name, "delete", icontrolURI(uriSecurityNatSourceTranslation, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriSecurityNatSourceTranslation, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSecurityNatSourceTranslationConfig(d *schema.ResourceData, config *SecurityNatSourceTranslation) *SecurityNatSourceTranslation {
+	config.Description = d.Get("description").(string)
+	config.Type = d.Get("type").(string)
+	config.PatMode = d.Get("pat_mode").(string)
+	config.Addresses = securityNatMembers(d.Get("addresses").(*schema.Set).List())
+	config.Ports = securityNatMembers(d.Get("ports").(*schema.Set).List())
+	return config
+}
+
+func securityNatMembers(names []interface{}) []securityNatMember {
+	members := make([]securityNatMember, 0, len(names))
+	for _, name := range names {
+		members = append(members, securityNatMember{Name: name.(string)})
+	}
+	return members
+}
+
+func securityNatMemberNames(members []securityNatMember) []string {
+	names := make([]string, 0, len(members))
+	for _, m := range members {
+		names = append(names, m.Name)
+	}
+	return names
+}
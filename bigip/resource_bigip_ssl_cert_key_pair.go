@@ -0,0 +1,376 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriSslCertKeyPairCert = "sys/file/ssl-cert"
+
+func resourceBigipSslCertKeyPair() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSslCertKeyPairCreate,
+		ReadContext:   resourceBigipSslCertKeyPairRead,
+		UpdateContext: resourceBigipSslCertKeyPairUpdate,
+		DeleteContext: resourceBigipSslCertKeyPairDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBigipSslCertKeyPairImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the certificate and key objects",
+			},
+			"partition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Common",
+				ForceNew:     true,
+				Description:  "Partition of the certificate and key objects",
+				ValidateFunc: validatePartitionName,
+			},
+			"cert_content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "PEM encoded certificate",
+			},
+			"key_content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key matching the certificate",
+			},
+			"chain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded intermediate chain, installed as a separate certificate object named <name>-chain",
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Passphrase on the key",
+			},
+			"full_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the certificate and key objects",
+			},
+			"chain_full_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the chain certificate object, empty when no chain is configured",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the certificate in RFC 3339 format",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 fingerprint of the certificate as lowercase hex",
+			},
+		},
+	}
+}
+
+func sslCertKeyPairChainName(name string) string {
+	return name + "-chain"
+}
+
+// uploadSslCertKeyPair copies the pair (and chain) to the device's upload
+// directory. It must run before a transaction is started: uploads do not
+// take part in transactions.
+func uploadSslCertKeyPair(client *bigip.BigIP, d *schema.ResourceData) (certSource, keySource, chainSource string, err error) {
+	name := d.Get("name").(string)
+	if _, err = client.UploadBytes([]byte(d.Get("cert_content").(string)), name+".crt"); err != nil {
+		return "", "", "", fmt.Errorf("error uploading certificate %s: %v", name, err)
+	}
+	certSource = "file://" + bigip.REST_DOWNLOAD_PATH + "/" + name + ".crt"
+	if keySource, err = client.UploadKey(name+".key", d.Get("key_content").(string)); err != nil {
+		return "", "", "", fmt.Errorf("error uploading key %s: %v", name, err)
+	}
+	if chain := d.Get("chain").(string); chain != "" {
+		if _, err = client.UploadBytes([]byte(chain), name+".chain.crt"); err != nil {
+			return "", "", "", fmt.Errorf("error uploading chain %s: %v", name, err)
+		}
+		chainSource = "file://" + bigip.REST_DOWNLOAD_PATH + "/" + name + ".chain.crt"
+	}
+	return certSource, keySource, chainSource, nil
+}
+
+// runSslTransaction runs fn inside a single iControl transaction so that the
+// certificate, key and chain are swapped together or not at all.
+func runSslTransaction(client *bigip.BigIP, fn func() error) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+	t, err := client.StartTransaction()
+	if err != nil {
+		return fmt.Errorf("error while starting transaction: %v", err)
+	}
+	if err := fn(); err != nil {
+		client.Transaction = ""
+		return err
+	}
+	if err := client.CommitTransaction(t.TransID); err != nil {
+		return fmt.Errorf("error while committing transaction: %v", err)
+	}
+	return nil
+}
+
+func resourceBigipSslCertKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	partition := d.Get("partition").(string)
+	fullPath := "/" + partition + "/" + name
+
+	certSource, keySource, chainSource, err := uploadSslCertKeyPair(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	apiLog := newAPICallLogger(ctx, "bigip_ssl_cert_key_pair", fullPath, "create", icontrolURI(uriSslCertKeyPairCert, fullPath))
+	err = runSslTransaction(client, func() error {
+		key := &bigip.Key{Name: name, Partition: partition, SourcePath: keySource, Passphrase: d.Get("passphrase").(string)}
+		if err := client.AddKey(key); err != nil {
+			return fmt.Errorf("error adding key %s: %v", fullPath, err)
+		}
+		if err := client.AddCertificate(&bigip.Certificate{Name: name, Partition: partition, SourcePath: certSource}); err != nil {
+			return fmt.Errorf("error adding certificate %s: %v", fullPath, err)
+		}
+		if chainSource != "" {
+			if err := client.AddCertificate(&bigip.Certificate{Name: sslCertKeyPairChainName(name), Partition: partition, SourcePath: chainSource}); err != nil {
+				return fmt.Errorf("error adding chain %s: %v", sslCertKeyPairChainName(fullPath), err)
+			}
+		}
+		return nil
+	})
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fullPath)
+	return resourceBigipSslCertKeyPairRead(ctx, d, meta)
+}
+
+func resourceBigipSslCertKeyPairRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	fullPath := d.Id()
+
+	apiLog := newAPICallLogger(ctx, "bigip_ssl_cert_key_pair", fullPath, "read", icontrolURI(uriSslCertKeyPairCert, fullPath))
+	cert, err := client.GetCertificate(fullPath)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving certificate %s: %v", fullPath, err))
+	}
+	if cert == nil {
+		tflog.Warn(apiLog.ctx, "SSL certificate not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	key, err := client.GetKey(fullPath)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving key %s: %v", fullPath, err))
+	}
+	if key == nil {
+		tflog.Warn(apiLog.ctx, "SSL key not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", cert.Name)
+	_ = d.Set("partition", cert.Partition)
+	_ = d.Set("full_path", cert.FullPath)
+	if d.Get("chain").(string) != "" {
+		_ = d.Set("chain_full_path", sslCertKeyPairChainName(cert.FullPath))
+	} else {
+		_ = d.Set("chain_full_path", "")
+	}
+	if cert.ExpirationDate > 0 {
+		_ = d.Set("expiration_date", time.Unix(cert.ExpirationDate, 0).UTC().Format(time.RFC3339))
+	}
+	_ = d.Set("sha256", sslCertFingerprint(cert.Fingerprint, d.Get("cert_content").(string)))
+	return nil
+}
+
+func resourceBigipSslCertKeyPairUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	partition := d.Get("partition").(string)
+	fullPath := d.Id()
+
+	if !d.HasChanges("cert_content", "key_content", "chain", "passphrase") {
+		return resourceBigipSslCertKeyPairRead(ctx, d, meta)
+	}
+
+	certSource, keySource, chainSource, err := uploadSslCertKeyPair(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	oldChain, _ := d.GetChange("chain")
+
+	apiLog := newAPICallLogger(ctx, "bigip_ssl_cert_key_pair", fullPath, "update", icontrolURI(uriSslCertKeyPairCert, fullPath))
+	err = runSslTransaction(client, func() error {
+		key := &bigip.Key{Name: name, Partition: partition, SourcePath: keySource, Passphrase: d.Get("passphrase").(string)}
+		if err := client.ModifyKey(fullPath, key); err != nil {
+			return fmt.Errorf("error modifying key %s: %v", fullPath, err)
+		}
+		if err := client.ModifyCertificate(fullPath, &bigip.Certificate{SourcePath: certSource}); err != nil {
+			return fmt.Errorf("error modifying certificate %s: %v", fullPath, err)
+		}
+		chainPath := sslCertKeyPairChainName(fullPath)
+		switch {
+		case chainSource != "" && oldChain.(string) == "":
+			if err := client.AddCertificate(&bigip.Certificate{Name: sslCertKeyPairChainName(name), Partition: partition, SourcePath: chainSource}); err != nil {
+				return fmt.Errorf("error adding chain %s: %v", chainPath, err)
+			}
+		case chainSource != "":
+			if err := client.ModifyCertificate(chainPath, &bigip.Certificate{SourcePath: chainSource}); err != nil {
+				return fmt.Errorf("error modifying chain %s: %v", chainPath, err)
+			}
+		case oldChain.(string) != "":
+			if err := client.DeleteCertificate(chainPath); err != nil {
+				return fmt.Errorf("error deleting chain %s: %v", chainPath, err)
+			}
+		}
+		return nil
+	})
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipSslCertKeyPairRead(ctx, d, meta)
+}
+
+func resourceBigipSslCertKeyPairDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	fullPath := d.Id()
+	chainPath := ""
+	if d.Get("chain").(string) != "" {
+		chainPath = sslCertKeyPairChainName(fullPath)
+	}
+
+	profiles, err := sslProfilesUsingCertKeyPair(client, fullPath, chainPath)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing SSL profiles referencing %s: %v", fullPath, err))
+	}
+	if len(profiles) > 0 {
+		return diag.Errorf("certificate/key pair %s is still referenced by SSL profiles: %s", fullPath, strings.Join(profiles, ", "))
+	}
+
+	apiLog := newAPICallLogger(ctx, "bigip_ssl_cert_key_pair", fullPath, "delete", icontrolURI(uriSslCertKeyPairCert, fullPath))
+	err = runSslTransaction(client, func() error {
+		if err := client.DeleteCertificate(fullPath); err != nil {
+			return fmt.Errorf("error deleting certificate %s: %v", fullPath, err)
+		}
+		if err := client.DeleteKey(fullPath); err != nil {
+			return fmt.Errorf("error deleting key %s: %v", fullPath, err)
+		}
+		if chainPath != "" {
+			if err := client.DeleteCertificate(chainPath); err != nil {
+				return fmt.Errorf("error deleting chain %s: %v", chainPath, err)
+			}
+		}
+		return nil
+	})
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourceBigipSslCertKeyPairImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(strings.TrimPrefix(d.Id(), "/"), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid import ID %q, expected /<partition>/<name>", d.Id())
+	}
+	_ = d.Set("partition", parts[0])
+	_ = d.Set("name", parts[1])
+	d.SetId("/" + parts[0] + "/" + parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+type sslProfileCertReferences struct {
+	FullPath     string `json:"fullPath"`
+	Cert         string `json:"cert"`
+	Key          string `json:"key"`
+	Chain        string `json:"chain"`
+	CertKeyChain []struct {
+		Cert  string `json:"cert"`
+		Key   string `json:"key"`
+		Chain string `json:"chain"`
+	} `json:"certKeyChain"`
+}
+
+// sslProfilesUsingCertKeyPair returns the client-ssl and server-ssl profiles
+// referencing any of the given certificate, key or chain paths.
+func sslProfilesUsingCertKeyPair(client *bigip.BigIP, paths ...string) ([]string, error) {
+	used := func(names ...string) bool {
+		for _, n := range names {
+			for _, p := range paths {
+				if p != "" && n == p {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	var names []string
+	for _, uri := range []string{
+		"ltm/profile/client-ssl?$select=fullPath,cert,key,chain,certKeyChain",
+		"ltm/profile/server-ssl?$select=fullPath,cert,key,chain",
+	} {
+		profiles, err := getRestItems[sslProfileCertReferences](client, uri)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range profiles {
+			inUse := used(p.Cert, p.Key, p.Chain)
+			for _, ckc := range p.CertKeyChain {
+				inUse = inUse || used(ckc.Cert, ckc.Key, ckc.Chain)
+			}
+			if inUse {
+				names = append(names, p.FullPath)
+			}
+		}
+	}
+	return names, nil
+}
+
+// sslCertFingerprint normalizes the device fingerprint ("SHA256/AB:CD:...")
+// to lowercase hex, computing it from the PEM content when the device did not
+// report one.
+func sslCertFingerprint(fingerprint, content string) string {
+	if fingerprint != "" {
+		fp := strings.TrimPrefix(fingerprint, "SHA256/")
+		return strings.ToLower(strings.ReplaceAll(fp, ":", ""))
+	}
+	block, _ := pem.Decode([]byte(content))
+	if block == nil {
+		return ""
+	}
+	sum := sha256.Sum256(block.Bytes)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccBigipSslCertKeyPairConfig(cert, key string) string {
+	return fmt.Sprintf(`
+resource "bigip_ssl_cert_key_pair" "test" {
+  name         = "test-cert-key-pair"
+  cert_content = file("%[1]s/../examples/%[2]s")
+  key_content  = file("%[1]s/../examples/%[3]s")
+}
+`, folder, cert, key)
+}
+
+const testAccBigipSslCertKeyPairProfileConfig = `
+resource "bigip_ltm_profile_server_ssl" "test" {
+  name          = "/Common/test-cert-key-pair-ssl"
+  defaults_from = "/Common/serverssl"
+  cert          = "/Common/test-cert-key-pair"
+  key           = "/Common/test-cert-key-pair"
+  %s
+}
+`
+
+func testAccBigipSslCertKeyPairInUseConfig() string {
+	return testAccBigipSslCertKeyPairConfig("servercert.crt", "serverkey.key") +
+		fmt.Sprintf(testAccBigipSslCertKeyPairProfileConfig, "depends_on = [bigip_ssl_cert_key_pair.test]")
+}
+
+func TestAccBigipSslCertKeyPairCreateRotate(t *testing.T) {
+	resName := "bigip_ssl_cert_key_pair.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSslCertKeyPairDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipSslCertKeyPairConfig("servercert.crt", "serverkey.key"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "full_path", "/Common/test-cert-key-pair"),
+					resource.TestCheckResourceAttrSet(resName, "expiration_date"),
+					resource.TestCheckResourceAttrSet(resName, "sha256"),
+				),
+			},
+			{
+				Config: testAccBigipSslCertKeyPairConfig("mycertocspv2.crt", "mycertocspv2.pem"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "full_path", "/Common/test-cert-key-pair"),
+					resource.TestCheckResourceAttrSet(resName, "sha256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSslCertKeyPairDestroyInUse(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipSslCertKeyPairInUseConfig(),
+			},
+			{
+				// the pair is removed while the profile still references it
+				Config:      fmt.Sprintf(testAccBigipSslCertKeyPairProfileConfig, ""),
+				ExpectError: regexp.MustCompile("still referenced by SSL profiles: /Common/test-cert-key-pair-ssl"),
+			},
+			{
+				Config: testAccBigipSslCertKeyPairInUseConfig(),
+			},
+		},
+	})
+}
+
+func testCheckSslCertKeyPairDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ssl_cert_key_pair" {
+			continue
+		}
+		cert, err := client.GetCertificate(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if cert != nil {
+			return fmt.Errorf("certificate %s not destroyed", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSslProfilesUsingCertKeyPair(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/profile/client-ssl", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[
+			{"fullPath":"/Common/clientssl","cert":"/Common/default.crt","key":"/Common/default.key"},
+			{"fullPath":"/Common/cssl1","certKeyChain":[{"cert":"/Common/site","key":"/Common/site","chain":"/Common/site-chain"}]},
+			{"fullPath":"/Common/cssl2","chain":"/Common/site-chain"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/profile/server-ssl", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[
+			{"fullPath":"/Common/serverssl"},
+			{"fullPath":"/Common/sssl1","cert":"/Common/site","key":"/Common/site"}]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	profiles, err := sslProfilesUsingCertKeyPair(client, "/Common/site", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/Common/cssl1", "/Common/sssl1"}, profiles)
+
+	profiles, err = sslProfilesUsingCertKeyPair(client, "/Common/site", "/Common/site-chain")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/Common/cssl1", "/Common/cssl2", "/Common/sssl1"}, profiles)
+
+	profiles, err = sslProfilesUsingCertKeyPair(client, "/Common/other", "")
+	assert.NoError(t, err)
+	assert.Empty(t, profiles)
+}
+
+func TestSslCertFingerprint(t *testing.T) {
+	assert.Equal(t, "ab01cdef", sslCertFingerprint("SHA256/AB:01:CD:EF", ""))
+	// sha256 of the bytes "test", wrapped in a PEM block
+	pemContent := "-----BEGIN CERTIFICATE-----\ndGVzdA==\n-----END CERTIFICATE-----\n"
+	assert.Equal(t, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", sslCertFingerprint("", pemContent))
+	assert.Equal(t, "", sslCertFingerprint("", "not a pem"))
+}
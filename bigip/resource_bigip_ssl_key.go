@@ -10,6 +10,7 @@ import (
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceBigipSslKey() *schema.Resource {
@@ -55,6 +56,19 @@ func resourceBigipSslKey() *schema.Resource {
 				Computed:    true,
 				Description: "Full Path Name of ssl key",
 			},
+			"security_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "normal",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"normal", "fips", "nethsm"}, false),
+				Description:  "Key store the key is installed in: normal, fips or nethsm",
+			},
+			"checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checksum of the key reported by the BIG-IP, empty for keys whose material stays in the FIPS or netHSM key store",
+			},
 		},
 	}
 }
@@ -75,10 +89,11 @@ func resourceBigipSslKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("error in Uploading certificate key (%s): %s", name, err))
 	}
 	certkey := bigip.Key{
-		Name:       name,
-		SourcePath: sourcePath,
-		Partition:  partition,
-		Passphrase: passPhrase,
+		Name:         name,
+		SourcePath:   sourcePath,
+		Partition:    partition,
+		Passphrase:   passPhrase,
+		SecurityType: d.Get("security_type").(string),
 	}
 	log.Printf("[DEBUG] certkey: %+v\n", certkey)
 	err = client.AddKey(&certkey)
@@ -118,6 +133,12 @@ func resourceBigipSslKeyRead(ctx context.Context, d *schema.ResourceData, meta i
 	_ = d.Set("name", certkey.Name)
 	_ = d.Set("partition", certkey.Partition)
 	_ = d.Set("full_path", certkey.FullPath)
+	// The material of FIPS and netHSM keys never leaves the key store, only the
+	// metadata below is compared.
+	if certkey.SecurityType != "" {
+		_ = d.Set("security_type", certkey.SecurityType)
+	}
+	_ = d.Set("checksum", certkey.Checksum)
 	return nil
 }
 
@@ -137,10 +158,11 @@ func resourceBigipSslKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("error in Uploading certificate key (%s): %s", name, err))
 	}
 	certkey := bigip.Key{
-		Name:       name,
-		SourcePath: sourcePath,
-		Partition:  partition,
-		Passphrase: passPhrase,
+		Name:         name,
+		SourcePath:   sourcePath,
+		Partition:    partition,
+		Passphrase:   passPhrase,
+		SecurityType: d.Get("security_type").(string),
 	}
 	keyName := fmt.Sprintf("/%s/%s", partition, name)
 	err = client.ModifyKey(keyName, &certkey)
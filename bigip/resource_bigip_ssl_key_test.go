@@ -48,6 +48,35 @@ func TestAccBigipSslKeyImportToBigip(t *testing.T) {
 	})
 }
 
+var TestSslKeyPassphraseResource = `
+resource "bigip_ssl_key" "test-key-passphrase" {
+        name = "serverkey_passphrase.key"
+        content = "${file("` + folder1 + `/../examples/serverkey_passphrase.key")}"
+        passphrase = "F5site02"
+        partition = "` + TestPartition + `"
+}
+`
+
+func TestAccBigipSslKeyPassphrase(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testChecksslKeyDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestSslKeyPassphraseResource,
+				Check: resource.ComposeTestCheckFunc(
+					testChecksslkeyExists(fmt.Sprintf("/%s/serverkey_passphrase.key", TestPartition), true),
+					resource.TestCheckResourceAttr("bigip_ssl_key.test-key-passphrase", "security_type", "normal"),
+					resource.TestCheckResourceAttrSet("bigip_ssl_key.test-key-passphrase", "checksum"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBigipSslKeyTCs(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -0,0 +1,210 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriSysDaemonLogSettings = "sys/daemon-log-settings"
+
+// sysDaemonLogDaemons are the daemons managed by bigip_sys_daemon_log_settings, one block each.
+var sysDaemonLogDaemons = []string{"tmm", "mcpd", "icrd"}
+
+// sysDaemonLogFields maps the attributes of each daemon block to the fields of its
+// sys daemon-log-settings object.
+var sysDaemonLogFields = map[string]map[string]string{
+	"tmm": {
+		"os_log_level":     "osLogLevel",
+		"arp_log_level":    "arpLogLevel",
+		"http_log_level":   "httpLogLevel",
+		"ip_log_level":     "ipLogLevel",
+		"irule_log_level":  "iruleLogLevel",
+		"layer4_log_level": "layer4LogLevel",
+		"net_log_level":    "netLogLevel",
+		"ssl_log_level":    "sslLogLevel",
+	},
+	"mcpd": {
+		"log_level": "logLevel",
+	},
+	"icrd": {
+		"log_level": "logLevel",
+	},
+}
+
+// sysDaemonLogDefaults are the BIG-IP default log levels, restored when a daemon is no longer managed.
+var sysDaemonLogDefaults = map[string]map[string]string{
+	"tmm": {
+		"osLogLevel":     "notice",
+		"arpLogLevel":    "warning",
+		"httpLogLevel":   "error",
+		"ipLogLevel":     "warning",
+		"iruleLogLevel":  "informational",
+		"layer4LogLevel": "notice",
+		"netLogLevel":    "warning",
+		"sslLogLevel":    "warning",
+	},
+	"mcpd": {
+		"logLevel": "notice",
+	},
+	"icrd": {
+		"logLevel": "notice",
+	},
+}
+
+var sysLogLevels = []string{"emergency", "alert", "critical", "error", "warning", "notice", "informational", "debug"}
+
+func resourceBigipSysDaemonLogSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysDaemonLogSettingsCreate,
+		ReadContext:   resourceBigipSysDaemonLogSettingsRead,
+		UpdateContext: resourceBigipSysDaemonLogSettingsUpdate,
+		DeleteContext: resourceBigipSysDaemonLogSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"tmm":  sysDaemonLogBlock("tmm", "Log levels of the traffic management microkernel"),
+			"mcpd": sysDaemonLogBlock("mcpd", "Log level of the master control program daemon"),
+			"icrd": sysDaemonLogBlock("icrd", "Log level of the iControl REST daemon"),
+		},
+	}
+}
+
+func sysDaemonLogBlock(daemon, description string) *schema.Schema {
+	fields := make(map[string]*schema.Schema)
+	for attr := range sysDaemonLogFields[daemon] {
+		fields[attr] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(sysLogLevels, false),
+			Description:  fmt.Sprintf("Minimum level of the %s messages logged", daemon),
+		}
+	}
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		AtLeastOneOf: sysDaemonLogDaemons,
+		Description:  description,
+		Elem:         &schema.Resource{Schema: fields},
+	}
+}
+
+func resourceBigipSysDaemonLogSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	for _, daemon := range sysDaemonLogDaemons {
+		if err := setSysDaemonLogLevels(ctx, client, daemon, "create", getSysDaemonLogConfig(d, daemon)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("daemon-log-settings")
+	return resourceBigipSysDaemonLogSettingsRead(ctx, d, meta)
+}
+
+func resourceBigipSysDaemonLogSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	// An imported resource has no block in its state yet, all the daemons are read then.
+	importing := true
+	for _, daemon := range sysDaemonLogDaemons {
+		if len(d.Get(daemon).([]interface{})) > 0 {
+			importing = false
+		}
+	}
+	for _, daemon := range sysDaemonLogDaemons {
+		if !importing && len(d.Get(daemon).([]interface{})) == 0 {
+			continue
+		}
+		uri := uriSysDaemonLogSettings + "/" + daemon
+		apiLog := newAPICallLogger(ctx, "bigip_sys_daemon_log_settings", daemon, "read", "/mgmt/tm/"+uri)
+		settings := make(map[string]interface{})
+		_, err := getRestEntity(client, &settings, uri)
+		apiLog.done(err)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		block := make(map[string]interface{})
+		for attr, field := range sysDaemonLogFields[daemon] {
+			block[attr], _ = settings[field].(string)
+		}
+		if err := d.Set(daemon, []interface{}{block}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting %s log levels: %s", daemon, err))
+		}
+	}
+	return nil
+}
+
+func resourceBigipSysDaemonLogSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	for _, daemon := range sysDaemonLogDaemons {
+		if !d.HasChange(daemon) {
+			continue
+		}
+		config := getSysDaemonLogConfig(d, daemon)
+		if config == nil {
+			// the block was removed, its daemon is no longer managed
+			config = sysDaemonLogDefaults[daemon]
+		}
+		if err := setSysDaemonLogLevels(ctx, client, daemon, "update", config); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceBigipSysDaemonLogSettingsRead(ctx, d, meta)
+}
+
+func resourceBigipSysDaemonLogSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	// The settings cannot be deleted, the log levels of the managed daemons are reset to their defaults.
+	for _, daemon := range sysDaemonLogDaemons {
+		if len(d.Get(daemon).([]interface{})) == 0 {
+			continue
+		}
+		if err := setSysDaemonLogLevels(ctx, client, daemon, "delete", sysDaemonLogDefaults[daemon]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func setSysDaemonLogLevels(ctx context.Context, client *bigip.BigIP, daemon, op string, config map[string]string) error {
+	if len(config) == 0 {
+		return nil
+	}
+	uri := uriSysDaemonLogSettings + "/" + daemon
+	apiLog := newAPICallLogger(ctx, "bigip_sys_daemon_log_settings", daemon, op, "/mgmt/tm/"+uri)
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, uri)
+	apiLog.done(err)
+	if err != nil {
+		return fmt.Errorf("error setting %s log levels: %s", daemon, err)
+	}
+	return nil
+}
+
+// getSysDaemonLogConfig returns the log levels set in the block of daemon, or nil when the daemon
+// has no block. Levels left empty are not sent, the device keeps its current value.
+func getSysDaemonLogConfig(d *schema.ResourceData, daemon string) map[string]string {
+	blocks := d.Get(daemon).([]interface{})
+	if len(blocks) == 0 {
+		return nil
+	}
+	config := make(map[string]string)
+	block, _ := blocks[0].(map[string]interface{})
+	for attr, field := range sysDaemonLogFields[daemon] {
+		if level, _ := block[attr].(string); level != "" {
+			config[field] = level
+		}
+	}
+	return config
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccBigipSysDaemonLogSettingsConfig(sslLevel, mcpdLevel string) string {
+	return fmt.Sprintf(`
+resource "bigip_sys_daemon_log_settings" "debug" {
+  tmm {
+    ssl_log_level = "%s"
+  }
+  mcpd {
+    log_level = "%s"
+  }
+}
+`, sslLevel, mcpdLevel)
+}
+
+func TestAccBigipSysDaemonLogSettings_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckDaemonLogLevel("tmm", "sslLogLevel", "warning"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipSysDaemonLogSettingsConfig("debug", "informational"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDaemonLogLevel("tmm", "sslLogLevel", "debug"),
+					testCheckDaemonLogLevel("mcpd", "logLevel", "informational"),
+					resource.TestCheckResourceAttr("bigip_sys_daemon_log_settings.debug", "tmm.0.ssl_log_level", "debug"),
+					resource.TestCheckResourceAttrSet("bigip_sys_daemon_log_settings.debug", "tmm.0.os_log_level"),
+					resource.TestCheckResourceAttr("bigip_sys_daemon_log_settings.debug", "mcpd.0.log_level", "informational"),
+				),
+			},
+			{
+				Config: testAccBigipSysDaemonLogSettingsConfig("informational", "debug"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDaemonLogLevel("tmm", "sslLogLevel", "informational"),
+					testCheckDaemonLogLevel("mcpd", "logLevel", "debug"),
+				),
+			},
+			{
+				ResourceName:      "bigip_sys_daemon_log_settings.debug",
+				ImportStateId:     "daemon-log-settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the import reads all the daemons, icrd included
+				ImportStateVerifyIgnore: []string{"icrd"},
+			},
+		},
+	})
+}
+
+func testCheckDaemonLogLevel(daemon, field, level string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		settings := make(map[string]interface{})
+		if _, err := getRestEntity(client, &settings, uriSysDaemonLogSettings+"/"+daemon); err != nil {
+			return err
+		}
+		if settings[field] != level {
+			return fmt.Errorf("%s %s is %v, expected %s", daemon, field, settings[field], level)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceBigipSysDaemonLogSettingsLifecycle(t *testing.T) {
+	setup()
+	defer teardown()
+
+	patched := make(map[string][]map[string]string)
+	for _, daemon := range sysDaemonLogDaemons {
+		daemon := daemon
+		mux.HandleFunc("/mgmt/tm/sys/daemon-log-settings/"+daemon, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPatch {
+				body := make(map[string]string)
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				patched[daemon] = append(patched[daemon], body)
+			}
+			_, _ = fmt.Fprintf(w, `{"osLogLevel":"notice","sslLogLevel":"debug","arpLogLevel":"warning","logLevel":"notice"}`)
+		})
+	}
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBigipSysDaemonLogSettings().Schema, map[string]interface{}{
+		"tmm": []interface{}{map[string]interface{}{"ssl_log_level": "debug"}},
+	})
+	diags := resourceBigipSysDaemonLogSettingsCreate(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []map[string]string{{"sslLogLevel": "debug"}}, patched["tmm"])
+	assert.Empty(t, patched["mcpd"])
+	assert.Equal(t, "debug", d.Get("tmm.0.ssl_log_level"))
+	assert.Equal(t, "warning", d.Get("tmm.0.arp_log_level"))
+	assert.Empty(t, d.Get("mcpd"))
+
+	diags = resourceBigipSysDaemonLogSettingsDelete(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, sysDaemonLogDefaults["tmm"], patched["tmm"][1])
+	assert.Empty(t, patched["icrd"])
+}
+
+func TestResourceBigipSysDaemonLogSettingsImport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/sys/daemon-log-settings/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"osLogLevel":"notice","logLevel":"informational"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBigipSysDaemonLogSettings().Schema, map[string]interface{}{})
+	d.SetId("daemon-log-settings")
+	diags := resourceBigipSysDaemonLogSettingsRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "notice", d.Get("tmm.0.os_log_level"))
+	assert.Equal(t, "informational", d.Get("mcpd.0.log_level"))
+	assert.Equal(t, "informational", d.Get("icrd.0.log_level"))
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	uriSysHttpd = "sys/httpd"
+	// deviceCertUploadName is the name the certificate and key are uploaded under, with the .crt and
+	// .key extensions, before they are moved to the files httpd serves.
+	deviceCertUploadName = "terraform-device-cert"
+)
+
+// deviceCertPollInterval is the delay between two checks of the management interface while httpd
+// restarts with the new certificate.
+var deviceCertPollInterval = 5 * time.Second
+
+// SysHttpd holds the files of the certificate and key served by httpd on the management interface.
+type SysHttpd struct {
+	SslCertfile    string `json:"sslCertfile,omitempty"`
+	SslCertkeyfile string `json:"sslCertkeyfile,omitempty"`
+}
+
+// bigip_sys_device_cert manages the certificate of the management interface, the one the provider
+// itself connects to. Without certificate the resource only reports the certificate in use, e.g. the
+// self-signed one of a new device, and warns before it expires.
+func resourceBigipSysDeviceCert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysDeviceCertCreate,
+		ReadContext:   resourceBigipSysDeviceCertRead,
+		UpdateContext: resourceBigipSysDeviceCertUpdate,
+		DeleteContext: resourceBigipSysDeviceCertDelete,
+		CustomizeDiff: deviceCertCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"private_key"},
+				Description:  "PEM encoded certificate, followed by its chain if any, installed for the management interface. Without it the certificate in use is only monitored",
+			},
+			"private_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"certificate"},
+				Description:  "PEM encoded private key of certificate",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of seconds to wait for the management interface to come back after httpd is restarted with the new certificate",
+			},
+			"expiration_warning_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of days before the expiration of the certificate in use from which a warning is reported, 0 disables the warning",
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 fingerprint of the certificate presented by the management interface",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject of the certificate presented by the management interface",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Issuer of the certificate presented by the management interface",
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the certificate presented by the management interface, in RFC 3339 format",
+			},
+		},
+	}
+}
+
+func resourceBigipSysDeviceCertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	if _, ok := d.GetOk("certificate"); ok {
+		if err := installDeviceCert(ctx, d, client, "create"); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId("device-cert")
+		return resourceBigipSysDeviceCertReadInstalled(ctx, d, meta)
+	}
+	d.SetId("device-cert")
+	return resourceBigipSysDeviceCertRead(ctx, d, meta)
+}
+
+func resourceBigipSysDeviceCertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	cert, _, err := presentedDeviceCert(ctx, client, client.Transport)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading the certificate of the management interface: %v", err))
+	}
+	setDeviceCertAttributes(d, cert)
+	diags := deviceCertExpirationWarning(cert, d.Get("expiration_warning_days").(int), time.Now())
+
+	// A certificate replaced outside Terraform, or by a reset to the default one, shows as a change
+	// of fingerprint, see deviceCertCustomizeDiff, and is installed again.
+	if configured := d.Get("certificate").(string); configured != "" {
+		if leaf, err := parseDeviceCertLeaf(configured); err == nil && !leaf.Equal(cert) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Management interface certificate is not the configured one",
+				Detail: fmt.Sprintf("The management interface presents certificate %s instead of the configured certificate %s. "+
+					"The next apply installs the configured certificate again and restarts httpd, which briefly interrupts the management interface.",
+					certificateFingerprint(cert.Raw), certificateFingerprint(leaf.Raw)),
+			})
+		}
+	}
+	return diags
+}
+
+// resourceBigipSysDeviceCertReadInstalled reads the certificate just installed. A provider pinning
+// the certificate with tls_server_fingerprint cannot connect to the BIG-IP until the fingerprint is
+// updated, so the state is set from the configured certificate, which the management interface was
+// checked to present, and a warning asks for the new fingerprint.
+func resourceBigipSysDeviceCertReadInstalled(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	if !deviceCertPinned(client) {
+		return resourceBigipSysDeviceCertRead(ctx, d, meta)
+	}
+	leaf, err := parseDeviceCertLeaf(d.Get("certificate").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	setDeviceCertAttributes(d, leaf)
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "tls_server_fingerprint pins the replaced certificate",
+			Detail: fmt.Sprintf("The management interface now presents certificate %s. Set tls_server_fingerprint to it, "+
+				"the provider refuses to connect to the BIG-IP until then.", certificateFingerprint(leaf.Raw)),
+		},
+	}
+}
+
+func resourceBigipSysDeviceCertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	if d.HasChanges("certificate", "private_key", "fingerprint") && d.Get("certificate").(string) != "" {
+		if err := installDeviceCert(ctx, d, client, "update"); err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceBigipSysDeviceCertReadInstalled(ctx, d, meta)
+	}
+	return resourceBigipSysDeviceCertRead(ctx, d, meta)
+}
+
+// deviceCertCustomizeDiff plans the attributes of the configured certificate, so that a certificate
+// presented instead of it shows as a change of fingerprint and is installed again.
+func deviceCertCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("certificate") {
+		return d.SetNewComputed("fingerprint")
+	}
+	leaf, err := parseDeviceCertLeaf(d.Get("certificate").(string))
+	if err != nil || certificateFingerprint(leaf.Raw) == d.Get("fingerprint").(string) {
+		// without certificate, or with an invalid one reported on apply, the attributes are only read
+		return nil
+	}
+	for attr, value := range deviceCertAttributes(leaf) {
+		if err := d.SetNew(attr, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deviceCertAttributes(cert *x509.Certificate) map[string]string {
+	return map[string]string{
+		"fingerprint": certificateFingerprint(cert.Raw),
+		"subject":     cert.Subject.String(),
+		"issuer":      cert.Issuer.String(),
+		"expiration":  cert.NotAfter.UTC().Format(time.RFC3339),
+	}
+}
+
+func setDeviceCertAttributes(d *schema.ResourceData, cert *x509.Certificate) {
+	for attr, value := range deviceCertAttributes(cert) {
+		_ = d.Set(attr, value)
+	}
+}
+
+// resourceBigipSysDeviceCertDelete leaves the installed certificate in place, the management
+// interface cannot go without one.
+func resourceBigipSysDeviceCertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// deviceCertExpirationWarning warns when cert expires within days of now.
+func deviceCertExpirationWarning(cert *x509.Certificate, days int, now time.Time) diag.Diagnostics {
+	if days == 0 || cert.NotAfter.After(now.AddDate(0, 0, days)) {
+		return nil
+	}
+	summary := "Management interface certificate expires soon"
+	if cert.NotAfter.Before(now) {
+		summary = "Management interface certificate has expired"
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail: fmt.Sprintf("The certificate %s of the management interface expires on %s. Set certificate and private_key "+
+				"to install a new one.", cert.Subject, cert.NotAfter.UTC().Format(time.RFC3339)),
+		},
+	}
+}
+
+// installDeviceCert uploads the certificate and key of d, moves them to the files served by httpd
+// and restarts it, then waits for the management interface to present the new certificate and for
+// iControl REST to answer again.
+func installDeviceCert(ctx context.Context, d *schema.ResourceData, client *bigip.BigIP, action string) error {
+	certificate := d.Get("certificate").(string)
+	privateKey := d.Get("private_key").(string)
+	if _, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey)); err != nil {
+		return fmt.Errorf("error reading the device certificate: %v", err)
+	}
+	leaf, err := parseDeviceCertLeaf(certificate)
+	if err != nil {
+		return err
+	}
+	if err := checkDeviceCertTrusted(client, certificate, leaf); err != nil {
+		return err
+	}
+
+	httpd := &SysHttpd{}
+	if _, err := getRestEntity(client, httpd, uriSysHttpd+"?$select=sslCertfile,sslCertkeyfile"); err != nil {
+		return fmt.Errorf("error reading the httpd certificate files: %v", err)
+	}
+	if httpd.SslCertfile == "" || httpd.SslCertkeyfile == "" {
+		return fmt.Errorf("error reading the httpd certificate files: %s has no sslCertfile or sslCertkeyfile", uriSysHttpd)
+	}
+	if _, err := client.UploadBytes([]byte(certificate), deviceCertUploadName+".crt"); err != nil {
+		return fmt.Errorf("error uploading the device certificate: %v", err)
+	}
+	if _, err := client.UploadBytes([]byte(privateKey), deviceCertUploadName+".key"); err != nil {
+		return fmt.Errorf("error uploading the device certificate key: %v", err)
+	}
+
+	// httpd is restarted in the background once the command has answered, the restart drops the
+	// connection the command runs on.
+	script := fmt.Sprintf("install -m 0644 %[1]s/%[2]s.crt %[3]s && install -m 0600 %[1]s/%[2]s.key %[4]s && "+
+		"rm -f %[1]s/%[2]s.crt %[1]s/%[2]s.key && "+
+		"(nohup sh -c 'sleep 2; tmsh restart sys service httpd' >/dev/null 2>&1 &)",
+		uriRestDownloads, deviceCertUploadName, httpd.SslCertfile, httpd.SslCertkeyfile)
+	apiLog := newAPICallLogger(ctx, "bigip_sys_device_cert", httpd.SslCertfile, action, "/mgmt/tm/util/bash")
+	apiLog.payload(script)
+	out, err := runBashCommand(client, script)
+	if err == nil && out != "" {
+		err = fmt.Errorf("error installing the device certificate: %s", out)
+	}
+	if err == nil {
+		timeout := time.Duration(d.Get("timeout").(int)) * time.Second
+		err = waitDeviceCert(apiLog.ctx, client, leaf, timeout)
+	}
+	apiLog.done(err)
+	return err
+}
+
+// waitDeviceCert polls the management interface until it presents leaf and iControl REST answers.
+// The polls trust leaf alone, whatever the provider verifies: a provider pinning the replaced
+// certificate, see resourceBigipSysDeviceCertReadInstalled, would refuse every one. The failures
+// while httpd restarts are expected and only logged.
+func waitDeviceCert(ctx context.Context, client *bigip.BigIP, leaf *x509.Certificate, timeout time.Duration) error {
+	fingerprint := certificateFingerprint(leaf.Raw)
+	transport := client.Transport.Clone()
+	transport.TLSClientConfig.InsecureSkipVerify = true
+	transport.TLSClientConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return fmt.Errorf("the management interface presented no certificate")
+		}
+		if actual := certificateFingerprint(rawCerts[0]); actual != fingerprint {
+			return fmt.Errorf("the management interface still presents certificate %s", actual)
+		}
+		return nil
+	}
+	rt := hookTransport(client, transport)
+	defer transport.CloseIdleConnections()
+
+	deadline := time.Now().Add(timeout)
+	for {
+		_, status, err := presentedDeviceCert(ctx, client, rt)
+		if err == nil && status != http.StatusOK {
+			err = fmt.Errorf("iControl REST answered with status %d", status)
+		}
+		if err == nil {
+			return nil
+		}
+		tflog.Debug(ctx, fmt.Sprintf("waiting for httpd to restart with the new certificate: %v", err))
+		if time.Now().After(deadline) {
+			return fmt.Errorf("the management interface did not come back with the new certificate after %s: %v", timeout, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(deviceCertPollInterval):
+		}
+	}
+}
+
+// presentedDeviceCert returns the leaf certificate presented by the management interface of client
+// and the status of the iControl REST call it was read from, sent through rt with the credentials of
+// client.
+func presentedDeviceCert(ctx context.Context, client *bigip.BigIP, rt http.RoundTripper) (*x509.Certificate, int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.Host+"/mgmt/tm/sys/version", nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if client.Token != "" {
+		req.Header.Set("X-F5-Auth-Token", client.Token)
+	} else {
+		req.SetBasicAuth(client.User, client.Password)
+	}
+	resp, err := (&http.Client{Transport: rt, Timeout: 30 * time.Second}).Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.TLS == nil || len(resp.TLS.PeerCertificates) == 0 {
+		return nil, 0, fmt.Errorf("%s presented no certificate", client.Host)
+	}
+	return resp.TLS.PeerCertificates[0], resp.StatusCode, nil
+}
+
+// deviceCertPinned reports whether the provider verifies the BIG-IP by the fingerprint of its
+// certificate, see pinServerCertificate.
+func deviceCertPinned(client *bigip.BigIP) bool {
+	tlsConfig := client.Transport.TLSClientConfig
+	return tlsConfig != nil && tlsConfig.VerifyPeerCertificate != nil
+}
+
+// checkDeviceCertTrusted fails when the provider verifies the BIG-IP certificate with its trusted
+// CAs and would not trust leaf, the certificate of the PEM chain certificate: the provider could
+// not connect to the BIG-IP any more once it is installed.
+func checkDeviceCertTrusted(client *bigip.BigIP, certificate string, leaf *x509.Certificate) error {
+	tlsConfig := client.Transport.TLSClientConfig
+	if tlsConfig == nil || tlsConfig.InsecureSkipVerify {
+		return nil
+	}
+	u, err := url.Parse(client.Host)
+	if err != nil {
+		return err
+	}
+	opts := x509.VerifyOptions{Roots: tlsConfig.RootCAs, Intermediates: x509.NewCertPool(), DNSName: u.Hostname()}
+	if tlsConfig.ServerName != "" {
+		opts.DNSName = tlsConfig.ServerName
+	}
+	rest := []byte(certificate)
+	for {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			break
+		}
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil && !cert.Equal(leaf) {
+			opts.Intermediates.AddCert(cert)
+		}
+	}
+	if _, err := leaf.Verify(opts); err != nil {
+		return fmt.Errorf("the provider would not trust the new device certificate, so could not connect to the BIG-IP once it is installed: %v. "+
+			"Add its CA to trusted_cert_path, or set tls_server_fingerprint, before installing it", err)
+	}
+	return nil
+}
+
+// parseDeviceCertLeaf returns the first certificate of the PEM content.
+func parseDeviceCertLeaf(content string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(content))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("error reading the device certificate: no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Installing a certificate restarts httpd on the test device, only the monitoring is covered here.
+func TestAccBigipSysDeviceCert_monitorOnly(t *testing.T) {
+	res := "bigip_sys_device_cert.mgmt"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigipSysDeviceCertConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(res, "fingerprint"),
+					resource.TestCheckResourceAttrSet(res, "subject"),
+					resource.TestCheckResourceAttrSet(res, "expiration"),
+				),
+			},
+			{
+				Config:   testAccBigipSysDeviceCertConfig,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccBigipSysDeviceCertConfig = `
+resource "bigip_sys_device_cert" "mgmt" {
+  expiration_warning_days = 0
+}
+`
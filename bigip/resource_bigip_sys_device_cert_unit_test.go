@@ -0,0 +1,244 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// testDeviceCertServer starts a BIG-IP whose management interface presents the certificate certPEM.
+func testDeviceCertServer(t *testing.T, mux *http.ServeMux, certPEM, keyPEM string) (*httptest.Server, *bigip.BigIP) {
+	cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	assert.NoError(t, err)
+	server := httptest.NewUnstartedServer(mux)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	server.StartTLS()
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx", CertVerifyDisable: true})
+	return server, client
+}
+
+func TestResourceBigipSysDeviceCertMonitorOnly(t *testing.T) {
+	certPEM, keyPEM := testClientCertificatePEM(t, "bigip1.example.com")
+	server, client := testDeviceCertServer(t, http.NewServeMux(), certPEM, keyPEM)
+	defer server.Close()
+	// the certificate is read through the transport of the provider and its hooks
+	audit := &bytes.Buffer{}
+	installTransportHooks(client, &transportHooks{audit: &auditLog{w: audit}})
+
+	r := resourceBigipSysDeviceCert()
+	d := r.TestResourceData()
+	_ = d.Set("expiration_warning_days", 30)
+	diags := r.CreateContext(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	// the test certificate expires within the hour
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "Management interface certificate expires soon", diags[0].Summary)
+	}
+	leaf, err := parseDeviceCertLeaf(certPEM)
+	assert.NoError(t, err)
+	assert.Equal(t, "device-cert", d.Id())
+	assert.Equal(t, certificateFingerprint(leaf.Raw), d.Get("fingerprint"))
+	assert.Equal(t, "CN=bigip1.example.com", d.Get("subject"))
+	assert.Equal(t, leaf.NotAfter.UTC().Format(time.RFC3339), d.Get("expiration"))
+	assert.Contains(t, audit.String(), `"uri":"/mgmt/tm/sys/version"`)
+
+	_ = d.Set("expiration_warning_days", 0)
+	assert.Empty(t, r.ReadContext(context.Background(), d, client))
+}
+
+func TestResourceBigipSysDeviceCertInstall(t *testing.T) {
+	certPEM, keyPEM := testClientCertificatePEM(t, "bigip1.example.com")
+	uploads := map[string]string{}
+	var script string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mgmt/tm/sys/httpd", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"sslCertfile":"/etc/httpd/conf/ssl.crt/server.crt","sslCertkeyfile":"/etc/httpd/conf/ssl.key/server.key"}`)
+	})
+	mux.HandleFunc("/mgmt/shared/file-transfer/uploads/", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		uploads[r.URL.Path[len("/mgmt/shared/file-transfer/uploads/"):]] = string(body)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/util/bash", func(w http.ResponseWriter, r *http.Request) {
+		cmd := bigip.BigipCommand{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&cmd))
+		script = cmd.UtilCmdArgs
+		_ = json.NewEncoder(w).Encode(bigip.BigipCommand{Command: "run", UtilCmdArgs: cmd.UtilCmdArgs})
+	})
+	mux.HandleFunc("/mgmt/tm/sys/version", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"entries":{"0":{"nestedStats":{"entries":{"Product":{"description":"BIG-IP"},"Version":{"description":"17.1.0"}}}}}}`)
+	})
+	server, client := testDeviceCertServer(t, mux, certPEM, keyPEM)
+	defer server.Close()
+
+	r := resourceBigipSysDeviceCert()
+	d := r.TestResourceData()
+	_ = d.Set("certificate", certPEM)
+	_ = d.Set("private_key", keyPEM)
+	_ = d.Set("expiration_warning_days", 0)
+	assert.False(t, r.CreateContext(context.Background(), d, client).HasError())
+
+	assert.Equal(t, certPEM, uploads[deviceCertUploadName+".crt"])
+	assert.Equal(t, keyPEM, uploads[deviceCertUploadName+".key"])
+	assert.Contains(t, script, "install -m 0644 /var/config/rest/downloads/terraform-device-cert.crt /etc/httpd/conf/ssl.crt/server.crt")
+	assert.Contains(t, script, "install -m 0600 /var/config/rest/downloads/terraform-device-cert.key /etc/httpd/conf/ssl.key/server.key")
+	assert.Contains(t, script, "tmsh restart sys service httpd")
+	assert.Equal(t, certPEM, d.Get("certificate"))
+
+	// a certificate replaced outside Terraform is kept in the configuration, and installed again
+	otherPEM, otherKeyPEM := testClientCertificatePEM(t, "other.example.com")
+	_ = d.Set("certificate", otherPEM)
+	_ = d.Set("private_key", otherKeyPEM)
+	diags := r.ReadContext(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "Management interface certificate is not the configured one", diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "restarts httpd")
+	}
+	assert.Equal(t, otherPEM, d.Get("certificate"))
+
+	other, err := parseDeviceCertLeaf(otherPEM)
+	assert.NoError(t, err)
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"certificate":             otherPEM,
+		"private_key":             otherKeyPEM,
+		"expiration_warning_days": 0,
+	}), client)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) && assert.NotNil(t, diff.Attributes["fingerprint"]) {
+		assert.Equal(t, certificateFingerprint(other.Raw), diff.Attributes["fingerprint"].New)
+		assert.Nil(t, diff.Attributes["certificate"])
+	}
+}
+
+func TestResourceBigipSysDeviceCertInstallPinned(t *testing.T) {
+	saved := deviceCertPollInterval
+	deviceCertPollInterval = 10 * time.Millisecond
+	defer func() { deviceCertPollInterval = saved }()
+
+	oldPEM, oldKeyPEM := testClientCertificatePEM(t, "old.example.com")
+	newPEM, newKeyPEM := testClientCertificatePEM(t, "bigip1.example.com")
+	oldCert, err := tls.X509KeyPair([]byte(oldPEM), []byte(oldKeyPEM))
+	assert.NoError(t, err)
+	newCert, err := tls.X509KeyPair([]byte(newPEM), []byte(newKeyPEM))
+	assert.NoError(t, err)
+	var mu sync.Mutex
+	presented := &oldCert
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mgmt/tm/sys/httpd", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"sslCertfile":"/etc/httpd/conf/ssl.crt/server.crt","sslCertkeyfile":"/etc/httpd/conf/ssl.key/server.key"}`)
+	})
+	mux.HandleFunc("/mgmt/shared/file-transfer/uploads/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/util/bash", func(w http.ResponseWriter, r *http.Request) {
+		cmd := bigip.BigipCommand{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&cmd))
+		_ = json.NewEncoder(w).Encode(bigip.BigipCommand{Command: "run", UtilCmdArgs: cmd.UtilCmdArgs})
+		// httpd restarts with the new certificate
+		mu.Lock()
+		presented = &newCert
+		mu.Unlock()
+	})
+	mux.HandleFunc("/mgmt/tm/sys/version", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	server := httptest.NewUnstartedServer(mux)
+	server.TLS = &tls.Config{GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return &tls.Config{Certificates: []tls.Certificate{*presented}}, nil
+	}}
+	server.StartTLS()
+	defer server.Close()
+
+	old, err := parseDeviceCertLeaf(oldPEM)
+	assert.NoError(t, err)
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+	assert.NoError(t, pinServerCertificate(client.Transport.TLSClientConfig, certificateFingerprint(old.Raw)))
+
+	r := resourceBigipSysDeviceCert()
+	d := r.TestResourceData()
+	_ = d.Set("certificate", newPEM)
+	_ = d.Set("private_key", newKeyPEM)
+	_ = d.Set("expiration_warning_days", 0)
+	diags := r.CreateContext(context.Background(), d, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, "tls_server_fingerprint pins the replaced certificate", diags[0].Summary)
+	}
+	leaf, err := parseDeviceCertLeaf(newPEM)
+	assert.NoError(t, err)
+	assert.Equal(t, certificateFingerprint(leaf.Raw), d.Get("fingerprint"))
+	assert.Equal(t, "CN=bigip1.example.com", d.Get("subject"))
+}
+
+func TestCheckDeviceCertTrusted(t *testing.T) {
+	certPEM, _ := testClientCertificatePEM(t, "bigip1.example.com")
+	leaf, err := parseDeviceCertLeaf(certPEM)
+	assert.NoError(t, err)
+
+	client := bigip.NewSession(&bigip.Config{Address: "https://bigip1.example.com", CertVerifyDisable: true})
+	assert.NoError(t, checkDeviceCertTrusted(client, certPEM, leaf))
+
+	client = bigip.NewSession(&bigip.Config{Address: "https://bigip1.example.com"})
+	client.Transport.TLSClientConfig.RootCAs = x509.NewCertPool()
+	err = checkDeviceCertTrusted(client, certPEM, leaf)
+	assert.ErrorContains(t, err, "the provider would not trust the new device certificate")
+	assert.ErrorContains(t, err, "trusted_cert_path")
+}
+
+func TestResourceBigipSysDeviceCertInstallMismatchedKey(t *testing.T) {
+	certPEM, _ := testClientCertificatePEM(t, "bigip1.example.com")
+	_, otherKeyPEM := testClientCertificatePEM(t, "other.example.com")
+
+	r := resourceBigipSysDeviceCert()
+	d := r.TestResourceData()
+	_ = d.Set("certificate", certPEM)
+	_ = d.Set("private_key", otherKeyPEM)
+	err := installDeviceCert(context.Background(), d, bigip.NewSession(&bigip.Config{Address: "127.0.0.1"}), "create")
+	assert.ErrorContains(t, err, "private key does not match public key")
+}
+
+func TestWaitDeviceCertTimeout(t *testing.T) {
+	saved := deviceCertPollInterval
+	deviceCertPollInterval = 10 * time.Millisecond
+	defer func() { deviceCertPollInterval = saved }()
+
+	certPEM, keyPEM := testClientCertificatePEM(t, "bigip1.example.com")
+	server, client := testDeviceCertServer(t, http.NewServeMux(), certPEM, keyPEM)
+	defer server.Close()
+
+	otherPEM, _ := testClientCertificatePEM(t, "other.example.com")
+	other, err := parseDeviceCertLeaf(otherPEM)
+	assert.NoError(t, err)
+	err = waitDeviceCert(context.Background(), client, other, 50*time.Millisecond)
+	assert.ErrorContains(t, err, "the management interface did not come back with the new certificate")
+	assert.ErrorContains(t, err, "still presents certificate")
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriSysGlobalSettings = "sys/global-settings"
+
+// SysGlobalSettings mirrors the sys global-settings singleton.
+type SysGlobalSettings struct {
+	Hostname                 string `json:"hostname,omitempty"`
+	GuiSecurityBanner        string `json:"guiSecurityBanner,omitempty"`
+	GuiSecurityBannerText    string `json:"guiSecurityBannerText,omitempty"`
+	ConsoleInactivityTimeout int    `json:"consoleInactivityTimeout"`
+	MgmtDhcp                 string `json:"mgmtDhcp,omitempty"`
+	UsernamePrompt           string `json:"usernamePrompt,omitempty"`
+	PasswordPrompt           string `json:"passwordPrompt,omitempty"`
+}
+
+// this module does not have a DELETE API; destroy only removes the resource from state.
+func resourceBigipSysGlobalSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysGlobalSettingsCreate,
+		ReadContext:   resourceBigipSysGlobalSettingsRead,
+		UpdateContext: resourceBigipSysGlobalSettingsUpdate,
+		DeleteContext: resourceBigipSysGlobalSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Fully qualified hostname of the device. Changing it on an HA member affects device trust",
+			},
+			"gui_security_banner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Specifies whether the security banner is shown on the GUI login screen",
+			},
+			"gui_security_banner_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Text of the security banner shown on the GUI login screen",
+			},
+			"console_inactivity_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Seconds of inactivity before the console is logged out. 0 disables the timeout",
+			},
+			"mgmt_dhcp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Specifies whether DHCP is used on the management interface",
+			},
+			"username_prompt": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Text of the username prompt on the GUI login screen",
+			},
+			"password_prompt": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Text of the password prompt on the GUI login screen",
+			},
+		},
+	}
+}
+
+func resourceBigipSysGlobalSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	diags := sysGlobalSettingsHostnameWarning(ctx, d, client)
+	apiLog := newAPICallLogger(ctx, "bigip_sys_global_settings", "global-settings", "create", "/mgmt/tm/"+uriSysGlobalSettings)
+	config := getSysGlobalSettingsConfig(d)
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, uriSysGlobalSettings)
+	apiLog.done(err)
+	if err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("error configuring sys global-settings: %s", err))...)
+	}
+	d.SetId("global-settings")
+	return append(diags, resourceBigipSysGlobalSettingsRead(ctx, d, meta)...)
+}
+
+func resourceBigipSysGlobalSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	apiLog := newAPICallLogger(ctx, "bigip_sys_global_settings", "global-settings", "read", "/mgmt/tm/"+uriSysGlobalSettings)
+
+	settings := &SysGlobalSettings{}
+	_, err := getRestEntity(client, settings, uriSysGlobalSettings)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("hostname", settings.Hostname)
+	_ = d.Set("gui_security_banner", settings.GuiSecurityBanner)
+	_ = d.Set("gui_security_banner_text", settings.GuiSecurityBannerText)
+	_ = d.Set("console_inactivity_timeout", settings.ConsoleInactivityTimeout)
+	_ = d.Set("mgmt_dhcp", settings.MgmtDhcp)
+	_ = d.Set("username_prompt", settings.UsernamePrompt)
+	_ = d.Set("password_prompt", settings.PasswordPrompt)
+	return nil
+}
+
+func resourceBigipSysGlobalSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	var diags diag.Diagnostics
+	if d.HasChange("hostname") {
+		diags = sysGlobalSettingsHostnameWarning(ctx, d, client)
+	}
+	apiLog := newAPICallLogger(ctx, "bigip_sys_global_settings", "global-settings", "update", "/mgmt/tm/"+uriSysGlobalSettings)
+	config := getSysGlobalSettingsConfig(d)
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, uriSysGlobalSettings)
+	apiLog.done(err)
+	if err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("error updating sys global-settings: %s", err))...)
+	}
+	return append(diags, resourceBigipSysGlobalSettingsRead(ctx, d, meta)...)
+}
+
+func resourceBigipSysGlobalSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// No API support for Delete, the settings are left as they are on the device
+	d.SetId("")
+	return nil
+}
+
+// getSysGlobalSettingsConfig returns only the attributes present in the configuration so that
+// settings Terraform does not manage are left untouched by the PATCH.
+func getSysGlobalSettingsConfig(d *schema.ResourceData) map[string]interface{} {
+	config := make(map[string]interface{})
+	rawConfig := d.GetRawConfig()
+	attrs := map[string]string{
+		"hostname":                   "hostname",
+		"gui_security_banner":        "guiSecurityBanner",
+		"gui_security_banner_text":   "guiSecurityBannerText",
+		"console_inactivity_timeout": "consoleInactivityTimeout",
+		"mgmt_dhcp":                  "mgmtDhcp",
+		"username_prompt":            "usernamePrompt",
+		"password_prompt":            "passwordPrompt",
+	}
+	for attr, key := range attrs {
+		if !rawConfig.IsNull() && rawConfig.GetAttr(attr).IsNull() {
+			continue
+		}
+		config[key] = d.Get(attr)
+	}
+	return config
+}
+
+// sysGlobalSettingsHostnameWarning warns when the hostname of a device that is part of a trust domain is changed.
+func sysGlobalSettingsHostnameWarning(ctx context.Context, d *schema.ResourceData, client *bigip.BigIP) diag.Diagnostics {
+	if _, ok := d.GetOk("hostname"); !ok {
+		return nil
+	}
+	devices, err := getRestItems[cmDevice](client, uriCmDevice)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to list cm devices", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+	if len(devices) < 2 {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Changing the hostname of an HA member",
+			Detail:   fmt.Sprintf("This device is in a trust domain with %d devices. Changing the hostname does not rename the cm device object and may require device trust and the device group to be re-established.", len(devices)),
+		},
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TEST_GLOBAL_SETTINGS_RESOURCE = `
+resource "bigip_sys_global_settings" "test-settings" {
+  gui_security_banner        = "enabled"
+  gui_security_banner_text   = "Terraform acceptance test"
+  console_inactivity_timeout = 1200
+}
+`
+
+func TestAccBigipSysGlobalSettings_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GLOBAL_SETTINGS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGlobalSettingsBanner("Terraform acceptance test"),
+					resource.TestCheckResourceAttr("bigip_sys_global_settings.test-settings", "gui_security_banner", "enabled"),
+					resource.TestCheckResourceAttr("bigip_sys_global_settings.test-settings", "gui_security_banner_text", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr("bigip_sys_global_settings.test-settings", "console_inactivity_timeout", "1200"),
+					resource.TestCheckResourceAttrSet("bigip_sys_global_settings.test-settings", "hostname"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysGlobalSettings_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GLOBAL_SETTINGS_RESOURCE,
+			},
+			{
+				ResourceName:      "bigip_sys_global_settings.test-settings",
+				ImportStateId:     "global-settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckGlobalSettingsBanner(text string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		settings := &SysGlobalSettings{}
+		if _, err := getRestEntity(client, settings, uriSysGlobalSettings); err != nil {
+			return err
+		}
+		if settings.GuiSecurityBannerText != text {
+			return fmt.Errorf("gui security banner text is %q, expected %q", settings.GuiSecurityBannerText, text)
+		}
+		return nil
+	}
+}
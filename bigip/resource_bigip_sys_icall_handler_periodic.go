@@ -0,0 +1,163 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriSysIcallHandlerPeriodic = "sys/icall/handler/periodic"
+
+// IcallHandlerPeriodic mirrors the sys icall handler periodic object.
+type IcallHandlerPeriodic struct {
+	Name            string `json:"name,omitempty"`
+	FullPath        string `json:"fullPath,omitempty"`
+	Script          string `json:"script,omitempty"`
+	Interval        int    `json:"interval,omitempty"`
+	FirstOccurrence string `json:"firstOccurrence,omitempty"`
+	Status          string `json:"status,omitempty"`
+	Description     string `json:"description"`
+}
+
+func resourceBigipSysIcallHandlerPeriodic() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysIcallHandlerPeriodicCreate,
+		ReadContext:   resourceBigipSysIcallHandlerPeriodicRead,
+		UpdateContext: resourceBigipSysIcallHandlerPeriodicUpdate,
+		DeleteContext: resourceBigipSysIcallHandlerPeriodicDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importProfileFullPath,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the periodic handler, in full path format e.g. /Common/cert-report",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"script": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the iCall script the handler runs",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of seconds between two runs of the script",
+			},
+			"first_occurrence": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}:\d{2}:\d{2}:\d{2}$`),
+					"must be a date and time of the form YYYY-MM-DD:HH:MM:SS"),
+				Description: "Date and time of the first run, e.g. 2024-01-01:02:00:00, the runs then follow every interval",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
+				Description:  "Specifies whether the handler runs the script, active or inactive",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+		},
+	}
+}
+
+func resourceBigipSysIcallHandlerPeriodicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_sys_icall_handler_periodic", name, "create", icontrolURI(uriSysIcallHandlerPeriodic, name))
+
+	config := getIcallHandlerPeriodicConfig(d, &IcallHandlerPeriodic{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriSysIcallHandlerPeriodic)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating iCall periodic handler (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipSysIcallHandlerPeriodicRead(ctx, d, meta)
+}
+
+func resourceBigipSysIcallHandlerPeriodicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_sys_icall_handler_periodic", name, "read", icontrolURI(uriSysIcallHandlerPeriodic, name))
+
+	handler := &IcallHandlerPeriodic{}
+	found, err := getRestEntity(client, handler, restObjectPath(uriSysIcallHandlerPeriodic, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "iCall periodic handler not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("script", handler.Script)
+	_ = d.Set("interval", handler.Interval)
+	_ = d.Set("first_occurrence", handler.FirstOccurrence)
+	_ = d.Set("status", handler.Status)
+	_ = d.Set("description", handler.Description)
+	return nil
+}
+
+func resourceBigipSysIcallHandlerPeriodicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_sys_icall_handler_periodic", name, "update", icontrolURI(uriSysIcallHandlerPeriodic, name))
+
+	config := getIcallHandlerPeriodicConfig(d, &IcallHandlerPeriodic{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriSysIcallHandlerPeriodic, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying iCall periodic handler (%s): %s", name, err))
+	}
+	return resourceBigipSysIcallHandlerPeriodicRead(ctx, d, meta)
+}
+
+func resourceBigipSysIcallHandlerPeriodicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_sys_icall_handler_periodic", name, "delete", icontrolURI(uriSysIcallHandlerPeriodic, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriSysIcallHandlerPeriodic, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting iCall periodic handler (%s): %s", name, err))
+	}
+	d.SetId("")
+	return nil
+}
+
+func getIcallHandlerPeriodicConfig(d *schema.ResourceData, config *IcallHandlerPeriodic) *IcallHandlerPeriodic {
+	config.Script = d.Get("script").(string)
+	config.Interval = d.Get("interval").(int)
+	config.FirstOccurrence = d.Get("first_occurrence").(string)
+	config.Status = d.Get("status").(string)
+	config.Description = d.Get("description").(string)
+	return config
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriSysIcallScript = "sys/icall/script"
+
+// IcallScript mirrors the sys icall script object.
+type IcallScript struct {
+	Name        string `json:"name,omitempty"`
+	FullPath    string `json:"fullPath,omitempty"`
+	Definition  string `json:"definition"`
+	Description string `json:"description"`
+}
+
+func resourceBigipSysIcallScript() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysIcallScriptCreate,
+		ReadContext:   resourceBigipSysIcallScriptRead,
+		UpdateContext: resourceBigipSysIcallScriptUpdate,
+		DeleteContext: resourceBigipSysIcallScriptDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importProfileFullPath,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the iCall script, in full path format e.g. /Common/cert-report",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"definition": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Tcl body of the script",
+				// the leading and trailing whitespace of a heredoc is dropped, like for iRules
+				StateFunc: func(s interface{}) string {
+					return strings.TrimSpace(s.(string))
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+		},
+	}
+}
+
+func resourceBigipSysIcallScriptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_sys_icall_script", name, "create", icontrolURI(uriSysIcallScript, name))
+
+	config := getIcallScriptConfig(d, &IcallScript{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriSysIcallScript)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating iCall script (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipSysIcallScriptRead(ctx, d, meta)
+}
+
+func resourceBigipSysIcallScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_sys_icall_script", name, "read", icontrolURI(uriSysIcallScript, name))
+
+	script := &IcallScript{}
+	found, err := getRestEntity(client, script, restObjectPath(uriSysIcallScript, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "iCall script not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	// the body is kept as the BIG-IP returns it, only the surrounding whitespace is trimmed as in
+	// the state of the configured value
+	_ = d.Set("definition", strings.TrimSpace(script.Definition))
+	_ = d.Set("description", script.Description)
+	return nil
+}
+
+func resourceBigipSysIcallScriptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_sys_icall_script", name, "update", icontrolURI(uriSysIcallScript, name))
+
+	config := getIcallScriptConfig(d, &IcallScript{})
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriSysIcallScript, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying iCall script (%s): %s", name, err))
+	}
+	return resourceBigipSysIcallScriptRead(ctx, d, meta)
+}
+
+func resourceBigipSysIcallScriptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_sys_icall_script", name, "delete", icontrolURI(uriSysIcallScript, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriSysIcallScript, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting iCall script (%s): %s", name, err))
+	}
+	d.SetId("")
+	return nil
+}
+
+func getIcallScriptConfig(d *schema.ResourceData, config *IcallScript) *IcallScript {
+	config.Definition = d.Get("definition").(string)
+	config.Description = d.Get("description").(string)
+	return config
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestIcallScriptName = fmt.Sprintf("/%s/test-icall-script", TestPartition)
+var TestIcallHandlerName = fmt.Sprintf("/%s/test-icall-handler", TestPartition)
+
+func TestAccBigipSysIcallScript_create(t *testing.T) {
+	scriptRes := "bigip_sys_icall_script.test-script"
+	handlerRes := "bigip_sys_icall_handler_periodic.test-handler"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckIcallDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIcallConfig("cert report", 3600, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIcallExists(uriSysIcallScript, TestIcallScriptName),
+					testCheckIcallExists(uriSysIcallHandlerPeriodic, TestIcallHandlerName),
+					resource.TestCheckResourceAttr(scriptRes, "definition", testAccIcallDefinition("cert report")),
+					resource.TestCheckResourceAttr(handlerRes, "script", TestIcallScriptName),
+					resource.TestCheckResourceAttr(handlerRes, "interval", "3600"),
+					resource.TestCheckResourceAttr(handlerRes, "first_occurrence", "2024-01-01:02:00:00"),
+					resource.TestCheckResourceAttr(handlerRes, "status", "active"),
+				),
+			},
+			{
+				Config:   testAccIcallConfig("cert report", 3600, "active"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccIcallConfig("nightly cert report", 86400, "inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(scriptRes, "definition", testAccIcallDefinition("nightly cert report")),
+					resource.TestCheckResourceAttr(handlerRes, "interval", "86400"),
+					resource.TestCheckResourceAttr(handlerRes, "status", "inactive"),
+				),
+			},
+			{
+				ResourceName:      scriptRes,
+				ImportStateId:     TestIcallScriptName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      handlerRes,
+				ImportStateId:     TestIcallHandlerName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIcallExists(collection, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &struct{}{}, restObjectPath(collection, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("%s %s was not created ", collection, name)
+		}
+		return nil
+	}
+}
+
+func testCheckIcallDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	collections := map[string]string{
+		"bigip_sys_icall_script":           uriSysIcallScript,
+		"bigip_sys_icall_handler_periodic": uriSysIcallHandlerPeriodic,
+	}
+	for _, rs := range s.RootModule().Resources {
+		collection, ok := collections[rs.Type]
+		if !ok {
+			continue
+		}
+		found, err := getRestEntity(client, &struct{}{}, restObjectPath(collection, rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("%s %s not destroyed ", collection, rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+// testAccIcallDefinition is a script body with indentation and blank lines, which must be kept.
+func testAccIcallDefinition(subject string) string {
+	return fmt.Sprintf(`foreach cert [tmsh::get_config sys file ssl-cert] {
+
+    set name [tmsh::get_name $cert]
+    exec logger -p local0.info "%s: $name"
+}`, subject)
+}
+
+func testAccIcallConfig(subject string, interval int, status string) string {
+	return fmt.Sprintf(`
+resource "bigip_sys_icall_script" "test-script" {
+  name       = "%s"
+  definition = <<EOT
+%s
+EOT
+}
+
+resource "bigip_sys_icall_handler_periodic" "test-handler" {
+  name             = "%s"
+  script           = bigip_sys_icall_script.test-script.name
+  interval         = %d
+  first_occurrence = "2024-01-01:02:00:00"
+  status           = "%s"
+}
+`, TestIcallScriptName, testAccIcallDefinition(subject), TestIcallHandlerName, interval, status)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceBigipSysIcallScriptPreservesDefinition(t *testing.T) {
+	setup()
+	defer teardown()
+
+	definition := "foreach cert [tmsh::get_config sys file ssl-cert] {\n\n\tset name [tmsh::get_name $cert]\n    exec logger \"$name\"\n}"
+	var posted IcallScript
+	mux.HandleFunc("/mgmt/tm/sys/icall/script", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&posted))
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/icall/script/~Common~report", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// the BIG-IP adds a newline around the body
+		body, _ := json.Marshal(IcallScript{Name: "report", FullPath: "/Common/report", Definition: "\n" + definition + "\n"})
+		_, _ = w.Write(body)
+	})
+
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+	r := resourceBigipSysIcallScript()
+	d := r.TestResourceData()
+	_ = d.Set("name", "/Common/report")
+	_ = d.Set("definition", definition)
+	diags := r.CreateContext(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "/Common/report", posted.Name)
+	assert.Equal(t, definition, posted.Definition)
+	assert.Equal(t, definition, d.Get("definition"))
+}
@@ -8,13 +8,46 @@ package bigip
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // the timezone validation must not depend on the zoneinfo of the host
 
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	uriSysNtp         = "sys/ntp"
+	uriSysNtpRestrict = "sys/ntp/restrict"
+	// ntpKeysFile is the ntpd keys file the keys of the resource are written to. It lives in /config
+	// so it is kept across reboots and upgrades.
+	ntpKeysFile = "/config/ntp.keys"
+)
+
+// SysNtp mirrors the sys ntp singleton, go-bigip does not model its include attribute. Include is
+// only sent when the keys change, an empty string then removes it.
+type SysNtp struct {
+	Description string   `json:"description"`
+	Servers     []string `json:"servers"`
+	Timezone    string   `json:"timezone,omitempty"`
+	Include     *string  `json:"include,omitempty"`
+}
+
+// SysNtpRestrict is an entry of the sys ntp restrict collection.
+type SysNtpRestrict struct {
+	Name    string   `json:"name"`
+	Address string   `json:"address,omitempty"`
+	Mask    string   `json:"mask,omitempty"`
+	Options []string `json:"options,omitempty"`
+}
+
 func resourceBigipSysNtp() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceBigipSysNtpCreate,
@@ -38,9 +71,81 @@ func resourceBigipSysNtp() *schema.Resource {
 				Description: "Specifies the time servers that the system uses to update the system time",
 			},
 			"timezone": {
-				Type:        schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateNtpTimezone,
+				Description:  "Specifies the time zone that you want to use for the system time, as an IANA time zone name",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Symmetric keys used to authenticate the time servers",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65534),
+							Description:  "Identifier of the key",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "MD5",
+							ValidateFunc: validation.StringInSlice([]string{"MD5", "SHA1"}, false),
+							Description:  "Digest algorithm of the key",
+						},
+						"secret": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Secret of the key, write only",
+						},
+						"trusted": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Specifies whether the key is trusted to authenticate the time servers",
+						},
+						"servers": {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "Time servers authenticated with this key, in addition to the ones in servers",
+						},
+					},
+				},
+			},
+			"restrict": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Specifies the time zone that you want to use for the system time",
+				Description: "Access control entries of the NTP service",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the restrict entry",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Address or network the entry applies to, `default` for all the hosts",
+						},
+						"mask": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Network mask of address",
+						},
+						"options": {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "Restrict flags of the entry, e.g. kod, nomodify, notrap, nopeer, noquery",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -48,19 +153,11 @@ func resourceBigipSysNtp() *schema.Resource {
 }
 
 func resourceBigipSysNtpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*bigip.BigIP)
-
 	description := d.Get("description").(string)
 
 	log.Println("[INFO] Configuring NTP Servers ")
 
-	configSysNTP := &bigip.NTP{
-		Description: description,
-	}
-	sysNTPConfig := getSysNTPConfig(d, configSysNTP)
-	err := client.ModifyNTP(sysNTPConfig)
-
-	if err != nil {
+	if err := setSysNtpConfig(ctx, d, meta.(*bigip.BigIP), description, "create"); err != nil {
 		log.Printf("[ERROR] Unable to Configure  NTP Servers  (%s) ", err)
 		return diag.FromErr(err)
 	}
@@ -69,18 +166,11 @@ func resourceBigipSysNtpCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceBigipSysNtpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*bigip.BigIP)
-
 	description := d.Id()
 
 	log.Println("[INFO] Updating NTP Servers" + description)
 
-	configSysNTP := &bigip.NTP{
-		Description: description,
-	}
-	sysNTPConfig := getSysNTPConfig(d, configSysNTP)
-	err := client.ModifyNTP(sysNTPConfig)
-	if err != nil {
+	if err := setSysNtpConfig(ctx, d, meta.(*bigip.BigIP), description, "update"); err != nil {
 		log.Printf("[ERROR] Unable to Modify  NTP Servers (%v) ", err)
 		return diag.FromErr(err)
 	}
@@ -94,12 +184,15 @@ func resourceBigipSysNtpRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	log.Println("[INFO] Reading NTP Config" + description)
 
-	ntp, err := client.NTPs()
+	apiLog := newAPICallLogger(ctx, "bigip_sys_ntp", description, "read", "/mgmt/tm/"+uriSysNtp)
+	ntp := &SysNtp{}
+	found, err := getRestEntity(client, ntp, uriSysNtp)
+	apiLog.done(err)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve NTP Config (%s) ", err)
 		return diag.FromErr(err)
 	}
-	if ntp == nil {
+	if !found {
 		log.Printf("[WARN] NTP Config (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -108,30 +201,287 @@ func resourceBigipSysNtpRead(ctx context.Context, d *schema.ResourceData, meta i
 	_ = d.Set("description", ntp.Description)
 	_ = d.Set("servers", ntp.Servers)
 	_ = d.Set("timezone", ntp.Timezone)
+	// The secrets cannot be read back, the keys are kept as configured as long as the device still
+	// loads the keys file.
+	if ntp.Include == nil || !strings.Contains(*ntp.Include, "keys "+ntpKeysFile) {
+		_ = d.Set("keys", nil)
+	}
+
+	restricts, err := getRestItems[SysNtpRestrict](client, uriSysNtpRestrict)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading NTP restrict entries: %s", err))
+	}
+	_ = d.Set("restrict", flattenSysNtpRestricts(d.Get("restrict").([]interface{}), restricts))
 
 	return nil
 }
 
+// NTP is a singleton without a Delete API: destroy resets it to the device defaults and removes the
+// keys and restrict entries the resource manages.
 func resourceBigipSysNtpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
 	description := d.Id()
 	log.Println("[INFO] Deleting System NTP Config:" + description)
-	configSysNTP := &bigip.NTP{
+	configSysNTP := &SysNtp{
 		Description: description,
 		Servers:     []string{},
 		Timezone:    "America/Los_Angeles",
 	}
-	err := client.ModifyNTP(configSysNTP)
+	keys := d.Get("keys").([]interface{})
+	if len(keys) > 0 {
+		include, err := sysNtpInclude(client, keys, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		configSysNTP.Include = &include
+	}
+	apiLog := newAPICallLogger(ctx, "bigip_sys_ntp", description, "delete", "/mgmt/tm/"+uriSysNtp)
+	apiLog.payload(configSysNTP)
+	err := patchRestEntity(client, configSysNTP, uriSysNtp)
+	apiLog.done(err)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Delete NTP Config (%s) (%v) ", description, err)
 		return diag.FromErr(err)
 	}
+	if len(keys) > 0 {
+		if err := removeNtpKeysFile(client); err != nil {
+			return diag.FromErr(fmt.Errorf("error removing NTP keys: %s", err))
+		}
+	}
+	for _, name := range sysNtpRestrictNames(d.Get("restrict").([]interface{})) {
+		if err := deleteRestEntity(client, uriSysNtpRestrict+"/"+name); err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting NTP restrict entry %s: %s", name, err))
+		}
+	}
 	d.SetId("")
 	return nil
 }
 
-func getSysNTPConfig(d *schema.ResourceData, config *bigip.NTP) *bigip.NTP {
+// setSysNtpConfig writes the keys file, then the sys ntp settings that reference it, then the
+// restrict entries. The keys file of removed keys goes once nothing references it.
+func setSysNtpConfig(ctx context.Context, d *schema.ResourceData, client *bigip.BigIP, description, action string) error {
+	create := action == "create"
+	keys := d.Get("keys").([]interface{})
+	keysChanged := (create && len(keys) > 0) || d.HasChange("keys")
+
+	config := getSysNTPConfig(d, &SysNtp{Description: description})
+	if keysChanged {
+		if len(keys) > 0 {
+			if err := writeNtpKeysFile(client, keys); err != nil {
+				return fmt.Errorf("error writing NTP keys: %s", err)
+			}
+		}
+		old, _ := d.GetChange("keys")
+		include, err := sysNtpInclude(client, old.([]interface{}), keys)
+		if err != nil {
+			return err
+		}
+		config.Include = &include
+	}
+	apiLog := newAPICallLogger(ctx, "bigip_sys_ntp", description, action, "/mgmt/tm/"+uriSysNtp)
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, uriSysNtp)
+	apiLog.done(err)
+	if err != nil {
+		return err
+	}
+	if keysChanged && len(keys) == 0 {
+		if err := removeNtpKeysFile(client); err != nil {
+			return fmt.Errorf("error removing NTP keys: %s", err)
+		}
+	}
+
+	if (create && len(d.Get("restrict").([]interface{})) > 0) || d.HasChange("restrict") {
+		return setSysNtpRestricts(client, d)
+	}
+	return nil
+}
+
+func getSysNTPConfig(d *schema.ResourceData, config *SysNtp) *SysNtp {
 	config.Servers = listToStringSlice(d.Get("servers").([]interface{}))
 	config.Timezone = d.Get("timezone").(string)
 	return config
 }
+
+// sysNtpInclude returns the include of the device with the lines of the old keys replaced by those
+// of keys, so the lines added outside Terraform are kept.
+func sysNtpInclude(client *bigip.BigIP, old, keys []interface{}) (string, error) {
+	ntp := &SysNtp{}
+	if _, err := getRestEntity(client, ntp, uriSysNtp+"?$select=include"); err != nil {
+		return "", fmt.Errorf("error reading the NTP include: %s", err)
+	}
+	current := ""
+	if ntp.Include != nil {
+		current = *ntp.Include
+	}
+	return mergeNtpInclude(current, old, keys), nil
+}
+
+// mergeNtpInclude replaces the lines of the old keys in include by those of keys.
+func mergeNtpInclude(include string, old, keys []interface{}) string {
+	managed := map[string]bool{"keys " + ntpKeysFile: true}
+	for _, line := range strings.Split(ntpKeysInclude(old), "\n") {
+		managed[line] = true
+	}
+	var b strings.Builder
+	for _, line := range strings.Split(include, "\n") {
+		if strings.TrimSpace(line) == "" || managed[line] {
+			continue
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString(ntpKeysInclude(keys))
+	return b.String()
+}
+
+// ntpKeysInclude returns the ntp.conf lines that load the keys file, trust the trusted keys and
+// authenticate the servers of each key.
+func ntpKeysInclude(keys []interface{}) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	lines := []string{"keys " + ntpKeysFile}
+	var trusted []string
+	for _, k := range keys {
+		key := k.(map[string]interface{})
+		id := strconv.Itoa(key["id"].(int))
+		if key["trusted"].(bool) {
+			trusted = append(trusted, id)
+		}
+		for _, server := range listToStringSlice(key["servers"].([]interface{})) {
+			lines = append(lines, fmt.Sprintf("server %s key %s", server, id))
+		}
+	}
+	if len(trusted) > 0 {
+		lines = append(lines, "trustedkey "+strings.Join(trusted, " "))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// ntpKeysFileContent returns the keys file of keys in the ntpd format, one "id type secret" line per key.
+func ntpKeysFileContent(keys []interface{}) string {
+	var b strings.Builder
+	for _, k := range keys {
+		key := k.(map[string]interface{})
+		fmt.Fprintf(&b, "%d %s %s\n", key["id"].(int), key["type"].(string), key["secret"].(string))
+	}
+	return b.String()
+}
+
+// writeNtpKeysFile uploads the keys file and moves it in place, readable by root only. The secrets go
+// through the file transfer endpoint, so they never show on a command line.
+func writeNtpKeysFile(client *bigip.BigIP, keys []interface{}) error {
+	name := path.Base(ntpKeysFile)
+	if _, err := client.UploadBytes([]byte(ntpKeysFileContent(keys)), name); err != nil {
+		return err
+	}
+	upload := uriRestDownloads + "/" + name
+	out, err := runBashCommand(client, fmt.Sprintf("install -m 0600 %s %s; rm -f %s", upload, ntpKeysFile, upload))
+	if err == nil && out != "" {
+		err = fmt.Errorf("%s", out)
+	}
+	return err
+}
+
+func removeNtpKeysFile(client *bigip.BigIP) error {
+	out, err := runBashCommand(client, "rm -f "+ntpKeysFile)
+	if err == nil && out != "" {
+		err = fmt.Errorf("%s", out)
+	}
+	return err
+}
+
+func expandSysNtpRestrict(r map[string]interface{}) *SysNtpRestrict {
+	return &SysNtpRestrict{
+		Name:    r["name"].(string),
+		Address: r["address"].(string),
+		Mask:    r["mask"].(string),
+		Options: listToStringSlice(r["options"].([]interface{})),
+	}
+}
+
+func sysNtpRestrictNames(restricts []interface{}) []string {
+	var names []string
+	for _, r := range restricts {
+		names = append(names, r.(map[string]interface{})["name"].(string))
+	}
+	return names
+}
+
+// setSysNtpRestricts deletes the restrict entries removed from the configuration, then creates or
+// replaces the configured ones.
+func setSysNtpRestricts(client *bigip.BigIP, d *schema.ResourceData) error {
+	o, _ := d.GetChange("restrict")
+	n := d.Get("restrict")
+	existing := make(map[string]bool)
+	for _, name := range sysNtpRestrictNames(o.([]interface{})) {
+		existing[name] = true
+	}
+	for _, name := range sysNtpRestrictNames(n.([]interface{})) {
+		delete(existing, name)
+	}
+	var removed []string
+	for name := range existing {
+		removed = append(removed, name)
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		if err := deleteRestEntity(client, uriSysNtpRestrict+"/"+name); err != nil {
+			return fmt.Errorf("error deleting NTP restrict entry %s: %s", name, err)
+		}
+	}
+
+	for _, r := range n.([]interface{}) {
+		restrict := expandSysNtpRestrict(r.(map[string]interface{}))
+		current := &SysNtpRestrict{}
+		found, err := getRestEntity(client, current, uriSysNtpRestrict+"/"+restrict.Name)
+		if err != nil {
+			return fmt.Errorf("error reading NTP restrict entry %s: %s", restrict.Name, err)
+		}
+		if found {
+			err = putRestEntity(client, restrict, uriSysNtpRestrict+"/"+restrict.Name)
+		} else {
+			err = postRestEntity(client, restrict, uriSysNtpRestrict)
+		}
+		if err != nil {
+			return fmt.Errorf("error configuring NTP restrict entry %s: %s", restrict.Name, err)
+		}
+	}
+	return nil
+}
+
+// flattenSysNtpRestricts returns the device entries named in configured, in the configured order.
+// Entries created outside Terraform are left out of the state.
+func flattenSysNtpRestricts(configured []interface{}, restricts []SysNtpRestrict) []interface{} {
+	byName := make(map[string]SysNtpRestrict, len(restricts))
+	for _, r := range restricts {
+		byName[r.Name] = r
+	}
+	var result []interface{}
+	for _, name := range sysNtpRestrictNames(configured) {
+		r, ok := byName[name]
+		if !ok {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"name":    r.Name,
+			"address": r.Address,
+			"mask":    r.Mask,
+			"options": r.Options,
+		})
+	}
+	return result
+}
+
+// validateNtpTimezone accepts the IANA time zone names, the list the device offers for sys ntp timezone.
+func validateNtpTimezone(value interface{}, field string) (ws []string, errors []error) {
+	tz := value.(string)
+	if tz == "" || tz == "Local" || strings.HasPrefix(tz, "/") {
+		errors = append(errors, fmt.Errorf("%q must be an IANA time zone name, e.g. America/Los_Angeles, got %q", field, tz))
+		return
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an IANA time zone name, e.g. America/Los_Angeles, got %q", field, tz))
+	}
+	return
+}
@@ -6,11 +6,19 @@ If a copy of the MPL was not distributed with this file, You can obtain one at h
 package bigip
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"regexp"
 	"testing"
 
+	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testBigipSysNtpInvalid(resourceName string) string {
@@ -42,3 +50,146 @@ func TestAccBigipSysNtpInvalid(t *testing.T) {
 		},
 	})
 }
+
+func TestValidateNtpTimezone(t *testing.T) {
+	for _, tz := range []string{"America/Los_Angeles", "Europe/Paris", "UTC", "Asia/Kolkata"} {
+		_, errs := validateNtpTimezone(tz, "timezone")
+		assert.Empty(t, errs, tz)
+	}
+	for _, tz := range []string{"", "Local", "Mars/Olympus_Mons", "PST 8", "/etc/localtime"} {
+		_, errs := validateNtpTimezone(tz, "timezone")
+		assert.NotEmpty(t, errs, tz)
+	}
+}
+
+func TestNtpKeys(t *testing.T) {
+	keys := []interface{}{
+		map[string]interface{}{"id": 1, "type": "MD5", "secret": "s3cret", "trusted": true, "servers": []interface{}{"10.10.10.10"}},
+		map[string]interface{}{"id": 7, "type": "SHA1", "secret": "0123456789abcdef", "trusted": false, "servers": []interface{}{}},
+		map[string]interface{}{"id": 12, "type": "MD5", "secret": "other", "trusted": true, "servers": []interface{}{}},
+	}
+	assert.Equal(t, "keys /config/ntp.keys\nserver 10.10.10.10 key 1\ntrustedkey 1 12\n", ntpKeysInclude(keys))
+	assert.Equal(t, "1 MD5 s3cret\n7 SHA1 0123456789abcdef\n12 MD5 other\n", ntpKeysFileContent(keys))
+	assert.Equal(t, "", ntpKeysInclude(nil))
+}
+
+func TestSysNtpKeysAndRestrict(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var ntpBodies []map[string]interface{}
+	var commands []string
+	var restrictCalls []string
+	uploads := map[string]string{}
+	include := "tinker panic 0\n"
+	mux.HandleFunc("/mgmt/tm/sys/ntp", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			body := map[string]interface{}{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			ntpBodies = append(ntpBodies, body)
+			if value, ok := body["include"]; ok {
+				include = value.(string)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"description":"/Common/NTP1","servers":["10.10.10.10"],"timezone":"Europe/Paris","include":%q}`, include)
+	})
+	mux.HandleFunc("/mgmt/shared/file-transfer/uploads/", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		uploads[r.URL.Path[len("/mgmt/shared/file-transfer/uploads/"):]] = string(body)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/util/bash", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		commands = append(commands, body["utilCmdArgs"].(string))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"command":"run"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/ntp/restrict", func(w http.ResponseWriter, r *http.Request) {
+		restrictCalls = append(restrictCalls, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			_, _ = fmt.Fprintf(w, `{"items":[{"name":"lan","address":"10.0.0.0","mask":"255.0.0.0","options":["kod","nomodify"]},{"name":"other","address":"default"}]}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/ntp/restrict/lan", func(w http.ResponseWriter, r *http.Request) {
+		restrictCalls = append(restrictCalls, r.Method+" lan")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := Provider().ResourcesMap["bigip_sys_ntp"]
+	d := r.TestResourceData()
+	_ = d.Set("description", "/Common/NTP1")
+	_ = d.Set("servers", []interface{}{"10.10.10.10"})
+	_ = d.Set("timezone", "Europe/Paris")
+	_ = d.Set("keys", []interface{}{map[string]interface{}{"id": 3, "type": "MD5", "secret": "s3cret", "trusted": true, "servers": []interface{}{"10.20.20.20"}}})
+	_ = d.Set("restrict", []interface{}{map[string]interface{}{"name": "lan", "address": "10.0.0.0", "mask": "255.0.0.0", "options": []interface{}{"kod", "nomodify"}}})
+	assert.False(t, r.CreateContext(context.Background(), d, client).HasError())
+
+	assert.Equal(t, "/Common/NTP1", d.Id())
+	assert.Equal(t, "tinker panic 0\nkeys /config/ntp.keys\nserver 10.20.20.20 key 3\ntrustedkey 3\n", ntpBodies[0]["include"])
+	assert.Equal(t, "Europe/Paris", ntpBodies[0]["timezone"])
+	assert.Equal(t, "3 MD5 s3cret\n", uploads["ntp.keys"])
+	if assert.Len(t, commands, 1) {
+		assert.Contains(t, commands[0], "install -m 0600 /var/config/rest/downloads/ntp.keys /config/ntp.keys")
+		assert.NotContains(t, commands[0], "s3cret")
+		assert.NotContains(t, commands[0], base64.StdEncoding.EncodeToString([]byte("3 MD5 s3cret\n")))
+	}
+	assert.Equal(t, []string{"GET lan", "POST", "GET"}, restrictCalls)
+	assert.Equal(t, "s3cret", d.Get("keys.0.secret"))
+	assert.Equal(t, 1, d.Get("restrict.#"))
+	assert.Equal(t, "kod", d.Get("restrict.0.options.0"))
+
+	// An update that leaves the keys alone does not send the include.
+	config := map[string]interface{}{
+		"description": "/Common/NTP1",
+		"servers":     []interface{}{"10.10.10.11"},
+		"timezone":    "Europe/Paris",
+		"keys":        []interface{}{map[string]interface{}{"id": 3, "type": "MD5", "secret": "s3cret", "trusted": true, "servers": []interface{}{"10.20.20.20"}}},
+		"restrict":    []interface{}{map[string]interface{}{"name": "lan", "address": "10.0.0.0", "mask": "255.0.0.0", "options": []interface{}{"kod", "nomodify"}}},
+	}
+	state := d.State()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+	state, diags := r.Apply(context.Background(), state, diff, client)
+	assert.False(t, diags.HasError())
+	if assert.Len(t, ntpBodies, 2) {
+		assert.NotContains(t, ntpBodies[1], "include")
+	}
+	assert.Len(t, commands, 1)
+
+	// Removing the keys keeps the lines added outside Terraform and then removes the keys file.
+	delete(config, "keys")
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+	_, diags = r.Apply(context.Background(), state, diff, client)
+	assert.False(t, diags.HasError())
+	if assert.Len(t, ntpBodies, 3) {
+		assert.Equal(t, "tinker panic 0\n", ntpBodies[2]["include"])
+	}
+	if assert.Len(t, commands, 2) {
+		assert.Contains(t, commands[1], "rm -f /config/ntp.keys")
+	}
+
+	include = ""
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	assert.Equal(t, 0, d.Get("keys.#"))
+}
+
+func TestMergeNtpInclude(t *testing.T) {
+	old := []interface{}{map[string]interface{}{"id": 3, "type": "MD5", "secret": "a", "trusted": true, "servers": []interface{}{"10.20.20.20"}}}
+	keys := []interface{}{map[string]interface{}{"id": 4, "type": "MD5", "secret": "b", "trusted": false, "servers": []interface{}{}}}
+	include := "tinker panic 0\nkeys /config/ntp.keys\nserver 10.20.20.20 key 3\ntrustedkey 3\nserver 10.30.30.30 key 9\n"
+	assert.Equal(t, "tinker panic 0\nserver 10.30.30.30 key 9\nkeys /config/ntp.keys\n", mergeNtpInclude(include, old, keys))
+	assert.Equal(t, "tinker panic 0\nserver 10.30.30.30 key 9\n", mergeNtpInclude(include, old, nil))
+	assert.Equal(t, "", mergeNtpInclude("", nil, nil))
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriSysOutboundSmtp = "sys/outbound-smtp"
+
+// SysOutboundSmtp mirrors the sys outbound-smtp singleton.
+type SysOutboundSmtp struct {
+	Mailhub          string `json:"mailhub,omitempty"`
+	FromLineOverride string `json:"fromLineOverride,omitempty"`
+	RewriteDomain    string `json:"rewriteDomain,omitempty"`
+}
+
+// this module does not have a DELETE API; destroy only removes the resource from state.
+func resourceBigipSysOutboundSmtp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysOutboundSmtpCreate,
+		ReadContext:   resourceBigipSysOutboundSmtpRead,
+		UpdateContext: resourceBigipSysOutboundSmtpUpdate,
+		DeleteContext: resourceBigipSysOutboundSmtpDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"mailhub": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Mail server the device relays its mail to, as host or host:port",
+			},
+			"from_line_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Specifies whether the From line set by the sender is kept instead of the one generated by the device",
+			},
+			"rewrite_domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Local domain the sender address of the mail is rewritten to",
+			},
+		},
+	}
+}
+
+func resourceBigipSysOutboundSmtpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	apiLog := newAPICallLogger(ctx, "bigip_sys_outbound_smtp", "outbound-smtp", "create", "/mgmt/tm/"+uriSysOutboundSmtp)
+	config := getSysOutboundSmtpConfig(d)
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, uriSysOutboundSmtp)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error configuring sys outbound-smtp: %s", err))
+	}
+	d.SetId("outbound-smtp")
+	return resourceBigipSysOutboundSmtpRead(ctx, d, meta)
+}
+
+func resourceBigipSysOutboundSmtpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	apiLog := newAPICallLogger(ctx, "bigip_sys_outbound_smtp", "outbound-smtp", "read", "/mgmt/tm/"+uriSysOutboundSmtp)
+
+	settings := &SysOutboundSmtp{}
+	_, err := getRestEntity(client, settings, uriSysOutboundSmtp)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("mailhub", settings.Mailhub)
+	_ = d.Set("from_line_override", settings.FromLineOverride)
+	_ = d.Set("rewrite_domain", settings.RewriteDomain)
+	return nil
+}
+
+func resourceBigipSysOutboundSmtpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	apiLog := newAPICallLogger(ctx, "bigip_sys_outbound_smtp", "outbound-smtp", "update", "/mgmt/tm/"+uriSysOutboundSmtp)
+	config := getSysOutboundSmtpConfig(d)
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, uriSysOutboundSmtp)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating sys outbound-smtp: %s", err))
+	}
+	return resourceBigipSysOutboundSmtpRead(ctx, d, meta)
+}
+
+func resourceBigipSysOutboundSmtpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// No API support for Delete, the settings are left as they are on the device
+	d.SetId("")
+	return nil
+}
+
+// getSysOutboundSmtpConfig returns only the attributes present in the configuration so that
+// settings Terraform does not manage are left untouched by the PATCH.
+func getSysOutboundSmtpConfig(d *schema.ResourceData) map[string]interface{} {
+	config := make(map[string]interface{})
+	rawConfig := d.GetRawConfig()
+	attrs := map[string]string{
+		"mailhub":            "mailhub",
+		"from_line_override": "fromLineOverride",
+		"rewrite_domain":     "rewriteDomain",
+	}
+	for attr, key := range attrs {
+		if !rawConfig.IsNull() && rawConfig.GetAttr(attr).IsNull() {
+			continue
+		}
+		config[key] = d.Get(attr)
+	}
+	return config
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TEST_OUTBOUND_SMTP_RESOURCE = `
+resource "bigip_sys_outbound_smtp" "test-smtp" {
+  mailhub        = "mail.example.com:25"
+  rewrite_domain = "example.com"
+}
+`
+
+func TestAccBigipSysOutboundSmtp_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_OUTBOUND_SMTP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOutboundSmtpMailhub("mail.example.com:25"),
+					resource.TestCheckResourceAttr("bigip_sys_outbound_smtp.test-smtp", "mailhub", "mail.example.com:25"),
+					resource.TestCheckResourceAttr("bigip_sys_outbound_smtp.test-smtp", "rewrite_domain", "example.com"),
+					resource.TestCheckResourceAttrSet("bigip_sys_outbound_smtp.test-smtp", "from_line_override"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysOutboundSmtp_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_OUTBOUND_SMTP_RESOURCE,
+			},
+			{
+				ResourceName:      "bigip_sys_outbound_smtp.test-smtp",
+				ImportStateId:     "outbound-smtp",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckOutboundSmtpMailhub(mailhub string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		settings := &SysOutboundSmtp{}
+		if _, err := getRestEntity(client, settings, uriSysOutboundSmtp); err != nil {
+			return err
+		}
+		if settings.Mailhub != mailhub {
+			return fmt.Errorf("outbound-smtp mailhub is %q, expected %q", settings.Mailhub, mailhub)
+		}
+		return nil
+	}
+}
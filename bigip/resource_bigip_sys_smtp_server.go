@@ -0,0 +1,197 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriSysSmtpServer = "sys/smtp-server"
+
+// SmtpServer mirrors the sys smtp-server object.
+type SmtpServer struct {
+	Name                   string `json:"name,omitempty"`
+	FullPath               string `json:"fullPath,omitempty"`
+	SmtpServerHostName     string `json:"smtpServerHostName,omitempty"`
+	SmtpServerPortNumber   int    `json:"smtpServerPortNumber,omitempty"`
+	LocalHostName          string `json:"localHostName"`
+	FromAddress            string `json:"fromAddress"`
+	EncryptedConnection    string `json:"encryptedConnection,omitempty"`
+	AuthenticationEnabled  bool   `json:"authenticationEnabled,omitempty"`
+	AuthenticationDisabled bool   `json:"authenticationDisabled,omitempty"`
+	Username               string `json:"username"`
+	Password               string `json:"password,omitempty"`
+}
+
+func resourceBigipSysSmtpServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysSmtpServerCreate,
+		ReadContext:   resourceBigipSysSmtpServerRead,
+		UpdateContext: resourceBigipSysSmtpServerUpdate,
+		DeleteContext: resourceBigipSysSmtpServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the SMTP server configuration, in full path format e.g. /Common/alerts",
+				ValidateFunc: validateF5NameWithDirectory,
+			},
+			"smtp_server_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Host name or IP address of the SMTP server",
+			},
+			"smtp_server_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      25,
+				ValidateFunc: validation.IntBetween(1, 65535),
+				Description:  "Port of the SMTP server",
+			},
+			"local_host_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Host name the BIG-IP announces to the SMTP server",
+			},
+			"from_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Email address the alerts are sent from",
+			},
+			"encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "none",
+				ValidateFunc: validation.StringInSlice([]string{"none", "tls", "ssl"}, false),
+				Description:  "Encryption of the connection to the SMTP server: none, tls or ssl",
+			},
+			"authentication_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Authenticate to the SMTP server with username and password",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User name used to authenticate to the SMTP server",
+			},
+			"password": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressObfuscatedSecretDiff,
+				Description:      "Password used to authenticate to the SMTP server",
+			},
+		},
+	}
+}
+
+func resourceBigipSysSmtpServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	apiLog := newAPICallLogger(ctx, "bigip_sys_smtp_server", name, "create", icontrolURI(uriSysSmtpServer, name))
+
+	config := getSmtpServerConfig(d, &SmtpServer{Name: name})
+	apiLog.payload(config)
+	err := postRestEntity(client, config, uriSysSmtpServer)
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating SMTP server (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipSysSmtpServerRead(ctx, d, meta)
+}
+
+func resourceBigipSysSmtpServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_sys_smtp_server", name, "read", icontrolURI(uriSysSmtpServer, name))
+
+	smtp := &SmtpServer{}
+	found, err := getRestEntity(client, smtp, restObjectPath(uriSysSmtpServer, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		tflog.Warn(apiLog.ctx, "SMTP server not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("smtp_server_address", smtp.SmtpServerHostName)
+	_ = d.Set("smtp_server_port", smtp.SmtpServerPortNumber)
+	_ = d.Set("local_host_name", smtp.LocalHostName)
+	_ = d.Set("from_address", smtp.FromAddress)
+	_ = d.Set("encryption", smtp.EncryptedConnection)
+	_ = d.Set("authentication_enabled", smtp.AuthenticationEnabled)
+	_ = d.Set("username", smtp.Username)
+	// The password is only returned obfuscated, if at all.
+	if smtp.Password != "" {
+		setSecret(d, "password", smtp.Password)
+	}
+	return nil
+}
+
+func resourceBigipSysSmtpServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_sys_smtp_server", name, "update", icontrolURI(uriSysSmtpServer, name))
+
+	config := getSmtpServerConfig(d, &SmtpServer{})
+	if !d.HasChange("password") {
+		config.Password = ""
+	}
+	apiLog.payload(config)
+	err := patchRestEntity(client, config, restObjectPath(uriSysSmtpServer, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying SMTP server (%s): %s", name, err))
+	}
+	return resourceBigipSysSmtpServerRead(ctx, d, meta)
+}
+
+func resourceBigipSysSmtpServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	apiLog := newAPICallLogger(ctx, "bigip_sys_smtp_server", name, "delete", icontrolURI(uriSysSmtpServer, name))
+
+	err := deleteRestEntity(client, restObjectPath(uriSysSmtpServer, name))
+	apiLog.done(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSmtpServerConfig(d *schema.ResourceData, config *SmtpServer) *SmtpServer {
+	config.SmtpServerHostName = d.Get("smtp_server_address").(string)
+	config.SmtpServerPortNumber = d.Get("smtp_server_port").(int)
+	config.LocalHostName = d.Get("local_host_name").(string)
+	config.FromAddress = d.Get("from_address").(string)
+	config.EncryptedConnection = d.Get("encryption").(string)
+	if d.Get("authentication_enabled").(bool) {
+		config.AuthenticationEnabled = true
+	} else {
+		config.AuthenticationDisabled = true
+	}
+	config.Username = d.Get("username").(string)
+	config.Password = d.Get("password").(string)
+	return config
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestSmtpServerName = fmt.Sprintf("/%s/test-smtp-server", TestPartition)
+
+func TestAccBigipSysSmtpServer_create(t *testing.T) {
+	resName := "bigip_sys_smtp_server.test-smtp"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSmtpServerDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSmtpServerConfig(TestSmtpServerName, 25, "none"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSmtpServerExists(TestSmtpServerName),
+					resource.TestCheckResourceAttr(resName, "smtp_server_address", "mail.example.com"),
+					resource.TestCheckResourceAttr(resName, "smtp_server_port", "25"),
+					resource.TestCheckResourceAttr(resName, "encryption", "none"),
+					resource.TestCheckResourceAttr(resName, "authentication_enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "password", "smtppass1234"),
+				),
+			},
+			{
+				Config: testAccSmtpServerConfig(TestSmtpServerName, 587, "tls"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSmtpServerExists(TestSmtpServerName),
+					resource.TestCheckResourceAttr(resName, "smtp_server_port", "587"),
+					resource.TestCheckResourceAttr(resName, "encryption", "tls"),
+				),
+			},
+			{
+				Config:   testAccSmtpServerConfig(TestSmtpServerName, 587, "tls"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resName,
+				ImportStateId:           TestSmtpServerName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccSmtpServerConfig(name string, port int, encryption string) string {
+	return fmt.Sprintf(`
+resource "bigip_sys_smtp_server" "test-smtp" {
+  name                   = "%s"
+  smtp_server_address    = "mail.example.com"
+  smtp_server_port       = %d
+  local_host_name        = "bigip.example.com"
+  from_address           = "bigip@example.com"
+  encryption             = "%s"
+  authentication_enabled = true
+  username               = "alerts"
+  password               = "smtppass1234"
+}
+`, name, port, encryption)
+}
+
+func testCheckSmtpServerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		found, err := getRestEntity(client, &SmtpServer{}, restObjectPath(uriSysSmtpServer, name))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("SMTP server %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckSmtpServerDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_smtp_server" {
+			continue
+		}
+		found, err := getRestEntity(client, &SmtpServer{}, restObjectPath(uriSysSmtpServer, rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("SMTP server %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -33,9 +33,11 @@ func resourceBigipSysSnmpTraps() *schema.Resource {
 				Description: "Name",
 			},
 			"auth_passwordencrypted": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Encrypted password ",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressObfuscatedSecretDiff,
+				Description:      "Encrypted password ",
 			},
 
 			"auth_protocol": {
@@ -75,12 +77,15 @@ func resourceBigipSysSnmpTraps() *schema.Resource {
 			"privacy_password": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				Description: "Specifies the clear text password used to encrypt traffic. This field will not be displayed. ",
 			},
 			"privacy_password_encrypted": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Specifies the encrypted password used to encrypt traffic. ",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressObfuscatedSecretDiff,
+				Description:      "Specifies the encrypted password used to encrypt traffic. ",
 			},
 			"privacy_protocol": {
 				Type:        schema.TypeString,
@@ -214,7 +219,7 @@ func resourceBigipSysSnmpTrapsRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("engine_id", traps.EngineId)
 	_ = d.Set("host", traps.Host)
 	_ = d.Set("port", traps.Port)
-	_ = d.Set("privacy_password", traps.PrivacyPassword)
+	setSecret(d, "privacy_password", traps.PrivacyPassword)
 	_ = d.Set("privacy_password_encrypted", traps.PrivacyPasswordEncrypted)
 	_ = d.Set("privacy_protocol", traps.PrivacyProtocol)
 	_ = d.Set("security_level", traps.SecurityLevel)
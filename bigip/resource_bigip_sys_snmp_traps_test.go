@@ -0,0 +1,87 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestSnmpTrapName = "test-snmp-trap-v3"
+
+var TestSnmpTrapV3Resource = `
+resource "bigip_sys_snmp_traps" "test-trap-v3" {
+  name                   = "` + TestSnmpTrapName + `"
+  host                   = "195.10.10.2"
+  port                   = 162
+  version                = "3"
+  security_level         = "auth-privacy"
+  security_name          = "tfsnmpuser"
+  engine_id              = "0x80001f8880c6b6067fdacfb558"
+  auth_protocol          = "sha"
+  auth_passwordencrypted = "authpass1234"
+  privacy_protocol       = "aes"
+  privacy_password       = "privpass1234"
+}
+`
+
+func TestAccBigipSysSnmpTraps_SecretsNoDiff(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSnmpTrapDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestSnmpTrapV3Resource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSnmpTrapExists(TestSnmpTrapName),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_traps.test-trap-v3", "privacy_password", "privpass1234"),
+				),
+			},
+			{
+				Config:   TestSnmpTrapV3Resource,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testCheckSnmpTrapExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		trap, err := client.TRAPs(name)
+		if err != nil {
+			return err
+		}
+		if trap == nil || trap.Name == "" {
+			return fmt.Errorf("SNMP trap %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckSnmpTrapDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_snmp_traps" {
+			continue
+		}
+		trap, err := client.TRAPs(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if trap != nil && trap.Name != "" {
+			return fmt.Errorf("SNMP trap %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The helpers below talk to iControl REST endpoints that go-bigip does not model yet.
+// Paths are relative to /mgmt/tm unless they start with "mgmt/", matching bigip.APICall.
+
+// restObjectPath returns the path of a named object inside a collection, e.g.
+// ltm/profile/ocsp-stapling-params/~Common~my-params.
+func restObjectPath(collection, name string) string {
+	return collection + "/" + strings.ReplaceAll(name, "/", "~")
+}
+
+func restMarshal(body interface{}) (string, error) {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(body); err != nil {
+		return "", err
+	}
+	return strings.TrimRight(buffer.String(), "\n"), nil
+}
+
+func restCall(client *bigip.BigIP, method, path string, body interface{}) ([]byte, error) {
+	req := &bigip.APIRequest{
+		Method:      method,
+		URL:         path,
+		ContentType: "application/json",
+	}
+	if body != nil {
+		payload, err := restMarshal(body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = payload
+	}
+	return client.APICall(req)
+}
+
+// isRestNotFound reports whether a failed API response was a 404.
+func isRestNotFound(resp []byte, err error) bool {
+	if err == nil {
+		return false
+	}
+	var reqError bigip.RequestError
+	if json.Unmarshal(resp, &reqError) == nil && reqError.Code == 404 {
+		return true
+	}
+	return strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "was not found")
+}
+
+// getRestEntity GETs path into out. It returns false, nil when the object does not exist.
+func getRestEntity(client *bigip.BigIP, out interface{}, path string) (bool, error) {
+	resp, err := restCall(client, "get", path, nil)
+	if err != nil {
+		if isRestNotFound(resp, err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := json.Unmarshal(resp, out); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// restCollectionPage is a page of a collection. The BIG-IP splits the collections larger than its
+// page size, e.g. the members of a pool with thousands of them, and links each page to the next.
+type restCollectionPage[T any] struct {
+	Items    []T    `json:"items"`
+	NextLink string `json:"nextLink"`
+}
+
+// getRestItems GETs the items of the collection at path, following the nextLink of each page until
+// the last one. It returns nil, nil when the collection does not exist.
+func getRestItems[T any](client *bigip.BigIP, path string) ([]T, error) {
+	var items []T
+	for path != "" {
+		page := &restCollectionPage[T]{}
+		found, err := getRestEntity(client, page, path)
+		if err != nil || !found {
+			return items, err
+		}
+		items = append(items, page.Items...)
+		next, err := restNextLinkPath(page.NextLink)
+		if err != nil {
+			return nil, err
+		}
+		if next == path {
+			return nil, fmt.Errorf("page %s of the collection links to itself", path)
+		}
+		path = next
+	}
+	return items, nil
+}
+
+// restNextLinkPath returns the path to GET the page a nextLink points to. The link is an absolute URL
+// on the management host as the BIG-IP sees it (https://localhost/mgmt/tm/...), only its path and
+// query are kept.
+func restNextLinkPath(link string) (string, error) {
+	if link == "" {
+		return "", nil
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("invalid nextLink %q: %v", link, err)
+	}
+	return strings.TrimPrefix(u.RequestURI(), "/"), nil
+}
+
+func postRestEntity(client *bigip.BigIP, body interface{}, path string) error {
+	_, err := restCall(client, "post", path, body)
+	return err
+}
+
+func putRestEntity(client *bigip.BigIP, body interface{}, path string) error {
+	_, err := restCall(client, "put", path, body)
+	return err
+}
+
+func patchRestEntity(client *bigip.BigIP, body interface{}, path string) error {
+	_, err := restCall(client, "patch", path, body)
+	return err
+}
+
+func deleteRestEntity(client *bigip.BigIP, path string) error {
+	_, err := restCall(client, "delete", path, nil)
+	return err
+}
+
+// checkRestReferences returns an error naming the objects of names that do not exist in collection,
+// so a reference to a missing object fails before anything is changed on the device.
+func checkRestReferences(client *bigip.BigIP, collection, kind string, names ...string) error {
+	var missing []string
+	for _, name := range names {
+		if name == "" || name == "none" {
+			continue
+		}
+		found, err := getRestEntity(client, &struct{}{}, restObjectPath(collection, name)+"?$select=name")
+		if err != nil {
+			return err
+		}
+		if !found {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s %s not found", kind, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// runTmshCommand runs a tmsh command through util/bash, for operations iControl REST does not
+// expose as properties. tmsh is silent on success, so any output is reported as an error.
+func runTmshCommand(client *bigip.BigIP, command string) error {
+	out, err := runBashCommand(client, "tmsh "+command)
+	if err != nil {
+		return err
+	}
+	if out != "" {
+		return fmt.Errorf("tmsh %s: %s", command, out)
+	}
+	return nil
+}
+
+// runBashCommand runs script through util/bash and returns its output without the surrounding
+// white space.
+func runBashCommand(client *bigip.BigIP, script string) (string, error) {
+	escaped := strings.ReplaceAll(script, "'", "'\\''")
+	result, err := client.RunCommand(&bigip.BigipCommand{
+		Command:     "run",
+		UtilCmdArgs: fmt.Sprintf("-c '%s'", escaped),
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(result.CommandResult), nil
+}
+
+// ltmObjectMeta holds the description of an LTM object and the iApp application service owning it,
+// for the objects whose go-bigip type does not model them.
+type ltmObjectMeta struct {
+	Description string `json:"description,omitempty"`
+	AppService  string `json:"appService,omitempty"`
+}
+
+func getLtmObjectMeta(client *bigip.BigIP, path string) (*ltmObjectMeta, error) {
+	meta := &ltmObjectMeta{}
+	_, err := getRestEntity(client, meta, path+"?$select=description,appService")
+	return meta, err
+}
+
+// setLtmObjectMeta PATCHes the "description" and "app_service" attributes of d listed in attrs onto
+// the object at path. A configured description is always sent, since a PUT of the other attributes
+// may have reset it, and app_service only when it changed, so adopted objects keep their owner.
+func setLtmObjectMeta(d *schema.ResourceData, client *bigip.BigIP, path string, attrs ...string) error {
+	payload := make(map[string]string)
+	for _, attr := range attrs {
+		value := d.Get(attr).(string)
+		switch attr {
+		case "description":
+			if value != "" || d.HasChange(attr) {
+				payload["description"] = value
+			}
+		case "app_service":
+			if value != "" && d.HasChange(attr) {
+				payload["appService"] = value
+			}
+		}
+	}
+	if len(payload) == 0 {
+		return nil
+	}
+	return patchRestEntity(client, payload, path)
+}
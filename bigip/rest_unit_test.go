@@ -0,0 +1,148 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// handlePagedMembers serves the members of /Common/big-pool in three pages of two members, linked
+// the way the BIG-IP links them.
+func handlePagedMembers(t *testing.T, requests *[]string) {
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~big-pool/members", func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RawQuery)
+		link := "https://localhost/mgmt/tm/ltm/pool/~Common~big-pool/members?$top=2&$skip=%d&ver=16.1.0"
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("$skip") {
+		case "":
+			_, _ = fmt.Fprintf(w, `{"items":[{"name":"10.0.0.1:80","fullPath":"/Common/10.0.0.1:80"},
+				{"name":"10.0.0.2:80","fullPath":"/Common/10.0.0.2:80"}],"nextLink":"`+link+`"}`, 2)
+		case "2":
+			_, _ = fmt.Fprintf(w, `{"items":[{"name":"10.0.0.3:80","fullPath":"/Common/10.0.0.3:80"},
+				{"name":"10.0.0.4:80","fullPath":"/Common/10.0.0.4:80"}],"nextLink":"`+link+`"}`, 4)
+		case "4":
+			_, _ = fmt.Fprintf(w, `{"items":[{"name":"10.0.0.5:80","fullPath":"/Common/10.0.0.5:80","ratio":3},
+				{"name":"10.0.0.6:80","fullPath":"/Common/10.0.0.6:80"}]}`)
+		default:
+			t.Errorf("unexpected page %s", r.URL.RawQuery)
+		}
+	})
+}
+
+func TestGetRestItemsPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests []string
+	handlePagedMembers(t, &requests)
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	members, err := getPoolMembers(client, "/Common/big-pool")
+	assert.NoError(t, err)
+	var names []string
+	for _, m := range members {
+		names = append(names, m.Name)
+	}
+	assert.Equal(t, []string{"10.0.0.1:80", "10.0.0.2:80", "10.0.0.3:80", "10.0.0.4:80", "10.0.0.5:80", "10.0.0.6:80"}, names)
+	assert.Equal(t, []string{"", "$top=2&$skip=2&ver=16.1.0", "$top=2&$skip=4&ver=16.1.0"}, requests)
+}
+
+// TestPoolAttachmentReadLastPage reads a member that is only on the last page of the members.
+func TestPoolAttachmentReadLastPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests []string
+	handlePagedMembers(t, &requests)
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~big-pool", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"big-pool","fullPath":"/Common/big-pool"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~big-pool/members/~Common~10.0.0.5:80", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"description":"last page"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	r := resourceBigipLtmPoolAttachment()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"pool": "/Common/big-pool",
+		"node": "/Common/10.0.0.5:80",
+	})
+	d.SetId("/Common/big-pool-/Common/10.0.0.5:80")
+	assert.False(t, r.ReadContext(context.Background(), d, client).HasError())
+	assert.NotEqual(t, "", d.Id())
+	assert.Equal(t, 3, d.Get("ratio"))
+	assert.Equal(t, "last page", d.Get("description"))
+	assert.Len(t, requests, 3)
+}
+
+func TestGetRestItemsNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~missing/members", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested Pool (/Common/missing) was not found."}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	members, err := getPoolMembers(client, "/Common/missing")
+	assert.NoError(t, err)
+	assert.Empty(t, members)
+}
+
+func TestGetRestItemsLinkLoop(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/cm/device", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"bigip1"}],"nextLink":"https://localhost/mgmt/tm/cm/device?$skip=1"}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	_, err := getRestItems[cmDevice](client, "cm/device")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "links to itself")
+}
+
+func TestRestNextLinkPath(t *testing.T) {
+	for link, expected := range map[string]string{
+		"": "",
+		"https://localhost/mgmt/tm/ltm/virtual?$top=500&$skip=500":        "mgmt/tm/ltm/virtual?$top=500&$skip=500",
+		"https://localhost:443/mgmt/tm/asm/policies?$skip=100&$top=100":   "mgmt/tm/asm/policies?$skip=100&$top=100",
+		"https://localhost/mgmt/tm/ltm/pool/~Common~p/members?ver=17.1.0": "mgmt/tm/ltm/pool/~Common~p/members?ver=17.1.0",
+	} {
+		path, err := restNextLinkPath(link)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, path, link)
+	}
+}
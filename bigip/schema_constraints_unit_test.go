@@ -0,0 +1,116 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSchemaConstraints checks that the mutually exclusive and dependent attributes of the LTM and
+// NET resources fail validation, naming the offending attribute, before any call to the BIG-IP.
+func TestSchemaConstraints(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		resource *schema.Resource
+		config   map[string]interface{}
+		err      string
+	}{
+		{
+			name:     "route gateway and tunnel",
+			resource: resourceBigipNetRoute(),
+			config:   map[string]interface{}{"name": "/Common/r1", "network": "10.10.10.0/24", "gw": "1.1.1.2", "tunnel_ref": "/Common/tun1"},
+			err:      `"gw": only one of`,
+		},
+		{
+			name:     "route without next hop",
+			resource: resourceBigipNetRoute(),
+			config:   map[string]interface{}{"name": "/Common/r1", "network": "10.10.10.0/24"},
+			err:      `one of `,
+		},
+		{
+			name:     "route gateway",
+			resource: resourceBigipNetRoute(),
+			config:   map[string]interface{}{"name": "/Common/r1", "network": "10.10.10.0/24", "gw": "1.1.1.2"},
+		},
+		{
+			name:     "virtual server destination and traffic matching criteria",
+			resource: resourceBigipLtmVirtualServer(),
+			config:   map[string]interface{}{"name": "/Common/vs1", "destination": "10.1.1.1", "trafficmatching_criteria": "/Common/tmc1"},
+			err:      `"destination": conflicts with trafficmatching_criteria`,
+		},
+		{
+			name:     "virtual server snatpool without translation",
+			resource: resourceBigipLtmVirtualServer(),
+			config:   map[string]interface{}{"name": "/Common/vs1", "destination": "10.1.1.1", "port": 80, "snatpool": "/Common/snat1"},
+			err:      `"snatpool": all of`,
+		},
+		{
+			name:     "virtual server default persistence without persistence profiles",
+			resource: resourceBigipLtmVirtualServer(),
+			config:   map[string]interface{}{"name": "/Common/vs1", "destination": "10.1.1.1", "port": 80, "default_persistence_profile": "/Common/cookie"},
+			err:      `"default_persistence_profile": all of`,
+		},
+		{
+			name:     "virtual server fallback persistence without persistence profiles",
+			resource: resourceBigipLtmVirtualServer(),
+			config:   map[string]interface{}{"name": "/Common/vs1", "destination": "10.1.1.1", "port": 80, "fallback_persistence_profile": "/Common/source_addr"},
+			err:      `"fallback_persistence_profile": all of`,
+		},
+		{
+			name:     "virtual server snat pool",
+			resource: resourceBigipLtmVirtualServer(),
+			config:   map[string]interface{}{"name": "/Common/vs1", "destination": "10.1.1.1", "port": 80, "source_address_translation": "snat", "snatpool": "/Common/snat1"},
+		},
+		{
+			name:     "snat translation and snatpool",
+			resource: resourceBigipLtmSnat(),
+			config:   map[string]interface{}{"name": "/Common/snat1", "origins": []interface{}{map[string]interface{}{"name": "10.1.1.0/24"}}, "translation": "/Common/10.2.2.2", "snatpool": "/Common/snatpool1"},
+			err:      `"translation": conflicts with snatpool`,
+		},
+		{
+			name:     "client ssl ciphers and cipher group",
+			resource: resourceBigipLtmProfileClientSsl(),
+			config:   map[string]interface{}{"name": "/Common/clientssl1", "ciphers": "DEFAULT", "cipher_group": "/Common/f5-default"},
+			err:      `"cipher_group": conflicts with ciphers`,
+		},
+		{
+			name:     "server ssl ciphers and cipher group",
+			resource: resourceBigipLtmProfileServerSsl(),
+			config:   map[string]interface{}{"name": "/Common/serverssl1", "ciphers": "DEFAULT", "cipher_group": "/Common/f5-default"},
+			err:      `"cipher_group": conflicts with ciphers`,
+		},
+		{
+			name:     "pool member session without state",
+			resource: resourceBigipLtmPoolAttachment(),
+			config:   map[string]interface{}{"pool": "/Common/pool1", "node": "/Common/10.1.1.1:80", "session": "user-disabled"},
+			err:      `"session": all of`,
+		},
+		{
+			name:     "message routing transport config snat pool without translation",
+			resource: resourceBigipLtmMessageRoutingTransportConfig(),
+			config:   map[string]interface{}{"name": "/Common/tc1", "profiles": []interface{}{"/Common/tcp"}, "snat_pool": "/Common/snat1"},
+			err:      `"snat_pool": all of`,
+		},
+	} {
+		diags := tc.resource.Validate(terraform.NewResourceConfigRaw(tc.config))
+		if tc.err == "" {
+			assert.False(t, diags.HasError(), "%s: %v", tc.name, diags)
+			continue
+		}
+		found := false
+		for _, d := range diags {
+			if strings.Contains(d.Detail, tc.err) {
+				found = true
+			}
+		}
+		assert.True(t, found, "%s: expected %q in %v", tc.name, tc.err, diags)
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const defaultCredentialsProfile = "default"
+
+// sharedCredentials is one profile of a shared credentials file. The keys are the provider
+// attribute names, in both the JSON and the ini format.
+type sharedCredentials struct {
+	Address    string `json:"address"`
+	Port       string `json:"port"`
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	TokenValue string `json:"token_value"`
+}
+
+// loadSharedCredentials reads profile from the credentials file at path. A file starting with
+// "{" is read as a JSON object keyed by profile name, anything else as ini sections.
+func loadSharedCredentials(path, profile string) (*sharedCredentials, error) {
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, path[2:])
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read shared credentials file: %v", err)
+	}
+	var profiles map[string]*sharedCredentials
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		if err := json.Unmarshal(data, &profiles); err != nil {
+			return nil, fmt.Errorf("unable to parse shared credentials file %s: %v", path, err)
+		}
+	} else if profiles, err = parseCredentialsIni(data); err != nil {
+		return nil, fmt.Errorf("unable to parse shared credentials file %s: %v", path, err)
+	}
+	creds, ok := profiles[profile]
+	if !ok || creds == nil {
+		return nil, fmt.Errorf("profile %q not found in shared credentials file %s", profile, path)
+	}
+	return creds, nil
+}
+
+func parseCredentialsIni(data []byte) (map[string]*sharedCredentials, error) {
+	profiles := make(map[string]*sharedCredentials)
+	var current *sharedCredentials
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+		if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+			current = &sharedCredentials{}
+			profiles[strings.TrimSpace(text[1:len(text)-1])] = current
+			continue
+		}
+		key, value, found := strings.Cut(text, "=")
+		if !found || current == nil {
+			return nil, fmt.Errorf("line %d: expected key = value inside a [profile] section", line)
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		switch strings.TrimSpace(key) {
+		case "address":
+			current.Address = value
+		case "port":
+			current.Port = value
+		case "username":
+			current.Username = value
+		case "password":
+			current.Password = value
+		case "token_value":
+			current.TokenValue = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %q", line, strings.TrimSpace(key))
+		}
+	}
+	return profiles, scanner.Err()
+}
+
+// applySharedCredentials fills the connection settings left empty by the provider attributes and
+// their environment variables from the selected profile of the shared credentials file.
+func applySharedCredentials(d *schema.ResourceData, config *bigip.Config) error {
+	path := d.Get("shared_credentials_file").(string)
+	profile := d.Get("profile").(string)
+	if path == "" {
+		if profile != "" {
+			return fmt.Errorf("profile %q is set but no shared_credentials_file is configured", profile)
+		}
+		return nil
+	}
+	if profile == "" {
+		profile = defaultCredentialsProfile
+	}
+	creds, err := loadSharedCredentials(path, profile)
+	if err != nil {
+		return err
+	}
+	if config.Address == "" {
+		config.Address = creds.Address
+	}
+	if config.Port == "" {
+		config.Port = creds.Port
+	}
+	if config.Username == "" {
+		config.Username = creds.Username
+	}
+	if config.Password == "" {
+		config.Password = creds.Password
+	}
+	if config.Token == "" {
+		config.Token = creds.TokenValue
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCredentialsIni = `
+# lab devices
+[default]
+address  = 10.1.1.1
+username = admin
+password = "default-pass"
+
+[dc1-bigip01]
+address  = 10.1.2.1
+port     = 8443
+username = dc1-admin
+password = dc1-pass
+`
+
+func writeTestCredentials(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadSharedCredentials(t *testing.T) {
+	path := writeTestCredentials(t, "credentials", testCredentialsIni)
+
+	creds, err := loadSharedCredentials(path, "default")
+	assert.NoError(t, err)
+	assert.Equal(t, &sharedCredentials{Address: "10.1.1.1", Username: "admin", Password: "default-pass"}, creds)
+
+	creds, err = loadSharedCredentials(path, "dc1-bigip01")
+	assert.NoError(t, err)
+	assert.Equal(t, &sharedCredentials{Address: "10.1.2.1", Port: "8443", Username: "dc1-admin", Password: "dc1-pass"}, creds)
+
+	_, err = loadSharedCredentials(path, "dc2-bigip01")
+	assert.EqualError(t, err, fmt.Sprintf("profile \"dc2-bigip01\" not found in shared credentials file %s", path))
+
+	path = writeTestCredentials(t, "credentials.json", `{"dc1-bigip01": {"address": "10.1.2.1", "username": "dc1-admin", "token_value": "dc1-token"}}`)
+	creds, err = loadSharedCredentials(path, "dc1-bigip01")
+	assert.NoError(t, err)
+	assert.Equal(t, &sharedCredentials{Address: "10.1.2.1", Username: "dc1-admin", TokenValue: "dc1-token"}, creds)
+
+	path = writeTestCredentials(t, "broken", "[default]\nuser = admin\n")
+	_, err = loadSharedCredentials(path, "default")
+	assert.ErrorContains(t, err, `line 2: unknown key "user"`)
+}
+
+// unsetProviderEnv clears the provider environment variables for the duration of the test.
+func unsetProviderEnv(t *testing.T) {
+	for _, name := range []string{"BIGIP_HOST", "BIGIP_PORT", "BIGIP_USER", "BIGIP_PASSWORD", "BIGIP_TOKEN_VALUE",
+		"BIGIP_SHARED_CREDENTIALS_FILE", "BIGIP_PROFILE", "BIGIP_AUDIT_LOG_FILE", "BIGIP_CLIENT_CERT_FILE",
+		"BIGIP_CLIENT_KEY_FILE", "BIGIP_CLIENT_CERT_PEM", "BIGIP_CLIENT_KEY_PEM"} {
+		t.Setenv(name, "")
+	}
+}
+
+// testLoginServer records the username of every token login.
+func testLoginServer(t *testing.T) (*httptest.Server, *string) {
+	var username string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mgmt/shared/authn/login", func(w http.ResponseWriter, r *http.Request) {
+		var auth map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&auth))
+		username = auth["username"]
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"token":{"token":"abc"},"timeout":{"timeout":1200}}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &username
+}
+
+func TestProviderConfigureSharedCredentialsPrecedence(t *testing.T) {
+	unsetProviderEnv(t)
+	server, username := testLoginServer(t)
+	path := writeTestCredentials(t, "credentials", fmt.Sprintf("[dc1]\naddress = %s\nusername = file-user\npassword = file-pass\n", server.URL))
+
+	configure := func(raw map[string]interface{}) error {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+		_, diags := providerConfigure(context.Background(), d, "test")
+		if diags.HasError() {
+			return fmt.Errorf("%s", diags[0].Summary)
+		}
+		return nil
+	}
+
+	// file only
+	assert.NoError(t, configure(map[string]interface{}{"shared_credentials_file": path, "profile": "dc1"}))
+	assert.Equal(t, "file-user", *username)
+
+	// the environment overrides the file
+	t.Setenv("BIGIP_USER", "env-user")
+	t.Setenv("BIGIP_PROFILE", "dc1")
+	assert.NoError(t, configure(map[string]interface{}{"shared_credentials_file": path}))
+	assert.Equal(t, "env-user", *username)
+
+	// the attribute overrides the environment
+	assert.NoError(t, configure(map[string]interface{}{"shared_credentials_file": path, "username": "attr-user"}))
+	assert.Equal(t, "attr-user", *username)
+
+	// the environment selects the file
+	t.Setenv("BIGIP_SHARED_CREDENTIALS_FILE", path)
+	assert.NoError(t, configure(map[string]interface{}{}))
+	assert.Equal(t, "env-user", *username)
+}
+
+func TestProviderConfigureSharedCredentialsErrors(t *testing.T) {
+	unsetProviderEnv(t)
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"profile": "dc1"})
+	_, diags := providerConfigure(context.Background(), d, "test")
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `profile "dc1" is set but no shared_credentials_file is configured`, diags[0].Summary)
+
+	path := writeTestCredentials(t, "credentials", testCredentialsIni)
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"shared_credentials_file": path, "profile": "dc1"})
+	_, diags = providerConfigure(context.Background(), d, "test")
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, `profile "dc1" not found`)
+}
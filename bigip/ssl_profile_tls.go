@@ -0,0 +1,113 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sslTmOptions are the tm_options of the client-ssl and server-ssl profiles known to this provider.
+// TMOS adds values over time, so other values are accepted with a warning.
+var sslTmOptions = map[string]bool{
+	"all-bugfixes":                           true,
+	"cipher-server-preference":               true,
+	"dont-insert-empty-fragments":            true,
+	"enable-tls13-compat":                    true,
+	"microsoft-big-sslv3-buffer":             true,
+	"microsoft-sess-id-bug":                  true,
+	"msie-sslv2-rsa-padding":                 true,
+	"netscape-ca-dn-bug":                     true,
+	"netscape-demo-cipher-change-bug":        true,
+	"netscape-reuse-cipher-change-bug":       true,
+	"no-dtls":                                true,
+	"no-dtlsv1":                              true,
+	"no-dtlsv1.2":                            true,
+	"no-session-resumption-on-renegotiation": true,
+	"no-ssl":                                 true,
+	"no-sslv2":                               true,
+	"no-sslv3":                               true,
+	"no-tlsv1":                               true,
+	"no-tlsv1.1":                             true,
+	"no-tlsv1.2":                             true,
+	"no-tlsv1.3":                             true,
+	"passive-close":                          true,
+	"pkcs1-check-1":                          true,
+	"pkcs1-check-2":                          true,
+	"single-dh-use":                          true,
+	"ssleay-080-client-dh-bug":               true,
+	"sslref2-reuse-cert-type-bug":            true,
+	"tls-block-padding-bug":                  true,
+	"tls-d5-bug":                             true,
+	"tls-rollback-bug":                       true,
+}
+
+// sslData0rttValues are the known values of the TLS 1.3 early data setting.
+var sslData0rttValues = map[string]bool{
+	"disabled":                 true,
+	"enabled":                  true,
+	"enabled-with-anti-replay": true,
+	"enabled-no-anti-replay":   true,
+}
+
+func warnUnknownSslValue(known map[string]bool) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) ([]string, []error) {
+		if known[v.(string)] {
+			return nil, nil
+		}
+		return []string{fmt.Sprintf("%q is not a %s value known to the provider, it is sent to the BIG-IP as is", v.(string), k)}, nil
+	}
+}
+
+// sslTls13CipherGroupWarning warns about TLS 1.3 enabled with a cipher string: the BIG-IP only
+// negotiates TLS 1.3 with the ciphers of a cipher group, so clients silently fall back to TLS 1.2.
+func sslTls13CipherGroupWarning(d *schema.ResourceData, name string) diag.Diagnostics {
+	t, ok := d.GetOk("tm_options")
+	if !ok || t.(*schema.Set).Contains("no-tlsv1.3") {
+		return nil
+	}
+	if cipherGroup := d.Get("cipher_group").(string); cipherGroup != "" && cipherGroup != "none" {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("TLS 1.3 enabled without a cipher group on SSL profile %s", name),
+			Detail:   "tm_options does not contain no-tlsv1.3, but TLS 1.3 is only negotiated with the ciphers of a cipher_group.",
+		},
+	}
+}
+
+// setSslProfileData0rtt sends data_0rtt, which the go-bigip SSL profile models cannot carry.
+func setSslProfileData0rtt(d *schema.ResourceData, client *bigip.BigIP, collection, name string) error {
+	data0rtt, ok := d.GetOk("data_0rtt")
+	if !ok {
+		return nil
+	}
+	if !d.IsNewResource() && !d.HasChange("data_0rtt") {
+		return nil
+	}
+	body := map[string]interface{}{
+		"data_0rtt": data0rtt.(string),
+	}
+	if err := patchRestEntity(client, body, restObjectPath(collection, name)); err != nil {
+		return fmt.Errorf("error setting data_0rtt of SSL profile (%s): %s", name, err)
+	}
+	return nil
+}
+
+func readSslProfileData0rtt(d *schema.ResourceData, client *bigip.BigIP, collection, name string) error {
+	profile := &struct {
+		Data0rtt string `json:"data_0rtt"`
+	}{}
+	if _, err := getRestEntity(client, profile, restObjectPath(collection, name)+"?$select=data_0rtt"); err != nil {
+		return fmt.Errorf("error reading data_0rtt of SSL profile (%s): %s", name, err)
+	}
+	return d.Set("data_0rtt", profile.Data0rtt)
+}
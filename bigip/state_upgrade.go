@@ -0,0 +1,27 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// A resource whose attributes change shape bumps its SchemaVersion and appends a StateUpgrader for
+// the previous version, so that the states written by older providers are converted to the new
+// layout instead of planning a destroy and create. Each upgrader only rewrites the raw JSON state of
+// its own version, the SDK chains them from the version of the state up to the current one and then
+// drops the attributes the schema no longer has.
+
+// stateUpgrader returns the upgrader of the states of version, read with the current schema s.
+// The type is only used for the flatmap states of Terraform 0.11, which hold no nested layouts the
+// upgraders depend on.
+func stateUpgrader(version int, s map[string]*schema.Schema, upgrade schema.StateUpgradeFunc) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    (&schema.Resource{Schema: s}).CoreConfigSchema().ImpliedType(),
+		Upgrade: upgrade,
+	}
+}
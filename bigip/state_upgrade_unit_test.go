@@ -0,0 +1,70 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// upgradeTestState runs the state upgrade of the SDK on rawState, a state of resourceType written
+// at version, and returns the upgraded state as Terraform stores it.
+func upgradeTestState(t *testing.T, resourceType string, version int64, rawState string) map[string]interface{} {
+	provider := Provider()
+	server := schema.NewGRPCProviderServer(provider)
+	resp, err := server.UpgradeResourceState(context.Background(), &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: resourceType,
+		Version:  version,
+		RawState: &tfprotov5.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diagnostic := range resp.Diagnostics {
+		if diagnostic.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+	ty := provider.ResourcesMap[resourceType].CoreConfigSchema().ImpliedType()
+	val, err := ctymsgpack.Unmarshal(resp.UpgradedState.MsgPack, ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ctyjson.Marshal(val, ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := map[string]interface{}{}
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+// TestProfileFullPathStateUpgrade runs the upgrade of a bare name state of version 0 through the
+// SDK, as Terraform does on the first refresh after a provider upgrade.
+func TestProfileFullPathStateUpgrade(t *testing.T) {
+	state := upgradeTestState(t, "bigip_ltm_profile_client_ssl", 0, `{
+		"id": "web-ssl",
+		"name": "web-ssl",
+		"cert_key_chain": [{"name": "web", "cert": "/Common/web.crt", "key": "/Common/web.key"}],
+		"removed_attribute": "x"
+	}`)
+	assert.Equal(t, "/Common/web-ssl", state["id"])
+	assert.Equal(t, "/Common/web-ssl", state["name"])
+	assert.NotContains(t, state, "removed_attribute")
+	chain := state["cert_key_chain"].([]interface{})
+	if assert.Len(t, chain, 1) {
+		assert.Equal(t, "/Common/web.key", chain[0].(map[string]interface{})["key"])
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+)
+
+// errReadOnlyMode is returned for every request that would modify the BIG-IP while read_only is set.
+const errReadOnlyMode = "provider is in read-only mode"
+
+// transportHooks holds the provider level behaviour applied to every request sent to a BIG-IP.
+type transportHooks struct {
+	readOnly     bool
+	extraHeaders map[string]string
+	audit        *auditLog
+	metrics      *apiMetrics
+	// serverFingerprint is the pinned SHA-256 fingerprint of the BIG-IP certificate, see pinServerCertificate.
+	serverFingerprint string
+}
+
+// configured reports whether any hook applies to the requests; the pinned fingerprint is checked by
+// the TLS configuration of the client itself.
+func (h *transportHooks) configured() bool {
+	return h != nil && (h.readOnly || len(h.extraHeaders) > 0 || h.audit != nil || h.metrics != nil)
+}
+
+// hookedTransport is an http.RoundTripper applying transportHooks before handing the request to next.
+type hookedTransport struct {
+	hooks *transportHooks
+	next  http.RoundTripper
+}
+
+func (t *hookedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.hooks.readOnly && !isReadOnlyRequest(req) {
+		return nil, fmt.Errorf("%s: refusing %s %s", errReadOnlyMode, req.Method, req.URL.Path)
+	}
+	if len(t.hooks.extraHeaders) > 0 {
+		// a RoundTripper must not modify the request it was given
+		req = req.Clone(req.Context())
+		for k, v := range t.hooks.extraHeaders {
+			req.Header.Set(k, v)
+		}
+	}
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		resp, err := t.next.RoundTrip(req)
+		if err == nil {
+			logResponseBody(req, resp)
+		}
+		if t.hooks.audit != nil {
+			t.hooks.audit.record(req, resp, err, time.Since(start))
+		}
+		if t.hooks.metrics != nil {
+			t.hooks.metrics.record(req, resp, time.Since(start))
+		}
+		if attempt >= createRetries || !isFolderNotVisible(req, resp, err) {
+			return resp, err
+		}
+		log.Printf("[DEBUG] %s %s failed as its folder is not visible yet, retrying in %s (attempt %d of %d)",
+			req.Method, req.URL.Path, createRetryInterval, attempt, createRetries)
+		select {
+		case <-req.Context().Done():
+			return resp, err
+		case <-time.After(createRetryInterval):
+		}
+		body, bodyErr := req.GetBody()
+		if bodyErr != nil {
+			return resp, err
+		}
+		_ = resp.Body.Close()
+		req = req.Clone(req.Context())
+		req.Body = body
+	}
+}
+
+// A create sent right after its partition or folder was created, or after a config sync, can fail
+// because the folder is not visible to the REST worker yet. Such creates are retried createRetries
+// times in total, createRetryInterval apart, before the original error is returned.
+var (
+	createRetries       = 3
+	createRetryInterval = 2 * time.Second
+)
+
+// isFolderNotVisible reports whether resp is the failure of a create whose folder could not be found.
+// The response body is read to check the error and restored for the caller.
+func isFolderNotVisible(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil || resp == nil || req.Method != http.MethodPost || req.GetBody == nil || resp.StatusCode < 400 {
+		return false
+	}
+	body, readErr := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		return false
+	}
+	msg := strings.ToLower(string(body))
+	return strings.Contains(msg, "folder not found") || strings.Contains(msg, "not found in partition") ||
+		(strings.Contains(msg, "folder") && strings.Contains(msg, "was not found"))
+}
+
+// auditLog appends a JSON line for every request sent to a BIG-IP. Each line is written to w as
+// soon as the request completes, nothing is buffered.
+type auditLog struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// runAuditLogs holds the audit logs of the provider instances of this process, closed by
+// CloseAuditLogs when the plugin exits.
+var runAuditLogs sync.Map
+
+func newAuditLog(w io.Writer) *auditLog {
+	a := &auditLog{w: w}
+	runAuditLogs.Store(a, struct{}{})
+	return a
+}
+
+// CloseAuditLogs closes the audit log of every provider instance configured with audit_log_file that
+// was not stopped yet. It is called once the plugin stops serving.
+func CloseAuditLogs() {
+	runAuditLogs.Range(func(k, _ interface{}) bool {
+		if err := k.(*auditLog).close(); err != nil {
+			log.Printf("[ERROR] Unable to close the audit log: %v", err)
+		}
+		return true
+	})
+}
+
+// close closes the underlying writer when it is closable. Requests recorded afterwards are dropped.
+func (a *auditLog) close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	runAuditLogs.Delete(a)
+	c, ok := a.w.(io.Closer)
+	a.w = nil
+	if ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// auditRecord is a single line of the audit log.
+type auditRecord struct {
+	Time       string `json:"time"`
+	Method     string `json:"method"`
+	URI        string `json:"uri"`
+	Status     int    `json:"status,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+func (a *auditLog) record(req *http.Request, resp *http.Response, err error, elapsed time.Duration) {
+	rec := auditRecord{
+		Time:       time.Now().UTC().Format(time.RFC3339Nano),
+		Method:     req.Method,
+		URI:        req.URL.RequestURI(),
+		DurationMs: elapsed.Milliseconds(),
+	}
+	if resp != nil {
+		rec.Status = resp.StatusCode
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	line, _ := json.Marshal(rec)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.w == nil {
+		return
+	}
+	_, _ = a.w.Write(append(line, '\n'))
+}
+
+// isReadOnlyRequest reports whether req is allowed in read-only mode. Besides safe methods,
+// authentication calls are let through so token sessions keep working.
+func isReadOnlyRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		return strings.HasSuffix(req.URL.Path, "/mgmt/shared/authn/login")
+	case http.MethodPatch:
+		return strings.Contains(req.URL.Path, "/mgmt/shared/authz/tokens/")
+	}
+	return false
+}
+
+// isReadOnlyClient reports whether client was configured with read_only set.
+func isReadOnlyClient(client *bigip.BigIP) bool {
+	hooks, ok := clientHooks.Load(client)
+	return ok && hooks.(*transportHooks).readOnly
+}
+
+// clientHooks maps every configured *bigip.BigIP to its transportHooks, so standalone HTTP
+// clients built by resources (DO, service discovery) can apply the same hooks.
+var clientHooks sync.Map
+
+// installTransportHooks routes every request of the go-bigip client through hooks. go-bigip builds
+// a new http.Client around client.Transport for each call, so the hooks are registered as the
+// round tripper for the http and https schemes of that transport; the requests are then sent by
+// an inner transport sharing its TLS configuration and proxy.
+func installTransportHooks(client *bigip.BigIP, hooks *transportHooks) {
+	inner := &http.Transport{
+		TLSClientConfig: client.Transport.TLSClientConfig,
+		Proxy:           client.Transport.Proxy,
+	}
+	rt := &hookedTransport{hooks: hooks, next: inner}
+	client.Transport.RegisterProtocol("https", rt)
+	client.Transport.RegisterProtocol("http", rt)
+	clientHooks.Store(client, hooks)
+}
+
+// hookTransport wraps a standalone transport with the hooks installed on client, if any.
+func hookTransport(client *bigip.BigIP, tr http.RoundTripper) http.RoundTripper {
+	if hooks, ok := clientHooks.Load(client); ok {
+		return &hookedTransport{hooks: hooks.(*transportHooks), next: tr}
+	}
+	return tr
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransportHooksReadOnly(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var modified bool
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			modified = true
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	installTransportHooks(client, &transportHooks{readOnly: true})
+
+	_, err := client.APICall(&bigip.APIRequest{Method: "get", URL: "ltm/node"})
+	assert.NoError(t, err)
+
+	_, err = client.APICall(&bigip.APIRequest{Method: "post", URL: "ltm/node", Body: `{"name":"test"}`, ContentType: "application/json"})
+	assert.ErrorContains(t, err, errReadOnlyMode)
+	assert.False(t, modified, "read-only mode let a POST through")
+}
+
+func TestTransportHooksExtraHeadersAndAudit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var signature string
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get("X-Request-Signature")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	var audit bytes.Buffer
+	installTransportHooks(client, &transportHooks{
+		extraHeaders: map[string]string{"X-Request-Signature": "signed"},
+		audit:        &auditLog{w: &audit},
+	})
+
+	_, err := client.APICall(&bigip.APIRequest{Method: "get", URL: "ltm/node"})
+	assert.NoError(t, err)
+	assert.Equal(t, "signed", signature)
+
+	lines := strings.Split(strings.TrimSpace(audit.String()), "\n")
+	assert.Len(t, lines, 1)
+	var rec auditRecord
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &rec))
+	assert.Equal(t, http.MethodGet, rec.Method)
+	assert.Equal(t, "/mgmt/tm/ltm/node", rec.URI)
+	assert.Equal(t, http.StatusOK, rec.Status)
+}
+
+func TestIsReadOnlyRequest(t *testing.T) {
+	cases := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{http.MethodGet, "/mgmt/tm/ltm/pool", true},
+		{http.MethodPost, "/mgmt/shared/authn/login", true},
+		{http.MethodPatch, "/mgmt/shared/authz/tokens/ABC", true},
+		{http.MethodPost, "/mgmt/tm/ltm/pool", false},
+		{http.MethodPut, "/mgmt/tm/ltm/pool/~Common~p1", false},
+		{http.MethodPatch, "/mgmt/tm/sys/global-settings", false},
+		{http.MethodDelete, "/mgmt/tm/ltm/pool/~Common~p1", false},
+	}
+	for _, c := range cases {
+		req, _ := http.NewRequest(c.method, "https://bigip"+c.path, nil)
+		assert.Equal(t, c.want, isReadOnlyRequest(req), "%s %s", c.method, c.path)
+	}
+}
+
+func TestNewClientTokenLoginUsesHooks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var loginSignature, authToken string
+	mux.HandleFunc("/mgmt/shared/authn/login", func(w http.ResponseWriter, r *http.Request) {
+		loginSignature = r.Header.Get("X-Request-Signature")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"token":{"token":"ABC"},"timeout":{"timeout":1200}}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		authToken = r.Header.Get("X-F5-Auth-Token")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+
+	var audit bytes.Buffer
+	client, err := newClient(&bigip.Config{
+		Address:           server.URL,
+		Username:          "xxxx",
+		Password:          "xxxx",
+		LoginReference:    "tmos",
+		CertVerifyDisable: true,
+		ConfigOptions:     &bigip.ConfigOptions{TokenTimeout: 1200 * time.Second, APICallTimeout: 60 * time.Second, APICallRetries: 1},
+	}, &transportHooks{
+		extraHeaders: map[string]string{"X-Request-Signature": "signed"},
+		audit:        &auditLog{w: &audit},
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "ABC", client.Token)
+	assert.Equal(t, "signed", loginSignature, "token login bypassed the extra headers")
+	assert.Equal(t, "ABC", authToken)
+
+	lines := strings.Split(strings.TrimSpace(audit.String()), "\n")
+	assert.Len(t, lines, 2)
+	var rec auditRecord
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &rec))
+	assert.Equal(t, http.MethodPost, rec.Method)
+	assert.Equal(t, "/mgmt/shared/authn/login", rec.URI)
+}
+
+type closeRecorder struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestAuditLogClose(t *testing.T) {
+	w := &closeRecorder{}
+	a := &auditLog{w: w}
+	assert.NoError(t, a.close())
+	assert.True(t, w.closed)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://bigip/mgmt/tm/ltm/pool", nil)
+	a.record(req, nil, nil, 0)
+	assert.Zero(t, w.Len(), "audit log written after close")
+}
+
+func TestCloseAuditLogs(t *testing.T) {
+	w := &closeRecorder{}
+	newAuditLog(w)
+	CloseAuditLogs()
+	assert.True(t, w.closed)
+}
+
+func TestTransportHooksConfigured(t *testing.T) {
+	var none *transportHooks
+	assert.False(t, none.configured())
+	assert.False(t, (&transportHooks{extraHeaders: map[string]string{}}).configured())
+	assert.False(t, (&transportHooks{serverFingerprint: "AB:CD"}).configured())
+	assert.True(t, (&transportHooks{readOnly: true}).configured())
+	assert.True(t, (&transportHooks{extraHeaders: map[string]string{"X-Request-Signature": "signed"}}).configured())
+}
+
+func TestNewClientWithoutHooks(t *testing.T) {
+	client, err := newClient(&bigip.Config{Address: "https://bigip1.example.com"}, &transportHooks{extraHeaders: map[string]string{}}, nil)
+	assert.NoError(t, err)
+	_, ok := clientHooks.Load(client)
+	assert.False(t, ok, "hooks installed without any hook configured")
+}
+
+func TestTransportHooksKeepProxy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+	client := bigip.NewSession(&bigip.Config{Address: server.URL, Username: "xxxx", Password: "xxxx"})
+	var proxied int
+	client.Transport.Proxy = func(*http.Request) (*url.URL, error) {
+		proxied++
+		return nil, nil
+	}
+	installTransportHooks(client, &transportHooks{readOnly: true})
+	_, err := client.APICall(&bigip.APIRequest{Method: "get", URL: "ltm/node"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, proxied, "the proxy of the client was not used")
+}
+
+func TestTransportHooksRetryFolderNotVisible(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(interval time.Duration) { createRetryInterval = interval }(createRetryInterval)
+	createRetryInterval = time.Millisecond
+
+	var bodies []string
+	mux.HandleFunc("/mgmt/tm/ltm/pool", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		w.Header().Set("Content-Type", "application/json")
+		if len(bodies) < 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintf(w, `{"code":400,"message":"01020036:3: The requested folder (/tenant1/app1) was not found."}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"name":"pool1"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		bodies = append(bodies, "node")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintf(w, `{"code":400,"message":"01020036:3: The requested folder (/tenant1/app1) was not found."}`)
+	})
+
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+	})
+	client.ConfigOptions.APICallRetries = 1
+	installTransportHooks(client, &transportHooks{})
+
+	_, err := client.APICall(&bigip.APIRequest{Method: "post", URL: "ltm/pool", Body: `{"name":"/tenant1/app1/pool1"}`, ContentType: "application/json"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`{"name":"/tenant1/app1/pool1"}`, `{"name":"/tenant1/app1/pool1"}`, `{"name":"/tenant1/app1/pool1"}`}, bodies)
+
+	// gives up with the original error after createRetries attempts
+	bodies = nil
+	_, err = client.APICall(&bigip.APIRequest{Method: "post", URL: "ltm/node", Body: `{"name":"/tenant1/app1/node1"}`, ContentType: "application/json"})
+	assert.ErrorContains(t, err, "was not found")
+	assert.Len(t, bodies, createRetries)
+}
@@ -18,4 +18,8 @@ func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: bigip.Provider,
 	})
+	// Write the metrics and close the audit logs of the providers Terraform did not stop before
+	// closing the plugin.
+	bigip.FlushMetrics()
+	bigip.CloseAuditLogs()
 }